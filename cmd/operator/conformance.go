@@ -0,0 +1,374 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/environment"
+
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+const conformanceCommand = "conformance"
+
+const (
+	conformancePollInterval = 2 * time.Second
+	conformanceEventType    = "dev.knative.operator.conformance"
+	conformanceReceiverPort = 8080
+)
+
+var (
+	knativeServiceGVR = schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1", Resource: "services"}
+	brokerGVR         = schema.GroupVersionResource{Group: "eventing.knative.dev", Version: "v1", Resource: "brokers"}
+	triggerGVR        = schema.GroupVersionResource{Group: "eventing.knative.dev", Version: "v1", Resource: "triggers"}
+)
+
+// conformanceOptions are the options of a conformance check, which runs in a Job created by the
+// conformance stage of the operator.
+type conformanceOptions struct {
+	check     common.ConformanceCheck
+	namespace string
+	image     string
+	timeout   time.Duration
+	// jobName is the name of the Job running the check, whose pod receives the events.
+	jobName string
+}
+
+// runConformance runs a check of the trimmed Knative conformance subset against the cluster.
+func runConformance(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet(conformanceCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	env := new(environment.ClientConfig)
+	env.InitFlags(fs)
+	opts := conformanceOptions{jobName: os.Getenv("JOB_NAME")}
+	check := fs.String("check", "", fmt.Sprintf("Check to run, one of %s, %s and %s.",
+		common.ConformanceService, common.ConformanceScaleToZero, common.ConformanceBrokerTrigger))
+	fs.StringVar(&opts.namespace, "namespace", "default", "Namespace to create the resources of the check in.")
+	fs.StringVar(&opts.image, "image", "ghcr.io/knative/helloworld-go:latest", "Image of the Knative Services of the checks.")
+	fs.DurationVar(&opts.timeout, "timeout", 5*time.Minute, "Time the check may take.")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s %s -check CHECK [-namespace NAMESPACE]\n", os.Args[0], conformanceCommand)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	opts.check = common.ConformanceCheck(*check)
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	if err := checkConformance(ctx, env, opts); err != nil {
+		fmt.Fprintf(stderr, "The conformance check %s failed: %v\n", opts.check, err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "The conformance check %s passed\n", opts.check)
+	return 0
+}
+
+func checkConformance(ctx context.Context, env *environment.ClientConfig, opts conformanceOptions) error {
+	cfg, err := env.GetRESTConfig()
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	switch opts.check {
+	case common.ConformanceService:
+		return checkService(ctx, dynamicClient, opts)
+	case common.ConformanceScaleToZero:
+		return checkScaleToZero(ctx, dynamicClient, kubeClient, opts)
+	case common.ConformanceBrokerTrigger:
+		return checkBrokerTrigger(ctx, dynamicClient, kubeClient, opts)
+	default:
+		return fmt.Errorf("unknown check %q", opts.check)
+	}
+}
+
+// checkService creates a Knative Service, and requests it once it is ready.
+func checkService(ctx context.Context, client dynamic.Interface, opts conformanceOptions) error {
+	name, url, err := createKnativeService(ctx, client, opts, nil)
+	if name != "" {
+		defer deleteResource(client, knativeServiceGVR, opts.namespace, name)
+	}
+	if err != nil {
+		return err
+	}
+	return request(ctx, url)
+}
+
+// checkScaleToZero requests a Knative Service, and waits for its pods to be scaled down to zero.
+func checkScaleToZero(ctx context.Context, client dynamic.Interface, kubeClient kubernetes.Interface, opts conformanceOptions) error {
+	// The shortest stable window lets the Knative Service scale down soon after the request.
+	name, url, err := createKnativeService(ctx, client, opts, map[string]interface{}{
+		"autoscaling.knative.dev/window": "6s",
+	})
+	if name != "" {
+		defer deleteResource(client, knativeServiceGVR, opts.namespace, name)
+	}
+	if err != nil {
+		return err
+	}
+	if err := request(ctx, url); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, conformancePollInterval, true, func(ctx context.Context) (bool, error) {
+		pods, err := kubeClient.CoreV1().Pods(opts.namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: "serving.knative.dev/service=" + name,
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp == nil {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// checkBrokerTrigger sends an event to a Broker, and waits for a Trigger to deliver it to a receiver
+// in the pod of the Job.
+func checkBrokerTrigger(ctx context.Context, client dynamic.Interface, kubeClient kubernetes.Interface, opts conformanceOptions) error {
+	if opts.jobName == "" {
+		return errors.New("the env var JOB_NAME is not set")
+	}
+	received := make(chan struct{}, 1)
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", conformanceReceiverPort))
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		ReadHeaderTimeout: 10 * time.Second,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Ce-Type") == conformanceEventType {
+				select {
+				case received <- struct{}{}:
+				default:
+				}
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}),
+	}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	service := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"generateName": "conformance-receiver-"},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"job-name": opts.jobName},
+			"ports": []interface{}{map[string]interface{}{
+				"port":       int64(80),
+				"targetPort": int64(conformanceReceiverPort),
+			}},
+		},
+	}}
+	svcGVR := schema.GroupVersionResource{Version: "v1", Resource: "services"}
+	svc, err := client.Resource(svcGVR).Namespace(opts.namespace).Create(ctx, service, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create the receiver: %w", err)
+	}
+	defer deleteResource(client, svcGVR, opts.namespace, svc.GetName())
+
+	broker := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "eventing.knative.dev/v1",
+		"kind":       "Broker",
+		"metadata":   map[string]interface{}{"generateName": "conformance-"},
+	}}
+	b, err := client.Resource(brokerGVR).Namespace(opts.namespace).Create(ctx, broker, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create the broker: %w", err)
+	}
+	defer deleteResource(client, brokerGVR, opts.namespace, b.GetName())
+
+	trigger := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "eventing.knative.dev/v1",
+		"kind":       "Trigger",
+		"metadata":   map[string]interface{}{"generateName": "conformance-"},
+		"spec": map[string]interface{}{
+			"broker": b.GetName(),
+			"filter": map[string]interface{}{
+				"attributes": map[string]interface{}{"type": conformanceEventType},
+			},
+			"subscriber": map[string]interface{}{
+				"ref": map[string]interface{}{"apiVersion": "v1", "kind": "Service", "name": svc.GetName()},
+			},
+		},
+	}}
+	tr, err := client.Resource(triggerGVR).Namespace(opts.namespace).Create(ctx, trigger, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create the trigger: %w", err)
+	}
+	defer deleteResource(client, triggerGVR, opts.namespace, tr.GetName())
+
+	url, err := waitReady(ctx, client, brokerGVR, opts.namespace, b.GetName())
+	if err != nil {
+		return err
+	}
+	if _, err := waitReady(ctx, client, triggerGVR, opts.namespace, tr.GetName()); err != nil {
+		return err
+	}
+	// The event is sent again, until it is received, as the Trigger may not be programmed in the
+	// data plane yet, when it is ready.
+	for i := 0; ; i++ {
+		if err := sendEvent(ctx, url, fmt.Sprintf("%s-%d", opts.jobName, i)); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to send the event: %v\n", err)
+		}
+		select {
+		case <-received:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("the event was not delivered: %w", ctx.Err())
+		case <-time.After(5 * conformancePollInterval):
+		}
+	}
+}
+
+// createKnativeService creates a Knative Service with the annotations on its revisions, and returns its
+// name and URL, once it is ready.
+func createKnativeService(ctx context.Context, client dynamic.Interface, opts conformanceOptions, annotations map[string]interface{}) (string, string, error) {
+	template := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"image": opts.image}},
+		},
+	}
+	if annotations != nil {
+		template["metadata"] = map[string]interface{}{"annotations": annotations}
+	}
+	ksvc := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "serving.knative.dev/v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"generateName": "conformance-"},
+		"spec":       map[string]interface{}{"template": template},
+	}}
+	created, err := client.Resource(knativeServiceGVR).Namespace(opts.namespace).Create(ctx, ksvc, metav1.CreateOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create the Knative Service: %w", err)
+	}
+	url, err := waitReady(ctx, client, knativeServiceGVR, opts.namespace, created.GetName())
+	return created.GetName(), url, err
+}
+
+// waitReady waits for the resource to be ready, and returns its address.
+func waitReady(ctx context.Context, client dynamic.Interface, gvr schema.GroupVersionResource, namespace, name string) (string, error) {
+	var url string
+	err := wait.PollUntilContextCancel(ctx, conformancePollInterval, true, func(ctx context.Context) (bool, error) {
+		u, err := client.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+		for _, c := range conditions {
+			c, _ := c.(map[string]interface{})
+			if c["type"] == "Ready" && c["status"] == "True" {
+				url, _, _ = unstructured.NestedString(u.Object, "status", "address", "url")
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("%s %s is not ready: %w", gvr.Resource, name, err)
+	}
+	return url, nil
+}
+
+// request requests the URL, until it responds with 200, as the ingress may not be programmed yet,
+// when the Knative Service is ready.
+func request(ctx context.Context, url string) error {
+	var lastErr error
+	err := wait.PollUntilContextCancel(ctx, conformancePollInterval, true, func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return false, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			lastErr = fmt.Errorf("status %d", resp.StatusCode)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to request %s: %v (last error: %w)", url, err, lastErr)
+	}
+	return nil
+}
+
+// sendEvent posts a CloudEvent in the binary content mode to the URL.
+func sendEvent(ctx context.Context, url, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBufferString(`{"conformance":true}`))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Ce-Specversion", "1.0")
+	req.Header.Set("Ce-Id", id)
+	req.Header.Set("Ce-Type", conformanceEventType)
+	req.Header.Set("Ce-Source", "knative-operator/conformance")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// deleteResource deletes a resource of a check, also after the check timed out.
+func deleteResource(client dynamic.Interface, gvr schema.GroupVersionResource, namespace, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	propagation := metav1.DeletePropagationBackground
+	err := client.Resource(gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !apierrors.IsNotFound(err) {
+		fmt.Fprintf(os.Stderr, "Failed to delete %s %s: %v\n", gvr.Resource, name, err)
+	}
+}
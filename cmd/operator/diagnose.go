@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/environment"
+
+	clientset "knative.dev/operator/pkg/client/clientset/versioned"
+	"knative.dev/operator/pkg/diagnose"
+)
+
+const diagnoseCommand = "diagnose"
+
+// runDiagnose collects the troubleshooting bundle of the Knative components from the cluster.
+func runDiagnose(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet(diagnoseCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	env := new(environment.ClientConfig)
+	env.InitFlags(fs)
+	output := fs.String("o", "", "Path of the gzipped tarball to write, the bundle is printed to stdout if empty.")
+	opts := diagnose.Options{}
+	fs.StringVar(&opts.OperatorNamespace, "operator-namespace", "knative-operator", "Namespace of the operator deployment.")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s %s [-o FILE] [-kubeconfig FILE]\n", os.Args[0], diagnoseCommand)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if err := collectDiagnostics(env, opts, *output, stdout); err != nil {
+		fmt.Fprintf(stderr, "Failed to collect the diagnostics: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func collectDiagnostics(env *environment.ClientConfig, opts diagnose.Options, output string, stdout io.Writer) error {
+	cfg, err := env.GetRESTConfig()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	operatorClient, err := clientset.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := diagnose.Collect(context.Background(), kubeClient, operatorClient, opts)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		return bundle.WriteText(stdout)
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	if err := bundle.WriteTarball(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/helmvalues"
+)
+
+const importHelmCommand = "import-helm"
+
+// runImportHelm prints the KnativeServing and KnativeEventing resources, which install what the
+// helm-style values in the given file configure.
+func runImportHelm(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet(importHelmCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	filename := fs.String("f", "-", "File containing the helm values, - for stdin.")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s %s [-f FILE]\n", os.Args[0], importHelmCommand)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if err := importHelmValues(*filename, stdout); err != nil {
+		fmt.Fprintf(stderr, "Failed to import the helm values: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func importHelmValues(filename string, out io.Writer) error {
+	var data []byte
+	var err error
+	if filename == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return err
+	}
+	components, err := helmvalues.Convert(data)
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(component)
+		if err != nil {
+			return err
+		}
+		// Neither the status nor the empty creation timestamp belong into the resources to apply.
+		delete(obj, "status")
+		unstructured.RemoveNestedField(obj, "metadata", "creationTimestamp")
+		if certs, _, _ := unstructured.NestedStringMap(obj, "spec", "controller-custom-certs"); certs["name"] == "" {
+			unstructured.RemoveNestedField(obj, "spec", "controller-custom-certs")
+		}
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog/v2"
+	"knative.dev/pkg/environment"
+	"knative.dev/pkg/system"
+)
+
+// defaultSystemNamespace is the namespace of the operator, if it runs outside of a pod.
+const defaultSystemNamespace = "knative-operator"
+
+// clientConfig extends the flags of environment.ClientConfig with the context of the kubeconfig,
+// so that the operator can run outside of the cluster it operates, e.g. against a local kind
+// cluster, or from a management cluster.
+type clientConfig struct {
+	environment.ClientConfig
+	// Context is the context of the kubeconfig, defaults to the current context.
+	Context string
+}
+
+func (c *clientConfig) InitFlags(fs *flag.FlagSet) {
+	c.ClientConfig.InitFlags(fs)
+	fs.StringVar(&c.Context, "context", "", "The context of the kubeconfig to use. Defaults to the current context. Only used if out-of-cluster.")
+}
+
+func (c *clientConfig) GetRESTConfig() (*rest.Config, error) {
+	if c.Context == "" {
+		return c.ClientConfig.GetRESTConfig()
+	}
+	// The same as environment.ClientConfig, except for the context.
+	if c.Burst < 0 {
+		return nil, fmt.Errorf("provided burst value %d must be > 0", c.Burst)
+	}
+	if c.QPS < 0 || c.QPS > math.MaxFloat32 {
+		return nil, fmt.Errorf("provided QPS value %f must be >0 and <3.4+e38", c.QPS)
+	}
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = c.Kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: c.Context}
+	if c.Cluster != "" {
+		overrides.Context = clientcmdapi.Context{Cluster: c.Cluster}
+	} else if c.ServerURL != "" {
+		overrides.ClusterInfo = clientcmdapi.Cluster{Server: c.ServerURL}
+	}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client config for the context %q: %w", c.Context, err)
+	}
+	config.QPS = float32(c.QPS)
+	config.Burst = c.Burst
+	return config, nil
+}
+
+// parseAndGetRESTConfigOrDie replaces injection.ParseAndGetRESTConfigOrDie with the flags of
+// clientConfig. Outside of a pod, it defaults the namespace of the operator, unless it is set.
+func parseAndGetRESTConfigOrDie() *rest.Config {
+	env := &clientConfig{}
+	env.InitFlags(flag.CommandLine)
+	klog.InitFlags(flag.CommandLine)
+	flag.Parse()
+	cfg, err := env.GetRESTConfig()
+	if err != nil {
+		log.Fatal("Error building kubeconfig: ", err)
+	}
+	if _, inCluster := os.LookupEnv("KUBERNETES_SERVICE_HOST"); !inCluster && os.Getenv(system.NamespaceEnvKey) == "" {
+		log.Printf("Running out of cluster, defaulting %s to %s", system.NamespaceEnvKey, defaultSystemNamespace)
+		if err := os.Setenv(system.NamespaceEnvKey, defaultSystemNamespace); err != nil {
+			log.Fatal("Error setting the namespace: ", err)
+		}
+	}
+	return cfg
+}
@@ -17,21 +17,118 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/operator/pkg/admin"
+	"knative.dev/operator/pkg/extension"
+	"knative.dev/operator/pkg/reconciler/common"
 	"knative.dev/operator/pkg/reconciler/knativeeventing"
+	"knative.dev/operator/pkg/reconciler/knativefunctions"
+	"knative.dev/operator/pkg/reconciler/knativenetworking"
 	"knative.dev/operator/pkg/reconciler/knativeserving"
+	"knative.dev/operator/pkg/reconciler/openshift"
+	"knative.dev/operator/pkg/reconciler/storageversion"
 	kubefilteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/sharedmain"
+	"knative.dev/pkg/leaderelection"
 	"knative.dev/pkg/signals"
 )
 
 func main() {
-	ctx := signals.NewContext()
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case renderCommand:
+			os.Exit(runRender(os.Args[2:], os.Stdout, os.Stderr))
+		case diagnoseCommand:
+			os.Exit(runDiagnose(os.Args[2:], os.Stdout, os.Stderr))
+		case importHelmCommand:
+			os.Exit(runImportHelm(os.Args[2:], os.Stdout, os.Stderr))
+		case conformanceCommand:
+			os.Exit(runConformance(os.Args[2:], os.Stdout, os.Stderr))
+		}
+	}
+
+	cfg, err := common.ControllerConfigFromEnv()
+	if err != nil {
+		log.Fatal("Error reading the controller configuration: ", err)
+	}
+	ctx := common.WithControllerConfig(signals.NewContext(), cfg)
 	ctx = kubefilteredfactory.WithSelectors(ctx,
 		knativeserving.Selector,
 		knativeeventing.Selector,
+		knativefunctions.Selector,
+		knativenetworking.Selector,
 	)
-	sharedmain.MainWithContext(ctx, "knative-operator",
-		knativeserving.NewController,
-		knativeeventing.NewController,
+
+	// The flow of sharedmain.MainWithContext, with the leader election configured by the operator.
+	if val, ok := os.LookupEnv("K_THREADS_PER_CONTROLLER"); ok {
+		threadsPerController, err := strconv.Atoi(val)
+		if err != nil {
+			log.Fatalf("failed to parse value %q of K_THREADS_PER_CONTROLLER: %v\n", val, err)
+		}
+		controller.DefaultThreadsPerController = threadsPerController
+	}
+	disableHA := flag.Bool("disable-ha", false, "Whether to disable the leader election, e.g. for a single replica in development.")
+	restConfig := parseAndGetRESTConfigOrDie()
+	disabled, err := common.LeaderElectionDisabled()
+	if err != nil {
+		log.Fatal("Error reading the leader election configuration: ", err)
+	}
+	if *disableHA || disabled {
+		ctx = sharedmain.WithHADisabled(ctx)
+	} else {
+		leConfig, err := common.LeaderElectionConfig(ctx, kubernetes.NewForConfigOrDie(restConfig))
+		if err != nil {
+			log.Fatal("Error reading the leader election configuration: ", err)
+		}
+		ctx = leaderelection.WithConfig(ctx, leConfig)
+	}
+
+	servingController, eventingController := knativeserving.NewController, knativeeventing.NewController
+	if cfg.Platform == common.PlatformOpenShift {
+		servingController = extension.NewServingController(openshift.NewExtension)
+		eventingController = extension.NewEventingController(openshift.NewExtension)
+	}
+	ctx, ctors := common.WatchNamespaces(ctx,
+		servingController,
+		eventingController,
+		knativefunctions.NewController,
+		knativenetworking.NewController,
 	)
+	// The migration is cluster-wide, it is not scoped to the watched namespaces.
+	ctors = append(ctors, storageversion.NewController)
+	ctx, ctors, err = common.WatchOperatorConfig(ctx, kubernetes.NewForConfigOrDie(restConfig), ctors...)
+	if err != nil {
+		log.Fatal("Error reading the operator configuration: ", err)
+	}
+	// Under OLM, the Upgradeable condition holds back the replacement of the operator during upgrades.
+	ctx = common.WithUpgradeGate(ctx, dynamic.NewForConfigOrDie(restConfig))
+	startAdminServer(ctx, admin.NewServer(cfg.AdminAddress, admin.Options{
+		Store:     common.GetOperatorConfigStore(ctx),
+		Profiling: cfg.AdminProfiling,
+	}))
+	sharedmain.MainWithConfig(ctx, "knative-operator", restConfig, ctors...)
+}
+
+// startAdminServer serves the admin endpoint until the context is done. The operator keeps running
+// without it, if it fails.
+func startAdminServer(ctx context.Context, server *http.Server) {
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Print("Error serving the admin endpoint: ", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
 }
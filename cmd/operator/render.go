@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/logging"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/knativeeventing"
+	"knative.dev/operator/pkg/reconciler/knativefunctions"
+	"knative.dev/operator/pkg/reconciler/knativenetworking"
+	"knative.dev/operator/pkg/reconciler/knativeserving"
+)
+
+const renderCommand = "render"
+
+// runRender prints the manifests, which the operator would apply for the KnativeServing,
+// KnativeEventing, KnativeFunctions and KnativeNetworking resources in the given file, without
+// accessing a cluster.
+func runRender(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet(renderCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	filename := fs.String("f", "-", "File containing the KnativeServing, KnativeEventing, KnativeFunctions and/or KnativeNetworking resources, - for stdin.")
+	version := fs.String("version", "", "Target version overriding spec.version of the resources.")
+	verbose := fs.Bool("v", false, "Log the progress to stderr.")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s %s [-f FILE] [-version VERSION]\n", os.Args[0], renderCommand)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+	if *verbose {
+		l, err := zap.NewDevelopment()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		logger = l.Sugar()
+	}
+	ctx = logging.WithLogger(ctx, logger)
+
+	if err := renderFile(ctx, *filename, *version, stdout); err != nil {
+		fmt.Fprintf(stderr, "Failed to render the manifests: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func renderFile(ctx context.Context, filename, version string, out io.Writer) error {
+	in := os.Stdin
+	if filename != "-" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	input, err := mf.ManifestFrom(mf.Reader(in))
+	if err != nil {
+		return err
+	}
+	if len(input.Resources()) == 0 {
+		return fmt.Errorf("no resources found in %s", filename)
+	}
+
+	for _, u := range input.Resources() {
+		manifest, err := renderComponent(ctx, u, version)
+		if err != nil {
+			return fmt.Errorf("%s %s/%s: %w", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+		}
+		if err := writeManifest(out, manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func renderComponent(ctx context.Context, u unstructured.Unstructured, version string) (*mf.Manifest, error) {
+	if u.GroupVersionKind().GroupVersion() != v1beta1.SchemeGroupVersion {
+		return nil, fmt.Errorf("unsupported apiVersion %s, only %s is supported", u.GetAPIVersion(), v1beta1.SchemeGroupVersion)
+	}
+	switch u.GetKind() {
+	case "KnativeServing":
+		ks := &v1beta1.KnativeServing{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ks); err != nil {
+			return nil, err
+		}
+		if version != "" {
+			ks.Spec.Version = version
+		}
+		return knativeserving.Render(ctx, ks)
+	case "KnativeEventing":
+		ke := &v1beta1.KnativeEventing{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ke); err != nil {
+			return nil, err
+		}
+		if version != "" {
+			ke.Spec.Version = version
+		}
+		return knativeeventing.Render(ctx, ke)
+	case "KnativeFunctions":
+		kf := &v1beta1.KnativeFunctions{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, kf); err != nil {
+			return nil, err
+		}
+		if version != "" {
+			kf.Spec.Version = version
+		}
+		return knativefunctions.Render(ctx, kf)
+	case "KnativeNetworking":
+		kn := &v1beta1.KnativeNetworking{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, kn); err != nil {
+			return nil, err
+		}
+		if version != "" {
+			kn.Spec.Version = version
+		}
+		return knativenetworking.Render(ctx, kn)
+	}
+	return nil, fmt.Errorf("unsupported kind %s", u.GetKind())
+}
+
+// writeManifest prints the resources as a multi-document YAML stream.
+func writeManifest(out io.Writer, manifest *mf.Manifest) error {
+	for _, u := range manifest.Resources() {
+		// Without the UID of the live component, the owner reference would be rejected by the API server.
+		if refs := u.GetOwnerReferences(); len(refs) > 0 && refs[0].UID == "" {
+			u.SetOwnerReferences(nil)
+		}
+		data, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/reconciler/common"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+// TestRenderGolden renders the complete manifests of the representative resources in testdata/render
+// and compares them against their golden files, so that any change of a transformer shows its full
+// effect in the diff of the golden files. Run it with UPDATE_GOLDEN=true to update them.
+func TestRenderGolden(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "kodata")
+	inputs, err := filepath.Glob(filepath.Join("testdata", "render", "*.yaml"))
+	if err != nil {
+		t.Fatalf("Glob() = %v", err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("No resources found in testdata/render")
+	}
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".yaml")
+		t.Run(name, func(t *testing.T) {
+			ctx := logging.WithLogger(context.Background(), zap.NewNop().Sugar())
+			var out bytes.Buffer
+			if err := renderFile(ctx, input, "", &out); err != nil {
+				t.Fatalf("renderFile(%s) = %v", input, err)
+			}
+			util.AssertGolden(t, filepath.Join("testdata", "render", name+".golden"), out.Bytes())
+		})
+	}
+}
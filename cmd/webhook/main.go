@@ -21,13 +21,16 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/operator/pkg/apis/operator"
+	operatorv1alpha1 "knative.dev/operator/pkg/apis/operator/v1alpha1"
 	operatorv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/pkg/webhook/certificates"
+	"knative.dev/operator/pkg/webhook/validation"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/sharedmain"
 	"knative.dev/pkg/signals"
 	"knative.dev/pkg/webhook"
-	"knative.dev/pkg/webhook/certificates"
 	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 )
 
@@ -36,18 +39,31 @@ func main() {
 	ctx := webhook.WithOptions(signals.NewContext(), webhook.Options{
 		ServiceName: webhook.NameFromEnv(),
 		Port:        webhook.PortFromEnv(8443),
-		SecretName:  webhook.SecretNameFromEnv("operator-webhook-certs"),
+		SecretName:  webhook.SecretNameFromEnv(common.OperatorWebhookSecretName),
 	})
 
 	sharedmain.WebhookMainWithContext(ctx, webhook.NameFromEnv(),
 		certificates.NewController,
 		newConversionController,
+		newValidationController,
+	)
+}
+
+func newValidationController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return validation.NewAdmissionController(ctx,
+		// Name of the resource webhook, it is the value of the field metadata.name of the
+		// ValidatingWebhookConfiguration.
+		"validation.webhook.operator.knative.dev",
+
+		// The path on which to serve the webhook.
+		"/resource-validation",
 	)
 }
 
 func newConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	var (
-		v1beta1 = operatorv1beta1.SchemeGroupVersion.Version
+		v1alpha1 = operatorv1alpha1.SchemeGroupVersion.Version
+		v1beta1  = operatorv1beta1.SchemeGroupVersion.Version
 	)
 
 	return conversion.NewConversionController(ctx,
@@ -60,14 +76,16 @@ func newConversionController(ctx context.Context, cmw configmap.Watcher) *contro
 				DefinitionName: operator.KnativeServingResource.String(),
 				HubVersion:     v1beta1,
 				Zygotes: map[string]conversion.ConvertibleObject{
-					v1beta1: &operatorv1beta1.KnativeServing{},
+					v1alpha1: &operatorv1alpha1.KnativeServing{},
+					v1beta1:  &operatorv1beta1.KnativeServing{},
 				},
 			},
 			operatorv1beta1.Kind("KnativeEventing"): {
 				DefinitionName: operator.KnativeEventingResource.String(),
 				HubVersion:     v1beta1,
 				Zygotes: map[string]conversion.ConvertibleObject{
-					v1beta1: &operatorv1beta1.KnativeEventing{},
+					v1alpha1: &operatorv1alpha1.KnativeEventing{},
+					v1beta1:  &operatorv1beta1.KnativeEventing{},
 				},
 			},
 		},
@@ -25,7 +25,7 @@ kustomize build config/manifests | operator-sdk generate bundle -q --overwrite -
 operator-sdk bundle validate ./bundle
 
 # Rename the files of the manifests to conform the naming convention
-array=(config-logging_v1_configmap.yaml config-observability_v1_configmap.yaml operator-webhook-certs_v1_secret.yaml operator-webhook_v1_service.yaml)
+array=(config-logging_v1_configmap.yaml config-observability_v1_configmap.yaml config-leader-election_v1_configmap.yaml config-operator_v1_configmap.yaml operator-webhook-certs_v1_secret.yaml operator-webhook_v1_service.yaml)
 for file in "${array[@]}"
 do
 	mv bundle/manifests/${file} bundle/manifests/"knative-operator-"${file}
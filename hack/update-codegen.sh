@@ -38,6 +38,7 @@ kube::codegen::gen_client \
   --output-dir "${REPO_ROOT_DIR}/pkg/client" \
   --output-pkg "knative.dev/operator/pkg/client" \
   --with-watch \
+  --plural-exceptions "KnativeFunctions:KnativeFunctions" \
   "${REPO_ROOT_DIR}/pkg/apis"
 
 group "Knative Codegen"
@@ -45,7 +46,12 @@ group "Knative Codegen"
 ${KNATIVE_CODEGEN_PKG}/hack/generate-knative.sh "injection" \
   knative.dev/operator/pkg/client knative.dev/operator/pkg/apis \
   "operator:v1beta1" \
-  --go-header-file "${boilerplate}"
+  --go-header-file "${boilerplate}" \
+  --plural-exceptions "KnativeFunctions:KnativeFunctions"
+
+# The informers of injection don't take the plural exceptions into account.
+find "${REPO_ROOT_DIR}/pkg/client/injection/informers" -name '*.go' -path '*knativefunctions*' \
+  -exec sed -i 's/KnativeFunctionses()/KnativeFunctions()/g' {} +
 
 group "Deepcopy Gen"
 
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admin serves the admin endpoint of the operator, which changes the operator at runtime,
+// e.g. the log level during an incident, without a restart.
+package admin
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+const (
+	// LogLevelPath is the path of the log level of the operator.
+	LogLevelPath = "/loglevel"
+	// PprofPath is the path prefix of the pprof endpoints, which are only served with profiling.
+	PprofPath = "/debug/pprof/"
+	// ExpvarPath is the path of the expvar endpoint, which is only served with profiling.
+	ExpvarPath = "/debug/vars"
+
+	// The sources of the log level reported by LogLevelPath.
+	SourceAdmin          = "admin"
+	SourceConfigOperator = common.OperatorConfigName
+	SourceConfigLogging  = "config-logging"
+
+	// maxBodyBytes limits the size of the requests, which only carry a log level.
+	maxBodyBytes = 1 << 10
+)
+
+// LogLevel is the response of LogLevelPath.
+type LogLevel struct {
+	// Level is the log level, empty if the level of config-logging applies.
+	Level string `json:"level,omitempty"`
+	// Source is where the log level is set.
+	Source string `json:"source"`
+}
+
+// Options configure the admin endpoint.
+type Options struct {
+	// Store is the OperatorConfigStore, whose log level is changed.
+	Store *common.OperatorConfigStore
+	// Profiling serves the pprof and expvar endpoints, which are disabled by default, as they expose
+	// the memory of the operator and profiling slows it down.
+	Profiling bool
+}
+
+// NewServer returns the server of the admin endpoint on the address.
+func NewServer(addr string, opts Options) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           NewHandler(opts),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+// NewHandler returns the handler of the admin endpoint.
+//
+// GET /loglevel returns the log level and its source. PUT /loglevel with a level, e.g. debug, in
+// the body overrides the level of the config maps, until DELETE /loglevel resets it.
+//
+// With profiling, /debug/pprof/ serves the profiles of net/http/pprof and /debug/vars the
+// variables of expvar, including the memory statistics of the runtime.
+func NewHandler(opts Options) http.Handler {
+	store := opts.Store
+	mux := http.NewServeMux()
+	if opts.Profiling {
+		mux.HandleFunc(PprofPath, pprof.Index)
+		mux.HandleFunc(PprofPath+"cmdline", pprof.Cmdline)
+		mux.HandleFunc(PprofPath+"profile", pprof.Profile)
+		mux.HandleFunc(PprofPath+"symbol", pprof.Symbol)
+		mux.HandleFunc(PprofPath+"trace", pprof.Trace)
+		mux.Handle(ExpvarPath, expvar.Handler())
+	}
+	mux.HandleFunc(LogLevelPath, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			level, err := zapcore.ParseLevel(strings.TrimSpace(string(body)))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			store.SetLogLevel(&level)
+		case http.MethodDelete:
+			store.SetLogLevel(nil)
+		default:
+			w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPut, http.MethodDelete}, ", "))
+			http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(currentLogLevel(store))
+	})
+	return mux
+}
+
+func currentLogLevel(store *common.OperatorConfigStore) LogLevel {
+	if level := store.LogLevelOverride(); level != nil {
+		return LogLevel{Level: level.String(), Source: SourceAdmin}
+	}
+	if level := store.Load().LogLevel; level != nil {
+		return LogLevel{Level: level.String(), Source: SourceConfigOperator}
+	}
+	return LogLevel{Source: SourceConfigLogging}
+}
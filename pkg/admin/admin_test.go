@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/operator/pkg/reconciler/common"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestLogLevel(t *testing.T) {
+	store := common.NewOperatorConfigStore(common.ControllerConfig{})
+	handler := NewHandler(Options{Store: store})
+
+	do := func(method, body string, wantCode int) LogLevel {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, LogLevelPath, strings.NewReader(body)))
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s = %d, wanted %d", method, LogLevelPath, rec.Code, wantCode)
+		}
+		got := LogLevel{}
+		if wantCode == http.StatusOK {
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("Failed to decode the response %q: %v", rec.Body.String(), err)
+			}
+		}
+		return got
+	}
+
+	util.AssertDeepEqual(t, do(http.MethodGet, "", http.StatusOK), LogLevel{Source: SourceConfigLogging})
+
+	store.OnConfigChanged(&corev1.ConfigMap{Data: map[string]string{common.LogLevelKey: "warn"}})
+	util.AssertDeepEqual(t, do(http.MethodGet, "", http.StatusOK), LogLevel{Level: "warn", Source: SourceConfigOperator})
+
+	// The override takes precedence over the config map.
+	util.AssertDeepEqual(t, do(http.MethodPut, "debug\n", http.StatusOK), LogLevel{Level: "debug", Source: SourceAdmin})
+	util.AssertEqual(t, *store.LogLevel(), zapcore.DebugLevel)
+	do(http.MethodPut, "verbose", http.StatusBadRequest)
+	util.AssertEqual(t, *store.LogLevel(), zapcore.DebugLevel)
+	do(http.MethodPost, "info", http.StatusMethodNotAllowed)
+
+	util.AssertDeepEqual(t, do(http.MethodDelete, "", http.StatusOK), LogLevel{Level: "warn", Source: SourceConfigOperator})
+	util.AssertEqual(t, *store.LogLevel(), zapcore.WarnLevel)
+}
+
+func TestProfiling(t *testing.T) {
+	store := common.NewOperatorConfigStore(common.ControllerConfig{})
+	for _, profiling := range []bool{false, true} {
+		handler := NewHandler(Options{Store: store, Profiling: profiling})
+		want := http.StatusNotFound
+		if profiling {
+			want = http.StatusOK
+		}
+		for _, path := range []string{PprofPath, PprofPath + "heap", ExpvarPath} {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+			if rec.Code != want {
+				t.Errorf("GET %s with profiling %v = %d, wanted %d", path, profiling, rec.Code, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AppliedManifest records what the operator last applied for a component, so that audits can
+// tell exactly what was installed and when.
+type AppliedManifest struct {
+	// Sources are the manifests, which the applied resources were rendered from.
+	// +optional
+	Sources []ManifestSource `json:"sources,omitempty"`
+
+	// Digest is the sha256 digest of the applied resources, after all the transformations.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// ResourceCounts are the numbers of the applied resources by kind.
+	// +optional
+	ResourceCounts map[string]int `json:"resourceCounts,omitempty"`
+
+	// Time is the time of the last successful apply, which changed the sources, the digest or
+	// the resource counts.
+	// +optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// ManifestSource is a manifest, which the applied resources were rendered from.
+type ManifestSource struct {
+	// Path is the path or the url of the manifest.
+	Path string `json:"path"`
+
+	// Digest is the sha256 digest of the resources of the manifest, as they were read, empty if
+	// the manifest could not be read again.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
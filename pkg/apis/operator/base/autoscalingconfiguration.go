@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// AutoscalingConfiguration specifies the cluster-wide defaults of the Knative Pod Autoscaler, which
+// are rendered into config-autoscaler.
+type AutoscalingConfiguration struct {
+	// TargetConcurrency is the default number of concurrent requests per replica, which the
+	// autoscaler aims for, i.e. container-concurrency-target-default.
+	// +optional
+	TargetConcurrency *int64 `json:"targetConcurrency,omitempty"`
+
+	// TargetUtilizationPercentage is the percentage of the container concurrency of a revision, which
+	// the autoscaler aims for, i.e. container-concurrency-target-percentage.
+	// +optional
+	TargetUtilizationPercentage *int64 `json:"targetUtilizationPercentage,omitempty"`
+
+	// ScaleToZeroGracePeriod is the time, which the last replica of a revision is kept after the
+	// revision scaled to zero.
+	// +optional
+	ScaleToZeroGracePeriod *metav1.Duration `json:"scaleToZeroGracePeriod,omitempty"`
+
+	// InitialScale is the number of replicas of a new revision.
+	// +optional
+	InitialScale *int32 `json:"initialScale,omitempty"`
+
+	// AllowZeroInitialScale allows an initial scale of zero for the revisions.
+	// +optional
+	AllowZeroInitialScale *bool `json:"allowZeroInitialScale,omitempty"`
+
+	// PanicWindowPercentage is the length of the panic window, in percent of the stable window.
+	// +optional
+	PanicWindowPercentage *int64 `json:"panicWindowPercentage,omitempty"`
+
+	// MinScale is the default lower bound of the replicas of a revision.
+	// +optional
+	MinScale *int32 `json:"minScale,omitempty"`
+
+	// MaxScale is the default upper bound of the replicas of a revision, zero for no bound.
+	// +optional
+	MaxScale *int32 `json:"maxScale,omitempty"`
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import duckv1 "knative.dev/pkg/apis/duck/v1"
+
+// BrokerConfiguration specifies the defaults of the brokers, which set no configuration of their
+// own. It is rendered into the cluster default of config-br-defaults.
+type BrokerConfiguration struct {
+	// Config refers to the configuration of the brokers of the default broker class, e.g. the
+	// ConfigMap config-br-default-channel with the channel of the MTChannelBasedBroker.
+	// +optional
+	Config *duckv1.KReference `json:"config,omitempty"`
+
+	// Delivery configures the delivery of the events of the brokers.
+	// +optional
+	Delivery *BrokerDeliveryConfiguration `json:"delivery,omitempty"`
+}
+
+// BrokerDeliveryConfiguration specifies the retries of the events, which a broker fails to deliver,
+// and where they are sent to after the last retry.
+type BrokerDeliveryConfiguration struct {
+	// Retry is the number of retries of an event, before it is sent to the dead-letter sink.
+	// +optional
+	Retry *int32 `json:"retry,omitempty"`
+
+	// BackoffPolicy is the policy of the delay between the retries, linear or exponential.
+	// +optional
+	BackoffPolicy string `json:"backoffPolicy,omitempty"`
+
+	// BackoffDelay is the delay before the first retry, an ISO 8601 duration, e.g. PT0.2S.
+	// +optional
+	BackoffDelay string `json:"backoffDelay,omitempty"`
+
+	// DeadLetterSink is the sink of the events, which could not be delivered.
+	// +optional
+	DeadLetterSink *duckv1.Destination `json:"deadLetterSink,omitempty"`
+}
+
+// TriggerFiltersConfiguration specifies the filters of the triggers of the brokers.
+type TriggerFiltersConfiguration struct {
+	// New sets the new-trigger-filters flag of config-features, which enables the filters field of
+	// triggers, with the CloudEvents SQL dialect among others. Only versions with the flag support it.
+	// +optional
+	New *bool `json:"new,omitempty"`
+}
@@ -37,6 +37,61 @@ const (
 	// VersionMigrationEligible is a Condition indicating whether or not the current version of
 	// Knative component is eligible to upgrade or downgrade to the specified version.
 	VersionMigrationEligible apis.ConditionType = "VersionMigrationEligible"
+	// PreviewReady is a Condition indicating whether or not the preview of the changes, which
+	// would be applied to the cluster in dry-run mode, has been published.
+	PreviewReady apis.ConditionType = "PreviewReady"
+	// PreflightChecksPassed is a Condition indicating whether or not the cluster meets the requirements
+	// of the version of the Knative component to be installed or upgraded to.
+	PreflightChecksPassed apis.ConditionType = "PreflightChecksPassed"
+	// Paused is a Condition indicating that the reconciliation of the component is paused.
+	Paused apis.ConditionType = "Paused"
+	// VersionSkewWarning is a Condition indicating that the version of Knative Serving and the one of
+	// Knative Eventing in the same cluster are out of their supported skew. It does not affect the
+	// readiness of the component.
+	VersionSkewWarning apis.ConditionType = "VersionSkewWarning"
+	// WebhookCertificateWarning is a Condition indicating that the certificate of a webhook expires
+	// soon or is invalid, e.g. because its rotation failed. It does not affect the readiness of the
+	// component.
+	WebhookCertificateWarning apis.ConditionType = "WebhookCertificateWarning"
+	// ConformancePassed is a Condition indicating whether or not the installed component passed the
+	// subset of the Knative conformance tests, which the annotation ConformanceAnnotation enables. It
+	// does not affect the readiness of the component.
+	ConformancePassed apis.ConditionType = "ConformancePassed"
+	// UnmanagedConfigMapsWarning is a Condition indicating that keys of ConfigMaps of the component
+	// are excluded from the reconciliation by the annotation UnmanagedKeysAnnotation, e.g. for a
+	// hotfix. It does not affect the readiness of the component.
+	UnmanagedConfigMapsWarning apis.ConditionType = "UnmanagedConfigMapsWarning"
+	// UnknownConfigWarning is a Condition indicating that entries of spec.config match no ConfigMap
+	// of the rendered manifest, and have no effect, e.g. because of a typo. It does not affect the
+	// readiness of the component.
+	UnknownConfigWarning apis.ConditionType = "UnknownConfigWarning"
+)
+
+const (
+	// DryRunAnnotation is the annotation to set to "true" on the Knative component, if the operator
+	// should only render the manifests and publish the changes they would make into a ConfigMap,
+	// without applying anything to the cluster.
+	DryRunAnnotation = "operator.knative.dev/dry-run"
+	// SkipPreflightAnnotation is the annotation to set to "true" on the Knative component to skip
+	// the pre-flight checks, e.g. when taking over a manual installation on purpose.
+	SkipPreflightAnnotation = "operator.knative.dev/skip-preflight"
+	// PausedAnnotation is the annotation to set to "true" on the Knative component to stop the
+	// operator from changing any of its resources, e.g. during manual maintenance.
+	PausedAnnotation = "operator.knative.dev/paused"
+	// RegenerateWebhookCertificatesAnnotation is the annotation to set to "true" on the Knative
+	// component to have the operator clear the certificates of its webhooks, which expire soon or are
+	// invalid, so that the webhooks generate them again.
+	RegenerateWebhookCertificatesAnnotation = "operator.knative.dev/regenerate-webhook-certificates"
+	// ConformanceAnnotation is the annotation to set to "true" on the Knative component to run a subset
+	// of the Knative conformance tests as jobs, once each version is installed, e.g. to certify a build.
+	ConformanceAnnotation = "operator.knative.dev/conformance"
+	// UnmanagedKeysAnnotation is the annotation to set on a ConfigMap installed by the operator to the
+	// comma-separated keys, whose values the operator keeps instead of reverting them, or to "*" for
+	// all the keys of the ConfigMap.
+	UnmanagedKeysAnnotation = "operator.knative.dev/unmanaged-keys"
+	// AdditiveConfigAnnotation is the annotation to set to "true" on the Knative component to keep the
+	// keys removed from spec.config in the ConfigMaps, instead of removing them.
+	AdditiveConfigAnnotation = "operator.knative.dev/additive-config"
 )
 
 // KComponent is a common interface for accessing meta, spec and status of all known types.
@@ -54,6 +109,8 @@ type KComponent interface {
 type KComponentSpec interface {
 	// GetConfig returns means to override entries in upstream configmaps.
 	GetConfig() ConfigMapData
+	// GetConfigFrom returns the entries of upstream configmaps read from Secrets.
+	GetConfigFrom() ConfigMapValueSources
 	// GetRegistry returns means to override deployment images.
 	GetRegistry() *Registry
 	// GetResources returns a list of container resource overrides.
@@ -79,6 +136,53 @@ type KComponentSpec interface {
 
 	// GetPodDisruptionBudgetOverride gets the PodDisruptionBudget configurations to override.
 	GetPodDisruptionBudgetOverride() []PodDisruptionBudgetOverride
+
+	// GetServiceAccountOverride gets the ServiceAccount configurations to override.
+	GetServiceAccountOverride() []ServiceAccountOverride
+
+	// GetNetworkPolicies gets the configuration of the generated NetworkPolicies.
+	GetNetworkPolicies() *NetworkPolicies
+
+	// GetTargetCluster gets the cluster to install into, nil for the cluster of the operator.
+	GetTargetCluster() *TargetCluster
+
+	// GetFeatures gets the feature flags to set in config-features.
+	GetFeatures() map[string]string
+
+	// GetVersionOverrides gets the versions of the individual components, which differ from the version.
+	GetVersionOverrides() map[string]string
+
+	// GetPodSecurityStandard gets the Pod Security Standard, which the operands comply with.
+	GetPodSecurityStandard() PodSecurityStandard
+
+	// GetPlatform gets the platform profile, empty to detect it from the cluster.
+	GetPlatform() Platform
+
+	// GetArchitectures gets the architectures of the nodes, which the workloads are restricted to.
+	GetArchitectures() []string
+
+	// GetLinuxNodesOnly gets whether the workloads are restricted to Linux nodes.
+	GetLinuxNodesOnly() bool
+
+	// GetIPFamilies gets the IP families of the services.
+	GetIPFamilies() IPFamilyConfiguration
+
+	// GetSpot gets the profile, which places the workloads on spot nodes.
+	GetSpot() *SpotConfiguration
+	// GetResilience gets the profile, which spreads the critical components over the zones.
+	GetResilience() Resilience
+
+	// GetEco gets the mode, which scales the idle deployments down.
+	GetEco() *EcoMode
+
+	// GetResourceRecommendations gets the configuration of the recommended resources.
+	GetResourceRecommendations() *ResourceRecommendations
+
+	// GetTransformerOrder gets the order of the transformer plugins within their phase.
+	GetTransformerOrder() []string
+
+	// GetExternalTransformer gets the transformer outside of the operator, nil for none.
+	GetExternalTransformer() *ExternalTransformer
 }
 
 // KComponentStatus is a common interface for status mutations of all known types.
@@ -101,6 +205,65 @@ type KComponentStatus interface {
 	// the given message.
 	MarkVersionMigrationNotEligible(msg string)
 
+	// MarkPreviewReady marks the PreviewReady status as true, pointing to the ConfigMap containing
+	// the preview.
+	MarkPreviewReady(configMap string)
+	// MarkPreviewFailed marks the PreviewReady status as false with the given message.
+	MarkPreviewFailed(msg string)
+	// ClearPreview removes the PreviewReady status, when dry-run mode is not active.
+	ClearPreview()
+
+	// MarkPreflightChecksPassed marks the PreflightChecksPassed status as true.
+	MarkPreflightChecksPassed()
+	// MarkPreflightChecksFailed marks the PreflightChecksPassed status as false with the given
+	// violations.
+	MarkPreflightChecksFailed(violations []string)
+
+	// MarkPaused marks the Paused status as true.
+	MarkPaused()
+	// ClearPaused removes the Paused status, when the reconciliation is resumed.
+	ClearPaused()
+
+	// MarkVersionSkewWarning marks the VersionSkewWarning status as true with the given message.
+	MarkVersionSkewWarning(msg string)
+	// ClearVersionSkewWarning removes the VersionSkewWarning status, when the versions are in skew.
+	ClearVersionSkewWarning()
+
+	// MarkWebhookCertificateWarning marks the WebhookCertificateWarning status as true with the
+	// given message.
+	MarkWebhookCertificateWarning(msg string)
+	// ClearWebhookCertificateWarning removes the WebhookCertificateWarning status, when the
+	// certificates are valid.
+	ClearWebhookCertificateWarning()
+
+	// MarkConformancePassed marks the ConformancePassed status as true.
+	MarkConformancePassed()
+	// MarkConformanceRunning marks the ConformancePassed status as unknown, while the given checks
+	// are running.
+	MarkConformanceRunning(checks []string)
+	// MarkConformanceFailed marks the ConformancePassed status as false with the given failures.
+	MarkConformanceFailed(failures []string)
+	// ClearConformance removes the ConformancePassed status, when the checks are not enabled.
+	ClearConformance()
+
+	// MarkUnmanagedConfigMapsWarning marks the UnmanagedConfigMapsWarning status as true with the
+	// given exclusions.
+	MarkUnmanagedConfigMapsWarning(exclusions []string)
+	// ClearUnmanagedConfigMapsWarning removes the UnmanagedConfigMapsWarning status, when all the
+	// ConfigMaps are reconciled.
+	ClearUnmanagedConfigMapsWarning()
+
+	// MarkUnknownConfigWarning marks the UnknownConfigWarning status as true with the given message.
+	MarkUnknownConfigWarning(msg string)
+	// ClearUnknownConfigWarning removes the UnknownConfigWarning status, when all the entries of
+	// spec.config match a ConfigMap.
+	ClearUnknownConfigWarning()
+
+	// MarkStageSucceeded marks the condition of the custom stage as true.
+	MarkStageSucceeded(stage apis.ConditionType)
+	// MarkStageFailed marks the condition of the custom stage as false with the given message.
+	MarkStageFailed(stage apis.ConditionType, msg string)
+
 	// MarkDependenciesInstalled marks the DependenciesInstalled status as true.
 	MarkDependenciesInstalled()
 	// MarkDependencyInstalling marks the DependenciesInstalled status as false with the
@@ -115,11 +278,26 @@ type KComponentStatus interface {
 	// SetVersion sets the currently installed version of the component.
 	SetVersion(version string)
 
+	// GetResolvedImages gets the digests, which the images referenced by a tag are pinned to.
+	GetResolvedImages() map[string]string
+	// SetResolvedImages sets the digests, which the images referenced by a tag are pinned to.
+	SetResolvedImages(images map[string]string)
+
 	// GetManifests gets the url links of the manifests
 	GetManifests() []string
 	// SetManifests sets the url links of the manifests
 	SetManifests(manifests []string)
 
+	// GetPermissions gets the permissions granted to the service accounts of the component.
+	GetPermissions() []ServiceAccountPermissions
+	// SetPermissions sets the permissions granted to the service accounts of the component.
+	SetPermissions(permissions []ServiceAccountPermissions)
+
+	// GetAppliedManifest gets what was last applied for the component.
+	GetAppliedManifest() *AppliedManifest
+	// SetAppliedManifest sets what was last applied for the component.
+	SetAppliedManifest(applied *AppliedManifest)
+
 	// IsReady return true if all conditions are satisfied
 	IsReady() bool
 }
@@ -130,6 +308,11 @@ type CommonSpec struct {
 	// +optional
 	Config ConfigMapData `json:"config,omitempty"`
 
+	// ConfigFrom overrides entries in the upstream configmaps like Config, with values read
+	// from the keys of Secrets in the namespace of the component, e.g. for credentials.
+	// +optional
+	ConfigFrom ConfigMapValueSources `json:"configFrom,omitempty"`
+
 	// A means to override the corresponding deployment images in the upstream.
 	// If no registry is provided, the knative release images will be used.
 	// +optional
@@ -176,6 +359,84 @@ type CommonSpec struct {
 	// PodDisruptionBudgetOverride overrides PodDisruptionBudget configurations via minAvailable.
 	// +optional
 	PodDisruptionBudgetOverride []PodDisruptionBudgetOverride `json:"podDisruptionBudgets,omitempty"`
+
+	// ServiceAccountOverride overrides ServiceAccount configurations such as labels and annotations,
+	// e.g. the annotations binding the service accounts to the identities of a cloud provider.
+	// +optional
+	ServiceAccountOverride []ServiceAccountOverride `json:"serviceAccounts,omitempty"`
+
+	// NetworkPolicies generates NetworkPolicies, which allow the traffic the components need, for
+	// clusters denying all other traffic by default.
+	// +optional
+	NetworkPolicies *NetworkPolicies `json:"networkPolicies,omitempty"`
+
+	// TargetCluster specifies a remote cluster to install into, instead of the cluster of the operator.
+	// +optional
+	TargetCluster *TargetCluster `json:"targetCluster,omitempty"`
+
+	// Features sets the feature flags in config-features, e.g. "kubernetes.podspec-affinity: enabled".
+	// The features are validated against the ones of the installed version.
+	// +optional
+	Features map[string]string `json:"features,omitempty"`
+
+	// VersionOverrides pins individual components to a version other than spec.version, e.g.
+	// "istio: 1.20" for the Istio ingress of Knative Serving 1.21. The versions are validated
+	// against the skew, which the components support.
+	// +optional
+	VersionOverrides map[string]string `json:"versionOverrides,omitempty"`
+
+	// Platform selects the profile, which adjusts the manifests to the constraints of a managed
+	// platform, e.g. gke-autopilot. The platform is detected from the cluster, if it is unset,
+	// none disables the detection.
+	// +optional
+	Platform Platform `json:"platform,omitempty"`
+
+	// Architectures restricts the pods of all the workloads to the nodes of these architectures,
+	// e.g. amd64 and arm64, for clusters with node pools of several architectures.
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
+
+	// LinuxNodesOnly restricts the pods of all the workloads to the Linux nodes with the
+	// kubernetes.io/os node selector. It defaults to true, false lets the pods run on any node.
+	// +optional
+	LinuxNodesOnly *bool `json:"linuxNodesOnly,omitempty"`
+
+	// IPFamilyConfiguration sets the IP families of all the services, e.g. for IPv6 single-stack
+	// or dual-stack clusters. spec.services overrides them per service.
+	IPFamilyConfiguration `json:",inline"`
+
+	// Spot places the stateless workloads on spot or preemptible nodes, while keeping the
+	// activator and the webhooks on on-demand nodes.
+	// +optional
+	Spot *SpotConfiguration `json:"spot,omitempty"`
+
+	// Resilience selects the profile for the outage of a zone: "zonal" runs the activator, the
+	// webhooks and the ingress gateways with a replica in each zone, protected by
+	// PodDisruptionBudgets, "none", the default, keeps the manifests unchanged.
+	// +optional
+	Resilience Resilience `json:"resilience,omitempty"`
+
+	// Eco scales the optional deployments down to zero replicas, e.g. the controllers of the
+	// sources, as long as the cluster has no resources for them, to save resources on small
+	// clusters. They are scaled up again, once such resources are created.
+	// +optional
+	Eco *EcoMode `json:"eco,omitempty"`
+
+	// ResourceRecommendations records the usage of the containers from the metrics API, and
+	// recommends their requests and limits in a ConfigMap next to the component, optionally
+	// applying them.
+	// +optional
+	ResourceRecommendations *ResourceRecommendations `json:"resourceRecommendations,omitempty"`
+
+	// TransformerOrder orders the transformer plugins of the extensions within their phase by
+	// their names. The plugins, which are not listed, run after the listed ones by name.
+	// +optional
+	TransformerOrder []string `json:"transformerOrder,omitempty"`
+
+	// ExternalTransformer hands the rendered manifest to a service or a command outside of the
+	// operator, which returns the mutated manifest to install.
+	// +optional
+	ExternalTransformer *ExternalTransformer `json:"externalTransformer,omitempty"`
 }
 
 // GetConfig implements KComponentSpec.
@@ -183,6 +444,11 @@ func (c *CommonSpec) GetConfig() ConfigMapData {
 	return c.Config
 }
 
+// GetConfigFrom implements KComponentSpec.
+func (c *CommonSpec) GetConfigFrom() ConfigMapValueSources {
+	return c.ConfigFrom
+}
+
 // GetRegistry implements KComponentSpec.
 func (c *CommonSpec) GetRegistry() *Registry {
 	return &c.Registry
@@ -233,11 +499,97 @@ func (c *CommonSpec) GetPodDisruptionBudgetOverride() []PodDisruptionBudgetOverr
 	return c.PodDisruptionBudgetOverride
 }
 
+// GetServiceAccountOverride implements KComponentSpec.
+func (c *CommonSpec) GetServiceAccountOverride() []ServiceAccountOverride {
+	return c.ServiceAccountOverride
+}
+
+// GetNetworkPolicies implements KComponentSpec.
+func (c *CommonSpec) GetNetworkPolicies() *NetworkPolicies {
+	return c.NetworkPolicies
+}
+
+// GetTargetCluster implements KComponentSpec.
+func (c *CommonSpec) GetTargetCluster() *TargetCluster {
+	return c.TargetCluster
+}
+
+// GetFeatures implements KComponentSpec.
+func (c *CommonSpec) GetFeatures() map[string]string {
+	return c.Features
+}
+
+// GetPlatform implements KComponentSpec.
+func (c *CommonSpec) GetPlatform() Platform {
+	return c.Platform
+}
+
+// GetArchitectures implements KComponentSpec.
+func (c *CommonSpec) GetArchitectures() []string {
+	return c.Architectures
+}
+
+// GetLinuxNodesOnly implements KComponentSpec.
+func (c *CommonSpec) GetLinuxNodesOnly() bool {
+	return c.LinuxNodesOnly == nil || *c.LinuxNodesOnly
+}
+
+// GetIPFamilies implements KComponentSpec.
+func (c *CommonSpec) GetIPFamilies() IPFamilyConfiguration {
+	return c.IPFamilyConfiguration
+}
+
+// GetSpot implements KComponentSpec.
+func (c *CommonSpec) GetSpot() *SpotConfiguration {
+	return c.Spot
+}
+
+// GetResilience implements KComponentSpec.
+func (c *CommonSpec) GetResilience() Resilience {
+	return c.Resilience
+}
+
+// GetEco implements KComponentSpec.
+func (c *CommonSpec) GetEco() *EcoMode {
+	return c.Eco
+}
+
+// GetResourceRecommendations implements KComponentSpec.
+func (c *CommonSpec) GetResourceRecommendations() *ResourceRecommendations {
+	return c.ResourceRecommendations
+}
+
+// GetTransformerOrder implements KComponentSpec.
+func (c *CommonSpec) GetTransformerOrder() []string {
+	return c.TransformerOrder
+}
+
+// GetExternalTransformer implements KComponentSpec.
+func (c *CommonSpec) GetExternalTransformer() *ExternalTransformer {
+	return c.ExternalTransformer
+}
+
+// GetVersionOverrides implements KComponentSpec.
+func (c *CommonSpec) GetVersionOverrides() map[string]string {
+	return c.VersionOverrides
+}
+
 // ConfigMapData is a nested map of maps representing all upstream ConfigMaps. The first
 // level key is the key to the ConfigMap itself (i.e. "logging") while the second level
 // is the data to be filled into the respective ConfigMap.
 type ConfigMapData map[string]map[string]string
 
+// ConfigMapValueSources is a nested map like ConfigMapData, whose values are read from
+// other resources instead of being set in the spec.
+type ConfigMapValueSources map[string]map[string]ConfigValueSource
+
+// ConfigValueSource is the source of the value of an entry of a ConfigMap.
+type ConfigValueSource struct {
+	// SecretKeyRef selects the key of a Secret in the namespace of the component.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
 // Registry defines image overrides of knative images.
 // This affects both apps/v1.Deployment and caching.internal.knative.dev/v1beta1.Image.
 // The default value is used as a default format to override for all knative deployments.
@@ -257,6 +609,52 @@ type Registry struct {
 	// same namespace as the knative-serving deployments, and not the namespace of this resource.
 	// +optional
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// ServiceAccountPullSecrets attaches the imagePullSecrets to the service accounts of the
+	// knative deployments as well, which some admission controllers require.
+	// +optional
+	ServiceAccountPullSecrets bool `json:"serviceAccountPullSecrets,omitempty"`
+
+	// Rewrites replace the prefix of the images, e.g. gcr.io/knative-releases/* by registry.corp/knative/*.
+	// The rule with the longest matching prefix applies, unless override has an entry for the image.
+	// +optional
+	Rewrites []ImageRewrite `json:"rewrites,omitempty"`
+
+	// DigestResolution resolves the images referenced by a tag to their digests, when the manifests
+	// are applied, and pins the images to the digests recorded in status.resolvedImages.
+	// +optional
+	DigestResolution *DigestResolution `json:"digestResolution,omitempty"`
+
+	// VerifyImages checks in the pre-flight checks of a new version, that the registries serve all
+	// the images of the manifests with the credentials of imagePullSecrets.
+	// +optional
+	VerifyImages bool `json:"verifyImages,omitempty"`
+}
+
+// ImageRewrite replaces the prefix of the images in the manifests.
+type ImageRewrite struct {
+	// From is the prefix of the images to rewrite, optionally ending with a wildcard, e.g.
+	// gcr.io/knative-releases/*.
+	From string `json:"from"`
+
+	// To replaces the prefix, optionally ending with a wildcard, e.g. registry.corp/knative/*.
+	To string `json:"to"`
+
+	// Except lists the containers, as in override by the container name or by deployment/container,
+	// the caching images and the environment variables, whose images are not rewritten.
+	// +optional
+	Except []string `json:"except,omitempty"`
+}
+
+// DigestResolution configures the resolution of the tags of the images to digests.
+type DigestResolution struct {
+	// Enabled resolves the tags of the images to digests.
+	Enabled bool `json:"enabled"`
+
+	// A secret of the type kubernetes.io/dockerconfigjson with the credentials of the registries.
+	// The secret must be in the namespace of this resource. Anonymous access is used without it.
+	// +optional
+	CredentialsSecret *corev1.LocalObjectReference `json:"credentialsSecret,omitempty"`
 }
 
 // NamespaceConfiguration defines the configurations of namespaces to override.
@@ -324,6 +722,11 @@ type WorkloadOverride struct {
 	// When hostNetwork is enabled, this will set dnsPolicy to ClusterFirstWithHostNet automatically for the containers.
 	// +optional
 	HostNetwork *bool `json:"hostNetwork,omitempty"`
+
+	// Architectures restricts the pods to the nodes of these architectures, e.g. arm64, instead
+	// of the ones of spec.architectures.
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
 }
 
 // ServiceOverride defines the configurations of the service to override.
@@ -342,6 +745,119 @@ type ServiceOverride struct {
 	// Selector overrides the selector for the service
 	// +optional
 	Selector map[string]string `json:"selector,omitempty"`
+
+	// IPFamilyConfiguration overrides the IP families of the service.
+	IPFamilyConfiguration `json:",inline"`
+}
+
+// IPFamilyConfiguration defines the IP families of services.
+type IPFamilyConfiguration struct {
+	// IPFamilies are the IP families of the services, IPv4 and/or IPv6, the first one being the
+	// primary family.
+	// +optional
+	IPFamilies []corev1.IPFamily `json:"ipFamilies,omitempty"`
+
+	// IPFamilyPolicy is the dual-stack policy of the services, SingleStack, PreferDualStack or
+	// RequireDualStack.
+	// +optional
+	IPFamilyPolicy *corev1.IPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
+}
+
+// ServiceAccountOverride defines the configurations of service accounts to override.
+type ServiceAccountOverride struct {
+	// Name is the name of the service account to override.
+	Name string `json:"name"`
+
+	// Labels overrides labels for the service account.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations overrides annotations for the service account, e.g. eks.amazonaws.com/role-arn
+	// or iam.gke.io/gcp-service-account.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Platform is a profile, which adjusts the manifests to the constraints of a managed platform.
+type Platform string
+
+const (
+	// PlatformGKEAutopilot adjusts the manifests to the policies of GKE Autopilot.
+	PlatformGKEAutopilot Platform = "gke-autopilot"
+	// PlatformNone keeps the manifests unchanged and disables the detection of the platform.
+	PlatformNone Platform = "none"
+)
+
+// Resilience is a profile for the resilience of the control plane.
+type Resilience string
+
+const (
+	// ResilienceZonal spreads the critical components over the zones, so that they survive the
+	// outage of a zone.
+	ResilienceZonal Resilience = "zonal"
+	// ResilienceNone keeps the manifests unchanged.
+	ResilienceNone Resilience = "none"
+)
+
+// EcoMode configures the mode, which scales the idle deployments down.
+type EcoMode struct {
+	// Enabled scales the optional deployments down, while the cluster has no resources for them.
+	Enabled bool `json:"enabled"`
+
+	// Exclude are the names of the deployments, which keep running regardless.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// ResourceRecommendations configures the recommended resources of the containers.
+type ResourceRecommendations struct {
+	// Enabled records the usage of the containers and publishes the recommendations.
+	Enabled bool `json:"enabled"`
+
+	// Apply sets the recommended requests and limits of the containers, whose resources
+	// spec.workloads doesn't set.
+	// +optional
+	Apply bool `json:"apply,omitempty"`
+
+	// MinAllowed are the lowest requests recommended, e.g. cpu: 50m.
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+
+	// MaxAllowed are the highest requests recommended, e.g. memory: 1Gi.
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+}
+
+// NetworkPolicies configures the NetworkPolicies generated for the services of the components.
+type NetworkPolicies struct {
+	// Enabled generates a NetworkPolicy for each service of the manifests, allowing the ingress
+	// to the ports of the service.
+	Enabled bool `json:"enabled"`
+
+	// MetricsNamespaceSelector selects the namespaces, which may scrape the metrics ports, e.g. the
+	// one of Prometheus. All namespaces may scrape the metrics without it.
+	// +optional
+	MetricsNamespaceSelector *metav1.LabelSelector `json:"metricsNamespaceSelector,omitempty"`
+}
+
+// SpotConfiguration configures the profile, which places the workloads on spot nodes.
+type SpotConfiguration struct {
+	// Enabled places the deployments on the spot nodes, except for the ones serving requests,
+	// which can't be retried, and generates PodDisruptionBudgets for them.
+	Enabled bool `json:"enabled"`
+
+	// NodeSelector are the labels of the spot nodes, e.g. cloud.google.com/gke-spot: "true".
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations tolerate the taints of the spot nodes.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// OnDemand are the names of the deployments, which are kept on the on-demand nodes besides
+	// the activator and the webhooks, e.g. the gateway of an ingress.
+	// +optional
+	OnDemand []string `json:"onDemand,omitempty"`
 }
 
 type PodDisruptionBudgetOverride struct {
@@ -366,6 +882,8 @@ type EnvRequirementsOverride struct {
 	Container string `json:"container"`
 	// The desired EnvVarRequirements
 	EnvVars []corev1.EnvVar `json:"envVars,omitempty"`
+	// The names of the env vars set by the release manifest, which are removed
+	RemoveEnvVars []string `json:"removeEnvVars,omitempty"`
 }
 
 // ProbesRequirementsOverride enables the user to override any container's env vars.
@@ -420,6 +938,37 @@ type HighAvailability struct {
 	// Replicas is the number of replicas that HA parts of the control plane
 	// will be scaled to.
 	Replicas *int32 `json:"replicas"`
+
+	// Deployments overrides Replicas for single deployments, e.g. more replicas for the
+	// activator than for the controllers. spec.workloads[].replicas still takes precedence.
+	// +optional
+	Deployments []HighAvailabilityDeployment `json:"deployments,omitempty"`
+
+	// Exclude are the names of the deployments, which keep the replicas of the manifest.
+	// The deployments, which must run a single replica, are always excluded.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// HighAvailabilityDeployment is the number of replicas of a single deployment.
+type HighAvailabilityDeployment struct {
+	// Name is the name of the deployment.
+	Name string `json:"name"`
+
+	// Replicas is the number of replicas of the deployment.
+	Replicas int32 `json:"replicas"`
+}
+
+// TargetCluster refers to a remote cluster, in which the operator installs and manages the Knative
+// component, e.g. a workload cluster managed from a hub cluster.
+type TargetCluster struct {
+	// SecretName is the name of the secret containing the kubeconfig of the target cluster. The secret
+	// must be in the same namespace as this resource.
+	SecretName string `json:"secretName"`
+
+	// Key is the key of the kubeconfig in the secret, "kubeconfig" by default.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // CustomCerts refers to either a ConfigMap or Secret containing valid
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+// DomainConfiguration specifies the domains of the Knative services.
+type DomainConfiguration struct {
+	// Default is the domain of the Knative services, which match none of the selectors.
+	// +optional
+	Default string `json:"default,omitempty"`
+
+	// Selectors assign other domains to the Knative services with matching labels.
+	// +optional
+	Selectors []DomainSelector `json:"selectors,omitempty"`
+
+	// Template is the Go template of the domain names of the Knative services, e.g.
+	// {{.Name}}.{{.Namespace}}.{{.Domain}}.
+	// +optional
+	Template string `json:"template,omitempty"`
+
+	// Mapping configures the DomainMappings of custom domains to Knative services.
+	// +optional
+	Mapping *DomainMappingConfiguration `json:"mapping,omitempty"`
+}
+
+// DomainMappingConfiguration specifies how Knative Serving claims the domains of DomainMappings.
+type DomainMappingConfiguration struct {
+	// AutocreateClusterDomainClaims creates the ClusterDomainClaim of the domain of a DomainMapping,
+	// instead of requiring an administrator to create it.
+	// +optional
+	AutocreateClusterDomainClaims *bool `json:"autocreateClusterDomainClaims,omitempty"`
+}
+
+// DomainSelector assigns a domain to the Knative services, whose labels match the selector.
+type DomainSelector struct {
+	// Domain is the domain of the matching Knative services.
+	Domain string `json:"domain"`
+
+	// Selector are the labels of the matching Knative services.
+	Selector map[string]string `json:"selector"`
+}
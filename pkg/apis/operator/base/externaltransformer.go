@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExternalTransformerFailurePolicy is how a failure of the external transformer is handled.
+type ExternalTransformerFailurePolicy string
+
+const (
+	// ExternalTransformerFail fails the installation, until the transformer succeeds.
+	ExternalTransformerFail ExternalTransformerFailurePolicy = "Fail"
+	// ExternalTransformerIgnore installs the manifest as it was before the transformer.
+	ExternalTransformerIgnore ExternalTransformerFailurePolicy = "Ignore"
+)
+
+// ExternalTransformer hands the rendered manifest of the component to a transformer outside of the
+// operator, which returns the mutated manifest to install. Exactly one of Service and Command is set.
+type ExternalTransformer struct {
+	// Service is the in-cluster service, to which the manifest is posted over HTTPS.
+	// +optional
+	Service *ExternalTransformerService `json:"service,omitempty"`
+
+	// Command is the name of an executable in the transformers directory of the operator, e.g.
+	// provided by a sidecar through a shared volume, which reads the manifest from stdin and writes
+	// the mutated one to stdout.
+	// +optional
+	Command string `json:"command,omitempty"`
+
+	// Timeout is the timeout of a call of the transformer, 10s by default and at most 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailurePolicy is Fail, the default, or Ignore.
+	// +optional
+	FailurePolicy ExternalTransformerFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// ExternalTransformerService refers to the service of an external transformer.
+type ExternalTransformerService struct {
+	// Namespace is the namespace of the service.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the service.
+	Name string `json:"name"`
+
+	// Port is the port of the service, 443 by default.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Path is the path of the URL, to which the manifest is posted.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// CABundle is the PEM encoded CA bundle, which verifies the certificate of the service. The
+	// system roots verify it, if empty.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
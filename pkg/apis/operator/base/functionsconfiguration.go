@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// FunctionsBuildersConfiguration specifies the builder images of the on-cluster builds of func.
+// The builders left unset keep the images of the config-func-builders ConfigMap.
+type FunctionsBuildersConfiguration struct {
+	// Pack is the builder image of the builds with Cloud Native Buildpacks.
+	// +optional
+	Pack string `json:"pack,omitempty"`
+
+	// S2I is the builder image of the builds with Source-to-Image.
+	// +optional
+	S2I string `json:"s2i,omitempty"`
+}
+
+// FunctionsPVCConfiguration specifies the PersistentVolumeClaims, which hold the sources of the
+// functions during their on-cluster builds. The settings left unset keep those of the
+// config-func-pvc ConfigMap.
+type FunctionsPVCConfiguration struct {
+	// Size is the requested storage of a claim.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// StorageClassName is the storage class of the claims, the default class of the cluster if unset.
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// AccessMode is the access mode of the claims.
+	// +optional
+	AccessMode corev1.PersistentVolumeAccessMode `json:"accessMode,omitempty"`
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import "k8s.io/apimachinery/pkg/util/intstr"
+
+// GarbageCollectionConfiguration specifies the retention of the revisions, which are no longer
+// referenced by a route. It is rendered into config-gc.
+type GarbageCollectionConfiguration struct {
+	// RetainSinceCreateTime is the duration since the creation of a revision, before it is
+	// considered for garbage collection, or "disabled".
+	// +optional
+	RetainSinceCreateTime string `json:"retainSinceCreateTime,omitempty"`
+
+	// RetainSinceLastActiveTime is the duration since a revision was last referenced by a route,
+	// before it is considered for garbage collection, or "disabled".
+	// +optional
+	RetainSinceLastActiveTime string `json:"retainSinceLastActiveTime,omitempty"`
+
+	// MinNonActiveRevisions is the number of non-active revisions, which are always retained.
+	// +optional
+	MinNonActiveRevisions *int64 `json:"minNonActiveRevisions,omitempty"`
+
+	// MaxNonActiveRevisions is the number of non-active revisions, above which they are collected
+	// regardless of their age, or "disabled".
+	// +optional
+	MaxNonActiveRevisions *intstr.IntOrString `json:"maxNonActiveRevisions,omitempty"`
+}
@@ -32,6 +32,22 @@ type IstioIngressConfiguration struct {
 	// KnativeLocalGateway overrides the knative-local-gateway.
 	// +optional
 	KnativeLocalGateway *IstioGatewayOverride `json:"knative-local-gateway,omitempty"`
+
+	// Mesh configures Knative Serving to run in the Istio service mesh.
+	// +optional
+	Mesh *IstioMeshConfiguration `json:"mesh,omitempty"`
+}
+
+// IstioMeshConfiguration specifies how Knative Serving joins the Istio service mesh.
+type IstioMeshConfiguration struct {
+	// SidecarInjection injects the Istio sidecar into the pods of Knative Serving.
+	// +optional
+	SidecarInjection bool `json:"sidecar-injection,omitempty"`
+
+	// StrictPeerAuthentication makes Knative Serving compatible with a PeerAuthentication of mode
+	// STRICT. It implies SidecarInjection.
+	// +optional
+	StrictPeerAuthentication bool `json:"strict-peer-authentication,omitempty"`
 }
 
 // KourierIngressConfiguration specifies whether to enable the kourier ingresses.
@@ -52,11 +68,102 @@ type KourierIngressConfiguration struct {
 
 	// BootstrapConfigmapName specifies the ConfigMap name which contains envoy bootstrap.
 	BootstrapConfigmapName string `json:"bootstrap-configmap,omitempty"`
+
+	// ServiceAnnotations specifies annotations added to the kourier gateway service, e.g. to
+	// configure the load balancer of the cloud provider.
+	ServiceAnnotations map[string]string `json:"service-annotations,omitempty"`
+
+	// GatewayAutoscaling specifies the autoscaling of the kourier gateway.
+	GatewayAutoscaling *KourierGatewayAutoscaling `json:"gateway-autoscaling,omitempty"`
+}
+
+// KourierGatewayAutoscaling specifies the HorizontalPodAutoscaler of the kourier gateway. Unset
+// fields keep the values of the manifest.
+type KourierGatewayAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the gateway.
+	MinReplicas *int32 `json:"min-replicas,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas of the gateway.
+	MaxReplicas *int32 `json:"max-replicas,omitempty"`
+
+	// TargetCPUUtilization is the average CPU utilization of the gateway to scale at, in percent
+	// of the requested CPU.
+	TargetCPUUtilization *int32 `json:"target-cpu-utilization,omitempty"`
 }
 
 // ContourIngressConfiguration specifies whether to enable the contour ingresses.
 type ContourIngressConfiguration struct {
 	Enabled bool `json:"enabled"`
+
+	// External configures the Contour serving the traffic from outside of the cluster.
+	// +optional
+	External *ContourVisibilityConfiguration `json:"external,omitempty"`
+
+	// Internal configures the Contour serving the traffic from within the cluster.
+	// +optional
+	Internal *ContourVisibilityConfiguration `json:"internal,omitempty"`
+}
+
+// ContourVisibilityConfiguration specifies the Contour installation of a visibility. Empty fields
+// keep the defaults of net-contour, e.g. contour-external and contour-internal.
+type ContourVisibilityConfiguration struct {
+	// Class is the ingress class of the Contour, which the HTTPProxies are annotated with.
+	// +optional
+	Class string `json:"class,omitempty"`
+
+	// Namespace is the namespace of the Contour and its Envoy service.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Service is the name of the Envoy service.
+	// +optional
+	Service string `json:"service,omitempty"`
+}
+
+// GatewayAPIIngressConfiguration specifies options for the Gateway API ingress, net-gateway-api.
+type GatewayAPIIngressConfiguration struct {
+	Enabled bool `json:"enabled"`
+
+	// ExternalGateways are the Gateways for the traffic from outside of the cluster.
+	// +optional
+	ExternalGateways []GatewayAPIGateway `json:"external-gateways,omitempty"`
+
+	// LocalGateways are the Gateways for the traffic from within the cluster.
+	// +optional
+	LocalGateways []GatewayAPIGateway `json:"local-gateways,omitempty"`
+}
+
+// GatewayAPIGateway refers to a Gateway, which net-gateway-api attaches the HTTPRoutes to.
+type GatewayAPIGateway struct {
+	// Class is the name of the GatewayClass of the Gateway.
+	Class string `json:"class"`
+
+	// Gateway is the namespace/name of the Gateway.
+	Gateway string `json:"gateway"`
+
+	// Service is the namespace/name of the Service of the Gateway, which is used to probe the routes.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// SupportedFeatures are the optional features of the Gateway API, which the implementation of
+	// the GatewayClass supports.
+	// +optional
+	SupportedFeatures []string `json:"supported-features,omitempty"`
+}
+
+// ExternalDNSConfiguration specifies the annotations, with which external-dns manages the DNS records
+// of the Knative domain.
+type ExternalDNSConfiguration struct {
+	Enabled bool `json:"enabled"`
+
+	// Hostname is the hostname of the DNS records, the wildcard of spec.domain.default by default,
+	// e.g. *.example.com.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// TTL is the time to live of the DNS records in seconds.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
 }
 
 // IstioGatewayOverride override the knative-ingress-gateway and knative-local-gateway(cluster-local-gateway)
@@ -66,4 +173,22 @@ type IstioGatewayOverride struct {
 
 	// A list of server specifications.
 	Servers []*istiov1beta1.Server `json:"servers,omitempty"`
+
+	// Name renames the Gateway, or names an existing one, if Create is false.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Namespace is the namespace of an existing Gateway, the namespace of Knative Serving by default.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Service is the address of the Istio gateway service, which serves the Gateway, e.g.
+	// istio-ingressgateway.istio-system.svc.cluster.local.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// Create specifies whether the operator creates the Gateway, true by default. Set it to false
+	// to use an existing Gateway.
+	// +optional
+	Create *bool `json:"create,omitempty"`
 }
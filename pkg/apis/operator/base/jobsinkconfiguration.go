@@ -0,0 +1,26 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+// JobSinkConfiguration specifies whether to run the receiver of the JobSinks, which start a Job for
+// each event they receive.
+type JobSinkConfiguration struct {
+	// Enabled installs the job-sink deployment, true by default. The JobSink CRD and its controller
+	// are part of the core of Knative Eventing, and stay installed.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import corev1 "k8s.io/api/core/v1"
+
+// KafkaConfiguration specifies the installation of the Kafka components of Knative Eventing from
+// eventing-kafka-broker, and the Kafka cluster they connect to. The controller, the webhook and the
+// KafkaSource are always installed, the data planes of the broker, the channel and the sink only
+// if they are enabled.
+type KafkaConfiguration struct {
+	// BootstrapServers are the host:port addresses of the brokers of the Kafka cluster.
+	BootstrapServers []string `json:"bootstrapServers"`
+
+	// AuthSecretRef refers to the secret with the credentials for the Kafka cluster, in the
+	// namespace of Knative Eventing.
+	// +optional
+	AuthSecretRef *corev1.LocalObjectReference `json:"authSecretRef,omitempty"`
+
+	// ReplicationFactor is the replication factor of the topics of the brokers.
+	// +optional
+	ReplicationFactor *int32 `json:"replicationFactor,omitempty"`
+
+	// Partitions is the number of partitions of the topics of the brokers.
+	// +optional
+	Partitions *int32 `json:"partitions,omitempty"`
+
+	// Broker installs the data plane of the Kafka broker class.
+	// +optional
+	Broker *EventingComponentConfiguration `json:"broker,omitempty"`
+
+	// Channel installs the KafkaChannel.
+	// +optional
+	Channel *EventingComponentConfiguration `json:"channel,omitempty"`
+
+	// Sink installs the data plane of the KafkaSink.
+	// +optional
+	Sink *EventingComponentConfiguration `json:"sink,omitempty"`
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+// KEDAConfiguration specifies the autoscaling of the data plane of Knative Eventing with KEDA, which
+// has to be installed in the cluster.
+type KEDAConfiguration struct {
+	// Kafka makes the controller of eventing-kafka-broker scale the consumers of the KafkaSources,
+	// the triggers of Kafka brokers and the subscriptions of KafkaChannels, by the lag of their
+	// consumer groups.
+	// +optional
+	Kafka *KEDAKafkaConfiguration `json:"kafka,omitempty"`
+
+	// Workloads are the deployments of the data plane, which ScaledObjects of the operator scale by
+	// their CPU utilization, in place of their HorizontalPodAutoscalers.
+	// +optional
+	Workloads []KEDAWorkloadConfiguration `json:"workloads,omitempty"`
+}
+
+// KEDAScalingConfiguration specifies the bounds and the timing of the scaling by KEDA.
+type KEDAScalingConfiguration struct {
+	// MinReplicas is the minimum number of replicas.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// PollingInterval is the interval in seconds, in which KEDA checks the metrics.
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+
+	// CooldownPeriod is the period in seconds, which KEDA waits before it scales down.
+	// +optional
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+}
+
+// KEDAKafkaConfiguration specifies the autoscaling of the Kafka consumers. The settings left unset
+// keep those of the config-kafka-autoscaler ConfigMap.
+type KEDAKafkaConfiguration struct {
+	KEDAScalingConfiguration `json:",inline"`
+
+	// LagThreshold is the lag of a partition, above which KEDA adds a replica.
+	// +optional
+	LagThreshold *int64 `json:"lagThreshold,omitempty"`
+}
+
+// KEDAWorkloadConfiguration specifies the autoscaling of a deployment of the data plane. The
+// settings left unset keep those of the HorizontalPodAutoscaler of the deployment.
+type KEDAWorkloadConfiguration struct {
+	// Name is the name of the deployment, e.g. mt-broker-ingress.
+	Name string `json:"name"`
+
+	KEDAScalingConfiguration `json:",inline"`
+
+	// CPUUtilization is the average CPU utilization in percent of the requests of the pods, which
+	// KEDA keeps them at.
+	// +optional
+	CPUUtilization *int32 `json:"cpuUtilization,omitempty"`
+}
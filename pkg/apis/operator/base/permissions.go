@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// ServiceAccountPermissions are the permissions, which the bindings of the manifests grant to a
+// service account.
+type ServiceAccountPermissions struct {
+	// ServiceAccount is the namespace and name of the service account.
+	ServiceAccount string `json:"serviceAccount"`
+
+	// Grants are the roles bound to the service account.
+	// +optional
+	Grants []RoleGrant `json:"grants,omitempty"`
+}
+
+// RoleGrant is a role bound to a service account.
+type RoleGrant struct {
+	// Role is the kind and name of the role, e.g. ClusterRole/knative-serving-admin.
+	Role string `json:"role"`
+
+	// Namespace is the namespace, which the role is granted in, empty for all namespaces.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Rules are the rules of the role, including the aggregated ones. They are empty for the
+	// roles, which are not part of the manifests, e.g. the ones of Kubernetes.
+	// +optional
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty"`
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+// RabbitMQConfiguration specifies the installation of the RabbitMQ components of Knative Eventing
+// from eventing-rabbitmq. They rely on the RabbitMQ cluster operator and the messaging topology
+// operator, which are not installed by the Knative operator.
+type RabbitMQConfiguration struct {
+	// ClusterRef refers to the RabbitmqCluster, which the brokers of the RabbitMQBroker class use,
+	// unless they refer to a configuration of their own.
+	// +optional
+	ClusterRef *RabbitMQClusterReference `json:"clusterRef,omitempty"`
+
+	// QueueType is the type of the queues of the brokers using ClusterRef, quorum or classic.
+	// +optional
+	QueueType string `json:"queueType,omitempty"`
+
+	// Broker installs the RabbitMQBroker class.
+	// +optional
+	Broker *EventingComponentConfiguration `json:"broker,omitempty"`
+
+	// Source installs the RabbitmqSource.
+	// +optional
+	Source *EventingComponentConfiguration `json:"source,omitempty"`
+}
+
+// RabbitMQClusterReference refers to a RabbitmqCluster of the RabbitMQ cluster operator.
+type RabbitMQClusterReference struct {
+	// Name of the RabbitmqCluster.
+	Name string `json:"name"`
+
+	// Namespace of the RabbitmqCluster, the namespace of Knative Eventing by default.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// EventingComponentConfiguration specifies whether to install a component of an integration of
+// Knative Eventing, e.g. the Kafka broker.
+type EventingComponentConfiguration struct {
+	Enabled bool `json:"enabled"`
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RevisionDeploymentConfiguration specifies the defaults of the deployments, which Knative Serving
+// creates for the revisions. They are rendered into config-deployment.
+type RevisionDeploymentConfiguration struct {
+	// QueueProxy configures the queue-proxy sidecar of the revisions.
+	// +optional
+	QueueProxy *QueueProxyConfiguration `json:"queueProxy,omitempty"`
+
+	// ProgressDeadline is the time, which a revision has to become ready, before its deployment is
+	// considered failed.
+	// +optional
+	ProgressDeadline *metav1.Duration `json:"progressDeadline,omitempty"`
+
+	// RegistriesSkippingTagResolving are the registries, whose image tags are not resolved to
+	// digests.
+	// +optional
+	RegistriesSkippingTagResolving []string `json:"registriesSkippingTagResolving,omitempty"`
+}
+
+// QueueProxyConfiguration specifies the image and the resources of the queue-proxy sidecar.
+type QueueProxyConfiguration struct {
+	// Image is the image of the queue-proxy, e.g. a copy in the registry of an air-gapped cluster.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources are the requests and limits of the cpu, the memory and the ephemeral storage of the
+	// queue-proxy.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
@@ -20,3 +20,77 @@ package base
 type SecurityGuardConfiguration struct {
 	Enabled bool `json:"enabled"`
 }
+
+// CertManagerConfiguration specifies how Knative Serving gets the certificates of its domains from
+// cert-manager.
+type CertManagerConfiguration struct {
+	Enabled bool `json:"enabled"`
+
+	// IssuerRef is the issuer of the certificates of the external domains. If unset, the
+	// self-signed issuer of Knative Serving is used.
+	// +optional
+	IssuerRef *CertManagerIssuerRef `json:"issuerRef,omitempty"`
+
+	// ClusterLocalIssuerRef is the issuer of the certificates of the cluster-local domains.
+	// +optional
+	ClusterLocalIssuerRef *CertManagerIssuerRef `json:"clusterLocalIssuerRef,omitempty"`
+
+	// ClusterLocalDomainTLS enables TLS for the cluster-local domains, in addition to the
+	// external ones.
+	// +optional
+	ClusterLocalDomainTLS bool `json:"clusterLocalDomainTLS,omitempty"`
+}
+
+// CertManagerIssuerRef refers to a cert-manager ClusterIssuer or Issuer.
+type CertManagerIssuerRef struct {
+	// Kind is either ClusterIssuer or Issuer, ClusterIssuer by default.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the issuer.
+	Name string `json:"name"`
+}
+
+// SystemInternalTLSConfiguration specifies the encryption of the traffic between the internal
+// components of Knative Serving: the ingress, the activator and the queue-proxies.
+type SystemInternalTLSConfiguration struct {
+	Enabled bool `json:"enabled"`
+
+	// IssuerRef is the issuer of the certificates of the internal components. If unset, the
+	// self-signed issuer of Knative Serving is used.
+	// +optional
+	IssuerRef *CertManagerIssuerRef `json:"issuerRef,omitempty"`
+
+	// TrustBundle distributes the CA of the issuer to Knative Serving with trust-manager.
+	// +optional
+	TrustBundle *TrustBundleConfiguration `json:"trustBundle,omitempty"`
+}
+
+// TrustBundleConfiguration specifies the source of a trust-manager Bundle.
+type TrustBundleConfiguration struct {
+	// SecretName is the name of the secret with the CA, in the trust namespace of trust-manager.
+	SecretName string `json:"secretName"`
+
+	// Key is the key of the CA in the secret, ca.crt by default.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// PodSecurityStandard is a level of the Kubernetes Pod Security Standards.
+type PodSecurityStandard string
+
+const (
+	// PodSecurityStandardRestricted is the level of the hardened pods.
+	PodSecurityStandardRestricted PodSecurityStandard = "restricted"
+	// PodSecurityStandardBaseline is the level of the pods, which prevent known privilege escalations.
+	PodSecurityStandardBaseline PodSecurityStandard = "baseline"
+)
+
+// PodSecurityConfiguration specifies the Pod Security Standard, which the operands comply with.
+type PodSecurityConfiguration struct {
+	// PodSecurityStandard is the level, restricted or baseline, which the namespaces of the
+	// operands enforce with the Pod Security Admission. In restricted mode, the security contexts of
+	// all the containers of the operands are hardened to comply with it.
+	// +optional
+	PodSecurityStandard PodSecurityStandard `json:"podSecurityStandard,omitempty"`
+}
@@ -65,3 +65,11 @@ type RabbitmqSourceConfiguration struct {
 type RedisSourceConfiguration struct {
 	Enabled bool `json:"enabled"`
 }
+
+// PingSourceConfiguration specifies whether to run the adapter of the built-in PingSource.
+type PingSourceConfiguration struct {
+	// Enabled installs the pingsource-mt-adapter deployment, true by default. The PingSource CRD
+	// and its controller are part of the core of Knative Eventing, and stay installed.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SugarConfiguration specifies the sugar reconciler of Knative Eventing, which creates the default
+// broker of the selected namespaces and of the brokers the selected triggers refer to.
+type SugarConfiguration struct {
+	// NamespaceSelector selects the namespaces, which get a default broker. An empty selector
+	// selects all the namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// TriggerSelector selects the triggers, whose broker is created. An empty selector selects
+	// all the triggers.
+	// +optional
+	TriggerSelector *metav1.LabelSelector `json:"triggerSelector,omitempty"`
+}
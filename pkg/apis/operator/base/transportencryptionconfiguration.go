@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransportEncryptionConfiguration specifies the encryption of the event traffic between the
+// components of Knative Eventing with the certificates of cert-manager.
+type TransportEncryptionConfiguration struct {
+	// Mode is the transport-encryption of config-features: disabled, permissive or strict.
+	Mode string `json:"mode"`
+
+	// IssuerRef is the issuer of the certificates of the components. If unset, the operator creates
+	// a self-signed CA and the ClusterIssuer knative-eventing-ca-issuer issuing from it.
+	// +optional
+	IssuerRef *CertManagerIssuerRef `json:"issuerRef,omitempty"`
+
+	// CA configures the self-signed CA, which the operator creates without an IssuerRef.
+	// +optional
+	CA *TransportEncryptionCAConfiguration `json:"ca,omitempty"`
+
+	// TrustBundle distributes the CA to the namespaces of Knative Eventing with trust-manager.
+	// +optional
+	TrustBundle *EventingTrustBundleConfiguration `json:"trustBundle,omitempty"`
+}
+
+// TransportEncryptionCAConfiguration specifies the self-signed CA of the transport encryption.
+// cert-manager renews the CA before it expires, and reissues the certificates of the components.
+type TransportEncryptionCAConfiguration struct {
+	// Namespace is the cluster resource namespace of cert-manager, where the ClusterIssuer looks up
+	// the secret of the CA, cert-manager by default.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Duration is the lifetime of the CA, 8760h by default.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is the time before its expiry, when the CA is renewed, 720h by default.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// EventingTrustBundleConfiguration specifies the trust-manager Bundle of the CA of Knative Eventing.
+type EventingTrustBundleConfiguration struct {
+	// Source is the secret with the CA, in the trust namespace of trust-manager. It is required with
+	// an IssuerRef, the self-signed CA of the operator by default.
+	// +optional
+	Source *TrustBundleConfiguration `json:"source,omitempty"`
+
+	// NamespaceSelector selects the namespaces, which get a copy of the trust bundle, by default
+	// the namespace of Knative Eventing.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// CertificateStatus is the observed state of a cert-manager Certificate.
+type CertificateStatus struct {
+	// Name of the Certificate.
+	Name string `json:"name"`
+
+	// Namespace of the Certificate.
+	Namespace string `json:"namespace"`
+
+	// Ready reports whether the certificate is issued and up to date.
+	Ready bool `json:"ready"`
+
+	// NotAfter is the time, when the certificate expires.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// RenewalTime is the time, when cert-manager renews the certificate.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+}
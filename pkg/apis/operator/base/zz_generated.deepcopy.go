@@ -23,9 +23,101 @@ package base
 
 import (
 	v1beta1 "istio.io/api/networking/v1beta1"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedManifest) DeepCopyInto(out *AppliedManifest) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]ManifestSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceCounts != nil {
+		in, out := &in.ResourceCounts, &out.ResourceCounts
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedManifest.
+func (in *AppliedManifest) DeepCopy() *AppliedManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfiguration) DeepCopyInto(out *AutoscalingConfiguration) {
+	*out = *in
+	if in.TargetConcurrency != nil {
+		in, out := &in.TargetConcurrency, &out.TargetConcurrency
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetUtilizationPercentage != nil {
+		in, out := &in.TargetUtilizationPercentage, &out.TargetUtilizationPercentage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleToZeroGracePeriod != nil {
+		in, out := &in.ScaleToZeroGracePeriod, &out.ScaleToZeroGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.InitialScale != nil {
+		in, out := &in.InitialScale, &out.InitialScale
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowZeroInitialScale != nil {
+		in, out := &in.AllowZeroInitialScale, &out.AllowZeroInitialScale
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PanicWindowPercentage != nil {
+		in, out := &in.PanicWindowPercentage, &out.PanicWindowPercentage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinScale != nil {
+		in, out := &in.MinScale, &out.MinScale
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxScale != nil {
+		in, out := &in.MaxScale, &out.MaxScale
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfiguration.
+func (in *AutoscalingConfiguration) DeepCopy() *AutoscalingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwssqsSourceConfiguration) DeepCopyInto(out *AwssqsSourceConfiguration) {
 	*out = *in
@@ -42,6 +134,58 @@ func (in *AwssqsSourceConfiguration) DeepCopy() *AwssqsSourceConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerConfiguration) DeepCopyInto(out *BrokerConfiguration) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(duckv1.KReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Delivery != nil {
+		in, out := &in.Delivery, &out.Delivery
+		*out = new(BrokerDeliveryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerConfiguration.
+func (in *BrokerConfiguration) DeepCopy() *BrokerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerDeliveryConfiguration) DeepCopyInto(out *BrokerDeliveryConfiguration) {
+	*out = *in
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeadLetterSink != nil {
+		in, out := &in.DeadLetterSink, &out.DeadLetterSink
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerDeliveryConfiguration.
+func (in *BrokerDeliveryConfiguration) DeepCopy() *BrokerDeliveryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerDeliveryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephSourceConfiguration) DeepCopyInto(out *CephSourceConfiguration) {
 	*out = *in
@@ -58,6 +202,72 @@ func (in *CephSourceConfiguration) DeepCopy() *CephSourceConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerConfiguration) DeepCopyInto(out *CertManagerConfiguration) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.ClusterLocalIssuerRef != nil {
+		in, out := &in.ClusterLocalIssuerRef, &out.ClusterLocalIssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerConfiguration.
+func (in *CertManagerConfiguration) DeepCopy() *CertManagerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 	*out = *in
@@ -78,6 +288,23 @@ func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.ConfigFrom != nil {
+		in, out := &in.ConfigFrom, &out.ConfigFrom
+		*out = make(ConfigMapValueSources, len(*in))
+		for key, val := range *in {
+			var outVal map[string]ConfigValueSource
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]ConfigValueSource, len(*in))
+				for key, val := range *in {
+					(*out)[key] = *val.DeepCopy()
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	in.Registry.DeepCopyInto(&out.Registry)
 	if in.DeprecatedResources != nil {
 		in, out := &in.DeprecatedResources, &out.DeprecatedResources
@@ -134,6 +361,73 @@ func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ServiceAccountOverride != nil {
+		in, out := &in.ServiceAccountOverride, &out.ServiceAccountOverride
+		*out = make([]ServiceAccountOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(NetworkPolicies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetCluster != nil {
+		in, out := &in.TargetCluster, &out.TargetCluster
+		*out = new(TargetCluster)
+		**out = **in
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VersionOverrides != nil {
+		in, out := &in.VersionOverrides, &out.VersionOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LinuxNodesOnly != nil {
+		in, out := &in.LinuxNodesOnly, &out.LinuxNodesOnly
+		*out = new(bool)
+		**out = **in
+	}
+	in.IPFamilyConfiguration.DeepCopyInto(&out.IPFamilyConfiguration)
+	if in.Spot != nil {
+		in, out := &in.Spot, &out.Spot
+		*out = new(SpotConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Eco != nil {
+		in, out := &in.Eco, &out.Eco
+		*out = new(EcoMode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceRecommendations != nil {
+		in, out := &in.ResourceRecommendations, &out.ResourceRecommendations
+		*out = new(ResourceRecommendations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransformerOrder != nil {
+		in, out := &in.TransformerOrder, &out.TransformerOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalTransformer != nil {
+		in, out := &in.ExternalTransformer, &out.ExternalTransformer
+		*out = new(ExternalTransformer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -179,9 +473,72 @@ func (in ConfigMapData) DeepCopy() ConfigMapData {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ConfigMapValueSources) DeepCopyInto(out *ConfigMapValueSources) {
+	{
+		in := &in
+		*out = make(ConfigMapValueSources, len(*in))
+		for key, val := range *in {
+			var outVal map[string]ConfigValueSource
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]ConfigValueSource, len(*in))
+				for key, val := range *in {
+					(*out)[key] = *val.DeepCopy()
+				}
+			}
+			(*out)[key] = outVal
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapValueSources.
+func (in ConfigMapValueSources) DeepCopy() ConfigMapValueSources {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapValueSources)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigValueSource) DeepCopyInto(out *ConfigValueSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigValueSource.
+func (in *ConfigValueSource) DeepCopy() *ConfigValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigValueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContourIngressConfiguration) DeepCopyInto(out *ContourIngressConfiguration) {
 	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ContourVisibilityConfiguration)
+		**out = **in
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(ContourVisibilityConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -195,6 +552,22 @@ func (in *ContourIngressConfiguration) DeepCopy() *ContourIngressConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContourVisibilityConfiguration) DeepCopyInto(out *ContourVisibilityConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContourVisibilityConfiguration.
+func (in *ContourVisibilityConfiguration) DeepCopy() *ContourVisibilityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ContourVisibilityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CouchdbSourceConfiguration) DeepCopyInto(out *CouchdbSourceConfiguration) {
 	*out = *in
@@ -228,190 +601,1291 @@ func (in *CustomCerts) DeepCopy() *CustomCerts {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvRequirementsOverride) DeepCopyInto(out *EnvRequirementsOverride) {
+func (in *DigestResolution) DeepCopyInto(out *DigestResolution) {
 	*out = *in
-	if in.EnvVars != nil {
-		in, out := &in.EnvVars, &out.EnvVars
-		*out = make([]v1.EnvVar, len(*in))
+	if in.CredentialsSecret != nil {
+		in, out := &in.CredentialsSecret, &out.CredentialsSecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DigestResolution.
+func (in *DigestResolution) DeepCopy() *DigestResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(DigestResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainConfiguration) DeepCopyInto(out *DomainConfiguration) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]DomainSelector, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = new(DomainMappingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainConfiguration.
+func (in *DomainConfiguration) DeepCopy() *DomainConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMappingConfiguration) DeepCopyInto(out *DomainMappingConfiguration) {
+	*out = *in
+	if in.AutocreateClusterDomainClaims != nil {
+		in, out := &in.AutocreateClusterDomainClaims, &out.AutocreateClusterDomainClaims
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMappingConfiguration.
+func (in *DomainMappingConfiguration) DeepCopy() *DomainMappingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMappingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSelector) DeepCopyInto(out *DomainSelector) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSelector.
+func (in *DomainSelector) DeepCopy() *DomainSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EcoMode) DeepCopyInto(out *EcoMode) {
+	*out = *in
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EcoMode.
+func (in *EcoMode) DeepCopy() *EcoMode {
+	if in == nil {
+		return nil
+	}
+	out := new(EcoMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvRequirementsOverride) DeepCopyInto(out *EnvRequirementsOverride) {
+	*out = *in
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemoveEnvVars != nil {
+		in, out := &in.RemoveEnvVars, &out.RemoveEnvVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvRequirementsOverride.
+func (in *EnvRequirementsOverride) DeepCopy() *EnvRequirementsOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvRequirementsOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventingComponentConfiguration) DeepCopyInto(out *EventingComponentConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventingComponentConfiguration.
+func (in *EventingComponentConfiguration) DeepCopy() *EventingComponentConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EventingComponentConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventingTrustBundleConfiguration) DeepCopyInto(out *EventingTrustBundleConfiguration) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(TrustBundleConfiguration)
+		**out = **in
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventingTrustBundleConfiguration.
+func (in *EventingTrustBundleConfiguration) DeepCopy() *EventingTrustBundleConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EventingTrustBundleConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNSConfiguration) DeepCopyInto(out *ExternalDNSConfiguration) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNSConfiguration.
+func (in *ExternalDNSConfiguration) DeepCopy() *ExternalDNSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDNSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTransformer) DeepCopyInto(out *ExternalTransformer) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ExternalTransformerService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTransformer.
+func (in *ExternalTransformer) DeepCopy() *ExternalTransformer {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTransformer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTransformerService) DeepCopyInto(out *ExternalTransformerService) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTransformerService.
+func (in *ExternalTransformerService) DeepCopy() *ExternalTransformerService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTransformerService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionsBuildersConfiguration) DeepCopyInto(out *FunctionsBuildersConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionsBuildersConfiguration.
+func (in *FunctionsBuildersConfiguration) DeepCopy() *FunctionsBuildersConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionsBuildersConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionsPVCConfiguration) DeepCopyInto(out *FunctionsPVCConfiguration) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionsPVCConfiguration.
+func (in *FunctionsPVCConfiguration) DeepCopy() *FunctionsPVCConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionsPVCConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionConfiguration) DeepCopyInto(out *GarbageCollectionConfiguration) {
+	*out = *in
+	if in.MinNonActiveRevisions != nil {
+		in, out := &in.MinNonActiveRevisions, &out.MinNonActiveRevisions
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxNonActiveRevisions != nil {
+		in, out := &in.MaxNonActiveRevisions, &out.MaxNonActiveRevisions
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionConfiguration.
+func (in *GarbageCollectionConfiguration) DeepCopy() *GarbageCollectionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPIGateway) DeepCopyInto(out *GatewayAPIGateway) {
+	*out = *in
+	if in.SupportedFeatures != nil {
+		in, out := &in.SupportedFeatures, &out.SupportedFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPIGateway.
+func (in *GatewayAPIGateway) DeepCopy() *GatewayAPIGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPIGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPIIngressConfiguration) DeepCopyInto(out *GatewayAPIIngressConfiguration) {
+	*out = *in
+	if in.ExternalGateways != nil {
+		in, out := &in.ExternalGateways, &out.ExternalGateways
+		*out = make([]GatewayAPIGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LocalGateways != nil {
+		in, out := &in.LocalGateways, &out.LocalGateways
+		*out = make([]GatewayAPIGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPIIngressConfiguration.
+func (in *GatewayAPIIngressConfiguration) DeepCopy() *GatewayAPIIngressConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPIIngressConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubSourceConfiguration) DeepCopyInto(out *GithubSourceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubSourceConfiguration.
+func (in *GithubSourceConfiguration) DeepCopy() *GithubSourceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubSourceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitlabSourceConfiguration) DeepCopyInto(out *GitlabSourceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabSourceConfiguration.
+func (in *GitlabSourceConfiguration) DeepCopy() *GitlabSourceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GitlabSourceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make([]HighAvailabilityDeployment, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailability.
+func (in *HighAvailability) DeepCopy() *HighAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailabilityDeployment) DeepCopyInto(out *HighAvailabilityDeployment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilityDeployment.
+func (in *HighAvailabilityDeployment) DeepCopy() *HighAvailabilityDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailabilityDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRewrite) DeepCopyInto(out *ImageRewrite) {
+	*out = *in
+	if in.Except != nil {
+		in, out := &in.Except, &out.Except
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRewrite.
+func (in *ImageRewrite) DeepCopy() *ImageRewrite {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRewrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioGatewayOverride) DeepCopyInto(out *IstioGatewayOverride) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]*v1beta1.Server, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioGatewayOverride.
+func (in *IstioGatewayOverride) DeepCopy() *IstioGatewayOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioGatewayOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPFamilyConfiguration) DeepCopyInto(out *IPFamilyConfiguration) {
+	*out = *in
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]corev1.IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPFamilyPolicy != nil {
+		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
+		*out = new(corev1.IPFamilyPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFamilyConfiguration.
+func (in *IPFamilyConfiguration) DeepCopy() *IPFamilyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IPFamilyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioIngressConfiguration) DeepCopyInto(out *IstioIngressConfiguration) {
+	*out = *in
+	if in.KnativeIngressGateway != nil {
+		in, out := &in.KnativeIngressGateway, &out.KnativeIngressGateway
+		*out = new(IstioGatewayOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KnativeLocalGateway != nil {
+		in, out := &in.KnativeLocalGateway, &out.KnativeLocalGateway
+		*out = new(IstioGatewayOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mesh != nil {
+		in, out := &in.Mesh, &out.Mesh
+		*out = new(IstioMeshConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioIngressConfiguration.
+func (in *IstioIngressConfiguration) DeepCopy() *IstioIngressConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioIngressConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioMeshConfiguration) DeepCopyInto(out *IstioMeshConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioMeshConfiguration.
+func (in *IstioMeshConfiguration) DeepCopy() *IstioMeshConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioMeshConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobSinkConfiguration) DeepCopyInto(out *JobSinkConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobSinkConfiguration.
+func (in *JobSinkConfiguration) DeepCopy() *JobSinkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(JobSinkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAConfiguration) DeepCopyInto(out *KEDAConfiguration) {
+	*out = *in
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KEDAKafkaConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]KEDAWorkloadConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAConfiguration.
+func (in *KEDAConfiguration) DeepCopy() *KEDAConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAKafkaConfiguration) DeepCopyInto(out *KEDAKafkaConfiguration) {
+	*out = *in
+	in.KEDAScalingConfiguration.DeepCopyInto(&out.KEDAScalingConfiguration)
+	if in.LagThreshold != nil {
+		in, out := &in.LagThreshold, &out.LagThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAKafkaConfiguration.
+func (in *KEDAKafkaConfiguration) DeepCopy() *KEDAKafkaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAKafkaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAScalingConfiguration) DeepCopyInto(out *KEDAScalingConfiguration) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAScalingConfiguration.
+func (in *KEDAScalingConfiguration) DeepCopy() *KEDAScalingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAScalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAWorkloadConfiguration) DeepCopyInto(out *KEDAWorkloadConfiguration) {
+	*out = *in
+	in.KEDAScalingConfiguration.DeepCopyInto(&out.KEDAScalingConfiguration)
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAWorkloadConfiguration.
+func (in *KEDAWorkloadConfiguration) DeepCopy() *KEDAWorkloadConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAWorkloadConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConfiguration) DeepCopyInto(out *KafkaConfiguration) {
+	*out = *in
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Broker != nil {
+		in, out := &in.Broker, &out.Broker
+		*out = new(EventingComponentConfiguration)
+		**out = **in
+	}
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(EventingComponentConfiguration)
+		**out = **in
+	}
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(EventingComponentConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConfiguration.
+func (in *KafkaConfiguration) DeepCopy() *KafkaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceConfiguration) DeepCopyInto(out *KafkaSourceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceConfiguration.
+func (in *KafkaSourceConfiguration) DeepCopy() *KafkaSourceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KourierGatewayAutoscaling) DeepCopyInto(out *KourierGatewayAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilization != nil {
+		in, out := &in.TargetCPUUtilization, &out.TargetCPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KourierGatewayAutoscaling.
+func (in *KourierGatewayAutoscaling) DeepCopy() *KourierGatewayAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(KourierGatewayAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KourierIngressConfiguration) DeepCopyInto(out *KourierIngressConfiguration) {
+	*out = *in
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GatewayAutoscaling != nil {
+		in, out := &in.GatewayAutoscaling, &out.GatewayAutoscaling
+		*out = new(KourierGatewayAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KourierIngressConfiguration.
+func (in *KourierIngressConfiguration) DeepCopy() *KourierIngressConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KourierIngressConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Manifest) DeepCopyInto(out *Manifest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manifest.
+func (in *Manifest) DeepCopy() *Manifest {
+	if in == nil {
+		return nil
+	}
+	out := new(Manifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestSource) DeepCopyInto(out *ManifestSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSource.
+func (in *ManifestSource) DeepCopy() *ManifestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceConfiguration) DeepCopyInto(out *NamespaceConfiguration) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceConfiguration.
+func (in *NamespaceConfiguration) DeepCopy() *NamespaceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatssSourceConfiguration) DeepCopyInto(out *NatssSourceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatssSourceConfiguration.
+func (in *NatssSourceConfiguration) DeepCopy() *NatssSourceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NatssSourceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicies) DeepCopyInto(out *NetworkPolicies) {
+	*out = *in
+	if in.MetricsNamespaceSelector != nil {
+		in, out := &in.MetricsNamespaceSelector, &out.MetricsNamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicies.
+func (in *NetworkPolicies) DeepCopy() *NetworkPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PingSourceConfiguration) DeepCopyInto(out *PingSourceConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PingSourceConfiguration.
+func (in *PingSourceConfiguration) DeepCopy() *PingSourceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PingSourceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetOverride) DeepCopyInto(out *PodDisruptionBudgetOverride) {
+	*out = *in
+	in.PodDisruptionBudgetSpec.DeepCopyInto(&out.PodDisruptionBudgetSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetOverride.
+func (in *PodDisruptionBudgetOverride) DeepCopy() *PodDisruptionBudgetOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityConfiguration) DeepCopyInto(out *PodSecurityConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityConfiguration.
+func (in *PodSecurityConfiguration) DeepCopy() *PodSecurityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbesRequirementsOverride) DeepCopyInto(out *ProbesRequirementsOverride) {
+	*out = *in
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbesRequirementsOverride.
+func (in *ProbesRequirementsOverride) DeepCopy() *ProbesRequirementsOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbesRequirementsOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusSourceConfiguration) DeepCopyInto(out *PrometheusSourceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSourceConfiguration.
+func (in *PrometheusSourceConfiguration) DeepCopy() *PrometheusSourceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusSourceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueProxyConfiguration) DeepCopyInto(out *QueueProxyConfiguration) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueProxyConfiguration.
+func (in *QueueProxyConfiguration) DeepCopy() *QueueProxyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueProxyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQClusterReference) DeepCopyInto(out *RabbitMQClusterReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQClusterReference.
+func (in *RabbitMQClusterReference) DeepCopy() *RabbitMQClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RabbitMQClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RabbitMQConfiguration) DeepCopyInto(out *RabbitMQConfiguration) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(RabbitMQClusterReference)
+		**out = **in
+	}
+	if in.Broker != nil {
+		in, out := &in.Broker, &out.Broker
+		*out = new(EventingComponentConfiguration)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(EventingComponentConfiguration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvRequirementsOverride.
-func (in *EnvRequirementsOverride) DeepCopy() *EnvRequirementsOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitMQConfiguration.
+func (in *RabbitMQConfiguration) DeepCopy() *RabbitMQConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvRequirementsOverride)
+	out := new(RabbitMQConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GithubSourceConfiguration) DeepCopyInto(out *GithubSourceConfiguration) {
+func (in *RabbitmqSourceConfiguration) DeepCopyInto(out *RabbitmqSourceConfiguration) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubSourceConfiguration.
-func (in *GithubSourceConfiguration) DeepCopy() *GithubSourceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitmqSourceConfiguration.
+func (in *RabbitmqSourceConfiguration) DeepCopy() *RabbitmqSourceConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(GithubSourceConfiguration)
+	out := new(RabbitmqSourceConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitlabSourceConfiguration) DeepCopyInto(out *GitlabSourceConfiguration) {
+func (in *RedisSourceConfiguration) DeepCopyInto(out *RedisSourceConfiguration) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabSourceConfiguration.
-func (in *GitlabSourceConfiguration) DeepCopy() *GitlabSourceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSourceConfiguration.
+func (in *RedisSourceConfiguration) DeepCopy() *RedisSourceConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(GitlabSourceConfiguration)
+	out := new(RedisSourceConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
+func (in *Registry) DeepCopyInto(out *Registry) {
 	*out = *in
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
+	if in.Override != nil {
+		in, out := &in.Override, &out.Override
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rewrites != nil {
+		in, out := &in.Rewrites, &out.Rewrites
+		*out = make([]ImageRewrite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DigestResolution != nil {
+		in, out := &in.DigestResolution, &out.DigestResolution
+		*out = new(DigestResolution)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailability.
-func (in *HighAvailability) DeepCopy() *HighAvailability {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registry.
+func (in *Registry) DeepCopy() *Registry {
 	if in == nil {
 		return nil
 	}
-	out := new(HighAvailability)
+	out := new(Registry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IstioGatewayOverride) DeepCopyInto(out *IstioGatewayOverride) {
+func (in *ResourceRecommendations) DeepCopyInto(out *ResourceRecommendations) {
 	*out = *in
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = make(map[string]string, len(*in))
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = val.DeepCopy()
 		}
 	}
-	if in.Servers != nil {
-		in, out := &in.Servers, &out.Servers
-		*out = make([]*v1beta1.Server, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = (*in).DeepCopy()
-			}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioGatewayOverride.
-func (in *IstioGatewayOverride) DeepCopy() *IstioGatewayOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendations.
+func (in *ResourceRecommendations) DeepCopy() *ResourceRecommendations {
 	if in == nil {
 		return nil
 	}
-	out := new(IstioGatewayOverride)
+	out := new(ResourceRecommendations)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IstioIngressConfiguration) DeepCopyInto(out *IstioIngressConfiguration) {
+func (in *ResourceRequirementsOverride) DeepCopyInto(out *ResourceRequirementsOverride) {
 	*out = *in
-	if in.KnativeIngressGateway != nil {
-		in, out := &in.KnativeIngressGateway, &out.KnativeIngressGateway
-		*out = new(IstioGatewayOverride)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.KnativeLocalGateway != nil {
-		in, out := &in.KnativeLocalGateway, &out.KnativeLocalGateway
-		*out = new(IstioGatewayOverride)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioIngressConfiguration.
-func (in *IstioIngressConfiguration) DeepCopy() *IstioIngressConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirementsOverride.
+func (in *ResourceRequirementsOverride) DeepCopy() *ResourceRequirementsOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(IstioIngressConfiguration)
+	out := new(ResourceRequirementsOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSourceConfiguration) DeepCopyInto(out *KafkaSourceConfiguration) {
+func (in *RevisionDeploymentConfiguration) DeepCopyInto(out *RevisionDeploymentConfiguration) {
 	*out = *in
+	if in.QueueProxy != nil {
+		in, out := &in.QueueProxy, &out.QueueProxy
+		*out = new(QueueProxyConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProgressDeadline != nil {
+		in, out := &in.ProgressDeadline, &out.ProgressDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RegistriesSkippingTagResolving != nil {
+		in, out := &in.RegistriesSkippingTagResolving, &out.RegistriesSkippingTagResolving
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceConfiguration.
-func (in *KafkaSourceConfiguration) DeepCopy() *KafkaSourceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionDeploymentConfiguration.
+func (in *RevisionDeploymentConfiguration) DeepCopy() *RevisionDeploymentConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSourceConfiguration)
+	out := new(RevisionDeploymentConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KourierIngressConfiguration) DeepCopyInto(out *KourierIngressConfiguration) {
+func (in *RoleGrant) DeepCopyInto(out *RoleGrant) {
 	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]rbacv1.PolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KourierIngressConfiguration.
-func (in *KourierIngressConfiguration) DeepCopy() *KourierIngressConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleGrant.
+func (in *RoleGrant) DeepCopy() *RoleGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(KourierIngressConfiguration)
+	out := new(RoleGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Manifest) DeepCopyInto(out *Manifest) {
+func (in *SecurityGuardConfiguration) DeepCopyInto(out *SecurityGuardConfiguration) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manifest.
-func (in *Manifest) DeepCopy() *Manifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGuardConfiguration.
+func (in *SecurityGuardConfiguration) DeepCopy() *SecurityGuardConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(Manifest)
+	out := new(SecurityGuardConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceConfiguration) DeepCopyInto(out *NamespaceConfiguration) {
+func (in *ServiceAccountOverride) DeepCopyInto(out *ServiceAccountOverride) {
 	*out = *in
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
@@ -430,212 +1904,270 @@ func (in *NamespaceConfiguration) DeepCopyInto(out *NamespaceConfiguration) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceConfiguration.
-func (in *NamespaceConfiguration) DeepCopy() *NamespaceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountOverride.
+func (in *ServiceAccountOverride) DeepCopy() *ServiceAccountOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespaceConfiguration)
+	out := new(ServiceAccountOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NatssSourceConfiguration) DeepCopyInto(out *NatssSourceConfiguration) {
+func (in *ServiceAccountPermissions) DeepCopyInto(out *ServiceAccountPermissions) {
 	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]RoleGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatssSourceConfiguration.
-func (in *NatssSourceConfiguration) DeepCopy() *NatssSourceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountPermissions.
+func (in *ServiceAccountPermissions) DeepCopy() *ServiceAccountPermissions {
 	if in == nil {
 		return nil
 	}
-	out := new(NatssSourceConfiguration)
+	out := new(ServiceAccountPermissions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodDisruptionBudgetOverride) DeepCopyInto(out *PodDisruptionBudgetOverride) {
+func (in *ServiceOverride) DeepCopyInto(out *ServiceOverride) {
 	*out = *in
-	in.PodDisruptionBudgetSpec.DeepCopyInto(&out.PodDisruptionBudgetSpec)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.IPFamilyConfiguration.DeepCopyInto(&out.IPFamilyConfiguration)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetOverride.
-func (in *PodDisruptionBudgetOverride) DeepCopy() *PodDisruptionBudgetOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOverride.
+func (in *ServiceOverride) DeepCopy() *ServiceOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(PodDisruptionBudgetOverride)
+	out := new(ServiceOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProbesRequirementsOverride) DeepCopyInto(out *ProbesRequirementsOverride) {
+func (in *SpotConfiguration) DeepCopyInto(out *SpotConfiguration) {
 	*out = *in
-	if in.TerminationGracePeriodSeconds != nil {
-		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
-		*out = new(int64)
-		**out = **in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OnDemand != nil {
+		in, out := &in.OnDemand, &out.OnDemand
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbesRequirementsOverride.
-func (in *ProbesRequirementsOverride) DeepCopy() *ProbesRequirementsOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotConfiguration.
+func (in *SpotConfiguration) DeepCopy() *SpotConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(ProbesRequirementsOverride)
+	out := new(SpotConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusSourceConfiguration) DeepCopyInto(out *PrometheusSourceConfiguration) {
+func (in *SugarConfiguration) DeepCopyInto(out *SugarConfiguration) {
 	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TriggerSelector != nil {
+		in, out := &in.TriggerSelector, &out.TriggerSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSourceConfiguration.
-func (in *PrometheusSourceConfiguration) DeepCopy() *PrometheusSourceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SugarConfiguration.
+func (in *SugarConfiguration) DeepCopy() *SugarConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusSourceConfiguration)
+	out := new(SugarConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RabbitmqSourceConfiguration) DeepCopyInto(out *RabbitmqSourceConfiguration) {
+func (in *SystemInternalTLSConfiguration) DeepCopyInto(out *SystemInternalTLSConfiguration) {
 	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.TrustBundle != nil {
+		in, out := &in.TrustBundle, &out.TrustBundle
+		*out = new(TrustBundleConfiguration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RabbitmqSourceConfiguration.
-func (in *RabbitmqSourceConfiguration) DeepCopy() *RabbitmqSourceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemInternalTLSConfiguration.
+func (in *SystemInternalTLSConfiguration) DeepCopy() *SystemInternalTLSConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(RabbitmqSourceConfiguration)
+	out := new(SystemInternalTLSConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RedisSourceConfiguration) DeepCopyInto(out *RedisSourceConfiguration) {
+func (in *TargetCluster) DeepCopyInto(out *TargetCluster) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSourceConfiguration.
-func (in *RedisSourceConfiguration) DeepCopy() *RedisSourceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetCluster.
+func (in *TargetCluster) DeepCopy() *TargetCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisSourceConfiguration)
+	out := new(TargetCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Registry) DeepCopyInto(out *Registry) {
+func (in *TransportEncryptionCAConfiguration) DeepCopyInto(out *TransportEncryptionCAConfiguration) {
 	*out = *in
-	if in.Override != nil {
-		in, out := &in.Override, &out.Override
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
-		copy(*out, *in)
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registry.
-func (in *Registry) DeepCopy() *Registry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportEncryptionCAConfiguration.
+func (in *TransportEncryptionCAConfiguration) DeepCopy() *TransportEncryptionCAConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(Registry)
+	out := new(TransportEncryptionCAConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRequirementsOverride) DeepCopyInto(out *ResourceRequirementsOverride) {
+func (in *TransportEncryptionConfiguration) DeepCopyInto(out *TransportEncryptionConfiguration) {
 	*out = *in
-	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(TransportEncryptionCAConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustBundle != nil {
+		in, out := &in.TrustBundle, &out.TrustBundle
+		*out = new(EventingTrustBundleConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirementsOverride.
-func (in *ResourceRequirementsOverride) DeepCopy() *ResourceRequirementsOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportEncryptionConfiguration.
+func (in *TransportEncryptionConfiguration) DeepCopy() *TransportEncryptionConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRequirementsOverride)
+	out := new(TransportEncryptionConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGuardConfiguration) DeepCopyInto(out *SecurityGuardConfiguration) {
+func (in *TriggerFiltersConfiguration) DeepCopyInto(out *TriggerFiltersConfiguration) {
 	*out = *in
+	if in.New != nil {
+		in, out := &in.New, &out.New
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGuardConfiguration.
-func (in *SecurityGuardConfiguration) DeepCopy() *SecurityGuardConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerFiltersConfiguration.
+func (in *TriggerFiltersConfiguration) DeepCopy() *TriggerFiltersConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGuardConfiguration)
+	out := new(TriggerFiltersConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceOverride) DeepCopyInto(out *ServiceOverride) {
+func (in *TrustBundleConfiguration) DeepCopyInto(out *TrustBundleConfiguration) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOverride.
-func (in *ServiceOverride) DeepCopy() *ServiceOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustBundleConfiguration.
+func (in *TrustBundleConfiguration) DeepCopy() *TrustBundleConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceOverride)
+	out := new(TrustBundleConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -671,21 +2203,21 @@ func (in *WorkloadOverride) DeepCopyInto(out *WorkloadOverride) {
 	}
 	if in.TopologySpreadConstraints != nil {
 		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
-		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
@@ -721,6 +2253,11 @@ func (in *WorkloadOverride) DeepCopyInto(out *WorkloadOverride) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
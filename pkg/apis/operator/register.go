@@ -29,6 +29,12 @@ const (
 
 	// KindKnativeServing is the Kind of Knative Serving in a GVK context.
 	KindKnativeServing = "KnativeServing"
+
+	// KindKnativeFunctions is the Kind of the cluster components of Knative Functions in a GVK context.
+	KindKnativeFunctions = "KnativeFunctions"
+
+	// KindKnativeNetworking is the Kind of the networking layer of Knative Serving in a GVK context.
+	KindKnativeNetworking = "KnativeNetworking"
 )
 
 var (
@@ -42,4 +48,14 @@ var (
 		Group:    GroupName,
 		Resource: "knativeeventings",
 	}
+	// KnativeFunctionsResource represents the cluster components of Knative Functions
+	KnativeFunctionsResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "knativefunctions",
+	}
+	// KnativeNetworkingResource represents the networking layer of Knative Serving
+	KnativeNetworkingResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "knativenetworkings",
+	}
 )
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/apis"
+)
+
+// ConvertTo implements apis.Convertible
+// Converts KnativeEventing from v1alpha1.KnativeEventing into v1beta1.KnativeEventing
+func (ke *KnativeEventing) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1beta1.KnativeEventing:
+		source := ke.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		sink.Spec = source.Spec
+		sink.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+// Converts KnativeEventing from v1beta1.KnativeEventing into v1alpha1.KnativeEventing
+func (ke *KnativeEventing) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1beta1.KnativeEventing:
+		source = source.DeepCopy()
+		ke.ObjectMeta = source.ObjectMeta
+		ke.Spec = source.Spec
+		ke.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
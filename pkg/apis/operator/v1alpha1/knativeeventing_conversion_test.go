@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestKnativeEventingConversionRoundTrip(t *testing.T) {
+	want := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+		Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec: base.CommonSpec{
+				Version: "1.21",
+				Config:  base.ConfigMapData{"features": {"kreference-group": "enabled"}},
+				Registry: base.Registry{
+					Override: map[string]string{"eventing-controller": "example-registry.io/custom/controller:tag"},
+				},
+			},
+			DefaultBrokerClass:       "MTChannelBasedBroker",
+			SinkBindingSelectionMode: "inclusion",
+			Source:                   &v1beta1.SourceConfigs{Kafka: base.KafkaSourceConfiguration{Enabled: true}},
+		},
+		Status: v1beta1.KnativeEventingStatus{Version: "1.21.0"},
+	}
+
+	alpha := &KnativeEventing{}
+	if err := alpha.ConvertFrom(context.Background(), want); err != nil {
+		t.Fatalf("ConvertFrom() = %v", err)
+	}
+	got := &v1beta1.KnativeEventing{}
+	if err := alpha.ConvertTo(context.Background(), got); err != nil {
+		t.Fatalf("ConvertTo() = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Round trip (-want, +got): %s", diff)
+	}
+}
+
+func TestKnativeEventingConversionUnknownVersion(t *testing.T) {
+	source, sink := &KnativeEventing{}, &KnativeEventing{}
+
+	if err := source.ConvertTo(context.Background(), sink); err == nil {
+		t.Errorf("ConvertTo() = %#v, wanted error", sink)
+	}
+
+	if err := source.ConvertFrom(context.Background(), sink); err == nil {
+		t.Errorf("ConvertFrom() = %#v, wanted error", source)
+	}
+}
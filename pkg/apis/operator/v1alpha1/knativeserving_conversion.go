@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/apis"
+)
+
+// ConvertTo implements apis.Convertible
+// Converts KnativeServing from v1alpha1.KnativeServing into v1beta1.KnativeServing. The gateways
+// at the top level of the spec are moved into spec.ingress.istio, unless they are set there.
+func (ks *KnativeServing) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1beta1.KnativeServing:
+		source := ks.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		sink.Spec = v1beta1.KnativeServingSpec{
+			CommonSpec:            source.Spec.CommonSpec,
+			ControllerCustomCerts: source.Spec.ControllerCustomCerts,
+			Ingress:               source.Spec.Ingress,
+			Security:              source.Spec.Security,
+			Domain:                source.Spec.Domain,
+			Autoscaling:           source.Spec.Autoscaling,
+			RevisionDeployments:   source.Spec.RevisionDeployments,
+			GarbageCollection:     source.Spec.GarbageCollection,
+		}
+		if source.Spec.DeprecatedKnativeIngressGateway != nil || source.Spec.DeprecatedClusterLocalGateway != nil {
+			if sink.Spec.Ingress == nil {
+				// No ingress means Istio, which the gateways belong to.
+				sink.Spec.Ingress = &v1beta1.IngressConfigs{Istio: base.IstioIngressConfiguration{Enabled: true}}
+			}
+			istio := &sink.Spec.Ingress.Istio
+			if istio.KnativeIngressGateway == nil {
+				istio.KnativeIngressGateway = source.Spec.DeprecatedKnativeIngressGateway
+			}
+			if istio.KnativeLocalGateway == nil {
+				istio.KnativeLocalGateway = source.Spec.DeprecatedClusterLocalGateway
+			}
+		}
+		sink.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible
+// Converts KnativeServing from v1beta1.KnativeServing into v1alpha1.KnativeServing
+func (ks *KnativeServing) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1beta1.KnativeServing:
+		source = source.DeepCopy()
+		ks.ObjectMeta = source.ObjectMeta
+		ks.Spec = KnativeServingSpec{
+			CommonSpec:            source.Spec.CommonSpec,
+			ControllerCustomCerts: source.Spec.ControllerCustomCerts,
+			Ingress:               source.Spec.Ingress,
+			Security:              source.Spec.Security,
+			Domain:                source.Spec.Domain,
+			Autoscaling:           source.Spec.Autoscaling,
+			RevisionDeployments:   source.Spec.RevisionDeployments,
+			GarbageCollection:     source.Spec.GarbageCollection,
+		}
+		ks.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+var gateway = &base.IstioGatewayOverride{Selector: map[string]string{"istio": "custom-gateway"}}
+
+func TestKnativeServingConversionRoundTrip(t *testing.T) {
+	want := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving", Generation: 2},
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{
+				Version: "1.21",
+				Config:  base.ConfigMapData{"network": {"ingress-class": "kourier.ingress.networking.knative.dev"}},
+				Registry: base.Registry{
+					Default:  "example-registry.io/custom/path/${NAME}:custom-tag",
+					Override: map[string]string{"activator": "example-registry.io/custom/activator:tag"},
+				},
+				HighAvailability: &base.HighAvailability{Replicas: new(int32)},
+			},
+			ControllerCustomCerts: base.CustomCerts{Type: "Secret", Name: "certs"},
+			Ingress: &v1beta1.IngressConfigs{
+				Istio:   base.IstioIngressConfiguration{KnativeIngressGateway: gateway},
+				Kourier: base.KourierIngressConfiguration{Enabled: true},
+			},
+			Security: &v1beta1.SecurityConfigs{SecurityGuard: base.SecurityGuardConfiguration{Enabled: true}},
+			Domain: &base.DomainConfiguration{
+				Default:  "example.com",
+				Template: "{{.Name}}.{{.Domain}}",
+				Mapping:  &base.DomainMappingConfiguration{AutocreateClusterDomainClaims: ptr.To(true)},
+			},
+			Autoscaling:         &base.AutoscalingConfiguration{TargetConcurrency: ptr.To(int64(10)), MinScale: ptr.To(int32(1))},
+			RevisionDeployments: &base.RevisionDeploymentConfiguration{ProgressDeadline: &metav1.Duration{Duration: time.Minute}},
+			GarbageCollection:   &base.GarbageCollectionConfiguration{RetainSinceCreateTime: "48h", MaxNonActiveRevisions: ptr.To(intstr.FromInt32(5))},
+		},
+		Status: v1beta1.KnativeServingStatus{
+			Status:    duckv1.Status{ObservedGeneration: 2, Conditions: duckv1.Conditions{{Type: apis.ConditionReady, Status: "True"}}},
+			Version:   "1.21.0",
+			Manifests: []string{"kodata/knative-serving/1.21.0"},
+		},
+	}
+
+	// Every field of the spec must survive the round trip, so that none is lost, when a client
+	// reads or writes v1alpha1.
+	spec := reflect.ValueOf(want.Spec)
+	for i := 0; i < spec.NumField(); i++ {
+		if spec.Field(i).IsZero() {
+			t.Fatalf("spec.%s is not set in the round trip test", spec.Type().Field(i).Name)
+		}
+	}
+
+	alpha := &KnativeServing{}
+	if err := alpha.ConvertFrom(context.Background(), want); err != nil {
+		t.Fatalf("ConvertFrom() = %v", err)
+	}
+	got := &v1beta1.KnativeServing{}
+	if err := alpha.ConvertTo(context.Background(), got); err != nil {
+		t.Fatalf("ConvertTo() = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Round trip (-want, +got): %s", diff)
+	}
+}
+
+func TestKnativeServingConversionGateways(t *testing.T) {
+	other := &base.IstioGatewayOverride{Selector: map[string]string{"istio": "other"}}
+
+	tests := []struct {
+		name string
+		spec KnativeServingSpec
+		want *v1beta1.IngressConfigs
+	}{{
+		name: "no gateways",
+	}, {
+		name: "default ingress",
+		spec: KnativeServingSpec{DeprecatedKnativeIngressGateway: gateway, DeprecatedClusterLocalGateway: other},
+		want: &v1beta1.IngressConfigs{Istio: base.IstioIngressConfiguration{
+			Enabled:               true,
+			KnativeIngressGateway: gateway,
+			KnativeLocalGateway:   other,
+		}},
+	}, {
+		name: "the ingress takes precedence",
+		spec: KnativeServingSpec{
+			DeprecatedKnativeIngressGateway: gateway,
+			DeprecatedClusterLocalGateway:   gateway,
+			Ingress: &v1beta1.IngressConfigs{Istio: base.IstioIngressConfiguration{
+				Enabled:             true,
+				KnativeLocalGateway: other,
+			}},
+		},
+		want: &v1beta1.IngressConfigs{Istio: base.IstioIngressConfiguration{
+			Enabled:               true,
+			KnativeIngressGateway: gateway,
+			KnativeLocalGateway:   other,
+		}},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source := &KnativeServing{Spec: test.spec}
+			sink := &v1beta1.KnativeServing{}
+			if err := source.ConvertTo(context.Background(), sink); err != nil {
+				t.Fatalf("ConvertTo() = %v", err)
+			}
+			if diff := cmp.Diff(test.want, sink.Spec.Ingress); diff != "" {
+				t.Errorf("Ingress (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKnativeServingConversionUnknownVersion(t *testing.T) {
+	source, sink := &KnativeServing{}, &KnativeServing{}
+
+	if err := source.ConvertTo(context.Background(), sink); err == nil {
+		t.Errorf("ConvertTo() = %#v, wanted error", sink)
+	}
+
+	if err := source.ConvertFrom(context.Background(), sink); err == nil {
+		t.Errorf("ConvertFrom() = %#v, wanted error", source)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// KnativeServing is the deprecated v1alpha1 schema of the knativeservings API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KnativeServing struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KnativeServingSpec           `json:"spec,omitempty"`
+	Status v1beta1.KnativeServingStatus `json:"status,omitempty"`
+}
+
+// KnativeServingSpec defines the desired state of KnativeServing
+type KnativeServingSpec struct {
+	base.CommonSpec `json:",inline"`
+
+	// DeprecatedKnativeIngressGateway overrides the knative-ingress-gateway. It is replaced by
+	// spec.ingress.istio.knative-ingress-gateway in v1beta1.
+	// +optional
+	DeprecatedKnativeIngressGateway *base.IstioGatewayOverride `json:"knative-ingress-gateway,omitempty"`
+
+	// DeprecatedClusterLocalGateway overrides the cluster-local-gateway. It is replaced by
+	// spec.ingress.istio.knative-local-gateway in v1beta1.
+	// +optional
+	DeprecatedClusterLocalGateway *base.IstioGatewayOverride `json:"cluster-local-gateway,omitempty"`
+
+	// Enables controller to trust registries with self-signed certificates
+	ControllerCustomCerts base.CustomCerts `json:"controller-custom-certs,omitempty"`
+
+	// Ingress allows configuration of different ingress adapters to be shipped.
+	Ingress *v1beta1.IngressConfigs `json:"ingress,omitempty"`
+
+	// Security allows configuration of different security adapters to be shipped.
+	Security *v1beta1.SecurityConfigs `json:"security,omitempty"`
+
+	// Domain configures the domains of the Knative services.
+	// +optional
+	Domain *base.DomainConfiguration `json:"domain,omitempty"`
+
+	// Autoscaling configures the defaults of the autoscaler.
+	// +optional
+	Autoscaling *base.AutoscalingConfiguration `json:"autoscaling,omitempty"`
+
+	// RevisionDeployments configures the defaults of the deployments of the revisions.
+	// +optional
+	RevisionDeployments *base.RevisionDeploymentConfiguration `json:"revisionDeployments,omitempty"`
+
+	// GarbageCollection configures the retention of the non-active revisions.
+	// +optional
+	GarbageCollection *base.GarbageCollectionConfiguration `json:"garbageCollection,omitempty"`
+}
+
+// KnativeServingList contains a list of KnativeServing
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KnativeServingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KnativeServing `json:"items"`
+}
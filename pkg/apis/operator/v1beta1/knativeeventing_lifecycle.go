@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/operator/pkg/apis/operator"
 	"knative.dev/operator/pkg/apis/operator/base"
@@ -102,6 +103,141 @@ func (es *KnativeEventingStatus) MarkDeploymentsNotReady(deployments []string) {
 		"Waiting on deployments: %s", strings.Join(deployments, ", "))
 }
 
+// MarkPreviewReady marks the PreviewReady status as true.
+func (es *KnativeEventingStatus) MarkPreviewReady(configMap string) {
+	eventingCondSet.Manage(es).MarkTrueWithReason(
+		base.PreviewReady,
+		"DryRun",
+		"Preview of the changes is available in the ConfigMap %s", configMap)
+}
+
+// MarkPreviewFailed marks the PreviewReady status as false with the given message.
+func (es *KnativeEventingStatus) MarkPreviewFailed(msg string) {
+	eventingCondSet.Manage(es).MarkFalse(
+		base.PreviewReady,
+		"Error",
+		"Preview failed with message: %s", msg)
+}
+
+// ClearPreview removes the PreviewReady status.
+func (es *KnativeEventingStatus) ClearPreview() {
+	eventingCondSet.Manage(es).ClearCondition(base.PreviewReady)
+}
+
+// MarkPreflightChecksPassed marks the PreflightChecksPassed status as true.
+func (es *KnativeEventingStatus) MarkPreflightChecksPassed() {
+	eventingCondSet.Manage(es).MarkTrue(base.PreflightChecksPassed)
+}
+
+// MarkPreflightChecksFailed marks the PreflightChecksPassed status as false with the given violations.
+func (es *KnativeEventingStatus) MarkPreflightChecksFailed(violations []string) {
+	eventingCondSet.Manage(es).MarkFalse(
+		base.PreflightChecksPassed,
+		"PreflightFailed",
+		"Pre-flight checks failed: %s", strings.Join(violations, "; "))
+}
+
+// MarkPaused marks the Paused status as true.
+func (es *KnativeEventingStatus) MarkPaused() {
+	eventingCondSet.Manage(es).MarkTrueWithReason(
+		base.Paused,
+		"Paused",
+		"Reconciliation is paused by the annotation %s", base.PausedAnnotation)
+}
+
+// ClearPaused removes the Paused status.
+func (es *KnativeEventingStatus) ClearPaused() {
+	eventingCondSet.Manage(es).ClearCondition(base.Paused)
+}
+
+// MarkVersionSkewWarning marks the VersionSkewWarning status as true with the given message.
+func (es *KnativeEventingStatus) MarkVersionSkewWarning(msg string) {
+	eventingCondSet.Manage(es).MarkTrueWithReason(
+		base.VersionSkewWarning,
+		"VersionSkew",
+		"%s", msg)
+}
+
+// ClearVersionSkewWarning removes the VersionSkewWarning status.
+func (es *KnativeEventingStatus) ClearVersionSkewWarning() {
+	eventingCondSet.Manage(es).ClearCondition(base.VersionSkewWarning)
+}
+
+// MarkWebhookCertificateWarning marks the WebhookCertificateWarning status as true with the given message.
+func (es *KnativeEventingStatus) MarkWebhookCertificateWarning(msg string) {
+	eventingCondSet.Manage(es).MarkTrueWithReason(
+		base.WebhookCertificateWarning,
+		"WebhookCertificate",
+		"%s", msg)
+}
+
+// ClearWebhookCertificateWarning removes the WebhookCertificateWarning status.
+func (es *KnativeEventingStatus) ClearWebhookCertificateWarning() {
+	eventingCondSet.Manage(es).ClearCondition(base.WebhookCertificateWarning)
+}
+
+// MarkUnmanagedConfigMapsWarning marks the UnmanagedConfigMapsWarning status as true with the given exclusions.
+func (es *KnativeEventingStatus) MarkUnmanagedConfigMapsWarning(exclusions []string) {
+	eventingCondSet.Manage(es).MarkTrueWithReason(
+		base.UnmanagedConfigMapsWarning,
+		"UnmanagedConfigMaps",
+		"ConfigMaps excluded from the reconciliation: %s", strings.Join(exclusions, "; "))
+}
+
+// ClearUnmanagedConfigMapsWarning removes the UnmanagedConfigMapsWarning status.
+func (es *KnativeEventingStatus) ClearUnmanagedConfigMapsWarning() {
+	eventingCondSet.Manage(es).ClearCondition(base.UnmanagedConfigMapsWarning)
+}
+
+// MarkUnknownConfigWarning marks the UnknownConfigWarning status as true with the given message.
+func (es *KnativeEventingStatus) MarkUnknownConfigWarning(msg string) {
+	eventingCondSet.Manage(es).MarkTrueWithReason(
+		base.UnknownConfigWarning,
+		"UnknownConfig",
+		"%s", msg)
+}
+
+// ClearUnknownConfigWarning removes the UnknownConfigWarning status.
+func (es *KnativeEventingStatus) ClearUnknownConfigWarning() {
+	eventingCondSet.Manage(es).ClearCondition(base.UnknownConfigWarning)
+}
+
+// MarkConformancePassed marks the ConformancePassed status as true.
+func (es *KnativeEventingStatus) MarkConformancePassed() {
+	eventingCondSet.Manage(es).MarkTrue(base.ConformancePassed)
+}
+
+// MarkConformanceRunning marks the ConformancePassed status as unknown, while the given checks are running.
+func (es *KnativeEventingStatus) MarkConformanceRunning(checks []string) {
+	eventingCondSet.Manage(es).MarkUnknown(
+		base.ConformancePassed,
+		"Running",
+		"Conformance checks running: %s", strings.Join(checks, ", "))
+}
+
+// MarkConformanceFailed marks the ConformancePassed status as false with the given failures.
+func (es *KnativeEventingStatus) MarkConformanceFailed(failures []string) {
+	eventingCondSet.Manage(es).MarkFalse(
+		base.ConformancePassed,
+		"ConformanceFailed",
+		"Conformance checks failed: %s", strings.Join(failures, "; "))
+}
+
+// ClearConformance removes the ConformancePassed status.
+func (es *KnativeEventingStatus) ClearConformance() {
+	eventingCondSet.Manage(es).ClearCondition(base.ConformancePassed)
+}
+
+// MarkStageSucceeded marks the condition of the custom stage as true.
+func (es *KnativeEventingStatus) MarkStageSucceeded(stage apis.ConditionType) {
+	eventingCondSet.Manage(es).MarkTrue(stage)
+}
+
+// MarkStageFailed marks the condition of the custom stage as false with the given message.
+func (es *KnativeEventingStatus) MarkStageFailed(stage apis.ConditionType, msg string) {
+	eventingCondSet.Manage(es).MarkFalse(stage, "StageFailed", "%s", msg)
+}
+
 // MarkDependenciesInstalled marks the DependenciesInstalled status as true.
 func (es *KnativeEventingStatus) MarkDependenciesInstalled() {
 	eventingCondSet.Manage(es).MarkTrue(base.DependenciesInstalled)
@@ -130,11 +266,26 @@ func (es *KnativeEventingStatus) GetVersion() string {
 	return es.Version
 }
 
-// SetVersion sets the currently installed version of the component.
+// SetVersion sets the currently installed version of the component. An upgrade from another
+// installed version updates the LastUpgradeTime, the first installation does not.
 func (es *KnativeEventingStatus) SetVersion(version string) {
+	if es.Version != "" && version != es.Version {
+		now := metav1.Now()
+		es.LastUpgradeTime = &now
+	}
 	es.Version = version
 }
 
+// GetResolvedImages gets the digests, which the images referenced by a tag are pinned to.
+func (es *KnativeEventingStatus) GetResolvedImages() map[string]string {
+	return es.ResolvedImages
+}
+
+// SetResolvedImages sets the digests, which the images referenced by a tag are pinned to.
+func (es *KnativeEventingStatus) SetResolvedImages(images map[string]string) {
+	es.ResolvedImages = images
+}
+
 // GetManifests gets the url links of the manifests.
 func (es *KnativeEventingStatus) GetManifests() []string {
 	return es.Manifests
@@ -144,3 +295,23 @@ func (es *KnativeEventingStatus) GetManifests() []string {
 func (es *KnativeEventingStatus) SetManifests(manifests []string) {
 	es.Manifests = manifests
 }
+
+// GetPermissions gets the permissions granted to the service accounts of the component.
+func (es *KnativeEventingStatus) GetPermissions() []base.ServiceAccountPermissions {
+	return es.Permissions
+}
+
+// SetPermissions sets the permissions granted to the service accounts of the component.
+func (es *KnativeEventingStatus) SetPermissions(permissions []base.ServiceAccountPermissions) {
+	es.Permissions = permissions
+}
+
+// GetAppliedManifest gets what was last applied for the component.
+func (es *KnativeEventingStatus) GetAppliedManifest() *base.AppliedManifest {
+	return es.Applied
+}
+
+// SetAppliedManifest sets what was last applied for the component.
+func (es *KnativeEventingStatus) SetAppliedManifest(applied *base.AppliedManifest) {
+	es.Applied = applied
+}
@@ -151,3 +151,144 @@ func TestKnativeEventingVersionMigrationNotEligible(t *testing.T) {
 	ke.MarkVersionMigrationNotEligible("Version migration not eligible.")
 	apistest.CheckConditionFailed(ke, base.VersionMigrationEligible, t)
 }
+
+func TestKnativeEventingPreview(t *testing.T) {
+	ke := &KnativeEventingStatus{}
+	ke.InitializeConditions()
+
+	// A failed preview does not affect the readiness.
+	ke.MarkPreviewFailed("test")
+	apistest.CheckConditionFailed(ke, base.PreviewReady, t)
+	apistest.CheckConditionOngoing(ke, base.InstallSucceeded, t)
+
+	ke.MarkPreviewReady("knative-eventing-preview")
+	apistest.CheckConditionSucceeded(ke, base.PreviewReady, t)
+
+	ke.ClearPreview()
+	if c := ke.GetCondition(base.PreviewReady); c != nil {
+		t.Errorf("GetCondition(PreviewReady) = %v, want nil", c)
+	}
+}
+
+func TestKnativeEventingPreflight(t *testing.T) {
+	ke := &KnativeEventingStatus{}
+	ke.InitializeConditions()
+
+	ke.MarkPreflightChecksFailed([]string{"a", "b"})
+	apistest.CheckConditionFailed(ke, base.PreflightChecksPassed, t)
+	if got, want := ke.GetCondition(base.PreflightChecksPassed).Message, "Pre-flight checks failed: a; b"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+
+	ke.MarkPreflightChecksPassed()
+	apistest.CheckConditionSucceeded(ke, base.PreflightChecksPassed, t)
+}
+
+func TestKnativeEventingPaused(t *testing.T) {
+	ke := &KnativeEventingStatus{}
+	ke.InitializeConditions()
+
+	ke.MarkPaused()
+	apistest.CheckConditionSucceeded(ke, base.Paused, t)
+	apistest.CheckConditionOngoing(ke, base.InstallSucceeded, t)
+
+	ke.ClearPaused()
+	if c := ke.GetCondition(base.Paused); c != nil {
+		t.Errorf("GetCondition(Paused) = %v, want nil", c)
+	}
+}
+
+func TestKnativeEventingVersionSkewWarning(t *testing.T) {
+	ke := &KnativeEventingStatus{}
+	ke.InitializeConditions()
+	ke.MarkInstallSucceeded()
+	ke.MarkDeploymentsAvailable()
+	ke.MarkVersionMigrationEligible()
+
+	ke.MarkVersionSkewWarning("out of skew")
+	apistest.CheckConditionSucceeded(ke, base.VersionSkewWarning, t)
+	if !ke.IsReady() {
+		t.Error("IsReady() = false, the warning must not affect the readiness")
+	}
+
+	ke.ClearVersionSkewWarning()
+	if c := ke.GetCondition(base.VersionSkewWarning); c != nil {
+		t.Errorf("GetCondition(VersionSkewWarning) = %v, want nil", c)
+	}
+}
+
+func TestKnativeEventingWebhookCertificateWarning(t *testing.T) {
+	ke := &KnativeEventingStatus{}
+	ke.InitializeConditions()
+	ke.MarkInstallSucceeded()
+	ke.MarkDeploymentsAvailable()
+	ke.MarkVersionMigrationEligible()
+
+	ke.MarkWebhookCertificateWarning("expires soon")
+	apistest.CheckConditionSucceeded(ke, base.WebhookCertificateWarning, t)
+	if !ke.IsReady() {
+		t.Error("IsReady() = false, the warning must not affect the readiness")
+	}
+
+	ke.ClearWebhookCertificateWarning()
+	if c := ke.GetCondition(base.WebhookCertificateWarning); c != nil {
+		t.Errorf("GetCondition(WebhookCertificateWarning) = %v, want nil", c)
+	}
+}
+
+func TestKnativeEventingConformance(t *testing.T) {
+	ke := &KnativeEventingStatus{}
+	ke.InitializeConditions()
+	ke.MarkInstallSucceeded()
+	ke.MarkDeploymentsAvailable()
+	ke.MarkVersionMigrationEligible()
+
+	ke.MarkConformanceRunning([]string{"service", "scale-to-zero"})
+	apistest.CheckConditionOngoing(ke, base.ConformancePassed, t)
+	if got, want := ke.GetCondition(base.ConformancePassed).Message, "Conformance checks running: service, scale-to-zero"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+
+	ke.MarkConformanceFailed([]string{"a", "b"})
+	apistest.CheckConditionFailed(ke, base.ConformancePassed, t)
+	if got, want := ke.GetCondition(base.ConformancePassed).Message, "Conformance checks failed: a; b"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+	if !ke.IsReady() {
+		t.Error("IsReady() = false, the conformance checks must not affect the readiness")
+	}
+
+	ke.MarkConformancePassed()
+	apistest.CheckConditionSucceeded(ke, base.ConformancePassed, t)
+
+	ke.ClearConformance()
+	if c := ke.GetCondition(base.ConformancePassed); c != nil {
+		t.Errorf("GetCondition(ConformancePassed) = %v, want nil", c)
+	}
+}
+
+func TestKnativeEventingSetVersion(t *testing.T) {
+	ks := &KnativeEventingStatus{}
+
+	// The first installation is no upgrade.
+	ks.SetVersion("1.20.0")
+	if ks.LastUpgradeTime != nil {
+		t.Fatalf("LastUpgradeTime = %v, want nil after the installation", ks.LastUpgradeTime)
+	}
+
+	ks.SetVersion("1.21.0")
+	upgraded := ks.LastUpgradeTime
+	if upgraded == nil {
+		t.Fatal("LastUpgradeTime = nil, want the time of the upgrade")
+	}
+
+	ks.SetVersion("1.21.0")
+	if ks.LastUpgradeTime != upgraded {
+		t.Errorf("LastUpgradeTime = %v, want %v for the same version", ks.LastUpgradeTime, upgraded)
+	}
+
+	ks.SetVersion("1.22.0")
+	if ks.LastUpgradeTime == upgraded {
+		t.Error("LastUpgradeTime is unchanged after the upgrade")
+	}
+}
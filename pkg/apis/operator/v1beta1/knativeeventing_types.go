@@ -49,6 +49,14 @@ func (ke *KnativeEventing) GetStatus() base.KComponentStatus {
 	return &ke.Status
 }
 
+// GetPodSecurityStandard implements KComponentSpec.
+func (s *KnativeEventingSpec) GetPodSecurityStandard() base.PodSecurityStandard {
+	if s.Security == nil {
+		return ""
+	}
+	return s.Security.PodSecurityStandard
+}
+
 // KnativeEventingSpec defines the desired state of KnativeEventing
 type KnativeEventingSpec struct {
 	base.CommonSpec `json:",inline"`
@@ -58,6 +66,11 @@ type KnativeEventingSpec struct {
 	// +optional
 	DefaultBrokerClass string `json:"defaultBrokerClass,omitempty"`
 
+	// DefaultBrokerConfig configures the brokers of the default broker class, which set no
+	// configuration of their own.
+	// +optional
+	DefaultBrokerConfig *base.BrokerConfiguration `json:"defaultBrokerConfig,omitempty"`
+
 	// SinkBindingSelectionMode specifies the NamespaceSelector and ObjectSelector
 	// for the sinkbinding webhook.
 	// If `inclusion` is selected, namespaces/objects labelled as `bindings.knative.dev/include:true`
@@ -72,6 +85,43 @@ type KnativeEventingSpec struct {
 	// Source allows configuration of different eventing sources to be shipped.
 	// +optional
 	Source *SourceConfigs `json:"source,omitempty"`
+
+	// Kafka installs the Kafka components of Knative Eventing, and connects them to a Kafka cluster.
+	// +optional
+	Kafka *base.KafkaConfiguration `json:"kafka,omitempty"`
+
+	// RabbitMQ installs the RabbitMQ components of Knative Eventing.
+	// +optional
+	RabbitMQ *base.RabbitMQConfiguration `json:"rabbitmq,omitempty"`
+
+	// TransportEncryption encrypts the event traffic between the components of Knative Eventing.
+	// +optional
+	TransportEncryption *base.TransportEncryptionConfiguration `json:"transportEncryption,omitempty"`
+
+	// Sugar configures the sugar reconciler, which creates brokers for namespaces and triggers.
+	// +optional
+	Sugar *base.SugarConfiguration `json:"sugar,omitempty"`
+
+	// Istio installs eventing-istio, which integrates Knative Eventing with the Istio sidecars of
+	// its namespace.
+	// +optional
+	Istio *base.EventingComponentConfiguration `json:"istio,omitempty"`
+
+	// JobSink configures the receiver of the JobSinks.
+	// +optional
+	JobSink *base.JobSinkConfiguration `json:"jobSink,omitempty"`
+
+	// TriggerFilters configures the filters of the triggers.
+	// +optional
+	TriggerFilters *base.TriggerFiltersConfiguration `json:"triggerFilters,omitempty"`
+
+	// KEDA autoscales the data plane of Knative Eventing with KEDA.
+	// +optional
+	KEDA *base.KEDAConfiguration `json:"keda,omitempty"`
+
+	// Security configures the Pod Security Standard, which the operands comply with.
+	// +optional
+	Security *base.PodSecurityConfiguration `json:"security,omitempty"`
 }
 
 // KnativeEventingStatus defines the observed state of KnativeEventing
@@ -85,6 +135,26 @@ type KnativeEventingStatus struct {
 	// The url links of the manifests, separated by comma
 	// +optional
 	Manifests []string `json:"manifests,omitempty"`
+
+	// The time, when the installed version was last upgraded, unset until the first upgrade
+	// +optional
+	LastUpgradeTime *metav1.Time `json:"lastUpgradeTime,omitempty"`
+
+	// The digests, which the images referenced by a tag are pinned to with spec.registry.digestResolution
+	// +optional
+	ResolvedImages map[string]string `json:"resolvedImages,omitempty"`
+
+	// The permissions, which the bindings of the manifests grant to the service accounts
+	// +optional
+	Permissions []base.ServiceAccountPermissions `json:"permissions,omitempty"`
+
+	// The manifests, the digest and the resource counts of the last successful apply
+	// +optional
+	Applied *base.AppliedManifest `json:"applied,omitempty"`
+
+	// The certificates of the transport encryption, with their expiry
+	// +optional
+	Certificates []base.CertificateStatus `json:"certificates,omitempty"`
 }
 
 // KnativeEventingList contains a list of KnativeEventing
@@ -103,4 +173,13 @@ type SourceConfigs struct {
 	Kafka    base.KafkaSourceConfiguration    `json:"kafka"`
 	Rabbitmq base.RabbitmqSourceConfiguration `json:"rabbitmq"`
 	Redis    base.RedisSourceConfiguration    `json:"redis"`
+
+	// Ping configures the built-in PingSource.
+	// +optional
+	Ping *base.PingSourceConfiguration `json:"ping,omitempty"`
+
+	// Bundles are the names of further source bundles in the catalog of the operator, which are
+	// installed for the target version, e.g. the bundles of sources without a field above.
+	// +optional
+	Bundles []string `json:"bundles,omitempty"`
 }
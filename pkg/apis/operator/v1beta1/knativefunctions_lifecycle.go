@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/pkg/apis"
+)
+
+var (
+	_ base.KComponentStatus = (*KnativeFunctionsStatus)(nil)
+
+	functionsCondSet = apis.NewLivingConditionSet(
+		base.DependenciesInstalled,
+		base.DeploymentsAvailable,
+		base.InstallSucceeded,
+		base.VersionMigrationEligible,
+	)
+)
+
+// GroupVersionKind returns SchemeGroupVersion of a KnativeFunctions
+func (kf *KnativeFunctions) GroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(operator.KindKnativeFunctions)
+}
+
+// GetCondition returns the current condition of a given condition type
+func (fs *KnativeFunctionsStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return functionsCondSet.Manage(fs).GetCondition(t)
+}
+
+// InitializeConditions initializes conditions of a KnativeFunctionsStatus
+func (fs *KnativeFunctionsStatus) InitializeConditions() {
+	functionsCondSet.Manage(fs).InitializeConditions()
+}
+
+// IsReady looks at the conditions and if the Status has a condition
+// FunctionsConditionReady returns true if ConditionStatus is True
+func (fs *KnativeFunctionsStatus) IsReady() bool {
+	return functionsCondSet.Manage(fs).IsHappy()
+}
+
+// MarkInstallSucceeded marks the InstallationSucceeded status as true.
+func (fs *KnativeFunctionsStatus) MarkInstallSucceeded() {
+	functionsCondSet.Manage(fs).MarkTrue(base.InstallSucceeded)
+	if fs.GetCondition(base.DependenciesInstalled).IsUnknown() {
+		// Assume deps are installed if we're not sure
+		fs.MarkDependenciesInstalled()
+	}
+}
+
+// MarkInstallFailed marks the InstallationSucceeded status as false with the given
+// message.
+func (fs *KnativeFunctionsStatus) MarkInstallFailed(msg string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.InstallSucceeded,
+		"Error",
+		"Install failed with message: %s", msg)
+}
+
+// MarkDeploymentsAvailable marks the DeploymentsAvailable status as true.
+func (fs *KnativeFunctionsStatus) MarkDeploymentsAvailable() {
+	functionsCondSet.Manage(fs).MarkTrue(base.DeploymentsAvailable)
+}
+
+// MarkVersionMigrationEligible marks the VersionMigrationEligible status as true.
+func (fs *KnativeFunctionsStatus) MarkVersionMigrationEligible() {
+	functionsCondSet.Manage(fs).MarkTrue(base.VersionMigrationEligible)
+}
+
+// MarkVersionMigrationNotEligible marks the VersionMigrationEligible status as false with given message.
+func (fs *KnativeFunctionsStatus) MarkVersionMigrationNotEligible(msg string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.VersionMigrationEligible,
+		"Error",
+		"Version migration is not eligible with message: %s", msg)
+}
+
+// MarkDeploymentsNotReady marks the DeploymentsAvailable status as false and calls out
+// it's waiting for deployments.
+func (fs *KnativeFunctionsStatus) MarkDeploymentsNotReady(deployments []string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.DeploymentsAvailable,
+		"NotReady",
+		"Waiting on deployments: %s", strings.Join(deployments, ", "))
+}
+
+// MarkPreviewReady marks the PreviewReady status as true.
+func (fs *KnativeFunctionsStatus) MarkPreviewReady(configMap string) {
+	functionsCondSet.Manage(fs).MarkTrueWithReason(
+		base.PreviewReady,
+		"DryRun",
+		"Preview of the changes is available in the ConfigMap %s", configMap)
+}
+
+// MarkPreviewFailed marks the PreviewReady status as false with the given message.
+func (fs *KnativeFunctionsStatus) MarkPreviewFailed(msg string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.PreviewReady,
+		"Error",
+		"Preview failed with message: %s", msg)
+}
+
+// ClearPreview removes the PreviewReady status.
+func (fs *KnativeFunctionsStatus) ClearPreview() {
+	functionsCondSet.Manage(fs).ClearCondition(base.PreviewReady)
+}
+
+// MarkPreflightChecksPassed marks the PreflightChecksPassed status as true.
+func (fs *KnativeFunctionsStatus) MarkPreflightChecksPassed() {
+	functionsCondSet.Manage(fs).MarkTrue(base.PreflightChecksPassed)
+}
+
+// MarkPreflightChecksFailed marks the PreflightChecksPassed status as false with the given violations.
+func (fs *KnativeFunctionsStatus) MarkPreflightChecksFailed(violations []string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.PreflightChecksPassed,
+		"PreflightFailed",
+		"Pre-flight checks failed: %s", strings.Join(violations, "; "))
+}
+
+// MarkPaused marks the Paused status as true.
+func (fs *KnativeFunctionsStatus) MarkPaused() {
+	functionsCondSet.Manage(fs).MarkTrueWithReason(
+		base.Paused,
+		"Paused",
+		"Reconciliation is paused by the annotation %s", base.PausedAnnotation)
+}
+
+// ClearPaused removes the Paused status.
+func (fs *KnativeFunctionsStatus) ClearPaused() {
+	functionsCondSet.Manage(fs).ClearCondition(base.Paused)
+}
+
+// MarkVersionSkewWarning marks the VersionSkewWarning status as true with the given message.
+func (fs *KnativeFunctionsStatus) MarkVersionSkewWarning(msg string) {
+	functionsCondSet.Manage(fs).MarkTrueWithReason(
+		base.VersionSkewWarning,
+		"VersionSkew",
+		"%s", msg)
+}
+
+// ClearVersionSkewWarning removes the VersionSkewWarning status.
+func (fs *KnativeFunctionsStatus) ClearVersionSkewWarning() {
+	functionsCondSet.Manage(fs).ClearCondition(base.VersionSkewWarning)
+}
+
+// MarkWebhookCertificateWarning marks the WebhookCertificateWarning status as true with the given message.
+func (fs *KnativeFunctionsStatus) MarkWebhookCertificateWarning(msg string) {
+	functionsCondSet.Manage(fs).MarkTrueWithReason(
+		base.WebhookCertificateWarning,
+		"WebhookCertificate",
+		"%s", msg)
+}
+
+// ClearWebhookCertificateWarning removes the WebhookCertificateWarning status.
+func (fs *KnativeFunctionsStatus) ClearWebhookCertificateWarning() {
+	functionsCondSet.Manage(fs).ClearCondition(base.WebhookCertificateWarning)
+}
+
+// MarkUnmanagedConfigMapsWarning marks the UnmanagedConfigMapsWarning status as true with the given exclusions.
+func (fs *KnativeFunctionsStatus) MarkUnmanagedConfigMapsWarning(exclusions []string) {
+	functionsCondSet.Manage(fs).MarkTrueWithReason(
+		base.UnmanagedConfigMapsWarning,
+		"UnmanagedConfigMaps",
+		"ConfigMaps excluded from the reconciliation: %s", strings.Join(exclusions, "; "))
+}
+
+// ClearUnmanagedConfigMapsWarning removes the UnmanagedConfigMapsWarning status.
+func (fs *KnativeFunctionsStatus) ClearUnmanagedConfigMapsWarning() {
+	functionsCondSet.Manage(fs).ClearCondition(base.UnmanagedConfigMapsWarning)
+}
+
+// MarkUnknownConfigWarning marks the UnknownConfigWarning status as true with the given message.
+func (fs *KnativeFunctionsStatus) MarkUnknownConfigWarning(msg string) {
+	functionsCondSet.Manage(fs).MarkTrueWithReason(
+		base.UnknownConfigWarning,
+		"UnknownConfig",
+		"%s", msg)
+}
+
+// ClearUnknownConfigWarning removes the UnknownConfigWarning status.
+func (fs *KnativeFunctionsStatus) ClearUnknownConfigWarning() {
+	functionsCondSet.Manage(fs).ClearCondition(base.UnknownConfigWarning)
+}
+
+// MarkConformancePassed marks the ConformancePassed status as true.
+func (fs *KnativeFunctionsStatus) MarkConformancePassed() {
+	functionsCondSet.Manage(fs).MarkTrue(base.ConformancePassed)
+}
+
+// MarkConformanceRunning marks the ConformancePassed status as unknown, while the given checks are running.
+func (fs *KnativeFunctionsStatus) MarkConformanceRunning(checks []string) {
+	functionsCondSet.Manage(fs).MarkUnknown(
+		base.ConformancePassed,
+		"Running",
+		"Conformance checks running: %s", strings.Join(checks, ", "))
+}
+
+// MarkConformanceFailed marks the ConformancePassed status as false with the given failures.
+func (fs *KnativeFunctionsStatus) MarkConformanceFailed(failures []string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.ConformancePassed,
+		"ConformanceFailed",
+		"Conformance checks failed: %s", strings.Join(failures, "; "))
+}
+
+// ClearConformance removes the ConformancePassed status.
+func (fs *KnativeFunctionsStatus) ClearConformance() {
+	functionsCondSet.Manage(fs).ClearCondition(base.ConformancePassed)
+}
+
+// MarkStageSucceeded marks the condition of the custom stage as true.
+func (fs *KnativeFunctionsStatus) MarkStageSucceeded(stage apis.ConditionType) {
+	functionsCondSet.Manage(fs).MarkTrue(stage)
+}
+
+// MarkStageFailed marks the condition of the custom stage as false with the given message.
+func (fs *KnativeFunctionsStatus) MarkStageFailed(stage apis.ConditionType, msg string) {
+	functionsCondSet.Manage(fs).MarkFalse(stage, "StageFailed", "%s", msg)
+}
+
+// MarkDependenciesInstalled marks the DependenciesInstalled status as true.
+func (fs *KnativeFunctionsStatus) MarkDependenciesInstalled() {
+	functionsCondSet.Manage(fs).MarkTrue(base.DependenciesInstalled)
+}
+
+// MarkDependencyInstalling marks the DependenciesInstalled status as false with the
+// given message.
+func (fs *KnativeFunctionsStatus) MarkDependencyInstalling(msg string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.DependenciesInstalled,
+		"Installing",
+		"Dependency installing: %s", msg)
+}
+
+// MarkDependencyMissing marks the DependenciesInstalled status as false with the
+// given message.
+func (fs *KnativeFunctionsStatus) MarkDependencyMissing(msg string) {
+	functionsCondSet.Manage(fs).MarkFalse(
+		base.DependenciesInstalled,
+		"Error",
+		"Dependency missing: %s", msg)
+}
+
+// GetVersion gets the currently installed version of the component.
+func (fs *KnativeFunctionsStatus) GetVersion() string {
+	return fs.Version
+}
+
+// SetVersion sets the currently installed version of the component. An upgrade from another
+// installed version updates the LastUpgradeTime, the first installation does not.
+func (fs *KnativeFunctionsStatus) SetVersion(version string) {
+	if fs.Version != "" && version != fs.Version {
+		now := metav1.Now()
+		fs.LastUpgradeTime = &now
+	}
+	fs.Version = version
+}
+
+// GetResolvedImages gets the digests, which the images referenced by a tag are pinned to.
+func (fs *KnativeFunctionsStatus) GetResolvedImages() map[string]string {
+	return fs.ResolvedImages
+}
+
+// SetResolvedImages sets the digests, which the images referenced by a tag are pinned to.
+func (fs *KnativeFunctionsStatus) SetResolvedImages(images map[string]string) {
+	fs.ResolvedImages = images
+}
+
+// GetManifests gets the url links of the manifests.
+func (fs *KnativeFunctionsStatus) GetManifests() []string {
+	return fs.Manifests
+}
+
+// SetManifests sets the url links of the manifests.
+func (fs *KnativeFunctionsStatus) SetManifests(manifests []string) {
+	fs.Manifests = manifests
+}
+
+// GetPermissions gets the permissions granted to the service accounts of the component.
+func (fs *KnativeFunctionsStatus) GetPermissions() []base.ServiceAccountPermissions {
+	return fs.Permissions
+}
+
+// SetPermissions sets the permissions granted to the service accounts of the component.
+func (fs *KnativeFunctionsStatus) SetPermissions(permissions []base.ServiceAccountPermissions) {
+	fs.Permissions = permissions
+}
+
+// GetAppliedManifest gets what was last applied for the component.
+func (fs *KnativeFunctionsStatus) GetAppliedManifest() *base.AppliedManifest {
+	return fs.Applied
+}
+
+// SetAppliedManifest sets what was last applied for the component.
+func (fs *KnativeFunctionsStatus) SetAppliedManifest(applied *base.AppliedManifest) {
+	fs.Applied = applied
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+	apistest "knative.dev/pkg/apis/testing"
+)
+
+func TestKnativeFunctionsGroupVersionKind(t *testing.T) {
+	r := &KnativeFunctions{}
+	want := schema.GroupVersionKind{
+		Group:   operator.GroupName,
+		Version: SchemaVersion,
+		Kind:    operator.KindKnativeFunctions,
+	}
+	if got := r.GroupVersionKind(); got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestKnativeFunctionsHappyPath(t *testing.T) {
+	kf := &KnativeFunctionsStatus{}
+	kf.InitializeConditions()
+
+	apistest.CheckConditionOngoing(kf, base.DependenciesInstalled, t)
+	apistest.CheckConditionOngoing(kf, base.DeploymentsAvailable, t)
+	apistest.CheckConditionOngoing(kf, base.InstallSucceeded, t)
+
+	kf.MarkVersionMigrationEligible()
+
+	// Install succeeds, the dependencies are assumed successful too.
+	kf.MarkInstallSucceeded()
+	apistest.CheckConditionSucceeded(kf, base.DependenciesInstalled, t)
+	apistest.CheckConditionOngoing(kf, base.DeploymentsAvailable, t)
+	apistest.CheckConditionSucceeded(kf, base.InstallSucceeded, t)
+
+	kf.MarkDeploymentsAvailable()
+	apistest.CheckConditionSucceeded(kf, base.DeploymentsAvailable, t)
+	if ready := kf.IsReady(); !ready {
+		t.Errorf("kf.IsReady() = %v, want true", ready)
+	}
+}
+
+func TestKnativeFunctionsErrorPath(t *testing.T) {
+	kf := &KnativeFunctionsStatus{}
+	kf.InitializeConditions()
+	kf.MarkVersionMigrationEligible()
+
+	// Install fails, e.g. without Tekton Pipelines in the cluster.
+	kf.MarkInstallFailed("test")
+	apistest.CheckConditionOngoing(kf, base.DependenciesInstalled, t)
+	apistest.CheckConditionFailed(kf, base.InstallSucceeded, t)
+
+	// Install now succeeds.
+	kf.MarkInstallSucceeded()
+	kf.MarkDeploymentsNotReady([]string{"test"})
+	apistest.CheckConditionFailed(kf, base.DeploymentsAvailable, t)
+	if ready := kf.IsReady(); ready {
+		t.Errorf("kf.IsReady() = %v, want false", ready)
+	}
+
+	kf.MarkDeploymentsAvailable()
+	apistest.CheckConditionSucceeded(kf, base.DeploymentsAvailable, t)
+	if ready := kf.IsReady(); !ready {
+		t.Errorf("kf.IsReady() = %v, want true", ready)
+	}
+}
+
+func TestKnativeFunctionsSetVersion(t *testing.T) {
+	kf := &KnativeFunctionsStatus{}
+
+	// The first installation is no upgrade.
+	kf.SetVersion("1.20.0")
+	if kf.LastUpgradeTime != nil {
+		t.Fatalf("LastUpgradeTime = %v, want nil after the installation", kf.LastUpgradeTime)
+	}
+
+	kf.SetVersion("1.21.0")
+	upgraded := kf.LastUpgradeTime
+	if upgraded == nil {
+		t.Fatal("LastUpgradeTime = nil, want the time of the upgrade")
+	}
+
+	kf.SetVersion("1.21.0")
+	if kf.LastUpgradeTime != upgraded {
+		t.Errorf("LastUpgradeTime = %v, want %v for the same version", kf.LastUpgradeTime, upgraded)
+	}
+
+	kf.SetVersion("1.22.0")
+	if kf.LastUpgradeTime == upgraded {
+		t.Error("LastUpgradeTime is unchanged after the upgrade")
+	}
+}
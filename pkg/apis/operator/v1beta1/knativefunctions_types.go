@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/operator/pkg/apis/operator/base"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+var (
+	_ base.KComponent     = (*KnativeFunctions)(nil)
+	_ base.KComponentSpec = (*KnativeFunctionsSpec)(nil)
+)
+
+// KnativeFunctions is the Schema for the functions API, which installs the on-cluster build and
+// deploy components of func.
+// +genclient
+// +genreconciler:krshapedlogic=false
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KnativeFunctions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KnativeFunctionsSpec   `json:"spec,omitempty"`
+	Status KnativeFunctionsStatus `json:"status,omitempty"`
+}
+
+// GetSpec implements KComponent
+func (kf *KnativeFunctions) GetSpec() base.KComponentSpec {
+	return &kf.Spec
+}
+
+// GetStatus implements KComponent
+func (kf *KnativeFunctions) GetStatus() base.KComponentStatus {
+	return &kf.Status
+}
+
+// GetPodSecurityStandard implements KComponentSpec.
+func (s *KnativeFunctionsSpec) GetPodSecurityStandard() base.PodSecurityStandard {
+	if s.Security == nil {
+		return ""
+	}
+	return s.Security.PodSecurityStandard
+}
+
+// KnativeFunctionsSpec defines the desired state of KnativeFunctions
+type KnativeFunctionsSpec struct {
+	base.CommonSpec `json:",inline"`
+
+	// Builders configures the builder images of the on-cluster builds.
+	// +optional
+	Builders *base.FunctionsBuildersConfiguration `json:"builders,omitempty"`
+
+	// PVC configures the PersistentVolumeClaims of the on-cluster builds.
+	// +optional
+	PVC *base.FunctionsPVCConfiguration `json:"pvc,omitempty"`
+
+	// Security configures the Pod Security Standard, which the operands comply with.
+	// +optional
+	Security *base.PodSecurityConfiguration `json:"security,omitempty"`
+}
+
+// KnativeFunctionsStatus defines the observed state of KnativeFunctions
+type KnativeFunctionsStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// The version of the installed release
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// The url links of the manifests, separated by comma
+	// +optional
+	Manifests []string `json:"manifests,omitempty"`
+
+	// The time, when the installed version was last upgraded, unset until the first upgrade
+	// +optional
+	LastUpgradeTime *metav1.Time `json:"lastUpgradeTime,omitempty"`
+
+	// The digests, which the images referenced by a tag are pinned to with spec.registry.digestResolution
+	// +optional
+	ResolvedImages map[string]string `json:"resolvedImages,omitempty"`
+
+	// The permissions, which the bindings of the manifests grant to the service accounts
+	// +optional
+	Permissions []base.ServiceAccountPermissions `json:"permissions,omitempty"`
+
+	// The manifests, the digest and the resource counts of the last successful apply
+	// +optional
+	Applied *base.AppliedManifest `json:"applied,omitempty"`
+}
+
+// KnativeFunctionsList contains a list of KnativeFunctions
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KnativeFunctionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KnativeFunctions `json:"items"`
+}
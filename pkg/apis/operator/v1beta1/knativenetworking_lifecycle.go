@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/pkg/apis"
+)
+
+var (
+	_ base.KComponentStatus = (*KnativeNetworkingStatus)(nil)
+
+	networkingCondSet = apis.NewLivingConditionSet(
+		base.DependenciesInstalled,
+		base.DeploymentsAvailable,
+		base.InstallSucceeded,
+		base.VersionMigrationEligible,
+	)
+)
+
+// GroupVersionKind returns SchemeGroupVersion of a KnativeNetworking
+func (kn *KnativeNetworking) GroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(operator.KindKnativeNetworking)
+}
+
+// GetCondition returns the current condition of a given condition type
+func (ns *KnativeNetworkingStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return networkingCondSet.Manage(ns).GetCondition(t)
+}
+
+// InitializeConditions initializes conditions of a KnativeNetworkingStatus
+func (ns *KnativeNetworkingStatus) InitializeConditions() {
+	networkingCondSet.Manage(ns).InitializeConditions()
+}
+
+// IsReady looks at the conditions and if the Status has a condition
+// NetworkingConditionReady returns true if ConditionStatus is True
+func (ns *KnativeNetworkingStatus) IsReady() bool {
+	return networkingCondSet.Manage(ns).IsHappy()
+}
+
+// MarkInstallSucceeded marks the InstallationSucceeded status as true.
+func (ns *KnativeNetworkingStatus) MarkInstallSucceeded() {
+	networkingCondSet.Manage(ns).MarkTrue(base.InstallSucceeded)
+	if ns.GetCondition(base.DependenciesInstalled).IsUnknown() {
+		// Assume deps are installed if we're not sure
+		ns.MarkDependenciesInstalled()
+	}
+}
+
+// MarkInstallFailed marks the InstallationSucceeded status as false with the given
+// message.
+func (ns *KnativeNetworkingStatus) MarkInstallFailed(msg string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.InstallSucceeded,
+		"Error",
+		"Install failed with message: %s", msg)
+}
+
+// MarkDeploymentsAvailable marks the DeploymentsAvailable status as true.
+func (ns *KnativeNetworkingStatus) MarkDeploymentsAvailable() {
+	networkingCondSet.Manage(ns).MarkTrue(base.DeploymentsAvailable)
+}
+
+// MarkVersionMigrationEligible marks the VersionMigrationEligible status as true.
+func (ns *KnativeNetworkingStatus) MarkVersionMigrationEligible() {
+	networkingCondSet.Manage(ns).MarkTrue(base.VersionMigrationEligible)
+}
+
+// MarkVersionMigrationNotEligible marks the VersionMigrationEligible status as false with given message.
+func (ns *KnativeNetworkingStatus) MarkVersionMigrationNotEligible(msg string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.VersionMigrationEligible,
+		"Error",
+		"Version migration is not eligible with message: %s", msg)
+}
+
+// MarkDeploymentsNotReady marks the DeploymentsAvailable status as false and calls out
+// it's waiting for deployments.
+func (ns *KnativeNetworkingStatus) MarkDeploymentsNotReady(deployments []string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.DeploymentsAvailable,
+		"NotReady",
+		"Waiting on deployments: %s", strings.Join(deployments, ", "))
+}
+
+// MarkPreviewReady marks the PreviewReady status as true.
+func (ns *KnativeNetworkingStatus) MarkPreviewReady(configMap string) {
+	networkingCondSet.Manage(ns).MarkTrueWithReason(
+		base.PreviewReady,
+		"DryRun",
+		"Preview of the changes is available in the ConfigMap %s", configMap)
+}
+
+// MarkPreviewFailed marks the PreviewReady status as false with the given message.
+func (ns *KnativeNetworkingStatus) MarkPreviewFailed(msg string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.PreviewReady,
+		"Error",
+		"Preview failed with message: %s", msg)
+}
+
+// ClearPreview removes the PreviewReady status.
+func (ns *KnativeNetworkingStatus) ClearPreview() {
+	networkingCondSet.Manage(ns).ClearCondition(base.PreviewReady)
+}
+
+// MarkPreflightChecksPassed marks the PreflightChecksPassed status as true.
+func (ns *KnativeNetworkingStatus) MarkPreflightChecksPassed() {
+	networkingCondSet.Manage(ns).MarkTrue(base.PreflightChecksPassed)
+}
+
+// MarkPreflightChecksFailed marks the PreflightChecksPassed status as false with the given violations.
+func (ns *KnativeNetworkingStatus) MarkPreflightChecksFailed(violations []string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.PreflightChecksPassed,
+		"PreflightFailed",
+		"Pre-flight checks failed: %s", strings.Join(violations, "; "))
+}
+
+// MarkPaused marks the Paused status as true.
+func (ns *KnativeNetworkingStatus) MarkPaused() {
+	networkingCondSet.Manage(ns).MarkTrueWithReason(
+		base.Paused,
+		"Paused",
+		"Reconciliation is paused by the annotation %s", base.PausedAnnotation)
+}
+
+// ClearPaused removes the Paused status.
+func (ns *KnativeNetworkingStatus) ClearPaused() {
+	networkingCondSet.Manage(ns).ClearCondition(base.Paused)
+}
+
+// MarkVersionSkewWarning marks the VersionSkewWarning status as true with the given message.
+func (ns *KnativeNetworkingStatus) MarkVersionSkewWarning(msg string) {
+	networkingCondSet.Manage(ns).MarkTrueWithReason(
+		base.VersionSkewWarning,
+		"VersionSkew",
+		"%s", msg)
+}
+
+// ClearVersionSkewWarning removes the VersionSkewWarning status.
+func (ns *KnativeNetworkingStatus) ClearVersionSkewWarning() {
+	networkingCondSet.Manage(ns).ClearCondition(base.VersionSkewWarning)
+}
+
+// MarkWebhookCertificateWarning marks the WebhookCertificateWarning status as true with the given message.
+func (ns *KnativeNetworkingStatus) MarkWebhookCertificateWarning(msg string) {
+	networkingCondSet.Manage(ns).MarkTrueWithReason(
+		base.WebhookCertificateWarning,
+		"WebhookCertificate",
+		"%s", msg)
+}
+
+// ClearWebhookCertificateWarning removes the WebhookCertificateWarning status.
+func (ns *KnativeNetworkingStatus) ClearWebhookCertificateWarning() {
+	networkingCondSet.Manage(ns).ClearCondition(base.WebhookCertificateWarning)
+}
+
+// MarkUnmanagedConfigMapsWarning marks the UnmanagedConfigMapsWarning status as true with the given exclusions.
+func (ns *KnativeNetworkingStatus) MarkUnmanagedConfigMapsWarning(exclusions []string) {
+	networkingCondSet.Manage(ns).MarkTrueWithReason(
+		base.UnmanagedConfigMapsWarning,
+		"UnmanagedConfigMaps",
+		"ConfigMaps excluded from the reconciliation: %s", strings.Join(exclusions, "; "))
+}
+
+// ClearUnmanagedConfigMapsWarning removes the UnmanagedConfigMapsWarning status.
+func (ns *KnativeNetworkingStatus) ClearUnmanagedConfigMapsWarning() {
+	networkingCondSet.Manage(ns).ClearCondition(base.UnmanagedConfigMapsWarning)
+}
+
+// MarkUnknownConfigWarning marks the UnknownConfigWarning status as true with the given message.
+func (ns *KnativeNetworkingStatus) MarkUnknownConfigWarning(msg string) {
+	networkingCondSet.Manage(ns).MarkTrueWithReason(
+		base.UnknownConfigWarning,
+		"UnknownConfig",
+		"%s", msg)
+}
+
+// ClearUnknownConfigWarning removes the UnknownConfigWarning status.
+func (ns *KnativeNetworkingStatus) ClearUnknownConfigWarning() {
+	networkingCondSet.Manage(ns).ClearCondition(base.UnknownConfigWarning)
+}
+
+// MarkConformancePassed marks the ConformancePassed status as true.
+func (ns *KnativeNetworkingStatus) MarkConformancePassed() {
+	networkingCondSet.Manage(ns).MarkTrue(base.ConformancePassed)
+}
+
+// MarkConformanceRunning marks the ConformancePassed status as unknown, while the given checks are running.
+func (ns *KnativeNetworkingStatus) MarkConformanceRunning(checks []string) {
+	networkingCondSet.Manage(ns).MarkUnknown(
+		base.ConformancePassed,
+		"Running",
+		"Conformance checks running: %s", strings.Join(checks, ", "))
+}
+
+// MarkConformanceFailed marks the ConformancePassed status as false with the given failures.
+func (ns *KnativeNetworkingStatus) MarkConformanceFailed(failures []string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.ConformancePassed,
+		"ConformanceFailed",
+		"Conformance checks failed: %s", strings.Join(failures, "; "))
+}
+
+// ClearConformance removes the ConformancePassed status.
+func (ns *KnativeNetworkingStatus) ClearConformance() {
+	networkingCondSet.Manage(ns).ClearCondition(base.ConformancePassed)
+}
+
+// MarkStageSucceeded marks the condition of the custom stage as true.
+func (ns *KnativeNetworkingStatus) MarkStageSucceeded(stage apis.ConditionType) {
+	networkingCondSet.Manage(ns).MarkTrue(stage)
+}
+
+// MarkStageFailed marks the condition of the custom stage as false with the given message.
+func (ns *KnativeNetworkingStatus) MarkStageFailed(stage apis.ConditionType, msg string) {
+	networkingCondSet.Manage(ns).MarkFalse(stage, "StageFailed", "%s", msg)
+}
+
+// MarkDependenciesInstalled marks the DependenciesInstalled status as true.
+func (ns *KnativeNetworkingStatus) MarkDependenciesInstalled() {
+	networkingCondSet.Manage(ns).MarkTrue(base.DependenciesInstalled)
+}
+
+// MarkDependencyInstalling marks the DependenciesInstalled status as false with the
+// given message.
+func (ns *KnativeNetworkingStatus) MarkDependencyInstalling(msg string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.DependenciesInstalled,
+		"Installing",
+		"Dependency installing: %s", msg)
+}
+
+// MarkDependencyMissing marks the DependenciesInstalled status as false with the
+// given message.
+func (ns *KnativeNetworkingStatus) MarkDependencyMissing(msg string) {
+	networkingCondSet.Manage(ns).MarkFalse(
+		base.DependenciesInstalled,
+		"Error",
+		"Dependency missing: %s", msg)
+}
+
+// GetVersion gets the currently installed version of the component.
+func (ns *KnativeNetworkingStatus) GetVersion() string {
+	return ns.Version
+}
+
+// SetVersion sets the currently installed version of the component. An upgrade from another
+// installed version updates the LastUpgradeTime, the first installation does not.
+func (ns *KnativeNetworkingStatus) SetVersion(version string) {
+	if ns.Version != "" && version != ns.Version {
+		now := metav1.Now()
+		ns.LastUpgradeTime = &now
+	}
+	ns.Version = version
+}
+
+// GetResolvedImages gets the digests, which the images referenced by a tag are pinned to.
+func (ns *KnativeNetworkingStatus) GetResolvedImages() map[string]string {
+	return ns.ResolvedImages
+}
+
+// SetResolvedImages sets the digests, which the images referenced by a tag are pinned to.
+func (ns *KnativeNetworkingStatus) SetResolvedImages(images map[string]string) {
+	ns.ResolvedImages = images
+}
+
+// GetManifests gets the url links of the manifests.
+func (ns *KnativeNetworkingStatus) GetManifests() []string {
+	return ns.Manifests
+}
+
+// SetManifests sets the url links of the manifests.
+func (ns *KnativeNetworkingStatus) SetManifests(manifests []string) {
+	ns.Manifests = manifests
+}
+
+// GetPermissions gets the permissions granted to the service accounts of the component.
+func (ns *KnativeNetworkingStatus) GetPermissions() []base.ServiceAccountPermissions {
+	return ns.Permissions
+}
+
+// SetPermissions sets the permissions granted to the service accounts of the component.
+func (ns *KnativeNetworkingStatus) SetPermissions(permissions []base.ServiceAccountPermissions) {
+	ns.Permissions = permissions
+}
+
+// GetAppliedManifest gets what was last applied for the component.
+func (ns *KnativeNetworkingStatus) GetAppliedManifest() *base.AppliedManifest {
+	return ns.Applied
+}
+
+// SetAppliedManifest sets what was last applied for the component.
+func (ns *KnativeNetworkingStatus) SetAppliedManifest(applied *base.AppliedManifest) {
+	ns.Applied = applied
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+	apistest "knative.dev/pkg/apis/testing"
+)
+
+func TestKnativeNetworkingGroupVersionKind(t *testing.T) {
+	r := &KnativeNetworking{}
+	want := schema.GroupVersionKind{
+		Group:   operator.GroupName,
+		Version: SchemaVersion,
+		Kind:    operator.KindKnativeNetworking,
+	}
+	if got := r.GroupVersionKind(); got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestKnativeNetworkingHappyPath(t *testing.T) {
+	kn := &KnativeNetworkingStatus{}
+	kn.InitializeConditions()
+
+	apistest.CheckConditionOngoing(kn, base.DependenciesInstalled, t)
+	apistest.CheckConditionOngoing(kn, base.DeploymentsAvailable, t)
+	apistest.CheckConditionOngoing(kn, base.InstallSucceeded, t)
+
+	kn.MarkVersionMigrationEligible()
+
+	// Install succeeds, the dependencies are assumed successful too.
+	kn.MarkInstallSucceeded()
+	apistest.CheckConditionSucceeded(kn, base.DependenciesInstalled, t)
+	apistest.CheckConditionOngoing(kn, base.DeploymentsAvailable, t)
+	apistest.CheckConditionSucceeded(kn, base.InstallSucceeded, t)
+
+	kn.MarkDeploymentsAvailable()
+	apistest.CheckConditionSucceeded(kn, base.DeploymentsAvailable, t)
+	if ready := kn.IsReady(); !ready {
+		t.Errorf("kn.IsReady() = %v, want true", ready)
+	}
+}
+
+func TestKnativeNetworkingErrorPath(t *testing.T) {
+	kn := &KnativeNetworkingStatus{}
+	kn.InitializeConditions()
+	kn.MarkVersionMigrationEligible()
+
+	// Install fails, e.g. without Tekton Pipelines in the cluster.
+	kn.MarkInstallFailed("test")
+	apistest.CheckConditionOngoing(kn, base.DependenciesInstalled, t)
+	apistest.CheckConditionFailed(kn, base.InstallSucceeded, t)
+
+	// Install now succeeds.
+	kn.MarkInstallSucceeded()
+	kn.MarkDeploymentsNotReady([]string{"test"})
+	apistest.CheckConditionFailed(kn, base.DeploymentsAvailable, t)
+	if ready := kn.IsReady(); ready {
+		t.Errorf("kn.IsReady() = %v, want false", ready)
+	}
+
+	kn.MarkDeploymentsAvailable()
+	apistest.CheckConditionSucceeded(kn, base.DeploymentsAvailable, t)
+	if ready := kn.IsReady(); !ready {
+		t.Errorf("kn.IsReady() = %v, want true", ready)
+	}
+}
+
+func TestKnativeNetworkingSetVersion(t *testing.T) {
+	kn := &KnativeNetworkingStatus{}
+
+	// The first installation is no upgrade.
+	kn.SetVersion("1.20.0")
+	if kn.LastUpgradeTime != nil {
+		t.Fatalf("LastUpgradeTime = %v, want nil after the installation", kn.LastUpgradeTime)
+	}
+
+	kn.SetVersion("1.21.0")
+	upgraded := kn.LastUpgradeTime
+	if upgraded == nil {
+		t.Fatal("LastUpgradeTime = nil, want the time of the upgrade")
+	}
+
+	kn.SetVersion("1.21.0")
+	if kn.LastUpgradeTime != upgraded {
+		t.Errorf("LastUpgradeTime = %v, want %v for the same version", kn.LastUpgradeTime, upgraded)
+	}
+
+	kn.SetVersion("1.22.0")
+	if kn.LastUpgradeTime == upgraded {
+		t.Error("LastUpgradeTime is unchanged after the upgrade")
+	}
+}
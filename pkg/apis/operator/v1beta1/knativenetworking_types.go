@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/operator/pkg/apis/operator/base"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+var (
+	_ base.KComponent     = (*KnativeNetworking)(nil)
+	_ base.KComponentSpec = (*KnativeNetworkingSpec)(nil)
+)
+
+// KnativeNetworking is the Schema for the networkings API, which installs the ingresses of the
+// KnativeServing in its namespace, independently of the rest of Knative Serving.
+// +genclient
+// +genreconciler:krshapedlogic=false
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KnativeNetworking struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KnativeNetworkingSpec   `json:"spec,omitempty"`
+	Status KnativeNetworkingStatus `json:"status,omitempty"`
+}
+
+// GetSpec implements KComponent
+func (kn *KnativeNetworking) GetSpec() base.KComponentSpec {
+	return &kn.Spec
+}
+
+// GetStatus implements KComponent
+func (kn *KnativeNetworking) GetStatus() base.KComponentStatus {
+	return &kn.Status
+}
+
+// GetPodSecurityStandard implements KComponentSpec.
+func (s *KnativeNetworkingSpec) GetPodSecurityStandard() base.PodSecurityStandard {
+	if s.Security == nil {
+		return ""
+	}
+	return s.Security.PodSecurityStandard
+}
+
+// KnativeNetworkingSpec defines the desired state of KnativeNetworking
+type KnativeNetworkingSpec struct {
+	base.CommonSpec `json:",inline"`
+
+	// Ingress allows configuration of different ingress adapters to be shipped.
+	// The spec.ingress of the KnativeServing applies, if it is unset.
+	// +optional
+	Ingress *IngressConfigs `json:"ingress,omitempty"`
+
+	// Security configures the Pod Security Standard, which the operands comply with.
+	// +optional
+	Security *base.PodSecurityConfiguration `json:"security,omitempty"`
+}
+
+// KnativeNetworkingStatus defines the observed state of KnativeNetworking
+type KnativeNetworkingStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// The version of the installed release
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// The url links of the manifests, separated by comma
+	// +optional
+	Manifests []string `json:"manifests,omitempty"`
+
+	// The time, when the installed version was last upgraded, unset until the first upgrade
+	// +optional
+	LastUpgradeTime *metav1.Time `json:"lastUpgradeTime,omitempty"`
+
+	// The digests, which the images referenced by a tag are pinned to with spec.registry.digestResolution
+	// +optional
+	ResolvedImages map[string]string `json:"resolvedImages,omitempty"`
+
+	// The permissions, which the bindings of the manifests grant to the service accounts
+	// +optional
+	Permissions []base.ServiceAccountPermissions `json:"permissions,omitempty"`
+
+	// The manifests, the digest and the resource counts of the last successful apply
+	// +optional
+	Applied *base.AppliedManifest `json:"applied,omitempty"`
+
+	// The installed ingresses, separated by comma
+	// +optional
+	Ingress string `json:"ingress,omitempty"`
+}
+
+// KnativeNetworkingList contains a list of KnativeNetworking
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KnativeNetworkingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KnativeNetworking `json:"items"`
+}
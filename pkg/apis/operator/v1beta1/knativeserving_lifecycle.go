@@ -22,6 +22,7 @@ import (
 	"knative.dev/operator/pkg/apis/operator"
 	"knative.dev/operator/pkg/apis/operator/base"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
 )
@@ -102,6 +103,141 @@ func (is *KnativeServingStatus) MarkDeploymentsNotReady(deployments []string) {
 		"Waiting on deployments: %s", strings.Join(deployments, ", "))
 }
 
+// MarkPreviewReady marks the PreviewReady status as true.
+func (is *KnativeServingStatus) MarkPreviewReady(configMap string) {
+	servingCondSet.Manage(is).MarkTrueWithReason(
+		base.PreviewReady,
+		"DryRun",
+		"Preview of the changes is available in the ConfigMap %s", configMap)
+}
+
+// MarkPreviewFailed marks the PreviewReady status as false with the given message.
+func (is *KnativeServingStatus) MarkPreviewFailed(msg string) {
+	servingCondSet.Manage(is).MarkFalse(
+		base.PreviewReady,
+		"Error",
+		"Preview failed with message: %s", msg)
+}
+
+// ClearPreview removes the PreviewReady status.
+func (is *KnativeServingStatus) ClearPreview() {
+	servingCondSet.Manage(is).ClearCondition(base.PreviewReady)
+}
+
+// MarkPreflightChecksPassed marks the PreflightChecksPassed status as true.
+func (is *KnativeServingStatus) MarkPreflightChecksPassed() {
+	servingCondSet.Manage(is).MarkTrue(base.PreflightChecksPassed)
+}
+
+// MarkPreflightChecksFailed marks the PreflightChecksPassed status as false with the given violations.
+func (is *KnativeServingStatus) MarkPreflightChecksFailed(violations []string) {
+	servingCondSet.Manage(is).MarkFalse(
+		base.PreflightChecksPassed,
+		"PreflightFailed",
+		"Pre-flight checks failed: %s", strings.Join(violations, "; "))
+}
+
+// MarkPaused marks the Paused status as true.
+func (is *KnativeServingStatus) MarkPaused() {
+	servingCondSet.Manage(is).MarkTrueWithReason(
+		base.Paused,
+		"Paused",
+		"Reconciliation is paused by the annotation %s", base.PausedAnnotation)
+}
+
+// ClearPaused removes the Paused status.
+func (is *KnativeServingStatus) ClearPaused() {
+	servingCondSet.Manage(is).ClearCondition(base.Paused)
+}
+
+// MarkVersionSkewWarning marks the VersionSkewWarning status as true with the given message.
+func (is *KnativeServingStatus) MarkVersionSkewWarning(msg string) {
+	servingCondSet.Manage(is).MarkTrueWithReason(
+		base.VersionSkewWarning,
+		"VersionSkew",
+		"%s", msg)
+}
+
+// ClearVersionSkewWarning removes the VersionSkewWarning status.
+func (is *KnativeServingStatus) ClearVersionSkewWarning() {
+	servingCondSet.Manage(is).ClearCondition(base.VersionSkewWarning)
+}
+
+// MarkWebhookCertificateWarning marks the WebhookCertificateWarning status as true with the given message.
+func (is *KnativeServingStatus) MarkWebhookCertificateWarning(msg string) {
+	servingCondSet.Manage(is).MarkTrueWithReason(
+		base.WebhookCertificateWarning,
+		"WebhookCertificate",
+		"%s", msg)
+}
+
+// ClearWebhookCertificateWarning removes the WebhookCertificateWarning status.
+func (is *KnativeServingStatus) ClearWebhookCertificateWarning() {
+	servingCondSet.Manage(is).ClearCondition(base.WebhookCertificateWarning)
+}
+
+// MarkUnmanagedConfigMapsWarning marks the UnmanagedConfigMapsWarning status as true with the given exclusions.
+func (is *KnativeServingStatus) MarkUnmanagedConfigMapsWarning(exclusions []string) {
+	servingCondSet.Manage(is).MarkTrueWithReason(
+		base.UnmanagedConfigMapsWarning,
+		"UnmanagedConfigMaps",
+		"ConfigMaps excluded from the reconciliation: %s", strings.Join(exclusions, "; "))
+}
+
+// ClearUnmanagedConfigMapsWarning removes the UnmanagedConfigMapsWarning status.
+func (is *KnativeServingStatus) ClearUnmanagedConfigMapsWarning() {
+	servingCondSet.Manage(is).ClearCondition(base.UnmanagedConfigMapsWarning)
+}
+
+// MarkUnknownConfigWarning marks the UnknownConfigWarning status as true with the given message.
+func (is *KnativeServingStatus) MarkUnknownConfigWarning(msg string) {
+	servingCondSet.Manage(is).MarkTrueWithReason(
+		base.UnknownConfigWarning,
+		"UnknownConfig",
+		"%s", msg)
+}
+
+// ClearUnknownConfigWarning removes the UnknownConfigWarning status.
+func (is *KnativeServingStatus) ClearUnknownConfigWarning() {
+	servingCondSet.Manage(is).ClearCondition(base.UnknownConfigWarning)
+}
+
+// MarkConformancePassed marks the ConformancePassed status as true.
+func (is *KnativeServingStatus) MarkConformancePassed() {
+	servingCondSet.Manage(is).MarkTrue(base.ConformancePassed)
+}
+
+// MarkConformanceRunning marks the ConformancePassed status as unknown, while the given checks are running.
+func (is *KnativeServingStatus) MarkConformanceRunning(checks []string) {
+	servingCondSet.Manage(is).MarkUnknown(
+		base.ConformancePassed,
+		"Running",
+		"Conformance checks running: %s", strings.Join(checks, ", "))
+}
+
+// MarkConformanceFailed marks the ConformancePassed status as false with the given failures.
+func (is *KnativeServingStatus) MarkConformanceFailed(failures []string) {
+	servingCondSet.Manage(is).MarkFalse(
+		base.ConformancePassed,
+		"ConformanceFailed",
+		"Conformance checks failed: %s", strings.Join(failures, "; "))
+}
+
+// ClearConformance removes the ConformancePassed status.
+func (is *KnativeServingStatus) ClearConformance() {
+	servingCondSet.Manage(is).ClearCondition(base.ConformancePassed)
+}
+
+// MarkStageSucceeded marks the condition of the custom stage as true.
+func (is *KnativeServingStatus) MarkStageSucceeded(stage apis.ConditionType) {
+	servingCondSet.Manage(is).MarkTrue(stage)
+}
+
+// MarkStageFailed marks the condition of the custom stage as false with the given message.
+func (is *KnativeServingStatus) MarkStageFailed(stage apis.ConditionType, msg string) {
+	servingCondSet.Manage(is).MarkFalse(stage, "StageFailed", "%s", msg)
+}
+
 // MarkDependenciesInstalled marks the DependenciesInstalled status as true.
 func (is *KnativeServingStatus) MarkDependenciesInstalled() {
 	servingCondSet.Manage(is).MarkTrue(base.DependenciesInstalled)
@@ -130,11 +266,26 @@ func (is *KnativeServingStatus) GetVersion() string {
 	return is.Version
 }
 
-// SetVersion sets the currently installed version of the component.
+// SetVersion sets the currently installed version of the component. An upgrade from another
+// installed version updates the LastUpgradeTime, the first installation does not.
 func (is *KnativeServingStatus) SetVersion(version string) {
+	if is.Version != "" && version != is.Version {
+		now := metav1.Now()
+		is.LastUpgradeTime = &now
+	}
 	is.Version = version
 }
 
+// GetResolvedImages gets the digests, which the images referenced by a tag are pinned to.
+func (is *KnativeServingStatus) GetResolvedImages() map[string]string {
+	return is.ResolvedImages
+}
+
+// SetResolvedImages sets the digests, which the images referenced by a tag are pinned to.
+func (is *KnativeServingStatus) SetResolvedImages(images map[string]string) {
+	is.ResolvedImages = images
+}
+
 // GetManifests gets the url links of the manifests.
 func (is *KnativeServingStatus) GetManifests() []string {
 	return is.Manifests
@@ -144,3 +295,23 @@ func (is *KnativeServingStatus) GetManifests() []string {
 func (is *KnativeServingStatus) SetManifests(manifests []string) {
 	is.Manifests = manifests
 }
+
+// GetPermissions gets the permissions granted to the service accounts of the component.
+func (is *KnativeServingStatus) GetPermissions() []base.ServiceAccountPermissions {
+	return is.Permissions
+}
+
+// SetPermissions sets the permissions granted to the service accounts of the component.
+func (is *KnativeServingStatus) SetPermissions(permissions []base.ServiceAccountPermissions) {
+	is.Permissions = permissions
+}
+
+// GetAppliedManifest gets what was last applied for the component.
+func (is *KnativeServingStatus) GetAppliedManifest() *base.AppliedManifest {
+	return is.Applied
+}
+
+// SetAppliedManifest sets what was last applied for the component.
+func (is *KnativeServingStatus) SetAppliedManifest(applied *base.AppliedManifest) {
+	is.Applied = applied
+}
@@ -151,3 +151,167 @@ func TestKnativeServingVersionMigrationNotEligible(t *testing.T) {
 	ks.MarkVersionMigrationNotEligible("Version migration not eligible.")
 	apistest.CheckConditionFailed(ks, base.VersionMigrationEligible, t)
 }
+
+func TestKnativeServingPreview(t *testing.T) {
+	ks := &KnativeServingStatus{}
+	ks.InitializeConditions()
+
+	// A failed preview does not affect the readiness.
+	ks.MarkPreviewFailed("test")
+	apistest.CheckConditionFailed(ks, base.PreviewReady, t)
+	apistest.CheckConditionOngoing(ks, base.InstallSucceeded, t)
+
+	ks.MarkPreviewReady("knative-serving-preview")
+	apistest.CheckConditionSucceeded(ks, base.PreviewReady, t)
+
+	ks.ClearPreview()
+	if c := ks.GetCondition(base.PreviewReady); c != nil {
+		t.Errorf("GetCondition(PreviewReady) = %v, want nil", c)
+	}
+}
+
+func TestKnativeServingPreflight(t *testing.T) {
+	ks := &KnativeServingStatus{}
+	ks.InitializeConditions()
+
+	ks.MarkPreflightChecksFailed([]string{"a", "b"})
+	apistest.CheckConditionFailed(ks, base.PreflightChecksPassed, t)
+	if got, want := ks.GetCondition(base.PreflightChecksPassed).Message, "Pre-flight checks failed: a; b"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+
+	ks.MarkPreflightChecksPassed()
+	apistest.CheckConditionSucceeded(ks, base.PreflightChecksPassed, t)
+}
+
+func TestKnativeServingPaused(t *testing.T) {
+	ks := &KnativeServingStatus{}
+	ks.InitializeConditions()
+
+	ks.MarkPaused()
+	apistest.CheckConditionSucceeded(ks, base.Paused, t)
+	apistest.CheckConditionOngoing(ks, base.InstallSucceeded, t)
+
+	ks.ClearPaused()
+	if c := ks.GetCondition(base.Paused); c != nil {
+		t.Errorf("GetCondition(Paused) = %v, want nil", c)
+	}
+}
+
+func TestKnativeServingVersionSkewWarning(t *testing.T) {
+	ks := &KnativeServingStatus{}
+	ks.InitializeConditions()
+	ks.MarkInstallSucceeded()
+	ks.MarkDeploymentsAvailable()
+	ks.MarkVersionMigrationEligible()
+
+	ks.MarkVersionSkewWarning("out of skew")
+	apistest.CheckConditionSucceeded(ks, base.VersionSkewWarning, t)
+	if !ks.IsReady() {
+		t.Error("IsReady() = false, the warning must not affect the readiness")
+	}
+
+	ks.ClearVersionSkewWarning()
+	if c := ks.GetCondition(base.VersionSkewWarning); c != nil {
+		t.Errorf("GetCondition(VersionSkewWarning) = %v, want nil", c)
+	}
+}
+
+func TestKnativeServingWebhookCertificateWarning(t *testing.T) {
+	ks := &KnativeServingStatus{}
+	ks.InitializeConditions()
+	ks.MarkInstallSucceeded()
+	ks.MarkDeploymentsAvailable()
+	ks.MarkVersionMigrationEligible()
+
+	ks.MarkWebhookCertificateWarning("expires soon")
+	apistest.CheckConditionSucceeded(ks, base.WebhookCertificateWarning, t)
+	if !ks.IsReady() {
+		t.Error("IsReady() = false, the warning must not affect the readiness")
+	}
+
+	ks.ClearWebhookCertificateWarning()
+	if c := ks.GetCondition(base.WebhookCertificateWarning); c != nil {
+		t.Errorf("GetCondition(WebhookCertificateWarning) = %v, want nil", c)
+	}
+}
+
+func TestKnativeServingUnmanagedConfigMapsWarning(t *testing.T) {
+	ks := &KnativeServingStatus{}
+	ks.InitializeConditions()
+	ks.MarkInstallSucceeded()
+	ks.MarkDeploymentsAvailable()
+	ks.MarkVersionMigrationEligible()
+
+	ks.MarkUnmanagedConfigMapsWarning([]string{"knative-serving/config-autoscaler (all keys)", "knative-serving/config-network (keys a)"})
+	apistest.CheckConditionSucceeded(ks, base.UnmanagedConfigMapsWarning, t)
+	if got, want := ks.GetCondition(base.UnmanagedConfigMapsWarning).Message,
+		"ConfigMaps excluded from the reconciliation: knative-serving/config-autoscaler (all keys); knative-serving/config-network (keys a)"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+	if !ks.IsReady() {
+		t.Error("IsReady() = false, the warning must not affect the readiness")
+	}
+
+	ks.ClearUnmanagedConfigMapsWarning()
+	if c := ks.GetCondition(base.UnmanagedConfigMapsWarning); c != nil {
+		t.Errorf("GetCondition(UnmanagedConfigMapsWarning) = %v, want nil", c)
+	}
+}
+
+func TestKnativeServingConformance(t *testing.T) {
+	ks := &KnativeServingStatus{}
+	ks.InitializeConditions()
+	ks.MarkInstallSucceeded()
+	ks.MarkDeploymentsAvailable()
+	ks.MarkVersionMigrationEligible()
+
+	ks.MarkConformanceRunning([]string{"service", "scale-to-zero"})
+	apistest.CheckConditionOngoing(ks, base.ConformancePassed, t)
+	if got, want := ks.GetCondition(base.ConformancePassed).Message, "Conformance checks running: service, scale-to-zero"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+
+	ks.MarkConformanceFailed([]string{"a", "b"})
+	apistest.CheckConditionFailed(ks, base.ConformancePassed, t)
+	if got, want := ks.GetCondition(base.ConformancePassed).Message, "Conformance checks failed: a; b"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+	if !ks.IsReady() {
+		t.Error("IsReady() = false, the conformance checks must not affect the readiness")
+	}
+
+	ks.MarkConformancePassed()
+	apistest.CheckConditionSucceeded(ks, base.ConformancePassed, t)
+
+	ks.ClearConformance()
+	if c := ks.GetCondition(base.ConformancePassed); c != nil {
+		t.Errorf("GetCondition(ConformancePassed) = %v, want nil", c)
+	}
+}
+
+func TestKnativeServingSetVersion(t *testing.T) {
+	ks := &KnativeServingStatus{}
+
+	// The first installation is no upgrade.
+	ks.SetVersion("1.20.0")
+	if ks.LastUpgradeTime != nil {
+		t.Fatalf("LastUpgradeTime = %v, want nil after the installation", ks.LastUpgradeTime)
+	}
+
+	ks.SetVersion("1.21.0")
+	upgraded := ks.LastUpgradeTime
+	if upgraded == nil {
+		t.Fatal("LastUpgradeTime = nil, want the time of the upgrade")
+	}
+
+	ks.SetVersion("1.21.0")
+	if ks.LastUpgradeTime != upgraded {
+		t.Errorf("LastUpgradeTime = %v, want %v for the same version", ks.LastUpgradeTime, upgraded)
+	}
+
+	ks.SetVersion("1.22.0")
+	if ks.LastUpgradeTime == upgraded {
+		t.Error("LastUpgradeTime is unchanged after the upgrade")
+	}
+}
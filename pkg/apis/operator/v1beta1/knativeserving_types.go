@@ -49,6 +49,14 @@ func (ks *KnativeServing) GetStatus() base.KComponentStatus {
 	return &ks.Status
 }
 
+// GetPodSecurityStandard implements KComponentSpec.
+func (s *KnativeServingSpec) GetPodSecurityStandard() base.PodSecurityStandard {
+	if s.Security == nil {
+		return ""
+	}
+	return s.Security.PodSecurityStandard
+}
+
 // KnativeServingSpec defines the desired state of KnativeServing
 type KnativeServingSpec struct {
 	base.CommonSpec `json:",inline"`
@@ -61,6 +69,22 @@ type KnativeServingSpec struct {
 
 	// Security allows configuration of different security adapters to be shipped.
 	Security *SecurityConfigs `json:"security,omitempty"`
+
+	// Domain configures the domains of the Knative services.
+	// +optional
+	Domain *base.DomainConfiguration `json:"domain,omitempty"`
+
+	// Autoscaling configures the defaults of the autoscaler.
+	// +optional
+	Autoscaling *base.AutoscalingConfiguration `json:"autoscaling,omitempty"`
+
+	// RevisionDeployments configures the defaults of the deployments of the revisions.
+	// +optional
+	RevisionDeployments *base.RevisionDeploymentConfiguration `json:"revisionDeployments,omitempty"`
+
+	// GarbageCollection configures the retention of the non-active revisions.
+	// +optional
+	GarbageCollection *base.GarbageCollectionConfiguration `json:"garbageCollection,omitempty"`
 }
 
 // KnativeServingStatus defines the observed state of KnativeServing
@@ -74,6 +98,26 @@ type KnativeServingStatus struct {
 	// The url links of the manifests, separated by comma
 	// +optional
 	Manifests []string `json:"manifests,omitempty"`
+
+	// The time, when the installed version was last upgraded, unset until the first upgrade
+	// +optional
+	LastUpgradeTime *metav1.Time `json:"lastUpgradeTime,omitempty"`
+
+	// The digests, which the images referenced by a tag are pinned to with spec.registry.digestResolution
+	// +optional
+	ResolvedImages map[string]string `json:"resolvedImages,omitempty"`
+
+	// The permissions, which the bindings of the manifests grant to the service accounts
+	// +optional
+	Permissions []base.ServiceAccountPermissions `json:"permissions,omitempty"`
+
+	// The manifests, the digest and the resource counts of the last successful apply
+	// +optional
+	Applied *base.AppliedManifest `json:"applied,omitempty"`
+
+	// The installed ingresses, separated by comma
+	// +optional
+	Ingress string `json:"ingress,omitempty"`
 }
 
 // KnativeServingList contains a list of KnativeServing
@@ -86,12 +130,27 @@ type KnativeServingList struct {
 
 // IngressConfigs specifies options for the ingresses.
 type IngressConfigs struct {
-	Istio   base.IstioIngressConfiguration   `json:"istio"`
-	Kourier base.KourierIngressConfiguration `json:"kourier"`
-	Contour base.ContourIngressConfiguration `json:"contour"`
+	Istio      base.IstioIngressConfiguration      `json:"istio"`
+	Kourier    base.KourierIngressConfiguration    `json:"kourier"`
+	Contour    base.ContourIngressConfiguration    `json:"contour"`
+	GatewayAPI base.GatewayAPIIngressConfiguration `json:"gatewayAPI"`
+
+	// ExternalDNS annotates the ingress gateway for external-dns.
+	// +optional
+	ExternalDNS *base.ExternalDNSConfiguration `json:"externalDNS,omitempty"`
 }
 
 // SecurityConfigs specifies options for the security
 type SecurityConfigs struct {
 	SecurityGuard base.SecurityGuardConfiguration `json:"securityGuard"`
+
+	// CertManager provisions the certificates of the domains with cert-manager.
+	// +optional
+	CertManager base.CertManagerConfiguration `json:"certManager"`
+
+	// SystemInternalTLS encrypts the traffic between the internal components of Knative Serving.
+	// +optional
+	SystemInternalTLS *base.SystemInternalTLSConfiguration `json:"systemInternalTLS,omitempty"`
+
+	base.PodSecurityConfiguration `json:",inline"`
 }
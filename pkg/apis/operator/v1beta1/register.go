@@ -45,7 +45,11 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&KnativeServing{},
 		&KnativeServingList{},
 		&KnativeEventing{},
-		&KnativeEventingList{})
+		&KnativeEventingList{},
+		&KnativeFunctions{},
+		&KnativeFunctionsList{},
+		&KnativeNetworking{},
+		&KnativeNetworkingList{})
 	metav1.AddToGroupVersion(s, SchemeGroupVersion)
 	return nil
 }
@@ -23,14 +23,21 @@ package v1beta1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	base "knative.dev/operator/pkg/apis/operator/base"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressConfigs) DeepCopyInto(out *IngressConfigs) {
 	*out = *in
 	in.Istio.DeepCopyInto(&out.Istio)
-	out.Kourier = in.Kourier
-	out.Contour = in.Contour
+	in.Kourier.DeepCopyInto(&out.Kourier)
+	in.Contour.DeepCopyInto(&out.Contour)
+	in.GatewayAPI.DeepCopyInto(&out.GatewayAPI)
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(base.ExternalDNSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -109,9 +116,59 @@ func (in *KnativeEventingList) DeepCopyObject() runtime.Object {
 func (in *KnativeEventingSpec) DeepCopyInto(out *KnativeEventingSpec) {
 	*out = *in
 	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	if in.DefaultBrokerConfig != nil {
+		in, out := &in.DefaultBrokerConfig, &out.DefaultBrokerConfig
+		*out = new(base.BrokerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
 		*out = new(SourceConfigs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(base.KafkaConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RabbitMQ != nil {
+		in, out := &in.RabbitMQ, &out.RabbitMQ
+		*out = new(base.RabbitMQConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransportEncryption != nil {
+		in, out := &in.TransportEncryption, &out.TransportEncryption
+		*out = new(base.TransportEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sugar != nil {
+		in, out := &in.Sugar, &out.Sugar
+		*out = new(base.SugarConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Istio != nil {
+		in, out := &in.Istio, &out.Istio
+		*out = new(base.EventingComponentConfiguration)
+		**out = **in
+	}
+	if in.JobSink != nil {
+		in, out := &in.JobSink, &out.JobSink
+		*out = new(base.JobSinkConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TriggerFilters != nil {
+		in, out := &in.TriggerFilters, &out.TriggerFilters
+		*out = new(base.TriggerFiltersConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(base.KEDAConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(base.PodSecurityConfiguration)
 		**out = **in
 	}
 	return
@@ -136,6 +193,36 @@ func (in *KnativeEventingStatus) DeepCopyInto(out *KnativeEventingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastUpgradeTime != nil {
+		in, out := &in.LastUpgradeTime, &out.LastUpgradeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ResolvedImages != nil {
+		in, out := &in.ResolvedImages, &out.ResolvedImages
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]base.ServiceAccountPermissions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(base.AppliedManifest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]base.CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -149,6 +236,277 @@ func (in *KnativeEventingStatus) DeepCopy() *KnativeEventingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeFunctions) DeepCopyInto(out *KnativeFunctions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeFunctions.
+func (in *KnativeFunctions) DeepCopy() *KnativeFunctions {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeFunctions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KnativeFunctions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeFunctionsList) DeepCopyInto(out *KnativeFunctionsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KnativeFunctions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeFunctionsList.
+func (in *KnativeFunctionsList) DeepCopy() *KnativeFunctionsList {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeFunctionsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KnativeFunctionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeFunctionsSpec) DeepCopyInto(out *KnativeFunctionsSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	if in.Builders != nil {
+		in, out := &in.Builders, &out.Builders
+		*out = new(base.FunctionsBuildersConfiguration)
+		**out = **in
+	}
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(base.FunctionsPVCConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(base.PodSecurityConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeFunctionsSpec.
+func (in *KnativeFunctionsSpec) DeepCopy() *KnativeFunctionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeFunctionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeFunctionsStatus) DeepCopyInto(out *KnativeFunctionsStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpgradeTime != nil {
+		in, out := &in.LastUpgradeTime, &out.LastUpgradeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ResolvedImages != nil {
+		in, out := &in.ResolvedImages, &out.ResolvedImages
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]base.ServiceAccountPermissions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(base.AppliedManifest)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeFunctionsStatus.
+func (in *KnativeFunctionsStatus) DeepCopy() *KnativeFunctionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeFunctionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeNetworking) DeepCopyInto(out *KnativeNetworking) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeNetworking.
+func (in *KnativeNetworking) DeepCopy() *KnativeNetworking {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeNetworking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KnativeNetworking) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeNetworkingList) DeepCopyInto(out *KnativeNetworkingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KnativeNetworking, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeNetworkingList.
+func (in *KnativeNetworkingList) DeepCopy() *KnativeNetworkingList {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeNetworkingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KnativeNetworkingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeNetworkingSpec) DeepCopyInto(out *KnativeNetworkingSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressConfigs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(base.PodSecurityConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeNetworkingSpec.
+func (in *KnativeNetworkingSpec) DeepCopy() *KnativeNetworkingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeNetworkingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeNetworkingStatus) DeepCopyInto(out *KnativeNetworkingStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpgradeTime != nil {
+		in, out := &in.LastUpgradeTime, &out.LastUpgradeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ResolvedImages != nil {
+		in, out := &in.ResolvedImages, &out.ResolvedImages
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]base.ServiceAccountPermissions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(base.AppliedManifest)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeNetworkingStatus.
+func (in *KnativeNetworkingStatus) DeepCopy() *KnativeNetworkingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeNetworkingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KnativeServing) DeepCopyInto(out *KnativeServing) {
 	*out = *in
@@ -223,7 +581,27 @@ func (in *KnativeServingSpec) DeepCopyInto(out *KnativeServingSpec) {
 	if in.Security != nil {
 		in, out := &in.Security, &out.Security
 		*out = new(SecurityConfigs)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(base.DomainConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(base.AutoscalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionDeployments != nil {
+		in, out := &in.RevisionDeployments, &out.RevisionDeployments
+		*out = new(base.RevisionDeploymentConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(base.GarbageCollectionConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -247,6 +625,29 @@ func (in *KnativeServingStatus) DeepCopyInto(out *KnativeServingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastUpgradeTime != nil {
+		in, out := &in.LastUpgradeTime, &out.LastUpgradeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ResolvedImages != nil {
+		in, out := &in.ResolvedImages, &out.ResolvedImages
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]base.ServiceAccountPermissions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(base.AppliedManifest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -264,6 +665,13 @@ func (in *KnativeServingStatus) DeepCopy() *KnativeServingStatus {
 func (in *SecurityConfigs) DeepCopyInto(out *SecurityConfigs) {
 	*out = *in
 	out.SecurityGuard = in.SecurityGuard
+	out.PodSecurityConfiguration = in.PodSecurityConfiguration
+	in.CertManager.DeepCopyInto(&out.CertManager)
+	if in.SystemInternalTLS != nil {
+		in, out := &in.SystemInternalTLS, &out.SystemInternalTLS
+		*out = new(base.SystemInternalTLSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -286,6 +694,16 @@ func (in *SourceConfigs) DeepCopyInto(out *SourceConfigs) {
 	out.Kafka = in.Kafka
 	out.Rabbitmq = in.Rabbitmq
 	out.Redis = in.Redis
+	if in.Ping != nil {
+		in, out := &in.Ping, &out.Ping
+		*out = new(base.PingSourceConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bundles != nil {
+		in, out := &in.Bundles, &out.Bundles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -32,6 +32,14 @@ func (c *FakeOperatorV1beta1) KnativeEventings(namespace string) v1beta1.Knative
 	return newFakeKnativeEventings(c, namespace)
 }
 
+func (c *FakeOperatorV1beta1) KnativeFunctions(namespace string) v1beta1.KnativeFunctionsInterface {
+	return newFakeKnativeFunctions(c, namespace)
+}
+
+func (c *FakeOperatorV1beta1) KnativeNetworkings(namespace string) v1beta1.KnativeNetworkingInterface {
+	return newFakeKnativeNetworkings(c, namespace)
+}
+
 func (c *FakeOperatorV1beta1) KnativeServings(namespace string) v1beta1.KnativeServingInterface {
 	return newFakeKnativeServings(c, namespace)
 }
@@ -20,4 +20,8 @@ package v1beta1
 
 type KnativeEventingExpansion interface{}
 
+type KnativeFunctionsExpansion interface{}
+
+type KnativeNetworkingExpansion interface{}
+
 type KnativeServingExpansion interface{}
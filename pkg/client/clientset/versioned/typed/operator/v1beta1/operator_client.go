@@ -29,6 +29,8 @@ import (
 type OperatorV1beta1Interface interface {
 	RESTClient() rest.Interface
 	KnativeEventingsGetter
+	KnativeFunctionsGetter
+	KnativeNetworkingsGetter
 	KnativeServingsGetter
 }
 
@@ -41,6 +43,14 @@ func (c *OperatorV1beta1Client) KnativeEventings(namespace string) KnativeEventi
 	return newKnativeEventings(c, namespace)
 }
 
+func (c *OperatorV1beta1Client) KnativeFunctions(namespace string) KnativeFunctionsInterface {
+	return newKnativeFunctions(c, namespace)
+}
+
+func (c *OperatorV1beta1Client) KnativeNetworkings(namespace string) KnativeNetworkingInterface {
+	return newKnativeNetworkings(c, namespace)
+}
+
 func (c *OperatorV1beta1Client) KnativeServings(namespace string) KnativeServingInterface {
 	return newKnativeServings(c, namespace)
 }
@@ -55,6 +55,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=operator.knative.dev, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("knativeeventings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().KnativeEventings().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("knativefunctions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().KnativeFunctions().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("knativenetworkings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().KnativeNetworkings().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("knativeservings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().KnativeServings().Informer()}, nil
 
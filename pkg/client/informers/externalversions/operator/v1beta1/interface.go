@@ -26,6 +26,10 @@ import (
 type Interface interface {
 	// KnativeEventings returns a KnativeEventingInformer.
 	KnativeEventings() KnativeEventingInformer
+	// KnativeFunctions returns a KnativeFunctionsInformer.
+	KnativeFunctions() KnativeFunctionsInformer
+	// KnativeNetworkings returns a KnativeNetworkingInformer.
+	KnativeNetworkings() KnativeNetworkingInformer
 	// KnativeServings returns a KnativeServingInformer.
 	KnativeServings() KnativeServingInformer
 }
@@ -46,6 +50,16 @@ func (v *version) KnativeEventings() KnativeEventingInformer {
 	return &knativeEventingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// KnativeFunctions returns a KnativeFunctionsInformer.
+func (v *version) KnativeFunctions() KnativeFunctionsInformer {
+	return &knativeFunctionsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// KnativeNetworkings returns a KnativeNetworkingInformer.
+func (v *version) KnativeNetworkings() KnativeNetworkingInformer {
+	return &knativeNetworkingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // KnativeServings returns a KnativeServingInformer.
 func (v *version) KnativeServings() KnativeServingInformer {
 	return &knativeServingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -26,6 +26,22 @@ type KnativeEventingListerExpansion interface{}
 // KnativeEventingNamespaceLister.
 type KnativeEventingNamespaceListerExpansion interface{}
 
+// KnativeFunctionsListerExpansion allows custom methods to be added to
+// KnativeFunctionsLister.
+type KnativeFunctionsListerExpansion interface{}
+
+// KnativeFunctionsNamespaceListerExpansion allows custom methods to be added to
+// KnativeFunctionsNamespaceLister.
+type KnativeFunctionsNamespaceListerExpansion interface{}
+
+// KnativeNetworkingListerExpansion allows custom methods to be added to
+// KnativeNetworkingLister.
+type KnativeNetworkingListerExpansion interface{}
+
+// KnativeNetworkingNamespaceListerExpansion allows custom methods to be added to
+// KnativeNetworkingNamespaceLister.
+type KnativeNetworkingNamespaceListerExpansion interface{}
+
 // KnativeServingListerExpansion allows custom methods to be added to
 // KnativeServingLister.
 type KnativeServingListerExpansion interface{}
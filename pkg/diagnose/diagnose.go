@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diagnose collects the state of the Knative components managed by the operator into a
+// bundle, which can be attached to support issues.
+package diagnose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/yaml"
+
+	clientset "knative.dev/operator/pkg/client/clientset/versioned"
+)
+
+const (
+	// Redacted replaces every value, which may contain sensitive data.
+	Redacted = "<redacted>"
+
+	// maxEvents is the maximum number of the most recent events collected per namespace.
+	maxEvents = 200
+)
+
+var sensitiveKey = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|api-?key|private)`)
+
+// Bundle maps the path of each file in the bundle to its content.
+type Bundle map[string][]byte
+
+// Options configure, what is collected into the bundle.
+type Options struct {
+	// OperatorNamespace is the namespace of the operator deployment.
+	OperatorNamespace string
+}
+
+// ComponentVersion reports the versions of a single Knative component.
+type ComponentVersion struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Desired is spec.version, empty for the latest version known to the operator.
+	Desired string `json:"desired"`
+	// Installed is status.version.
+	Installed string `json:"installed"`
+	Ready     bool   `json:"ready"`
+}
+
+// Versions reports the version skew between the cluster and the Knative components.
+type Versions struct {
+	Kubernetes string             `json:"kubernetes"`
+	Components []ComponentVersion `json:"components"`
+}
+
+// DeploymentStatus is the digest of a deployment in an operand namespace.
+type DeploymentStatus struct {
+	Name              string                       `json:"name"`
+	Replicas          int32                        `json:"replicas"`
+	ReadyReplicas     int32                        `json:"readyReplicas"`
+	UpdatedReplicas   int32                        `json:"updatedReplicas"`
+	AvailableReplicas int32                        `json:"availableReplicas"`
+	Images            []string                     `json:"images"`
+	Conditions        []appsv1.DeploymentCondition `json:"conditions,omitempty"`
+}
+
+// Collect gathers the Knative components, the deployments, events and config-* ConfigMaps of their
+// namespaces, the webhook configurations of Knative and the version skew into a bundle. Values of
+// the configuration, which look sensitive, are redacted. Secrets are never collected.
+func Collect(ctx context.Context, kubeClient kubernetes.Interface, operatorClient clientset.Interface, opts Options) (Bundle, error) {
+	bundle := Bundle{}
+	namespaces := sets.New[string]()
+	if opts.OperatorNamespace != "" {
+		namespaces.Insert(opts.OperatorNamespace)
+	}
+	versions := Versions{}
+
+	serverVersion, err := kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the Kubernetes version: %w", err)
+	}
+	versions.Kubernetes = serverVersion.GitVersion
+
+	kss, err := operatorClient.OperatorV1beta1().KnativeServings("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KnativeServings: %w", err)
+	}
+	for i := range kss.Items {
+		ks := kss.Items[i].DeepCopy()
+		ks.ManagedFields = nil
+		redactConfig(ks.Spec.Config)
+		namespaces.Insert(ks.Namespace)
+		versions.Components = append(versions.Components, ComponentVersion{
+			Kind:      "KnativeServing",
+			Namespace: ks.Namespace,
+			Name:      ks.Name,
+			Desired:   ks.Spec.Version,
+			Installed: ks.Status.Version,
+			Ready:     ks.Status.GetCondition(apis.ConditionReady).IsTrue(),
+		})
+		if err := bundle.add(path.Join("components", "knativeserving", ks.Namespace, ks.Name+".yaml"), ks); err != nil {
+			return nil, err
+		}
+	}
+
+	kes, err := operatorClient.OperatorV1beta1().KnativeEventings("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KnativeEventings: %w", err)
+	}
+	for i := range kes.Items {
+		ke := kes.Items[i].DeepCopy()
+		ke.ManagedFields = nil
+		redactConfig(ke.Spec.Config)
+		namespaces.Insert(ke.Namespace)
+		versions.Components = append(versions.Components, ComponentVersion{
+			Kind:      "KnativeEventing",
+			Namespace: ke.Namespace,
+			Name:      ke.Name,
+			Desired:   ke.Spec.Version,
+			Installed: ke.Status.Version,
+			Ready:     ke.Status.GetCondition(apis.ConditionReady).IsTrue(),
+		})
+		if err := bundle.add(path.Join("components", "knativeeventing", ke.Namespace, ke.Name+".yaml"), ke); err != nil {
+			return nil, err
+		}
+	}
+
+	kfs, err := operatorClient.OperatorV1beta1().KnativeFunctions("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KnativeFunctions: %w", err)
+	}
+	for i := range kfs.Items {
+		kf := kfs.Items[i].DeepCopy()
+		kf.ManagedFields = nil
+		redactConfig(kf.Spec.Config)
+		namespaces.Insert(kf.Namespace)
+		versions.Components = append(versions.Components, ComponentVersion{
+			Kind:      "KnativeFunctions",
+			Namespace: kf.Namespace,
+			Name:      kf.Name,
+			Desired:   kf.Spec.Version,
+			Installed: kf.Status.Version,
+			Ready:     kf.Status.GetCondition(apis.ConditionReady).IsTrue(),
+		})
+		if err := bundle.add(path.Join("components", "knativefunctions", kf.Namespace, kf.Name+".yaml"), kf); err != nil {
+			return nil, err
+		}
+	}
+
+	kns, err := operatorClient.OperatorV1beta1().KnativeNetworkings("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KnativeNetworkings: %w", err)
+	}
+	for i := range kns.Items {
+		kn := kns.Items[i].DeepCopy()
+		kn.ManagedFields = nil
+		redactConfig(kn.Spec.Config)
+		namespaces.Insert(kn.Namespace)
+		versions.Components = append(versions.Components, ComponentVersion{
+			Kind:      "KnativeNetworking",
+			Namespace: kn.Namespace,
+			Name:      kn.Name,
+			Desired:   kn.Spec.Version,
+			Installed: kn.Status.Version,
+			Ready:     kn.Status.GetCondition(apis.ConditionReady).IsTrue(),
+		})
+		if err := bundle.add(path.Join("components", "knativenetworking", kn.Namespace, kn.Name+".yaml"), kn); err != nil {
+			return nil, err
+		}
+	}
+	if err := bundle.add("versions.yaml", versions); err != nil {
+		return nil, err
+	}
+
+	for _, ns := range sets.List(namespaces) {
+		if err := collectNamespace(ctx, kubeClient, ns, bundle); err != nil {
+			return nil, err
+		}
+	}
+	if err := collectWebhooks(ctx, kubeClient, bundle); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func collectNamespace(ctx context.Context, kubeClient kubernetes.Interface, ns string, bundle Bundle) error {
+	deployments, err := kubeClient.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list the deployments in %s: %w", ns, err)
+	}
+	statuses := make([]DeploymentStatus, 0, len(deployments.Items))
+	for _, d := range deployments.Items {
+		status := DeploymentStatus{
+			Name:              d.Name,
+			ReadyReplicas:     d.Status.ReadyReplicas,
+			UpdatedReplicas:   d.Status.UpdatedReplicas,
+			AvailableReplicas: d.Status.AvailableReplicas,
+			Conditions:        d.Status.Conditions,
+		}
+		if d.Spec.Replicas != nil {
+			status.Replicas = *d.Spec.Replicas
+		}
+		for _, c := range d.Spec.Template.Spec.Containers {
+			status.Images = append(status.Images, c.Image)
+		}
+		statuses = append(statuses, status)
+	}
+	if err := bundle.add(path.Join("namespaces", ns, "deployments.yaml"), statuses); err != nil {
+		return err
+	}
+
+	events, err := kubeClient.CoreV1().Events(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list the events in %s: %w", ns, err)
+	}
+	items := events.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return lastSeen(items[i]).After(lastSeen(items[j]))
+	})
+	if len(items) > maxEvents {
+		items = items[:maxEvents]
+	}
+	for i := range items {
+		items[i].ManagedFields = nil
+	}
+	if err := bundle.add(path.Join("namespaces", ns, "events.yaml"), items); err != nil {
+		return err
+	}
+
+	cms, err := kubeClient.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list the ConfigMaps in %s: %w", ns, err)
+	}
+	for i := range cms.Items {
+		cm := &cms.Items[i]
+		if !strings.HasPrefix(cm.Name, "config-") {
+			continue
+		}
+		cm.ManagedFields = nil
+		redactData(cm.Data)
+		cm.BinaryData = nil
+		if err := bundle.add(path.Join("namespaces", ns, "configmaps", cm.Name+".yaml"), cm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func collectWebhooks(ctx context.Context, kubeClient kubernetes.Interface, bundle Bundle) error {
+	mutating, err := kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list the mutating webhook configurations: %w", err)
+	}
+	for i := range mutating.Items {
+		wh := &mutating.Items[i]
+		if !strings.Contains(wh.Name, "knative") {
+			continue
+		}
+		wh.ManagedFields = nil
+		for j := range wh.Webhooks {
+			wh.Webhooks[j].ClientConfig.CABundle = nil
+		}
+		if err := bundle.add(path.Join("webhooks", "mutating", wh.Name+".yaml"), wh); err != nil {
+			return err
+		}
+	}
+
+	validating, err := kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list the validating webhook configurations: %w", err)
+	}
+	for i := range validating.Items {
+		wh := &validating.Items[i]
+		if !strings.Contains(wh.Name, "knative") {
+			continue
+		}
+		wh.ManagedFields = nil
+		for j := range wh.Webhooks {
+			wh.Webhooks[j].ClientConfig.CABundle = nil
+		}
+		if err := bundle.add(path.Join("webhooks", "validating", wh.Name+".yaml"), wh); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lastSeen(e corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+// redactConfig redacts the sensitive values of spec.config of a Knative component.
+func redactConfig(config map[string]map[string]string) {
+	for _, data := range config {
+		redactData(data)
+	}
+}
+
+// redactData redacts the values, whose key looks sensitive or which contain PEM encoded data.
+func redactData(data map[string]string) {
+	for key, value := range data {
+		if sensitiveKey.MatchString(key) || strings.Contains(value, "-----BEGIN") {
+			data[key] = Redacted
+		}
+	}
+}
+
+func (b Bundle) add(name string, obj interface{}) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	b[name] = data
+	return nil
+}
+
+// Names returns the sorted paths of all files in the bundle.
+func (b Bundle) Names() []string {
+	names := make([]string, 0, len(b))
+	for name := range b {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WriteTarball writes the bundle as gzipped tarball.
+func (b Bundle) WriteTarball(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, name := range b.Names() {
+		hdr := &tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(b[name])),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(b[name]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// WriteText writes all files of the bundle as a single YAML stream, each document preceded by
+// a comment with its path.
+func (b Bundle) WriteText(w io.Writer) error {
+	for _, name := range b.Names() {
+		if _, err := fmt.Fprintf(w, "---\n# %s\n%s", name, b[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnose
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	operatorfake "knative.dev/operator/pkg/client/clientset/versioned/fake"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestCollect(t *testing.T) {
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{
+				Version: "1.18",
+				Config: base.ConfigMapData{
+					"network": {"ingress-class": "kourier", "api-key": "abc"},
+				},
+			},
+		},
+		Status: v1beta1.KnativeServingStatus{Version: "1.17.0"},
+	}
+	kubeClient := kubefake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller", Namespace: "knative-serving"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "controller", Image: "gcr.io/controller"}}},
+				},
+			},
+			Status: appsv1.DeploymentStatus{ReadyReplicas: 1},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config-network", Namespace: "knative-serving"},
+			Data: map[string]string{
+				"ingress-class": "kourier",
+				"db-password":   "hunter2",
+				"ca":            "-----BEGIN CERTIFICATE-----",
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "knative-serving"},
+		},
+		&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "event", Namespace: "knative-serving"},
+			Reason:     "Failed",
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "config.webhook.serving.knative.dev"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name:         "config.webhook.serving.knative.dev",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte("ca")},
+			}},
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+		},
+	)
+	operatorClient := operatorfake.NewSimpleClientset(ks)
+
+	bundle, err := Collect(context.Background(), kubeClient, operatorClient, Options{OperatorNamespace: "knative-operator"})
+	if err != nil {
+		t.Fatalf("Collect() = %v", err)
+	}
+
+	util.AssertDeepEqual(t, bundle.Names(), []string{
+		"components/knativeserving/knative-serving/knative-serving.yaml",
+		"namespaces/knative-operator/deployments.yaml",
+		"namespaces/knative-operator/events.yaml",
+		"namespaces/knative-serving/configmaps/config-network.yaml",
+		"namespaces/knative-serving/deployments.yaml",
+		"namespaces/knative-serving/events.yaml",
+		"versions.yaml",
+		"webhooks/validating/config.webhook.serving.knative.dev.yaml",
+	})
+
+	cm := &corev1.ConfigMap{}
+	if err := yaml.Unmarshal(bundle["namespaces/knative-serving/configmaps/config-network.yaml"], cm); err != nil {
+		t.Fatalf("Failed to parse the ConfigMap: %v", err)
+	}
+	util.AssertDeepEqual(t, cm.Data, map[string]string{
+		"ingress-class": "kourier",
+		"db-password":   Redacted,
+		"ca":            Redacted,
+	})
+
+	component := &v1beta1.KnativeServing{}
+	if err := yaml.Unmarshal(bundle["components/knativeserving/knative-serving/knative-serving.yaml"], component); err != nil {
+		t.Fatalf("Failed to parse the KnativeServing: %v", err)
+	}
+	util.AssertEqual(t, component.Spec.Config["network"]["api-key"], Redacted)
+	// The live object must not be redacted.
+	util.AssertEqual(t, ks.Spec.Config["network"]["api-key"], "abc")
+
+	versions := Versions{}
+	if err := yaml.Unmarshal(bundle["versions.yaml"], &versions); err != nil {
+		t.Fatalf("Failed to parse the versions: %v", err)
+	}
+	util.AssertDeepEqual(t, versions.Components, []ComponentVersion{{
+		Kind:      "KnativeServing",
+		Namespace: "knative-serving",
+		Name:      "knative-serving",
+		Desired:   "1.18",
+		Installed: "1.17.0",
+	}})
+
+	if strings.Contains(string(bundle["webhooks/validating/config.webhook.serving.knative.dev.yaml"]), "caBundle") {
+		t.Error("The CA bundle of the webhook must be dropped")
+	}
+}
+
+func TestWriteTarball(t *testing.T) {
+	bundle := Bundle{"b.yaml": []byte("b"), "a.yaml": []byte("a")}
+	buf := &bytes.Buffer{}
+	if err := bundle.WriteTarball(buf); err != nil {
+		t.Fatalf("WriteTarball() = %v", err)
+	}
+
+	gz, err := gzip.NewReader(buf)
+	if err != nil {
+		t.Fatalf("Failed to read the tarball: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	got := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read the tarball: %v", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", hdr.Name, err)
+		}
+		got[hdr.Name] = string(data)
+	}
+	util.AssertDeepEqual(t, got, map[string]string{"a.yaml": "a", "b.yaml": "b"})
+}
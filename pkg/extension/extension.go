@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package extension is the stable API for distributions embedding the operator, e.g. vendor
+// operators extending it for their platform. The reconcilers under pkg/reconciler are internal and
+// change without notice, whereas the identifiers of this package keep their names and signatures
+// within a major version of the operator. Removals are announced in the release notes and
+// deprecated for at least two minor versions.
+//
+// A distribution implements an Extension, whose manifests are installed along with the ones of the
+// component, whose transformers run after the ones of the operator, whose Reconcile hook runs
+// before the stages of the reconciler and whose Finalize hook runs before the cluster-scoped
+// resources are deleted, and passes its ExtensionGenerator to NewServingController and
+// NewEventingController:
+//
+//	sharedmain.Main("operator",
+//		extension.NewServingController(myplatform.NewExtension),
+//		extension.NewEventingController(myplatform.NewExtension),
+//	)
+package extension
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/pkg/reconciler/knativeeventing"
+	"knative.dev/operator/pkg/reconciler/knativeserving"
+)
+
+// Component is a KnativeServing, KnativeEventing, KnativeFunctions or KnativeNetworking.
+type Component = base.KComponent
+
+// Extension enables platform-specific features in the reconcilers.
+type Extension = common.Extension
+
+// ExtensionGenerator creates the Extension of a controller.
+type ExtensionGenerator = common.ExtensionGenerator
+
+// ManifestSource provides the manifests, which are installed along with the ones of the component.
+type ManifestSource = common.ManifestSource
+
+// ManifestFetcher returns the manifest of a component, e.g. the one of its installed version.
+type ManifestFetcher = common.ManifestFetcher
+
+// TransformerProvider provides the transformers, which run after the ones of the operator.
+type TransformerProvider = common.TransformerProvider
+
+// Transformer mutates a resource of the manifest before it is applied.
+type Transformer = mf.Transformer
+
+// TransformerPlugin contributes a transformer to the pipeline of all the components.
+type TransformerPlugin = common.TransformerPlugin
+
+// TransformerPhase is the position of the transformers of the plugins in the pipeline.
+type TransformerPhase = common.TransformerPhase
+
+const (
+	// TransformerPhaseBeforeOverrides runs the transformers before the ones applying the spec.
+	TransformerPhaseBeforeOverrides = common.TransformerPhaseBeforeOverrides
+	// TransformerPhaseAfterOverrides runs the transformers after all the ones of the operator.
+	TransformerPhaseAfterOverrides = common.TransformerPhaseAfterOverrides
+)
+
+// Stage is a step of the reconciliation of a component.
+type Stage = common.Stage
+
+// Stages run in sequence until one fails or waits for the deployments.
+type Stages = common.Stages
+
+// CustomStage is a stage, which an extension inserts into the pipeline of the reconcilers.
+type CustomStage = common.CustomStage
+
+// StagePosition is the position of a custom stage in the pipeline.
+type StagePosition = common.StagePosition
+
+const (
+	// StagePositionAfterRender runs the stage once the manifest is rendered, before it is applied.
+	StagePositionAfterRender = common.StagePositionAfterRender
+	// StagePositionAfterInstall runs the stage once the manifest is applied.
+	StagePositionAfterInstall = common.StagePositionAfterInstall
+	// StagePositionAfterReady runs the stage once the deployments are ready.
+	StagePositionAfterReady = common.StagePositionAfterReady
+)
+
+// NoExtension is the ExtensionGenerator of the upstream operator, which extends nothing.
+func NoExtension(ctx context.Context, impl *controller.Impl) Extension {
+	return common.NoExtension(ctx, impl)
+}
+
+// NewServingController returns the constructor of the KnativeServing controller with the
+// extension of the generator.
+func NewServingController(generator ExtensionGenerator) injection.ControllerConstructor {
+	return knativeserving.NewExtendedController(generator)
+}
+
+// NewEventingController returns the constructor of the KnativeEventing controller with the
+// extension of the generator.
+func NewEventingController(generator ExtensionGenerator) injection.ControllerConstructor {
+	return knativeeventing.NewExtendedController(generator)
+}
+
+// RegisterTransformer registers the plugin for the transformers of all the components. It fails,
+// if a plugin with the same name is registered already.
+func RegisterTransformer(plugin TransformerPlugin) error {
+	return common.RegisterTransformer(plugin)
+}
+
+// RegisterStage inserts the stage into the pipeline of the reconcilers. Its outcome is reported in
+// the condition named like the stage and its duration in the metric kn.operator.stage.duration.
+func RegisterStage(stage CustomStage) error {
+	return common.RegisterStage(stage)
+}
+
+// Transform applies the transformers of the operator and the extra ones to the manifest, like the
+// reconcilers do before they install it.
+func Transform(ctx context.Context, manifest *mf.Manifest, component Component, extra ...Transformer) error {
+	return common.Transform(ctx, manifest, component, extra...)
+}
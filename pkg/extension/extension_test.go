@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+type labelExtension string
+
+func (labelExtension) Manifests(Component) ([]mf.Manifest, error) { return nil, nil }
+
+func (l labelExtension) Transformers(Component) []Transformer {
+	return []Transformer{func(u *unstructured.Unstructured) error {
+		u.SetLabels(map[string]string{"platform": string(l)})
+		return nil
+	}}
+}
+
+func (labelExtension) Reconcile(context.Context, Component) error { return nil }
+
+func (labelExtension) Finalize(context.Context, Component) error { return nil }
+
+// The signatures of the stable API must not change within a major version, the assignments fail to
+// compile otherwise.
+var (
+	_ Extension           = labelExtension("")
+	_ ManifestSource      = labelExtension("")
+	_ TransformerProvider = labelExtension("")
+	_ ExtensionGenerator  = NoExtension
+	_ ExtensionGenerator  = func(context.Context, *controller.Impl) Extension { return labelExtension("") }
+	_ ManifestFetcher     = func(context.Context, Component) (*mf.Manifest, error) { return nil, nil }
+	_ Stage               = func(context.Context, *mf.Manifest, Component) error { return nil }
+	_ Stages              = Stages{func(context.Context, *mf.Manifest, Component) error { return nil }}
+
+	_ func(ExtensionGenerator) injection.ControllerConstructor             = NewServingController
+	_ func(ExtensionGenerator) injection.ControllerConstructor             = NewEventingController
+	_ func(context.Context, *mf.Manifest, Component, ...Transformer) error = Transform
+)
+
+func TestTransform(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		util.MakeUnstructured(t, &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "config-network"},
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	ext := NoExtension(context.Background(), nil)
+	util.AssertEqual(t, len(ext.Transformers(ks)), 0)
+
+	ext = labelExtension("test")
+	if err := Transform(context.Background(), &manifest, ks, ext.Transformers(ks)...); err != nil {
+		t.Fatalf("Transform() = %v", err)
+	}
+	u := manifest.Resources()[0]
+	// The transformers of the operator run before the ones of the extension.
+	util.AssertEqual(t, u.GetNamespace(), "knative-serving")
+	util.AssertDeepEqual(t, u.GetLabels(), map[string]string{"platform": "test"})
+}
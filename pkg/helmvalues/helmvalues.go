@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package helmvalues converts the values.yaml of a helm-based installation of Knative into the
+// KnativeServing and KnativeEventing resources of the operator, which install the same.
+package helmvalues
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// releaseRegistry is the prefix of the images of the Knative releases, which a mirror replaces.
+const releaseRegistry = "gcr.io/knative-releases/*"
+
+// ingressClasses are the ingress classes of the ingresses, which serving.ingress selects.
+var ingressClasses = map[string]string{
+	"istio":       "istio.ingress.networking.knative.dev",
+	"kourier":     "kourier.ingress.networking.knative.dev",
+	"contour":     "contour.ingress.networking.knative.dev",
+	"gateway-api": "gateway-api.ingress.networking.knative.dev",
+}
+
+// Values are the helm-style values, which are converted.
+type Values struct {
+	Global   Global     `json:"global,omitempty"`
+	Serving  *Component `json:"serving,omitempty"`
+	Eventing *Component `json:"eventing,omitempty"`
+}
+
+// Global are the values shared by Knative Serving and Knative Eventing.
+type Global struct {
+	// Image.Registry is the mirror of the release images. Without a tag, it replaces the prefix
+	// gcr.io/knative-releases of all images, with a tag, the images are named
+	// <registry>/<container>:<tag>.
+	Image Image `json:"image,omitempty"`
+	// ImagePullSecrets are the secrets for pulling the images.
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+// Image is a reference to an image in the helm style.
+type Image struct {
+	Registry   string `json:"registry,omitempty"`
+	Repository string `json:"repository,omitempty"`
+	// Tag is a tag or a digest, e.g. sha256:0123...
+	Tag string `json:"tag,omitempty"`
+}
+
+// Component are the values of Knative Serving or Knative Eventing.
+type Component struct {
+	// Enabled defaults to true, if the component has any values.
+	Enabled   *bool  `json:"enabled,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Version   string `json:"version,omitempty"`
+	// Ingress is the ingress of Knative Serving: istio, kourier, contour or gateway-api.
+	Ingress string `json:"ingress,omitempty"`
+	// Config are the entries of the ConfigMaps by their name without the prefix config-.
+	Config base.ConfigMapData `json:"config,omitempty"`
+	// Workloads are the values of the deployments by their name.
+	Workloads map[string]Workload `json:"workloads,omitempty"`
+}
+
+// Workload are the values of a deployment, whose container has the name of the deployment.
+type Workload struct {
+	Replicas     *int32                       `json:"replicas,omitempty"`
+	Image        Image                        `json:"image,omitempty"`
+	Resources    *corev1.ResourceRequirements `json:"resources,omitempty"`
+	NodeSelector map[string]string            `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration          `json:"tolerations,omitempty"`
+	Affinity     *corev1.Affinity             `json:"affinity,omitempty"`
+}
+
+// Convert returns the KnativeServing and the KnativeEventing for the values. Unknown values are
+// rejected, so that nothing is dropped silently.
+func Convert(data []byte) ([]base.KComponent, error) {
+	values := &Values{}
+	if err := yaml.UnmarshalStrict(data, values); err != nil {
+		return nil, fmt.Errorf("failed to parse the values: %w", err)
+	}
+
+	var components []base.KComponent
+	if enabled(values.Serving) {
+		spec, err := commonSpec(values.Global, values.Serving)
+		if err != nil {
+			return nil, fmt.Errorf("serving: %w", err)
+		}
+		ks := &v1beta1.KnativeServing{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "KnativeServing"},
+			ObjectMeta: objectMeta(values.Serving, "knative-serving"),
+			Spec:       v1beta1.KnativeServingSpec{CommonSpec: spec},
+		}
+		if err := setIngress(ks, values.Serving.Ingress); err != nil {
+			return nil, fmt.Errorf("serving: %w", err)
+		}
+		components = append(components, ks)
+	}
+	if enabled(values.Eventing) {
+		if values.Eventing.Ingress != "" {
+			return nil, fmt.Errorf("eventing: ingress is only supported for serving")
+		}
+		spec, err := commonSpec(values.Global, values.Eventing)
+		if err != nil {
+			return nil, fmt.Errorf("eventing: %w", err)
+		}
+		components = append(components, &v1beta1.KnativeEventing{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "KnativeEventing"},
+			ObjectMeta: objectMeta(values.Eventing, "knative-eventing"),
+			Spec:       v1beta1.KnativeEventingSpec{CommonSpec: spec},
+		})
+	}
+	if len(components) == 0 {
+		return nil, fmt.Errorf("neither serving nor eventing is enabled")
+	}
+	return components, nil
+}
+
+func enabled(c *Component) bool {
+	return c != nil && (c.Enabled == nil || *c.Enabled)
+}
+
+// objectMeta names the component like its namespace, as in the samples of the operator.
+func objectMeta(c *Component, defaultNamespace string) metav1.ObjectMeta {
+	namespace := c.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	return metav1.ObjectMeta{Namespace: namespace, Name: defaultNamespace}
+}
+
+func commonSpec(global Global, c *Component) (base.CommonSpec, error) {
+	spec := base.CommonSpec{
+		Version: c.Version,
+		Config:  c.Config,
+		Registry: base.Registry{
+			ImagePullSecrets: global.ImagePullSecrets,
+		},
+	}
+	if global.Image.Repository != "" {
+		return spec, fmt.Errorf("global.image.repository is not supported, set global.image.registry")
+	}
+	if registry := strings.TrimSuffix(global.Image.Registry, "/"); registry != "" {
+		if global.Image.Tag != "" {
+			spec.Registry.Default = imageReference(registry+"/${NAME}", global.Image.Tag)
+		} else {
+			spec.Registry.Rewrites = []base.ImageRewrite{{From: releaseRegistry, To: registry + "/*"}}
+		}
+	}
+
+	names := make([]string, 0, len(c.Workloads))
+	for name := range c.Workloads {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w := c.Workloads[name]
+		if w.Image.Repository != "" {
+			if spec.Registry.Override == nil {
+				spec.Registry.Override = map[string]string{}
+			}
+			repository := w.Image.Repository
+			if w.Image.Registry != "" {
+				repository = strings.TrimSuffix(w.Image.Registry, "/") + "/" + repository
+			}
+			spec.Registry.Override[name] = imageReference(repository, w.Image.Tag)
+		} else if w.Image.Registry != "" || w.Image.Tag != "" {
+			return spec, fmt.Errorf("workloads.%s.image needs a repository", name)
+		}
+
+		override := base.WorkloadOverride{
+			Name:         name,
+			Replicas:     w.Replicas,
+			NodeSelector: w.NodeSelector,
+			Tolerations:  w.Tolerations,
+			Affinity:     w.Affinity,
+		}
+		if w.Resources != nil {
+			override.Resources = []base.ResourceRequirementsOverride{{Container: name, ResourceRequirements: *w.Resources}}
+		}
+		if override.Replicas != nil || override.NodeSelector != nil || override.Tolerations != nil ||
+			override.Affinity != nil || override.Resources != nil {
+			spec.Workloads = append(spec.Workloads, override)
+		}
+	}
+	return spec, nil
+}
+
+// imageReference joins the repository with a tag or a digest.
+func imageReference(repository, tag string) string {
+	switch {
+	case tag == "":
+		return repository
+	case strings.Contains(tag, ":"):
+		return repository + "@" + tag
+	default:
+		return repository + ":" + tag
+	}
+}
+
+// setIngress enables the ingress and selects its class, unless config.network sets it.
+func setIngress(ks *v1beta1.KnativeServing, ingress string) error {
+	if ingress == "" {
+		return nil
+	}
+	class, ok := ingressClasses[ingress]
+	if !ok {
+		return fmt.Errorf("unsupported ingress %q, must be one of istio, kourier, contour or gateway-api", ingress)
+	}
+	ks.Spec.Ingress = &v1beta1.IngressConfigs{}
+	switch ingress {
+	case "istio":
+		ks.Spec.Ingress.Istio.Enabled = true
+	case "kourier":
+		ks.Spec.Ingress.Kourier.Enabled = true
+	case "contour":
+		ks.Spec.Ingress.Contour.Enabled = true
+	case "gateway-api":
+		ks.Spec.Ingress.GatewayAPI.Enabled = true
+	}
+	if ks.Spec.Config == nil {
+		ks.Spec.Config = base.ConfigMapData{}
+	}
+	if ks.Spec.Config["network"] == nil {
+		ks.Spec.Config["network"] = map[string]string{}
+	}
+	if _, ok := ks.Spec.Config["network"]["ingress-class"]; !ok {
+		ks.Spec.Config["network"]["ingress-class"] = class
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helmvalues
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestConvert(t *testing.T) {
+	values := `
+global:
+  image:
+    registry: registry.example.com/knative/
+  imagePullSecrets:
+  - name: regcred
+serving:
+  version: "1.18"
+  ingress: kourier
+  config:
+    autoscaler:
+      enable-scale-to-zero: "false"
+  workloads:
+    activator:
+      replicas: 3
+      resources:
+        limits:
+          memory: 1Gi
+    controller:
+      image:
+        repository: mirror.example.com/controller
+        tag: sha256:0123
+      nodeSelector:
+        disk: ssd
+eventing:
+  namespace: eventing
+  workloads:
+    eventing-webhook:
+      image:
+        registry: mirror.example.com
+        repository: eventing-webhook
+        tag: v1.18.0
+`
+	components, err := Convert([]byte(values))
+	if err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	util.AssertEqual(t, len(components), 2)
+
+	registry := base.Registry{
+		Rewrites:         []base.ImageRewrite{{From: "gcr.io/knative-releases/*", To: "registry.example.com/knative/*"}},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "regcred"}},
+	}
+	servingRegistry := registry
+	servingRegistry.Override = map[string]string{"controller": "mirror.example.com/controller@sha256:0123"}
+	util.AssertDeepEqual(t, components[0], base.KComponent(&v1beta1.KnativeServing{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "operator.knative.dev/v1beta1", Kind: "KnativeServing"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{
+				Version: "1.18",
+				Config: base.ConfigMapData{
+					"autoscaler": {"enable-scale-to-zero": "false"},
+					"network":    {"ingress-class": "kourier.ingress.networking.knative.dev"},
+				},
+				Registry: servingRegistry,
+				Workloads: []base.WorkloadOverride{{
+					Name:     "activator",
+					Replicas: ptr.To(int32(3)),
+					Resources: []base.ResourceRequirementsOverride{{
+						Container: "activator",
+						ResourceRequirements: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+						},
+					}},
+				}, {
+					Name:         "controller",
+					NodeSelector: map[string]string{"disk": "ssd"},
+				}},
+			},
+			Ingress: &v1beta1.IngressConfigs{Kourier: base.KourierIngressConfiguration{Enabled: true}},
+		},
+	}))
+
+	eventingRegistry := registry
+	eventingRegistry.Override = map[string]string{"eventing-webhook": "mirror.example.com/eventing-webhook:v1.18.0"}
+	util.AssertDeepEqual(t, components[1], base.KComponent(&v1beta1.KnativeEventing{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "operator.knative.dev/v1beta1", Kind: "KnativeEventing"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "eventing", Name: "knative-eventing"},
+		Spec:       v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{Registry: eventingRegistry}},
+	}))
+}
+
+func TestConvertRegistryWithTag(t *testing.T) {
+	components, err := Convert([]byte(`
+global:
+  image:
+    registry: registry.example.com
+    tag: v1.18.0
+serving:
+  ingress: istio
+  config:
+    network:
+      ingress-class: custom
+`))
+	if err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	ks := components[0].(*v1beta1.KnativeServing)
+	util.AssertEqual(t, ks.Spec.Registry.Default, "registry.example.com/${NAME}:v1.18.0")
+	util.AssertEqual(t, len(ks.Spec.Registry.Rewrites), 0)
+	util.AssertEqual(t, ks.Spec.Ingress.Istio.Enabled, true)
+	// The ingress class of the values is kept.
+	util.AssertEqual(t, ks.Spec.Config["network"]["ingress-class"], "custom")
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		values string
+	}{{
+		name:   "unknown value",
+		values: "serving:\n  replicaCount: 2\n",
+	}, {
+		name:   "nothing enabled",
+		values: "serving:\n  enabled: false\n",
+	}, {
+		name:   "unsupported ingress",
+		values: "serving:\n  ingress: nginx\n",
+	}, {
+		name:   "ingress of eventing",
+		values: "eventing:\n  ingress: kourier\n",
+	}, {
+		name:   "global repository",
+		values: "global:\n  image:\n    repository: knative\nserving: {}\n",
+	}, {
+		name:   "image without repository",
+		values: "serving:\n  workloads:\n    activator:\n      image:\n        tag: v1\n",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Convert([]byte(test.values)); err == nil {
+				t.Error("Convert() = nil, want an error")
+			}
+		})
+	}
+}
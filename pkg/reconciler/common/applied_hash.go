@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clientgocache "k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// AppliedHashAnnotation is the annotation of the installed resources with the hash of their
+// rendered content, which the operator applied last.
+const AppliedHashAnnotation = "operator.knative.dev/applied-hash"
+
+// appliedHashes are the hashes of the resources, which the operator applied for each Knative
+// component, so that the unchanged resources are skipped with the feature gate SkipUnchangedApply.
+var appliedHashes = &appliedHashTracker{components: map[string]*componentHashes{}}
+
+type appliedHashTracker struct {
+	mu         sync.Mutex
+	components map[string]*componentHashes
+}
+
+// componentHashes are the hashes of the resources by their kind, namespace and name, which were
+// applied to the target cluster of a Knative component.
+type componentHashes struct {
+	targetCluster *base.TargetCluster
+	hashes        map[string]string
+}
+
+// appliedHashKey identifies the Knative component by its kind, as an owner reference does.
+func appliedHashKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+func resourceKey(u *unstructured.Unstructured) string {
+	return u.GroupVersionKind().GroupKind().String() + "/" + u.GetNamespace() + "/" + u.GetName()
+}
+
+// stampAppliedHashes returns the manifest with the hash of the content of each resource in the
+// annotation AppliedHashAnnotation.
+func stampAppliedHashes(manifest mf.Manifest) (mf.Manifest, error) {
+	return manifest.Transform(func(u *unstructured.Unstructured) error {
+		hash, err := contentHash(u)
+		if err != nil {
+			return err
+		}
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[AppliedHashAnnotation] = hash
+		u.SetAnnotations(annotations)
+		return nil
+	})
+}
+
+// contentHash returns the digest of the resource without the annotation AppliedHashAnnotation.
+func contentHash(u *unstructured.Unstructured) (string, error) {
+	if _, ok := u.GetAnnotations()[AppliedHashAnnotation]; ok {
+		u = u.DeepCopy()
+		annotations := u.GetAnnotations()
+		delete(annotations, AppliedHashAnnotation)
+		u.SetAnnotations(annotations)
+	}
+	return resourceDigest(u)
+}
+
+// unchanged returns a predicate, which matches the resources, which were applied with the same
+// content for the Knative component before.
+func (t *appliedHashTracker) unchanged(instance base.KComponent) mf.Predicate {
+	key := appliedHashKey(instance.GroupVersionKind().Kind, instance.GetNamespace(), instance.GetName())
+	targetCluster := instance.GetSpec().GetTargetCluster()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c := t.components[key]
+	if c == nil || !equality.Semantic.DeepEqual(c.targetCluster, targetCluster) {
+		return mf.Nothing
+	}
+	hashes := make(map[string]string, len(c.hashes))
+	for k, v := range c.hashes {
+		hashes[k] = v
+	}
+	return func(u *unstructured.Unstructured) bool {
+		applied, ok := hashes[resourceKey(u)]
+		if !ok {
+			return false
+		}
+		// A resource, which can't be hashed, is applied to report the error.
+		hash, err := contentHash(u)
+		return err == nil && hash == applied
+	}
+}
+
+// record remembers the hashes of the applied resources of the stamped manifest.
+func (t *appliedHashTracker) record(instance base.KComponent, manifest mf.Manifest) {
+	key := appliedHashKey(instance.GroupVersionKind().Kind, instance.GetNamespace(), instance.GetName())
+	targetCluster := instance.GetSpec().GetTargetCluster()
+	applied := map[string]string{}
+	_ = forEachResource(manifest, func(u *unstructured.Unstructured) error {
+		applied[resourceKey(u)] = u.GetAnnotations()[AppliedHashAnnotation]
+		return nil
+	})
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c := t.components[key]
+	if c == nil || !equality.Semantic.DeepEqual(c.targetCluster, targetCluster) {
+		c = &componentHashes{targetCluster: targetCluster.DeepCopy(), hashes: map[string]string{}}
+		t.components[key] = c
+	}
+	for k, hash := range applied {
+		c.hashes[k] = hash
+	}
+}
+
+// retain forgets the hashes of the resources, which are not in the manifest anymore, so that they
+// are applied again, if they are added back after they were deleted.
+func (t *appliedHashTracker) retain(instance base.KComponent, manifest mf.Manifest) {
+	key := appliedHashKey(instance.GroupVersionKind().Kind, instance.GetNamespace(), instance.GetName())
+	keep := map[string]struct{}{}
+	_ = forEachResource(manifest, func(u *unstructured.Unstructured) error {
+		keep[resourceKey(u)] = struct{}{}
+		return nil
+	})
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if c := t.components[key]; c != nil {
+		for k := range c.hashes {
+			if _, ok := keep[k]; !ok {
+				delete(c.hashes, k)
+			}
+		}
+	}
+}
+
+func (t *appliedHashTracker) forget(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.components, key)
+}
+
+// ForgetAppliedHashes forgets the hashes of the resources applied for the Knative component, when
+// it is deleted.
+func ForgetAppliedHashes(instance base.KComponent) {
+	appliedHashes.forget(appliedHashKey(instance.GroupVersionKind().Kind, instance.GetNamespace(), instance.GetName()))
+}
+
+// forgetAppliedHashesOf forgets the hashes of the resources applied for the Knative components.
+func forgetAppliedHashesOf(objects []interface{}) {
+	for _, obj := range objects {
+		if instance, ok := obj.(base.KComponent); ok {
+			ForgetAppliedHashes(instance)
+		}
+	}
+}
+
+// EnqueueDrifted returns a handler, which enqueues the Knative component controlling the changed
+// resource like impl.EnqueueControllerOf. Unless only the status of the resource changed, it forgets
+// the hashes of the resources applied for the component first, so that the next reconciliation
+// applies all its resources again and reverts the change.
+func EnqueueDrifted(impl *controller.Impl) clientgocache.ResourceEventHandler {
+	forget := func(obj interface{}) {
+		if object, err := kmeta.DeletionHandlingAccessor(obj); err == nil {
+			if owner := metav1.GetControllerOf(object); owner != nil {
+				appliedHashes.forget(appliedHashKey(owner.Kind, object.GetNamespace(), owner.Name))
+			}
+		}
+	}
+	return clientgocache.ResourceEventHandlerFuncs{
+		AddFunc: impl.EnqueueControllerOf,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if !statusChangedOnly(oldObj, newObj) {
+				forget(newObj)
+			}
+			impl.EnqueueControllerOf(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			forget(obj)
+			impl.EnqueueControllerOf(obj)
+		},
+	}
+}
+
+// statusChangedOnly returns whether the update kept the generation, the labels and the annotations
+// of a resource, which has a generation.
+func statusChangedOnly(oldObj, newObj interface{}) bool {
+	oldMeta, err := kmeta.DeletionHandlingAccessor(oldObj)
+	if err != nil {
+		return false
+	}
+	newMeta, err := kmeta.DeletionHandlingAccessor(newObj)
+	if err != nil {
+		return false
+	}
+	return newMeta.GetGeneration() != 0 && newMeta.GetGeneration() == oldMeta.GetGeneration() &&
+		equality.Semantic.DeepEqual(oldMeta.GetLabels(), newMeta.GetLabels()) &&
+		equality.Semantic.DeepEqual(oldMeta.GetAnnotations(), newMeta.GetAnnotations())
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgocache "k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+// hashRecordingApplier records the resources applied with server-side apply with their hashes.
+type hashRecordingApplier struct {
+	fake.Client
+
+	mu      sync.Mutex
+	applied []string
+}
+
+func (c *hashRecordingApplier) ApplyServerSide(_ context.Context, obj *unstructured.Unstructured) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !strings.HasPrefix(obj.GetAnnotations()[AppliedHashAnnotation], "sha256:") {
+		return nil
+	}
+	c.applied = append(c.applied, obj.GetName())
+	return nil
+}
+
+func (c *hashRecordingApplier) take() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	applied := c.applied
+	c.applied = nil
+	sort.Strings(applied)
+	return applied
+}
+
+func TestInstallSkipUnchanged(t *testing.T) {
+	ke := &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "skip-unchanged"}}
+	defer ForgetAppliedHashes(ke)
+	client := &hashRecordingApplier{}
+	install := func(gates string, resources ...unstructured.Unstructured) []string {
+		t.Helper()
+		manifest, err := mf.ManifestFrom(mf.Slice(resources), mf.UseClient(client))
+		if err != nil {
+			t.Fatalf("Failed to generate manifest: %v", err)
+		}
+		ctx := WithOperatorConfigStore(context.Background(), featureGateStore(gates))
+		if err := Install(ctx, &manifest, ke); err != nil {
+			t.Fatalf("Install() = %v", err)
+		}
+		return client.take()
+	}
+	first := *NamespacedResource("apps/v1", "Deployment", "knative-eventing", "first")
+	second := *NamespacedResource("apps/v1", "Deployment", "knative-eventing", "second")
+	changed := *second.DeepCopy()
+	changed.SetLabels(map[string]string{"changed": "true"})
+	const gates = "ServerSideApply=true,SkipUnchangedApply=true"
+
+	util.AssertDeepEqual(t, install(gates, first, second), []string{"first", "second"})
+	util.AssertDeepEqual(t, install(gates, first, second), []string(nil))
+	util.AssertDeepEqual(t, install(gates, first, changed), []string{"second"})
+	// Without the feature gate, all the resources are applied.
+	util.AssertDeepEqual(t, install("ServerSideApply=true", first, changed), []string{"first", "second"})
+
+	// A removed resource is applied again, once it's added back.
+	util.AssertDeepEqual(t, install(gates, first), []string(nil))
+	util.AssertDeepEqual(t, install(gates, first, changed), []string{"second"})
+
+	// A target cluster has its own resources.
+	ke.Spec.TargetCluster = &base.TargetCluster{}
+	util.AssertDeepEqual(t, install(gates, first, changed), []string{"first", "second"})
+}
+
+func TestEnqueueDrifted(t *testing.T) {
+	ctx := context.Background()
+	impl := controller.NewContext(ctx, nil, controller.ControllerOptions{WorkQueueName: "test", Logger: logging.FromContext(ctx)})
+	defer impl.WorkQueue().ShutDown()
+
+	ke := &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "drifted"}}
+	defer ForgetAppliedHashes(ke)
+	manifest, err := stampAppliedHashes(mf.Manifest{})
+	if err != nil {
+		t.Fatalf("stampAppliedHashes() = %v", err)
+	}
+	recorded := func() bool {
+		appliedHashes.mu.Lock()
+		defer appliedHashes.mu.Unlock()
+		_, ok := appliedHashes.components[appliedHashKey("KnativeEventing", "knative-eventing", "drifted")]
+		return ok
+	}
+
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Namespace:       "knative-eventing",
+		Name:            "eventing-controller",
+		Generation:      1,
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ke, ke.GroupVersionKind())},
+	}}
+	scaled := deployment.DeepCopy()
+	scaled.Generation = 2
+	handler := EnqueueDrifted(impl)
+
+	appliedHashes.record(ke, manifest)
+	// The status of the deployment changed.
+	handler.OnUpdate(deployment, deployment.DeepCopy())
+	util.AssertEqual(t, recorded(), true)
+	util.AssertEqual(t, impl.WorkQueue().Len(), 1)
+
+	handler.OnUpdate(deployment, scaled)
+	util.AssertEqual(t, recorded(), false)
+
+	appliedHashes.record(ke, manifest)
+	handler.OnDelete(scaled)
+	util.AssertEqual(t, recorded(), false)
+}
+
+func TestResyncPeriodicallyForgetsAppliedHashes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	impl := controller.NewContext(ctx, nil, controller.ControllerOptions{WorkQueueName: "test", Logger: logging.FromContext(ctx)})
+	defer impl.WorkQueue().ShutDown()
+	store := NewOperatorConfigStore(ControllerConfig{ResyncPeriod: 10 * time.Millisecond})
+
+	ke := &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "resynced"}}
+	defer ForgetAppliedHashes(ke)
+	informer := clientgocache.NewSharedInformer(nil, &v1beta1.KnativeEventing{}, 0)
+	if err := informer.GetStore().Add(ke); err != nil {
+		t.Fatalf("Failed to add the KnativeEventing to the informer: %v", err)
+	}
+	manifest, err := stampAppliedHashes(mf.Manifest{})
+	if err != nil {
+		t.Fatalf("stampAppliedHashes() = %v", err)
+	}
+	appliedHashes.record(ke, manifest)
+
+	// The resources, which no informer watches, drift unobserved, so the resync applies all of them.
+	ResyncPeriodically(WithOperatorConfigStore(ctx, store), impl, informer)
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		appliedHashes.mu.Lock()
+		defer appliedHashes.mu.Unlock()
+		_, ok := appliedHashes.components[appliedHashKey("KnativeEventing", "knative-eventing", "resynced")]
+		return !ok, nil
+	}); err != nil {
+		t.Fatalf("The hashes of the applied resources were not forgotten on the resync: %v", err)
+	}
+}
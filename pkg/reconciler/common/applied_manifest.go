@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// SetAppliedManifest records the manifests, which the applied resources were rendered from, the
+// digests of both and the numbers of the applied resources by kind in the status. It runs after
+// the last stage applying the manifest. The time is only updated, when anything else changed, so
+// that it tells when the installed resources last changed, rather than when they were last
+// reconciled.
+func SetAppliedManifest(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	status := instance.GetStatus()
+	applied := &base.AppliedManifest{ResourceCounts: map[string]int{}}
+	// The resources are hashed and counted in a single pass, without copying the whole manifest.
+	if err := withDigester(func(d *digester) error {
+		if err := forEachResource(*manifest, func(u *unstructured.Unstructured) error {
+			applied.ResourceCounts[u.GetKind()]++
+			return d.add(u)
+		}); err != nil {
+			return err
+		}
+		applied.Digest = d.sum()
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, path := range status.GetManifests() {
+		source := base.ManifestSource{Path: path}
+		// The manifests were read to render the applied resources, so they come from the cache.
+		if m, err := FetchManifest(path); err != nil {
+			logging.FromContext(ctx).Warnw("Failed to read the manifest for its digest", zap.String("path", path), zap.Error(err))
+		} else if source.Digest, err = resourcesDigest(m); err != nil {
+			return err
+		}
+		applied.Sources = append(applied.Sources, source)
+	}
+	if len(applied.ResourceCounts) == 0 {
+		applied.ResourceCounts = nil
+	}
+
+	if previous := status.GetAppliedManifest(); previous != nil {
+		applied.Time = previous.Time
+		if equality.Semantic.DeepEqual(previous, applied) {
+			return nil
+		}
+	}
+	now := metav1.Now()
+	applied.Time = &now
+	status.SetAppliedManifest(applied)
+	return nil
+}
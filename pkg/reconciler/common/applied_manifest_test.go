@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestSetAppliedManifest(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "config-logging"},
+	}
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		util.MakeUnstructured(t, configMap),
+		util.MakeUnstructured(t, &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "config-network"},
+		}),
+		util.MakeUnstructured(t, &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "webhook"},
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+
+	ks := &v1beta1.KnativeServing{}
+	ks.Status.SetManifests([]string{"testdata/manifest.yaml", "testdata/missing.yaml"})
+	if err := SetAppliedManifest(context.Background(), &manifest, ks); err != nil {
+		t.Fatalf("SetAppliedManifest() = %v", err)
+	}
+	applied := ks.Status.Applied
+	if applied == nil || applied.Time == nil {
+		t.Fatalf("Applied = %v, want the time of the apply", applied)
+	}
+	util.AssertEqual(t, len(applied.Sources), 2)
+	util.AssertEqual(t, applied.Sources[0].Path, "testdata/manifest.yaml")
+	util.AssertEqual(t, strings.HasPrefix(applied.Sources[0].Digest, "sha256:"), true)
+	// The digest of a manifest, which cannot be read, is left empty.
+	util.AssertEqual(t, applied.Sources[1].Path, "testdata/missing.yaml")
+	util.AssertEqual(t, applied.Sources[1].Digest, "")
+	util.AssertEqual(t, strings.HasPrefix(applied.Digest, "sha256:"), true)
+	util.AssertDeepEqual(t, applied.ResourceCounts, map[string]int{"ConfigMap": 2, "Service": 1})
+
+	// The time is kept, when the same resources are applied again.
+	previous := applied.DeepCopy()
+	previous.Time = &metav1.Time{Time: previous.Time.Add(-time.Hour)}
+	ks.Status.Applied = previous.DeepCopy()
+	if err := SetAppliedManifest(context.Background(), &manifest, ks); err != nil {
+		t.Fatalf("SetAppliedManifest() = %v", err)
+	}
+	util.AssertDeepEqual(t, ks.Status.Applied, previous)
+
+	// The digest and the time change with the resources.
+	configMap.Data = map[string]string{"loglevel.controller": "debug"}
+	changed, err := mf.ManifestFrom(mf.Slice(append([]unstructured.Unstructured{util.MakeUnstructured(t, configMap)}, manifest.Resources()[1:]...)))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	if err := SetAppliedManifest(context.Background(), &changed, ks); err != nil {
+		t.Fatalf("SetAppliedManifest() = %v", err)
+	}
+	util.AssertEqual(t, ks.Status.Applied.Digest == previous.Digest, false)
+	util.AssertEqual(t, ks.Status.Applied.Time.After(previous.Time.Time), true)
+	util.AssertDeepEqual(t, ks.Status.Applied.Sources, previous.Sources)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// ArchitecturesTransform restricts the pods of the workloads to the nodes of the architectures of
+// their spec.workloads override, or of spec.architectures. The requirement on the kubernetes.io/arch
+// label is added to every term of the required node affinity, so that the other terms still apply.
+func ArchitecturesTransform(instance base.KComponent) mf.Transformer {
+	spec := instance.GetSpec()
+	defaults := spec.GetArchitectures()
+	overrides := map[string][]string{}
+	for _, override := range spec.GetWorkloadOverrides() {
+		if len(override.Architectures) > 0 {
+			overrides[override.Name] = override.Architectures
+		}
+	}
+	if len(defaults) == 0 && len(overrides) == 0 {
+		return nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		path, ok := podSpecPaths[u.GetKind()]
+		if !ok {
+			return nil
+		}
+		name := u.GetName()
+		if u.GetKind() == "Job" && u.GetGenerateName() != "" {
+			name = u.GetGenerateName()
+		}
+		architectures, ok := overrides[name]
+		if !ok {
+			architectures = defaults
+		}
+		if len(architectures) == 0 {
+			return nil
+		}
+
+		affinityPath := append(append([]string{}, path...), "affinity")
+		current, _, err := unstructured.NestedMap(u.Object, affinityPath...)
+		if err != nil {
+			return err
+		}
+		affinity := &corev1.Affinity{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(current, affinity); err != nil {
+			return err
+		}
+		requireArchitectures(affinity, architectures)
+		result, err := runtime.DefaultUnstructuredConverter.ToUnstructured(affinity)
+		if err != nil {
+			return err
+		}
+		return unstructured.SetNestedMap(u.Object, result, affinityPath...)
+	}
+}
+
+// requireArchitectures sets the requirement on the architecture in all the terms of the required
+// node affinity, replacing any previous one.
+func requireArchitectures(affinity *corev1.Affinity, architectures []string) {
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelArchStable,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   append([]string{}, architectures...),
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil {
+		required = &corev1.NodeSelector{}
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = required
+	}
+	if len(required.NodeSelectorTerms) == 0 {
+		required.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range required.NodeSelectorTerms {
+		term := &required.NodeSelectorTerms[i]
+		expressions := []corev1.NodeSelectorRequirement{requirement}
+		for _, e := range term.MatchExpressions {
+			if e.Key != corev1.LabelArchStable {
+				expressions = append(expressions, e)
+			}
+		}
+		term.MatchExpressions = expressions
+	}
+}
+
+// imageIndex is the part of an OCI image index or a Docker manifest list, which lists the
+// platforms of the image.
+type imageIndex struct {
+	Manifests []struct {
+		Platform struct {
+			Architecture string `json:"architecture"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+// checkArchitectures validates that the images of the manifest are available for all the
+// architectures, which the pods may be scheduled to, if the cluster has nodes of several
+// architectures and spec.registry changes the images. The upstream images are multi-arch.
+func checkArchitectures(ctx context.Context, kubeClient kubernetes.Interface, client *http.Client, manifest *mf.Manifest, instance base.KComponent) ([]string, error) {
+	registry := instance.GetSpec().GetRegistry()
+	if registry == nil || (registry.Default == "" && len(registry.Override) == 0 && len(registry.Rewrites) == 0) {
+		return nil, nil
+	}
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the nodes: %w", err)
+	}
+	required := sets.New[string]()
+	for _, node := range nodes.Items {
+		if arch := node.Labels[corev1.LabelArchStable]; arch != "" {
+			required.Insert(arch)
+		}
+	}
+	if required.Len() < 2 {
+		return nil, nil
+	}
+	if architectures := instance.GetSpec().GetArchitectures(); len(architectures) > 0 {
+		required = required.Intersection(sets.New(architectures...))
+	}
+
+	config, violations := pullSecretsConfig(ctx, kubeClient, instance)
+	if registry.VerifyImages {
+		// The secrets, which can't be read, are reported by checkImages already.
+		violations = nil
+	}
+	all := func(string) bool { return true }
+	for _, image := range manifestImages(manifest, registry.Override, all) {
+		served, err := imageArchitectures(ctx, client, image, config)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("the architectures of the image %s are unknown: %v", image, err))
+			continue
+		}
+		if missing := required.Difference(served); missing.Len() > 0 {
+			violations = append(violations, fmt.Sprintf("the image %s is not available for the architectures %s of the nodes",
+				image, strings.Join(sets.List(missing), ", ")))
+		}
+	}
+	return violations, nil
+}
+
+// imageArchitectures returns the architectures listed by the index of the image, none if the
+// image is not multi-arch.
+func imageArchitectures(ctx context.Context, client *http.Client, image string, config dockerConfig) (sets.Set[string], error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	_, manifestURL, authorization, err := headManifest(ctx, client, ref, config)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := manifestRequest(ctx, client, http.MethodGet, manifestURL, authorization)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s of %s", resp.Status, manifestURL)
+	}
+	index := imageIndex{}
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return nil, fmt.Errorf("failed to decode the manifest %s: %w", manifestURL, err)
+	}
+	architectures := sets.New[string]()
+	for _, m := range index.Manifests {
+		if m.Platform.Architecture != "" {
+			architectures.Insert(m.Platform.Architecture)
+		}
+	}
+	return architectures, nil
+}
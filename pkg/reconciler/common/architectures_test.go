@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestArchitecturesTransform(t *testing.T) {
+	zone := corev1.NodeSelectorRequirement{Key: corev1.LabelTopologyZone, Operator: corev1.NodeSelectorOpIn, Values: []string{"zone-a"}}
+	tests := []struct {
+		name          string
+		architectures []string
+		overrides     []base.WorkloadOverride
+		affinity      *corev1.Affinity
+		want          *corev1.Affinity
+	}{{
+		name: "unset",
+	}, {
+		name:          "all workloads",
+		architectures: []string{"amd64", "arm64"},
+		want: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}}},
+			}}},
+		}},
+	}, {
+		name:          "override of the workload",
+		architectures: []string{"amd64", "arm64"},
+		overrides:     []base.WorkloadOverride{{Name: "controller", Architectures: []string{"arm64"}}},
+		affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{zone, {Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}}},
+			}}},
+		}},
+		want: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}}, zone},
+			}}},
+		}},
+	}, {
+		name:      "override of another workload",
+		overrides: []base.WorkloadOverride{{Name: "webhook", Architectures: []string{"arm64"}}},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Architectures: test.architectures, Workloads: test.overrides}},
+			}
+			u := util.MakeUnstructured(t, &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "controller", Namespace: "knative-serving"},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Affinity: test.affinity}},
+				},
+			})
+			if transform := ArchitecturesTransform(instance); transform != nil {
+				if err := transform(&u); err != nil {
+					t.Fatalf("ArchitecturesTransform() = %v", err)
+				}
+			}
+			got := &appsv1.Deployment{}
+			if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			util.AssertDeepEqual(t, got.Spec.Template.Spec.Affinity, test.want)
+		})
+	}
+}
+
+func TestCheckArchitectures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/manifests/multi"):
+			w.Write([]byte(`{"schemaVersion":2,"manifests":[{"platform":{"architecture":"amd64","os":"linux"}},{"platform":{"architecture":"arm64","os":"linux"}}]}`))
+		case strings.HasSuffix(req.URL.Path, "/manifests/amd64"):
+			w.Write([]byte(`{"schemaVersion":2,"manifests":[{"platform":{"architecture":"amd64","os":"linux"}}]}`))
+		case strings.HasSuffix(req.URL.Path, "/manifests/single"):
+			w.Write([]byte(testManifest))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	node := func(name, arch string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{corev1.LabelArchStable: arch}}}
+	}
+	tests := []struct {
+		name          string
+		tag           string
+		override      bool
+		architectures []string
+		nodes         []*corev1.Node
+		want          []string
+	}{{
+		name:     "multi-arch",
+		tag:      "multi",
+		override: true,
+		nodes:    []*corev1.Node{node("a", "amd64"), node("b", "arm64")},
+	}, {
+		name:     "single architecture",
+		tag:      "single",
+		override: true,
+		nodes:    []*corev1.Node{node("a", "amd64"), node("b", "arm64")},
+		want:     []string{"the image " + host + "/knative/controller:single is not available for the architectures amd64, arm64 of the nodes"},
+	}, {
+		name:     "missing architecture",
+		tag:      "amd64",
+		override: true,
+		nodes:    []*corev1.Node{node("a", "amd64"), node("b", "arm64")},
+		want:     []string{"the image " + host + "/knative/controller:amd64 is not available for the architectures arm64 of the nodes"},
+	}, {
+		name:          "restricted to the available architecture",
+		tag:           "amd64",
+		override:      true,
+		architectures: []string{"amd64"},
+		nodes:         []*corev1.Node{node("a", "amd64"), node("b", "arm64")},
+	}, {
+		name:     "single architecture of the nodes",
+		tag:      "single",
+		override: true,
+		nodes:    []*corev1.Node{node("a", "amd64"), node("b", "amd64")},
+	}, {
+		name:  "images not overridden",
+		tag:   "single",
+		nodes: []*corev1.Node{node("a", "amd64"), node("b", "arm64")},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			for _, n := range test.nodes {
+				kubeClient.CoreV1().Nodes().Create(context.Background(), n, metav1.CreateOptions{})
+			}
+			image := host + "/knative/controller:" + test.tag
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+				Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Architectures: test.architectures}},
+			}
+			if test.override {
+				ks.Spec.Registry.Override = map[string]string{"controller": image}
+			}
+			manifest, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{digestDeployment(image)}))
+
+			got, err := checkArchitectures(context.Background(), kubeClient, server.Client(), &manifest, ks)
+			if err != nil {
+				t.Fatalf("checkArchitectures() = %v", err)
+			}
+			util.AssertDeepEqual(t, got, test.want)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// benchmarkManifestCopies is how often the resources of Knative Serving are repeated, to get a
+// manifest of the size of Knative Serving, Knative Eventing and Kafka with all their extras.
+const benchmarkManifestCopies = 40
+
+// largeManifest returns a manifest with the resources of Knative Serving repeated under different
+// names, so that none of them is a duplicate.
+func largeManifest(b *testing.B, client mf.Client) mf.Manifest {
+	b.Helper()
+	serving, err := mf.NewManifest("../../../cmd/operator/kodata/knative-serving/1.21.1/2-serving-core.yaml")
+	if err != nil {
+		b.Fatalf("NewManifest() = %v", err)
+	}
+	resources := make([]unstructured.Unstructured, 0, benchmarkManifestCopies*len(serving.Resources()))
+	for i := 0; i < benchmarkManifestCopies; i++ {
+		for _, u := range serving.Resources() {
+			u.SetName(fmt.Sprintf("%s-%d", u.GetName(), i))
+			resources = append(resources, u)
+		}
+	}
+	manifest, err := mf.ManifestFrom(mf.Slice(resources), mf.UseClient(client))
+	if err != nil {
+		b.Fatalf("ManifestFrom() = %v", err)
+	}
+	return manifest
+}
+
+func BenchmarkSetAppliedManifest(b *testing.B) {
+	manifest := largeManifest(b, fake.New())
+	ks := &v1beta1.KnativeServing{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := SetAppliedManifest(context.Background(), &manifest, ks); err != nil {
+			b.Fatalf("SetAppliedManifest() = %v", err)
+		}
+	}
+}
+
+func BenchmarkDeleteObsoleteResources(b *testing.B) {
+	installed := largeManifest(b, fake.New())
+	// A tenth of the installed resources is obsolete.
+	var keep []unstructured.Unstructured
+	i := 0
+	if err := forEachResource(installed, func(u *unstructured.Unstructured) error {
+		if i%10 != 0 {
+			keep = append(keep, *u)
+		}
+		i++
+		return nil
+	}); err != nil {
+		b.Fatalf("forEachResource() = %v", err)
+	}
+	ks := &v1beta1.KnativeServing{}
+	ks.Status.SetManifests([]string{"installed"})
+	fetch := func(context.Context, base.KComponent) (*mf.Manifest, error) { return &installed, nil }
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		manifest, _ := mf.ManifestFrom(mf.Slice(keep), mf.UseClient(fake.New()))
+		stage := DeleteObsoleteResources(context.Background(), ks, fetch)
+		if err := stage(context.Background(), &manifest, ks); err != nil {
+			b.Fatalf("DeleteObsoleteResources() = %v", err)
+		}
+	}
+}
+
+func BenchmarkInstallUnchanged(b *testing.B) {
+	manifest := largeManifest(b, fake.New())
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "benchmark"}}
+	defer ForgetAppliedHashes(ks)
+	ctx := WithOperatorConfigStore(context.Background(), featureGateStore("SkipUnchangedApply=true"))
+	if err := Install(ctx, &manifest, ks); err != nil {
+		b.Fatalf("Install() = %v", err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Install(ctx, &manifest, ks); err != nil {
+			b.Fatalf("Install() = %v", err)
+		}
+	}
+}
+
+func BenchmarkInventoryConfigMap(b *testing.B) {
+	manifest := largeManifest(b, fake.New())
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "benchmark"}}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := inventoryConfigMap(&manifest, ks); err != nil {
+			b.Fatalf("inventoryConfigMap() = %v", err)
+		}
+	}
+}
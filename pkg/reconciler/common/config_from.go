@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	clientgocache "k8s.io/client-go/tools/cache"
+	kubefilteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// ConfigFromSecrets returns a Stage, which sets the entries of spec.configFrom in the ConfigMaps of
+// the manifest, with the values read from the Secrets in the namespace of the Knative component with
+// the kubeClient. The Secrets are read on every reconciliation, outside of the render cache, so that
+// a changed Secret is rolled out without a change of the Knative component. The values are never
+// logged.
+func ConfigFromSecrets(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		configFrom := instance.GetSpec().GetConfigFrom()
+		if len(configFrom) == 0 {
+			return nil
+		}
+		config, err := resolveConfigFrom(ctx, kubeClient, instance.GetNamespace(), configFrom)
+		if err != nil {
+			instance.GetStatus().MarkInstallFailed(err.Error())
+			return err
+		}
+		transformed, err := manifest.Transform(configFromTransform(config, logging.FromContext(ctx)))
+		if err != nil {
+			return err
+		}
+		*manifest = transformed
+		return nil
+	}
+}
+
+// resolveConfigFrom reads the values of the entries of spec.configFrom from the Secrets in the
+// namespace. The entries of optional Secrets or keys, which do not exist, are skipped.
+func resolveConfigFrom(ctx context.Context, kubeClient kubernetes.Interface, namespace string, configFrom base.ConfigMapValueSources) (base.ConfigMapData, error) {
+	secrets := map[string]*corev1.Secret{}
+	config := base.ConfigMapData{}
+	for _, name := range sets.List(sets.KeySet(configFrom)) {
+		for _, key := range sets.List(sets.KeySet(configFrom[name])) {
+			ref := configFrom[name][key].SecretKeyRef
+			if ref == nil {
+				return nil, fmt.Errorf("spec.configFrom.%s.%s has no secretKeyRef", name, key)
+			}
+			optional := ref.Optional != nil && *ref.Optional
+			secret, ok := secrets[ref.Name]
+			if !ok {
+				var err error
+				secret, err = kubeClient.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					secret = nil
+				} else if err != nil {
+					return nil, fmt.Errorf("failed to get the Secret %s/%s of spec.configFrom.%s.%s: %w", namespace, ref.Name, name, key, err)
+				}
+				secrets[ref.Name] = secret
+			}
+			if secret == nil {
+				if optional {
+					continue
+				}
+				return nil, fmt.Errorf("the Secret %s/%s of spec.configFrom.%s.%s does not exist", namespace, ref.Name, name, key)
+			}
+			value, ok := secret.Data[ref.Key]
+			if !ok {
+				if optional {
+					continue
+				}
+				return nil, fmt.Errorf("the Secret %s/%s has no key %s for spec.configFrom.%s.%s", namespace, ref.Name, ref.Key, name, key)
+			}
+			if config[name] == nil {
+				config[name] = map[string]string{}
+			}
+			config[name][key] = string(value)
+		}
+	}
+	return config, nil
+}
+
+// configFromTransform sets the resolved entries of spec.configFrom in the ConfigMaps like
+// ConfigMapTransform, but only logs the keys, as the values are sensitive.
+func configFromTransform(config base.ConfigMapData, log *zap.SugaredLogger) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" {
+			return nil
+		}
+		data, ok := config[u.GetName()]
+		if !ok && strings.HasPrefix(u.GetName(), "config-") {
+			// The "config-" prefix is optional
+			data, ok = config[strings.TrimPrefix(u.GetName(), "config-")]
+		}
+		if !ok {
+			return nil
+		}
+		for k, v := range data {
+			if err := unstructured.SetNestedField(u.Object, v, "data", k); err != nil {
+				return err
+			}
+		}
+		setManagedConfigKeys(u, managedConfigKeys(u).Union(sets.KeySet(data)))
+		log.Infow("Setting the values of spec.configFrom", "map", u.GetName(), "keys", sets.List(sets.KeySet(data)))
+		return nil
+	}
+}
+
+// WatchConfigSecrets enqueues the Knative components returned by list for the namespace of a Secret
+// with the label selector, which is referenced by their spec.configFrom, whenever the Secret changes.
+// Secrets without the label are only read again on the next resync. The informer is started by
+// WatchWebhookCertificates, which watches the same Secrets.
+func WatchConfigSecrets[T base.KComponent](ctx context.Context, impl *controller.Impl, selector string, list func(namespace string) ([]T, error)) {
+	informer := kubefilteredfactory.Get(ctx, selector).Core().V1().Secrets().Informer()
+	if _, err := informer.AddEventHandler(clientgocache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			object, ok := obj.(metav1.Object)
+			return ok && !isWebhookCertificateSecretName(object.GetName())
+		},
+		Handler: controller.HandleAll(func(obj interface{}) {
+			secret, err := kmeta.DeletionHandlingAccessor(obj)
+			if err != nil {
+				return
+			}
+			components, err := list(secret.GetNamespace())
+			if err != nil {
+				return
+			}
+			for _, component := range components {
+				if referencesSecret(component.GetSpec().GetConfigFrom(), secret.GetName()) {
+					impl.Enqueue(component)
+				}
+			}
+		}),
+	}); err != nil {
+		logging.FromContext(ctx).Warnw("Failed to watch the Secrets of spec.configFrom", zap.Error(err))
+	}
+}
+
+// referencesSecret returns true if an entry of configFrom is read from the Secret.
+func referencesSecret(configFrom base.ConfigMapValueSources, name string) bool {
+	for _, entries := range configFrom {
+		for _, source := range entries {
+			if source.SecretKeyRef != nil && source.SecretKeyRef.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestConfigFromSecrets(t *testing.T) {
+	secretKey := func(name, key string, optional bool) base.ConfigValueSource {
+		ref := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}
+		if optional {
+			ref.Optional = ptr.To(true)
+		}
+		return base.ConfigValueSource{SecretKeyRef: ref}
+	}
+
+	tests := []struct {
+		name       string
+		configFrom base.ConfigMapValueSources
+		want       map[string]string
+		managed    string
+		wantErr    string
+	}{{
+		name: "no configFrom",
+		want: map[string]string{"registries-skipping-tag-resolving": "kind.local", "queue-sidecar-image": "queue"},
+	}, {
+		name: "secret keys",
+		configFrom: base.ConfigMapValueSources{"deployment": {
+			"registry-password":   secretKey("registry", "password", false),
+			"queue-sidecar-image": secretKey("registry", "image", false),
+		}},
+		want: map[string]string{
+			"registries-skipping-tag-resolving": "kind.local",
+			"queue-sidecar-image":               "registry.local/queue",
+			"registry-password":                 "s3cr3t",
+		},
+		managed: "queue-sidecar-image,registries-skipping-tag-resolving,registry-password",
+	}, {
+		name: "optional",
+		configFrom: base.ConfigMapValueSources{"config-deployment": {
+			"registry-password": secretKey("registry", "password", true),
+			"registry-token":    secretKey("registry", "token", true),
+			"smtp-password":     secretKey("smtp", "password", true),
+		}},
+		want: map[string]string{
+			"registries-skipping-tag-resolving": "kind.local",
+			"queue-sidecar-image":               "queue",
+			"registry-password":                 "s3cr3t",
+		},
+		managed: "registries-skipping-tag-resolving,registry-password",
+	}, {
+		name:       "missing secret",
+		configFrom: base.ConfigMapValueSources{"deployment": {"smtp-password": secretKey("smtp", "password", false)}},
+		wantErr:    "the Secret knative-serving/smtp of spec.configFrom.deployment.smtp-password does not exist",
+	}, {
+		name:       "missing key",
+		configFrom: base.ConfigMapValueSources{"deployment": {"registry-token": secretKey("registry", "token", false)}},
+		wantErr:    "the Secret knative-serving/registry has no key token for spec.configFrom.deployment.registry-token",
+	}, {
+		name:       "no source",
+		configFrom: base.ConfigMapValueSources{"deployment": {"registry-token": {}}},
+		wantErr:    "spec.configFrom.deployment.registry-token has no secretKeyRef",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "registry"},
+				Data:       map[string][]byte{"password": []byte("s3cr3t"), "image": []byte("registry.local/queue")},
+			})
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{
+					Config:     base.ConfigMapData{"deployment": {"registries-skipping-tag-resolving": "kind.local"}},
+					ConfigFrom: test.configFrom,
+				}},
+			}
+			manifest := util.NewManifestBuilder(t).
+				Add(util.MakeConfigMap("config-deployment", map[string]string{"queue-sidecar-image": "queue"})).
+				InNamespace("knative-serving").
+				Build()
+			manifest, err := manifest.Transform(ConfigMapTransform(ks.Spec.Config, zap.NewNop().Sugar()))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = ConfigFromSecrets(kubeClient)(context.Background(), &manifest, ks)
+			if test.wantErr != "" {
+				if err == nil || err.Error() != test.wantErr {
+					t.Fatalf("ConfigFromSecrets() = %v, want %s", err, test.wantErr)
+				}
+				if c := ks.Status.GetCondition(base.InstallSucceeded); c == nil || c.Status != corev1.ConditionFalse {
+					t.Errorf("Condition = %v, want the installation failed", c)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConfigFromSecrets() = %v", err)
+			}
+			u := manifest.Resources()[0]
+			got, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Data (-want, +got): %s", diff)
+			}
+			if test.managed == "" {
+				test.managed = "registries-skipping-tag-resolving"
+			}
+			if got := u.GetAnnotations()[ManagedConfigKeysAnnotation]; got != test.managed {
+				t.Errorf("Annotation = %q, want %q", got, test.managed)
+			}
+		})
+	}
+}
+
+func TestReferencesSecret(t *testing.T) {
+	configFrom := base.ConfigMapValueSources{"deployment": {
+		"registry-password": {SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "registry"}, Key: "password"}},
+		"other":             {},
+	}}
+	if !referencesSecret(configFrom, "registry") {
+		t.Error("referencesSecret(registry) = false, want true")
+	}
+	if referencesSecret(configFrom, "smtp") {
+		t.Error("referencesSecret(smtp) = true, want false")
+	}
+}
@@ -17,29 +17,155 @@ limitations under the License.
 package common
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	mf "github.com/manifestival/manifestival"
 	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/logging"
+
 	"knative.dev/operator/pkg/apis/operator/base"
 )
 
+// ManagedConfigKeysAnnotation lists the keys of a ConfigMap, which are set by spec.config, so that
+// the keys removed from spec.config are known to PruneConfigKeys.
+const ManagedConfigKeysAnnotation = "operator.knative.dev/managed-config-keys"
+
 // ConfigMapTransform updates the ConfigMap with the values specified in operator CR
 func ConfigMapTransform(config base.ConfigMapData, log *zap.SugaredLogger) mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
 		// Let any config in instance override everything else
 		if u.GetKind() == "ConfigMap" {
 			if data, ok := config[u.GetName()]; ok {
-				return UpdateConfigMap(u, data, log)
+				return updateManagedConfigMap(u, data, log)
 			}
 			// The "config-" prefix is optional
 			if data, ok := config[u.GetName()[len(`config-`):]]; ok {
-				return UpdateConfigMap(u, data, log)
+				return updateManagedConfigMap(u, data, log)
 			}
 		}
 		return nil
 	}
 }
 
+// updateManagedConfigMap sets the data of spec.config in the ConfigMap, and records its keys in the
+// annotation ManagedConfigKeysAnnotation.
+func updateManagedConfigMap(cm *unstructured.Unstructured, data map[string]string, log *zap.SugaredLogger) error {
+	if err := UpdateConfigMap(cm, data, log); err != nil {
+		return err
+	}
+	setManagedConfigKeys(cm, sets.KeySet(data))
+	return nil
+}
+
+func managedConfigKeys(cm *unstructured.Unstructured) sets.Set[string] {
+	keys := sets.New[string]()
+	for _, key := range strings.Split(cm.GetAnnotations()[ManagedConfigKeysAnnotation], ",") {
+		if key != "" {
+			keys.Insert(key)
+		}
+	}
+	return keys
+}
+
+func setManagedConfigKeys(cm *unstructured.Unstructured, keys sets.Set[string]) {
+	annotations := cm.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if keys.Len() == 0 {
+		delete(annotations, ManagedConfigKeysAnnotation)
+	} else {
+		annotations[ManagedConfigKeysAnnotation] = strings.Join(sets.List(keys), ",")
+	}
+	cm.SetAnnotations(annotations)
+}
+
+// PruneConfigKeys removes the keys, which were removed from spec.config, from the live ConfigMaps,
+// unless the manifest sets them itself. The keys set by spec.config before are the ones in the
+// annotation ManagedConfigKeysAnnotation of the live ConfigMap. With the annotation
+// operator.knative.dev/additive-config=true on the Knative component, the removed keys are kept
+// with their live values instead. The keys excluded by operator.knative.dev/unmanaged-keys are
+// never pruned.
+func PruneConfigKeys(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	logger := logging.FromContext(ctx)
+	additive := strings.EqualFold(instance.GetAnnotations()[base.AdditiveConfigAnnotation], "true")
+	kept := map[types.NamespacedName]map[string]string{}
+	for _, u := range manifest.Filter(mf.ByKind("ConfigMap")).Resources() {
+		live, err := manifest.Client.Get(&u)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get the ConfigMap %s/%s: %w", u.GetNamespace(), u.GetName(), err)
+		}
+		unmanaged := unmanagedKeys(live)
+		if len(unmanaged) > 0 && unmanaged[0] == allUnmanagedKeys {
+			continue
+		}
+		desired, _, err := unstructured.NestedStringMap(u.Object, "data")
+		if err != nil {
+			return err
+		}
+		removed := managedConfigKeys(live).Difference(managedConfigKeys(&u)).Delete(unmanaged...)
+		liveData, _, err := unstructured.NestedStringMap(live.Object, "data")
+		if err != nil {
+			return err
+		}
+		obsolete := map[string]string{}
+		for _, key := range sets.List(removed) {
+			if value, ok := liveData[key]; ok {
+				if _, ok := desired[key]; !ok {
+					obsolete[key] = value
+				}
+			}
+		}
+		if len(obsolete) == 0 {
+			continue
+		}
+		if additive {
+			// The removed keys stay managed, so that they are not removed by the next apply.
+			kept[types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}] = obsolete
+			continue
+		}
+		for key := range obsolete {
+			delete(liveData, key)
+		}
+		if err := unstructured.SetNestedStringMap(live.Object, liveData, "data"); err != nil {
+			return err
+		}
+		if err := manifest.Client.Update(live); err != nil {
+			return fmt.Errorf("failed to remove the keys from the ConfigMap %s/%s: %w", u.GetNamespace(), u.GetName(), err)
+		}
+		logger.Infow("Removed the keys removed from spec.config", "configmap", u.GetName(), "keys", sets.List(sets.KeySet(obsolete)))
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+
+	transformed, err := manifest.Transform(func(u *unstructured.Unstructured) error {
+		obsolete, ok := kept[types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}]
+		if !ok || u.GetKind() != "ConfigMap" {
+			return nil
+		}
+		if err := UpdateConfigMap(u, obsolete, logger); err != nil {
+			return err
+		}
+		setManagedConfigKeys(u, managedConfigKeys(u).Union(sets.KeySet(obsolete)))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*manifest = transformed
+	return nil
+}
+
 // UpdateConfigMap set some data in a configmap, only overwriting common keys if they differ
 func UpdateConfigMap(cm *unstructured.Unstructured, data map[string]string, log *zap.SugaredLogger) error {
 	for k, v := range data {
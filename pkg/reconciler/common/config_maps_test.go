@@ -17,13 +17,16 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"testing"
 
+	fake "github.com/manifestival/manifestival/fake"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/scheme"
 	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	util "knative.dev/operator/pkg/reconciler/common/testing"
 )
 
@@ -143,3 +146,90 @@ func TestInvalidConfigMap(t *testing.T) {
 		t.Fatal("Should've returned an error")
 	}
 }
+
+func TestConfigMapTransformManagedKeys(t *testing.T) {
+	cm := createConfigMap("config-logging", map[string]string{"zap-logger-config": "{}"})
+	u := util.MakeUnstructured(t, &cm)
+	config := base.ConfigMapData{
+		"logging": {"loglevel.webhook": "debug", "loglevel.controller": "debug"},
+	}
+	if err := ConfigMapTransform(config, log)(&u); err != nil {
+		t.Fatalf("ConfigMapTransform() = %v", err)
+	}
+	if got, want := u.GetAnnotations()[ManagedConfigKeysAnnotation], "loglevel.controller,loglevel.webhook"; got != want {
+		t.Errorf("Annotation %s = %q, want %q", ManagedConfigKeysAnnotation, got, want)
+	}
+}
+
+func TestPruneConfigKeys(t *testing.T) {
+	// The live ConfigMap had the keys a and b set by spec.config, b is removed from it now.
+	live := func(annotations map[string]string) *corev1.ConfigMap {
+		cm := util.MakeConfigMap("config-logging", map[string]string{"a": "1", "b": "2", "default": "edited", "manual": "3"})
+		cm.Namespace = "knative-serving"
+		cm.Annotations = map[string]string{ManagedConfigKeysAnnotation: "a,b,default"}
+		for k, v := range annotations {
+			cm.Annotations[k] = v
+		}
+		return cm
+	}
+
+	tests := []struct {
+		name        string
+		live        *corev1.ConfigMap
+		additive    bool
+		wantLive    map[string]string
+		wantDesired map[string]string
+		wantKeys    string
+	}{{
+		name:        "pruned",
+		live:        live(nil),
+		wantLive:    map[string]string{"a": "1", "default": "edited", "manual": "3"},
+		wantDesired: map[string]string{"a": "1", "default": "0"},
+		wantKeys:    "a",
+	}, {
+		name:        "additive",
+		live:        live(nil),
+		additive:    true,
+		wantLive:    map[string]string{"a": "1", "b": "2", "default": "edited", "manual": "3"},
+		wantDesired: map[string]string{"a": "1", "b": "2", "default": "0"},
+		wantKeys:    "a,b",
+	}, {
+		name:        "unmanaged",
+		live:        live(map[string]string{base.UnmanagedKeysAnnotation: "b"}),
+		wantLive:    map[string]string{"a": "1", "b": "2", "default": "edited", "manual": "3"},
+		wantDesired: map[string]string{"a": "1", "default": "0"},
+		wantKeys:    "a",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The manifest sets the key default itself, and spec.config only sets a.
+			desired := util.MakeConfigMap("config-logging", map[string]string{"default": "0"})
+			manifest := util.NewManifestBuilder(t).Add(desired).InNamespace("knative-serving").Build()
+			manifest, err := manifest.Transform(ConfigMapTransform(base.ConfigMapData{"logging": {"a": "1"}}, log))
+			if err != nil {
+				t.Fatal(err)
+			}
+			client := fake.New(test.live)
+			manifest.Client = client
+			ks := &v1beta1.KnativeServing{}
+			if test.additive {
+				ks.Annotations = map[string]string{base.AdditiveConfigAnnotation: "true"}
+			}
+
+			if err := PruneConfigKeys(context.Background(), &manifest, ks); err != nil {
+				t.Fatalf("PruneConfigKeys() = %v", err)
+			}
+			u := manifest.Resources()[0]
+			current, err := client.Get(&u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotLive, _, _ := unstructured.NestedStringMap(current.Object, "data")
+			util.AssertDeepEqual(t, gotLive, test.wantLive)
+			gotDesired, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, gotDesired, test.wantDesired)
+			util.AssertEqual(t, u.GetAnnotations()[ManagedConfigKeysAnnotation], test.wantKeys)
+		})
+	}
+}
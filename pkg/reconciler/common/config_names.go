@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// maxConfigNameDistance is the largest edit distance between an unknown entry of spec.config and
+// the name of a ConfigMap, which is suggested for it.
+const maxConfigNameDistance = 3
+
+// CheckConfigNames surfaces the entries of spec.config, which match no ConfigMap of the rendered
+// manifest, with or without the "config-" prefix, in the UnknownConfigWarning condition. Such an
+// entry has no effect, e.g. autoscaling instead of autoscaler, or the config of an ingress, which is
+// not enabled. The ConfigMap with the closest name is suggested for each of them. The entries of
+// spec.configFrom are checked alike.
+func CheckConfigNames(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	status := instance.GetStatus()
+	installed := sets.New[string]()
+	for _, u := range manifest.Filter(mf.ByKind("ConfigMap")).Resources() {
+		installed.Insert(u.GetName())
+	}
+
+	var unknown []string
+	names := sets.KeySet(instance.GetSpec().GetConfig()).Union(sets.KeySet(instance.GetSpec().GetConfigFrom()))
+	for _, name := range sets.List(names) {
+		if installed.Has(name) || installed.Has("config-"+name) {
+			continue
+		}
+		if suggestion := closestConfigName(name, installed); suggestion != "" {
+			unknown = append(unknown, fmt.Sprintf("%s (did you mean %s?)", name, suggestion))
+		} else {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		status.ClearUnknownConfigWarning()
+		return nil
+	}
+	msg := fmt.Sprintf("spec.config entries match no ConfigMap of version %s: %s", TargetVersion(instance), strings.Join(unknown, ", "))
+	status.MarkUnknownConfigWarning(msg)
+	logging.FromContext(ctx).Warn(msg)
+	return nil
+}
+
+// closestConfigName returns the name of the installed ConfigMap closest to the entry of spec.config,
+// in the same form as the entry, i.e. without the "config-" prefix if the entry has none.
+func closestConfigName(name string, installed sets.Set[string]) string {
+	best, bestDistance := "", maxConfigNameDistance+1
+	for _, cm := range sets.List(installed) {
+		candidate := cm
+		if !strings.HasPrefix(name, "config-") {
+			candidate = strings.TrimPrefix(cm, "config-")
+		}
+		if d := editDistance(name, candidate); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
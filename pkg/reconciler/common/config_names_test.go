@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestCheckConfigNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  base.ConfigMapData
+		message string
+	}{{
+		name: "no config",
+	}, {
+		name:   "known",
+		config: base.ConfigMapData{"autoscaler": {}, "config-network": {}, "kourier": {}},
+	}, {
+		name:    "typos",
+		config:  base.ConfigMapData{"autoscaling": {}, "config-netwrok": {}, "autoscaler": {}},
+		message: "spec.config entries match no ConfigMap of version 1.21.1: autoscaling (did you mean autoscaler?), config-netwrok (did you mean config-network?)",
+	}, {
+		name:    "not installed",
+		config:  base.ConfigMapData{"istio": {}},
+		message: "spec.config entries match no ConfigMap of version 1.21.1: istio",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest := util.NewManifestBuilder(t).Add(
+				util.MakeConfigMap("config-autoscaler", nil),
+				util.MakeConfigMap("config-network", nil),
+				util.MakeConfigMap("config-kourier", nil),
+			).Build()
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Version: "1.21.1", Config: test.config},
+				},
+			}
+			ks.Status.MarkUnknownConfigWarning("outdated")
+
+			if err := CheckConfigNames(context.Background(), &manifest, ks); err != nil {
+				t.Fatalf("CheckConfigNames() = %v", err)
+			}
+			c := ks.Status.GetCondition(base.UnknownConfigWarning)
+			if test.message == "" {
+				if c != nil {
+					t.Errorf("Condition = %v, want none", c)
+				}
+				return
+			}
+			if c == nil || c.Status != corev1.ConditionTrue || c.Message != test.message {
+				t.Errorf("Condition = %v, want message %q", c, test.message)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"autoscaler", "autoscaler", 0},
+		{"autoscaling", "autoscaler", 3},
+		{"netwrok", "network", 2},
+		{"", "gc", 2},
+	} {
+		if got := editDistance(test.a, test.b); got != test.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
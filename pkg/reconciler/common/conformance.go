@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// ConformanceCheck is a check of the trimmed Knative conformance subset, which runs as a Job against
+// the installed Knative component.
+type ConformanceCheck string
+
+const (
+	// ConformanceService creates a Knative Service and requests it.
+	ConformanceService ConformanceCheck = "service"
+	// ConformanceScaleToZero requests a Knative Service and waits for it to scale to zero.
+	ConformanceScaleToZero ConformanceCheck = "scale-to-zero"
+	// ConformanceBrokerTrigger sends an event to a Broker and waits for its delivery by a Trigger.
+	ConformanceBrokerTrigger ConformanceCheck = "broker-trigger"
+)
+
+const (
+	// ConformanceImageKey is the environment variable with the image of the conformance Jobs, i.e. the
+	// image of the operator, which runs the checks with its conformance command.
+	ConformanceImageKey = "CONFORMANCE_IMAGE"
+
+	// ConformanceName is the name of the service account, role and role binding of the conformance Jobs.
+	ConformanceName = "knative-conformance"
+
+	// ConformanceLabel labels the conformance Jobs with the check they run.
+	ConformanceLabel = "operator.knative.dev/conformance-check"
+)
+
+// ConformancePollInterval is the interval, in which a component is reconciled again, until its
+// conformance checks are done.
+const ConformancePollInterval = 15 * time.Second
+
+// Conformance returns a Stage, which runs the checks as Jobs against the installed Knative component,
+// if it is annotated with operator.knative.dev/conformance=true. The checks run once per version, and
+// their result is reported in the ConformancePassed condition, which does not affect the readiness.
+func Conformance(kubeClient kubernetes.Interface, checks ...ConformanceCheck) Stage {
+	return func(ctx context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		status := instance.GetStatus()
+		if !strings.EqualFold(instance.GetAnnotations()[base.ConformanceAnnotation], "true") {
+			status.ClearConformance()
+			return nil
+		}
+		image := os.Getenv(ConformanceImageKey)
+		if image == "" {
+			status.MarkConformanceFailed([]string{fmt.Sprintf("the env var %s of the operator is not set", ConformanceImageKey)})
+			return nil
+		}
+		if err := ensureConformanceRBAC(ctx, kubeClient, instance); err != nil {
+			return err
+		}
+
+		var running, failures []string
+		for _, check := range checks {
+			job, err := ensureConformanceJob(ctx, kubeClient, instance, check, image)
+			if err != nil {
+				return err
+			}
+			switch {
+			case jobCondition(job, batchv1.JobComplete):
+				logging.FromContext(ctx).Debugw("Conformance check passed", "check", check)
+			case jobCondition(job, batchv1.JobFailed):
+				failures = append(failures, fmt.Sprintf("%s (see the logs of the job %s)", check, job.Name))
+			default:
+				running = append(running, string(check))
+			}
+		}
+		switch {
+		case len(failures) > 0:
+			status.MarkConformanceFailed(failures)
+		case len(running) > 0:
+			status.MarkConformanceRunning(running)
+		default:
+			status.MarkConformancePassed()
+		}
+		return nil
+	}
+}
+
+// ConformanceRunning returns true, if the conformance checks of the Knative component are not done yet.
+func ConformanceRunning(status interface {
+	GetCondition(apis.ConditionType) *apis.Condition
+}) bool {
+	c := status.GetCondition(base.ConformancePassed)
+	return c != nil && c.Status == corev1.ConditionUnknown
+}
+
+// ConformanceJobName returns the name of the Job, which runs the check for the target version.
+func ConformanceJobName(instance base.KComponent, check ConformanceCheck) string {
+	return fmt.Sprintf("conformance-%s-%s", check, TargetVersion(instance))
+}
+
+// conformanceOwner returns the owner references of the resources of the conformance checks. Owner
+// references across clusters are not valid, like for the resources of the manifest.
+func conformanceOwner(instance base.KComponent) []metav1.OwnerReference {
+	if instance.GetSpec().GetTargetCluster() != nil {
+		return nil
+	}
+	return []metav1.OwnerReference{*metav1.NewControllerRef(instance, instance.GroupVersionKind())}
+}
+
+// ensureConformanceRBAC creates the service account of the Jobs, which may create the Knative Services,
+// Brokers and Triggers of the checks in the namespace of the Knative component.
+func ensureConformanceRBAC(ctx context.Context, kubeClient kubernetes.Interface, instance base.KComponent) error {
+	ns := instance.GetNamespace()
+	meta := metav1.ObjectMeta{Name: ConformanceName, Namespace: ns, OwnerReferences: conformanceOwner(instance)}
+
+	sa := &corev1.ServiceAccount{ObjectMeta: meta}
+	if _, err := kubeClient.CoreV1().ServiceAccounts(ns).Create(ctx, sa, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create the service account %s: %w", ConformanceName, err)
+	}
+	role := &rbacv1.Role{
+		ObjectMeta: meta,
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{"serving.knative.dev"},
+			Resources: []string{"services"},
+			Verbs:     []string{"get", "create", "delete"},
+		}, {
+			APIGroups: []string{"eventing.knative.dev"},
+			Resources: []string{"brokers", "triggers"},
+			Verbs:     []string{"get", "create", "delete"},
+		}, {
+			APIGroups: []string{""},
+			Resources: []string{"services"},
+			Verbs:     []string{"create", "delete"},
+		}, {
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			Verbs:     []string{"list"},
+		}},
+	}
+	if _, err := kubeClient.RbacV1().Roles(ns).Create(ctx, role, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create the role %s: %w", ConformanceName, err)
+	}
+	binding := &rbacv1.RoleBinding{
+		ObjectMeta: meta,
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: ConformanceName, Namespace: ns}},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: ConformanceName},
+	}
+	if _, err := kubeClient.RbacV1().RoleBindings(ns).Create(ctx, binding, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create the role binding %s: %w", ConformanceName, err)
+	}
+	return nil
+}
+
+// ensureConformanceJob returns the Job of the check, and creates it, if it does not exist. A finished
+// Job is kept, so that the check runs only once per version, until the Job is deleted.
+func ensureConformanceJob(ctx context.Context, kubeClient kubernetes.Interface, instance base.KComponent, check ConformanceCheck, image string) (*batchv1.Job, error) {
+	ns := instance.GetNamespace()
+	name := ConformanceJobName(instance, check)
+	job, err := kubeClient.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return job, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get the job %s: %w", name, err)
+	}
+
+	backoffLimit := int32(0)
+	job = &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       ns,
+			Labels:          map[string]string{ConformanceLabel: string(check)},
+			OwnerReferences: conformanceOwner(instance),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ServiceAccountName: ConformanceName,
+					RestartPolicy:      corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:  "conformance",
+						Image: image,
+						Args:  []string{"conformance", "-check", string(check), "-namespace", ns},
+						Env:   []corev1.EnvVar{{Name: "JOB_NAME", Value: name}},
+					}},
+				},
+			},
+		},
+	}
+	addIstioIgnoreLabels(job)
+	created, err := kubeClient.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the job %s: %w", name, err)
+	}
+	logging.FromContext(ctx).Infow("Started the conformance check", "check", check, "job", name)
+	return created, nil
+}
+
+func jobCondition(job *batchv1.Job, condition batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == condition && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
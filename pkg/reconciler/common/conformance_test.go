@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestConformance(t *testing.T) {
+	t.Setenv(ConformanceImageKey, "ko.local/operator")
+	newServing := func(annotation string) *v1beta1.KnativeServing {
+		ks := &v1beta1.KnativeServing{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "knative-serving",
+				Namespace:   "knative-serving",
+				Annotations: map[string]string{base.ConformanceAnnotation: annotation},
+			},
+			Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Version: "1.15.0"}},
+		}
+		ks.Status.InitializeConditions()
+		ks.Status.MarkInstallSucceeded()
+		ks.Status.MarkDeploymentsAvailable()
+		ks.Status.MarkVersionMigrationEligible()
+		return ks
+	}
+	finished := func(check ConformanceCheck, condition batchv1.JobConditionType) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "conformance-" + string(check) + "-1.15.0"},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: condition, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		annotation string
+		jobs       []*batchv1.Job
+		status     corev1.ConditionStatus
+		message    string
+	}{{
+		name:       "not annotated",
+		annotation: "",
+	}, {
+		name:       "started",
+		annotation: "true",
+		status:     corev1.ConditionUnknown,
+		message:    "Conformance checks running: service, scale-to-zero",
+	}, {
+		name:       "one running",
+		annotation: "true",
+		jobs:       []*batchv1.Job{finished(ConformanceService, batchv1.JobComplete)},
+		status:     corev1.ConditionUnknown,
+		message:    "Conformance checks running: scale-to-zero",
+	}, {
+		name:       "passed",
+		annotation: "true",
+		jobs: []*batchv1.Job{
+			finished(ConformanceService, batchv1.JobComplete),
+			finished(ConformanceScaleToZero, batchv1.JobComplete),
+		},
+		status: corev1.ConditionTrue,
+	}, {
+		name:       "failed",
+		annotation: "True",
+		jobs: []*batchv1.Job{
+			finished(ConformanceService, batchv1.JobComplete),
+			finished(ConformanceScaleToZero, batchv1.JobFailed),
+		},
+		status:  corev1.ConditionFalse,
+		message: "Conformance checks failed: scale-to-zero (see the logs of the job conformance-scale-to-zero-1.15.0)",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			for _, job := range test.jobs {
+				if _, err := kubeClient.BatchV1().Jobs(job.Namespace).Create(context.Background(), job, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			ks := newServing(test.annotation)
+			stage := Conformance(kubeClient, ConformanceService, ConformanceScaleToZero)
+			if err := stage(context.Background(), nil, ks); err != nil {
+				t.Fatalf("Conformance() = %v", err)
+			}
+
+			c := ks.Status.GetCondition(base.ConformancePassed)
+			if test.status == "" {
+				if c != nil {
+					t.Fatalf("Condition = %v, want none", c)
+				}
+				if jobs, _ := kubeClient.BatchV1().Jobs("knative-serving").List(context.Background(), metav1.ListOptions{}); len(jobs.Items) != 0 {
+					t.Errorf("Jobs = %v, want none", jobs.Items)
+				}
+				return
+			}
+			if c == nil || c.Status != test.status || c.Message != test.message {
+				t.Fatalf("Condition = %v, want status %s with message %q", c, test.status, test.message)
+			}
+			if got, want := ConformanceRunning(&ks.Status), test.status == corev1.ConditionUnknown; got != want {
+				t.Errorf("ConformanceRunning() = %v, want %v", got, want)
+			}
+			if !ks.Status.IsReady() {
+				t.Error("IsReady() = false, the conformance checks must not affect the readiness")
+			}
+
+			for _, check := range []ConformanceCheck{ConformanceService, ConformanceScaleToZero} {
+				job, err := kubeClient.BatchV1().Jobs("knative-serving").Get(context.Background(), ConformanceJobName(ks, check), metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("Failed to get the job of the check %s: %v", check, err)
+				}
+				if len(job.Status.Conditions) > 0 {
+					continue
+				}
+				spec := job.Spec.Template.Spec
+				if spec.ServiceAccountName != ConformanceName || spec.Containers[0].Image != "ko.local/operator" {
+					t.Errorf("Job %s runs %s as %s", job.Name, spec.Containers[0].Image, spec.ServiceAccountName)
+				}
+				if got := job.Spec.Template.Labels[istioLabelName]; got != "false" {
+					t.Errorf("Label %s = %q, want false", istioLabelName, got)
+				}
+				if len(job.OwnerReferences) != 1 || job.OwnerReferences[0].Name != "knative-serving" {
+					t.Errorf("OwnerReferences = %v, want the KnativeServing", job.OwnerReferences)
+				}
+			}
+			if _, err := kubeClient.RbacV1().RoleBindings("knative-serving").Get(context.Background(), ConformanceName, metav1.GetOptions{}); err != nil {
+				t.Errorf("Failed to get the role binding: %v", err)
+			}
+		})
+	}
+}
+
+func TestConformanceWithoutImage(t *testing.T) {
+	t.Setenv(ConformanceImageKey, "")
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "knative-serving",
+			Annotations: map[string]string{base.ConformanceAnnotation: "true"},
+		},
+	}
+	if err := Conformance(kubefake.NewSimpleClientset(), ConformanceService)(context.Background(), nil, ks); err != nil {
+		t.Fatalf("Conformance() = %v", err)
+	}
+	if c := ks.Status.GetCondition(base.ConformancePassed); c == nil || c.Status != corev1.ConditionFalse {
+		t.Errorf("Condition = %v, want failed", c)
+	}
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/workqueue"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/logging/logkey"
+	"knative.dev/pkg/reconciler"
+)
+
+const (
+	// ResyncPeriodEnvKey is the environment variable to specify the interval, in which all
+	// Knative components are reconciled again, even without any change. The key resync-period of
+	// the config map config-operator overrides it.
+	ResyncPeriodEnvKey = "RESYNC_PERIOD"
+	// RetryInitialDelayEnvKey is the environment variable to specify the delay before the first
+	// retry of a failed reconciliation.
+	RetryInitialDelayEnvKey = "RETRY_INITIAL_DELAY"
+	// RetryMaxDelayEnvKey is the environment variable to specify the maximum delay between the
+	// retries of a failed reconciliation.
+	RetryMaxDelayEnvKey = "RETRY_MAX_DELAY"
+	// RetryJitterEnvKey is the environment variable to specify the maximum fraction, by which
+	// each retry delay is randomly extended.
+	RetryJitterEnvKey = "RETRY_JITTER"
+	// WorkqueueQPSEnvKey is the environment variable to specify the overall rate, in which the
+	// workqueue of each controller hands out Knative components to reconcile.
+	WorkqueueQPSEnvKey = "WORKQUEUE_QPS"
+	// WorkqueueBurstEnvKey is the environment variable to specify the burst of the overall rate of
+	// the workqueue of each controller.
+	WorkqueueBurstEnvKey = "WORKQUEUE_BURST"
+	// ControllerWorkersEnvKey is the environment variable to specify the number of workers, which
+	// reconcile the Knative components of a kind in parallel, as a comma separated list of
+	// kind=workers pairs, e.g. "KnativeServing=2,KnativeEventing=8". The kinds, which are not
+	// listed, keep K_THREADS_PER_CONTROLLER, 2 by default.
+	ControllerWorkersEnvKey = "CONTROLLER_WORKERS"
+	// WorkqueuePerKeyQPSEnvKey is the environment variable to specify the rate, in which each
+	// Knative component is reconciled at most, so that a component changing all the time doesn't
+	// keep the workers from the others. Unlimited, if it is empty or 0.
+	WorkqueuePerKeyQPSEnvKey = "WORKQUEUE_PER_KEY_QPS"
+	// WorkqueuePerKeyBurstEnvKey is the environment variable to specify the burst of the rate of
+	// each Knative component, 5 by default.
+	WorkqueuePerKeyBurstEnvKey = "WORKQUEUE_PER_KEY_BURST"
+	// ApplyConcurrencyEnvKey is the environment variable to specify the number of resources of a
+	// manifest, which are applied in parallel.
+	ApplyConcurrencyEnvKey = "APPLY_CONCURRENCY"
+	// WatchNamespacesEnvKey is the environment variable to specify a comma separated list of
+	// namespaces, to which the operator is restricted. All namespaces are watched, if it is empty.
+	WatchNamespacesEnvKey = "WATCH_NAMESPACES"
+	// PlatformEnvKey is the environment variable to specify the platform, whose extension the
+	// reconcilers of Knative Serving and Knative Eventing use, none by default.
+	PlatformEnvKey = "PLATFORM"
+	// AdminAddressEnvKey is the environment variable to specify the address of the admin endpoint
+	// of the operator, defaultAdminAddress by default.
+	AdminAddressEnvKey = "ADMIN_ADDRESS"
+	// AdminProfilingEnvKey is the environment variable to serve the pprof and expvar endpoints on the
+	// admin endpoint, false by default.
+	AdminProfilingEnvKey = "ADMIN_PROFILING"
+
+	// PlatformOpenShift is the platform of the OpenShift extension.
+	PlatformOpenShift = "openshift"
+
+	// The defaults match workqueue.DefaultTypedControllerRateLimiter.
+	defaultRetryInitialDelay = 5 * time.Millisecond
+	defaultRetryMaxDelay     = 1000 * time.Second
+	defaultWorkqueueQPS      = 10
+	defaultWorkqueueBurst    = 100
+	defaultPerKeyBurst       = 5
+
+	// The admin endpoint is only reachable from within the pod by default, e.g. with kubectl port-forward.
+	defaultAdminAddress = "127.0.0.1:8081"
+)
+
+// ControllerConfig configures the controllers of the operator.
+type ControllerConfig struct {
+	// ResyncPeriod is the default of the resync period of the OperatorConfig, controller.DefaultResyncPeriod if zero.
+	ResyncPeriod time.Duration
+	// RetryInitialDelay is the delay before the first retry, which is doubled on every failure.
+	RetryInitialDelay time.Duration
+	// RetryMaxDelay caps the delay between retries.
+	RetryMaxDelay time.Duration
+	// RetryJitter is the maximum fraction, by which each retry delay is randomly extended.
+	RetryJitter float64
+	// WorkqueueQPS is the overall rate limit of the workqueue of each controller.
+	WorkqueueQPS float64
+	// WorkqueueBurst is the burst of the overall rate limit of the workqueue.
+	WorkqueueBurst int
+	// Workers are the numbers of workers of the controllers by the kind of their Knative components.
+	Workers map[string]int
+	// PerKeyQPS is the rate limit of the reconciliations of each Knative component, unlimited if zero.
+	PerKeyQPS float64
+	// PerKeyBurst is the burst of the rate limit of each Knative component.
+	PerKeyBurst int
+	// ApplyConcurrency is the number of resources applied in parallel, 1 applies them one by one.
+	ApplyConcurrency int
+	// WatchNamespaces are the namespaces, in which the Knative components are reconciled, all
+	// namespaces if empty.
+	WatchNamespaces []string
+	// Platform is the platform, whose extension is used, none if empty.
+	Platform string
+	// AdminAddress is the address of the admin endpoint.
+	AdminAddress string
+	// AdminProfiling serves the pprof and expvar endpoints on the admin endpoint.
+	AdminProfiling bool
+}
+
+type controllerConfigKey struct{}
+
+func defaultControllerConfig() ControllerConfig {
+	return ControllerConfig{
+		RetryInitialDelay: defaultRetryInitialDelay,
+		RetryMaxDelay:     defaultRetryMaxDelay,
+		WorkqueueQPS:      defaultWorkqueueQPS,
+		WorkqueueBurst:    defaultWorkqueueBurst,
+		PerKeyBurst:       defaultPerKeyBurst,
+		ApplyConcurrency:  1,
+		AdminAddress:      defaultAdminAddress,
+	}
+}
+
+// ControllerConfigFromEnv reads the ControllerConfig from the environment variables.
+func ControllerConfigFromEnv() (ControllerConfig, error) {
+	cfg := defaultControllerConfig()
+	var err error
+	if cfg.ResyncPeriod, err = durationFromEnv(ResyncPeriodEnvKey, cfg.ResyncPeriod); err != nil {
+		return cfg, err
+	}
+	if cfg.RetryInitialDelay, err = durationFromEnv(RetryInitialDelayEnvKey, cfg.RetryInitialDelay); err != nil {
+		return cfg, err
+	}
+	if cfg.RetryMaxDelay, err = durationFromEnv(RetryMaxDelayEnvKey, cfg.RetryMaxDelay); err != nil {
+		return cfg, err
+	}
+	if v := os.Getenv(RetryJitterEnvKey); v != "" {
+		if cfg.RetryJitter, err = strconv.ParseFloat(v, 64); err != nil {
+			return cfg, fmt.Errorf("failed to parse %s: %w", RetryJitterEnvKey, err)
+		}
+	}
+	if v := os.Getenv(WorkqueueQPSEnvKey); v != "" {
+		if cfg.WorkqueueQPS, err = strconv.ParseFloat(v, 64); err != nil {
+			return cfg, fmt.Errorf("failed to parse %s: %w", WorkqueueQPSEnvKey, err)
+		}
+	}
+	if v := os.Getenv(WorkqueueBurstEnvKey); v != "" {
+		if cfg.WorkqueueBurst, err = strconv.Atoi(v); err != nil {
+			return cfg, fmt.Errorf("failed to parse %s: %w", WorkqueueBurstEnvKey, err)
+		}
+	}
+	if cfg.Workers, err = parseWorkers(os.Getenv(ControllerWorkersEnvKey)); err != nil {
+		return cfg, err
+	}
+	if v := os.Getenv(WorkqueuePerKeyQPSEnvKey); v != "" {
+		if cfg.PerKeyQPS, err = strconv.ParseFloat(v, 64); err != nil {
+			return cfg, fmt.Errorf("failed to parse %s: %w", WorkqueuePerKeyQPSEnvKey, err)
+		}
+	}
+	if v := os.Getenv(WorkqueuePerKeyBurstEnvKey); v != "" {
+		if cfg.PerKeyBurst, err = strconv.Atoi(v); err != nil {
+			return cfg, fmt.Errorf("failed to parse %s: %w", WorkqueuePerKeyBurstEnvKey, err)
+		}
+	}
+	if v := os.Getenv(ApplyConcurrencyEnvKey); v != "" {
+		if cfg.ApplyConcurrency, err = strconv.Atoi(v); err != nil {
+			return cfg, fmt.Errorf("failed to parse %s: %w", ApplyConcurrencyEnvKey, err)
+		}
+	}
+	for _, ns := range strings.Split(os.Getenv(WatchNamespacesEnvKey), ",") {
+		if ns = strings.TrimSpace(ns); ns != "" && !slices.Contains(cfg.WatchNamespaces, ns) {
+			cfg.WatchNamespaces = append(cfg.WatchNamespaces, ns)
+		}
+	}
+	cfg.Platform = strings.TrimSpace(os.Getenv(PlatformEnvKey))
+	if v := strings.TrimSpace(os.Getenv(AdminAddressEnvKey)); v != "" {
+		cfg.AdminAddress = v
+	}
+	if v := strings.TrimSpace(os.Getenv(AdminProfilingEnvKey)); v != "" {
+		if cfg.AdminProfiling, err = strconv.ParseBool(v); err != nil {
+			return cfg, fmt.Errorf("failed to parse %s: %w", AdminProfilingEnvKey, err)
+		}
+	}
+	return cfg, cfg.validate()
+}
+
+// controllerKinds are the kinds of the Knative components, whose controllers are configured by kind.
+var controllerKinds = []string{"KnativeServing", "KnativeEventing", "KnativeFunctions", "KnativeNetworking"}
+
+// parseWorkers parses a comma separated list of kind=workers pairs.
+func parseWorkers(value string) (map[string]int, error) {
+	var workers map[string]int
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		kind, v, ok := strings.Cut(pair, "=")
+		kind = strings.TrimSpace(kind)
+		if !ok || !slices.Contains(controllerKinds, kind) {
+			return nil, fmt.Errorf("%s must be a list of kind=workers with the kinds %v, got %q", ControllerWorkersEnvKey, controllerKinds, pair)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the workers of %s in %s: %w", kind, ControllerWorkersEnvKey, err)
+		}
+		if n <= 0 {
+			return nil, fmt.Errorf("the workers of %s in %s must be positive, got %d", kind, ControllerWorkersEnvKey, n)
+		}
+		if workers == nil {
+			workers = map[string]int{}
+		}
+		workers[kind] = n
+	}
+	return workers, nil
+}
+
+func durationFromEnv(key string, value time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return value, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return value, fmt.Errorf("failed to parse %s: %w", key, err)
+	}
+	return d, nil
+}
+
+func (c ControllerConfig) validate() error {
+	if c.ResyncPeriod < 0 {
+		return fmt.Errorf("%s must not be negative, got %v", ResyncPeriodEnvKey, c.ResyncPeriod)
+	}
+	if c.RetryInitialDelay <= 0 {
+		return fmt.Errorf("%s must be positive, got %v", RetryInitialDelayEnvKey, c.RetryInitialDelay)
+	}
+	if c.RetryMaxDelay < c.RetryInitialDelay {
+		return fmt.Errorf("%s must not be lower than %s, got %v", RetryMaxDelayEnvKey, RetryInitialDelayEnvKey, c.RetryMaxDelay)
+	}
+	if c.RetryJitter < 0 || c.RetryJitter > 1 {
+		return fmt.Errorf("%s must be between 0 and 1, got %v", RetryJitterEnvKey, c.RetryJitter)
+	}
+	if c.WorkqueueQPS <= 0 {
+		return fmt.Errorf("%s must be positive, got %v", WorkqueueQPSEnvKey, c.WorkqueueQPS)
+	}
+	if c.WorkqueueBurst <= 0 {
+		return fmt.Errorf("%s must be positive, got %v", WorkqueueBurstEnvKey, c.WorkqueueBurst)
+	}
+	if c.PerKeyQPS < 0 {
+		return fmt.Errorf("%s must not be negative, got %v", WorkqueuePerKeyQPSEnvKey, c.PerKeyQPS)
+	}
+	if c.PerKeyBurst <= 0 {
+		return fmt.Errorf("%s must be positive, got %v", WorkqueuePerKeyBurstEnvKey, c.PerKeyBurst)
+	}
+	if c.ApplyConcurrency <= 0 {
+		return fmt.Errorf("%s must be positive, got %v", ApplyConcurrencyEnvKey, c.ApplyConcurrency)
+	}
+	if c.Platform != "" && c.Platform != PlatformOpenShift {
+		return fmt.Errorf("%s must be empty or %q, got %q", PlatformEnvKey, PlatformOpenShift, c.Platform)
+	}
+	if _, _, err := net.SplitHostPort(c.AdminAddress); err != nil {
+		return fmt.Errorf("%s must be a host and a port, got %q: %w", AdminAddressEnvKey, c.AdminAddress, err)
+	}
+	for _, ns := range c.WatchNamespaces {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("%s contains the invalid namespace %q: %s", WatchNamespacesEnvKey, ns, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// ListNamespaces returns the namespaces, in which the Knative components have to be listed, i.e.
+// the WatchNamespaces, or metav1.NamespaceAll.
+func (c ControllerConfig) ListNamespaces() []string {
+	if len(c.WatchNamespaces) == 0 {
+		return []string{metav1.NamespaceAll}
+	}
+	return c.WatchNamespaces
+}
+
+// WithControllerConfig attaches the ControllerConfig to the context. It also disables the resync
+// of the informers, which ResyncPeriodically replaces, so it has to be called before the informers
+// are created.
+func WithControllerConfig(ctx context.Context, cfg ControllerConfig) context.Context {
+	ctx = controller.WithResyncPeriod(ctx, 0)
+	return context.WithValue(ctx, controllerConfigKey{}, cfg)
+}
+
+// GetControllerConfig returns the ControllerConfig attached to the context, or the defaults.
+func GetControllerConfig(ctx context.Context) ControllerConfig {
+	if cfg, ok := ctx.Value(controllerConfigKey{}).(ControllerConfig); ok {
+		return cfg
+	}
+	return defaultControllerConfig()
+}
+
+// RateLimiter returns the rate limiter of the workqueue, which delays the retries of failed
+// reconciliations exponentially with jitter and limits the overall rate of reconciliations.
+func (c ControllerConfig) RateLimiter() workqueue.TypedRateLimiter[any] {
+	return workqueue.NewTypedMaxOfRateLimiter[any](
+		&jitterRateLimiter{
+			TypedRateLimiter: workqueue.NewTypedItemExponentialFailureRateLimiter[any](c.RetryInitialDelay, c.RetryMaxDelay),
+			jitter:           c.RetryJitter,
+		},
+		&workqueue.TypedBucketRateLimiter[any]{Limiter: rate.NewLimiter(rate.Limit(c.WorkqueueQPS), c.WorkqueueBurst)},
+	)
+}
+
+// jitterRateLimiter randomly extends the delays of the wrapped rate limiter, so that the retries of
+// many failed items do not hit the API server at the same time.
+type jitterRateLimiter struct {
+	workqueue.TypedRateLimiter[any]
+	jitter float64
+}
+
+func (r *jitterRateLimiter) When(item any) time.Duration {
+	d := r.TypedRateLimiter.When(item)
+	if r.jitter <= 0 {
+		return d
+	}
+	return d + time.Duration(rand.Float64()*r.jitter*float64(d)) //nolint:gosec // No cryptographic randomness required
+}
+
+// ConfigureController replaces the workqueue of the generated controller with one rate limited per
+// the ControllerConfig in the context, with the workers and the per-key rate limit configured for
+// the kind of its Knative components.
+func ConfigureController(ctx context.Context, kind string, impl *controller.Impl) *controller.Impl {
+	cfg := GetControllerConfig(ctx)
+	// The workqueue of the generated controller is not used anymore.
+	impl.WorkQueue().ShutDown()
+	name := impl.Name
+	if injection.HasNamespaceScope(ctx) {
+		// The controllers of the watched namespaces need distinct names, which also name their leases.
+		name += "." + injection.GetNamespaceScope(ctx)
+	}
+	r := impl.Reconciler
+	if la, ok := r.(reconciler.LeaderAware); ok && cfg.PerKeyQPS > 0 {
+		r = newPerKeyRateLimitedReconciler(r, la, cfg.PerKeyQPS, cfg.PerKeyBurst)
+	}
+	return controller.NewContext(ctx, r, controller.ControllerOptions{
+		WorkQueueName: name,
+		Logger:        logging.FromContext(ctx).With(zap.String(logkey.ControllerType, name)),
+		RateLimiter:   cfg.RateLimiter(),
+		// Zero keeps controller.DefaultThreadsPerController.
+		Concurrency: cfg.Workers[kind],
+	})
+}
+
+// perKeyRateLimitedReconciler requeues the keys, which are reconciled more often than the rate
+// limit, instead of reconciling them, so that they don't keep the workers from the other keys.
+// The workqueue only rate limits the retries of failed keys, not the changes of the resources.
+type perKeyRateLimitedReconciler struct {
+	controller.Reconciler
+	reconciler.LeaderAware
+
+	qps   rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newPerKeyRateLimitedReconciler(r controller.Reconciler, la reconciler.LeaderAware, qps float64, burst int) *perKeyRateLimitedReconciler {
+	return &perKeyRateLimitedReconciler{
+		Reconciler:  r,
+		LeaderAware: la,
+		qps:         rate.Limit(qps),
+		burst:       burst,
+		limiters:    map[string]*rate.Limiter{},
+	}
+}
+
+// Reconcile reconciles the key, unless it exceeds its rate limit.
+func (r *perKeyRateLimitedReconciler) Reconcile(ctx context.Context, key string) error {
+	if delay := r.reserve(key, time.Now()); delay > 0 {
+		return controller.NewRequeueAfter(delay)
+	}
+	return r.Reconciler.Reconcile(ctx, key)
+}
+
+// reserve takes a token of the key and returns zero, or the delay until it has one.
+func (r *perKeyRateLimitedReconciler) reserve(key string, now time.Time) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, l := range r.limiters {
+		// A full limiter is the same as a new one.
+		if k != key && l.TokensAt(now) >= float64(r.burst) {
+			delete(r.limiters, k)
+		}
+	}
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(r.qps, r.burst)
+		r.limiters[key] = limiter
+	}
+	reservation := limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay
+	}
+	return 0
+}
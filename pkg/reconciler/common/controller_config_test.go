@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestControllerConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    ControllerConfig
+		wantErr bool
+	}{{
+		name: "defaults",
+		want: ControllerConfig{RetryInitialDelay: 5 * time.Millisecond, RetryMaxDelay: 1000 * time.Second, WorkqueueQPS: 10, WorkqueueBurst: 100, PerKeyBurst: 5, ApplyConcurrency: 1, AdminAddress: "127.0.0.1:8081"},
+	}, {
+		name: "all set",
+		env: map[string]string{
+			ResyncPeriodEnvKey:         "1h",
+			RetryInitialDelayEnvKey:    "1s",
+			RetryMaxDelayEnvKey:        "5m",
+			RetryJitterEnvKey:          "0.2",
+			WorkqueueQPSEnvKey:         "50",
+			WorkqueueBurstEnvKey:       "500",
+			ControllerWorkersEnvKey:    "KnativeServing=2, KnativeEventing=8",
+			WorkqueuePerKeyQPSEnvKey:   "0.5",
+			WorkqueuePerKeyBurstEnvKey: "3",
+			ApplyConcurrencyEnvKey:     "8",
+			AdminAddressEnvKey:         "0.0.0.0:9090",
+			AdminProfilingEnvKey:       "true",
+		},
+		want: ControllerConfig{
+			ResyncPeriod:      time.Hour,
+			RetryInitialDelay: time.Second,
+			RetryMaxDelay:     5 * time.Minute,
+			RetryJitter:       0.2,
+			WorkqueueQPS:      50,
+			WorkqueueBurst:    500,
+			Workers:           map[string]int{"KnativeServing": 2, "KnativeEventing": 8},
+			PerKeyQPS:         0.5,
+			PerKeyBurst:       3,
+			ApplyConcurrency:  8,
+			AdminAddress:      "0.0.0.0:9090",
+			AdminProfiling:    true,
+		},
+	}, {
+		name: "watch namespaces",
+		env:  map[string]string{WatchNamespacesEnvKey: "team-a, team-b,,team-a"},
+		want: ControllerConfig{
+			RetryInitialDelay: 5 * time.Millisecond,
+			RetryMaxDelay:     1000 * time.Second,
+			WorkqueueQPS:      10,
+			WorkqueueBurst:    100,
+			PerKeyBurst:       5,
+			ApplyConcurrency:  1,
+			WatchNamespaces:   []string{"team-a", "team-b"},
+			AdminAddress:      "127.0.0.1:8081",
+		},
+	}, {
+		name: "openshift",
+		env:  map[string]string{PlatformEnvKey: "openshift"},
+		want: ControllerConfig{
+			RetryInitialDelay: 5 * time.Millisecond,
+			RetryMaxDelay:     1000 * time.Second,
+			WorkqueueQPS:      10,
+			WorkqueueBurst:    100,
+			PerKeyBurst:       5,
+			ApplyConcurrency:  1,
+			Platform:          PlatformOpenShift,
+			AdminAddress:      "127.0.0.1:8081",
+		},
+	}, {
+		name:    "unknown platform",
+		env:     map[string]string{PlatformEnvKey: "gardener"},
+		wantErr: true,
+	}, {
+		name:    "invalid namespace",
+		env:     map[string]string{WatchNamespacesEnvKey: "Team_A"},
+		wantErr: true,
+	}, {
+		name:    "invalid duration",
+		env:     map[string]string{ResyncPeriodEnvKey: "often"},
+		wantErr: true,
+	}, {
+		name:    "max below initial delay",
+		env:     map[string]string{RetryInitialDelayEnvKey: "1m", RetryMaxDelayEnvKey: "1s"},
+		wantErr: true,
+	}, {
+		name:    "jitter out of range",
+		env:     map[string]string{RetryJitterEnvKey: "2"},
+		wantErr: true,
+	}, {
+		name:    "invalid burst",
+		env:     map[string]string{WorkqueueBurstEnvKey: "0"},
+		wantErr: true,
+	}, {
+		name:    "unknown kind of workers",
+		env:     map[string]string{ControllerWorkersEnvKey: "KnativeKafka=2"},
+		wantErr: true,
+	}, {
+		name:    "invalid workers",
+		env:     map[string]string{ControllerWorkersEnvKey: "KnativeServing=0"},
+		wantErr: true,
+	}, {
+		name:    "negative per-key rate",
+		env:     map[string]string{WorkqueuePerKeyQPSEnvKey: "-1"},
+		wantErr: true,
+	}, {
+		name:    "admin address without port",
+		env:     map[string]string{AdminAddressEnvKey: "localhost"},
+		wantErr: true,
+	}, {
+		name:    "invalid admin profiling",
+		env:     map[string]string{AdminProfilingEnvKey: "sometimes"},
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, key := range []string{ResyncPeriodEnvKey, RetryInitialDelayEnvKey, RetryMaxDelayEnvKey, RetryJitterEnvKey, WorkqueueQPSEnvKey, WorkqueueBurstEnvKey, ControllerWorkersEnvKey, WorkqueuePerKeyQPSEnvKey, WorkqueuePerKeyBurstEnvKey, ApplyConcurrencyEnvKey, WatchNamespacesEnvKey, PlatformEnvKey, AdminAddressEnvKey, AdminProfilingEnvKey} {
+				t.Setenv(key, test.env[key])
+			}
+			got, err := ControllerConfigFromEnv()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ControllerConfigFromEnv() = %v, wantErr %v", err, test.wantErr)
+			}
+			if !test.wantErr {
+				util.AssertDeepEqual(t, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithControllerConfig(t *testing.T) {
+	cfg := ControllerConfig{ResyncPeriod: time.Hour, RetryInitialDelay: time.Second, RetryMaxDelay: time.Minute, WorkqueueQPS: 1, WorkqueueBurst: 1}
+	ctx := WithControllerConfig(context.Background(), cfg)
+
+	// The informers don't resync, ResyncPeriodically reconciles the components in the period instead.
+	util.AssertEqual(t, controller.GetResyncPeriod(ctx), time.Duration(0))
+	util.AssertDeepEqual(t, GetControllerConfig(ctx), cfg)
+	util.AssertEqual(t, GetOperatorConfig(ctx).ResyncPeriod, time.Hour)
+	util.AssertEqual(t, GetOperatorConfig(WithControllerConfig(context.Background(), ControllerConfig{})).ResyncPeriod, controller.DefaultResyncPeriod)
+}
+
+func TestListNamespaces(t *testing.T) {
+	util.AssertDeepEqual(t, ControllerConfig{}.ListNamespaces(), []string{metav1.NamespaceAll})
+	util.AssertDeepEqual(t, ControllerConfig{WatchNamespaces: []string{"team-a", "team-b"}}.ListNamespaces(), []string{"team-a", "team-b"})
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := ControllerConfig{RetryInitialDelay: time.Second, RetryMaxDelay: 4 * time.Second, RetryJitter: 0.5, WorkqueueQPS: 100, WorkqueueBurst: 100}.RateLimiter()
+
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		got := limiter.When("key")
+		if got < want || got > want+want/2 {
+			t.Errorf("When() = %v, want between %v and %v", got, want, want+want/2)
+		}
+	}
+	limiter.Forget("key")
+	if got := limiter.When("key"); got > 1500*time.Millisecond {
+		t.Errorf("When() after Forget() = %v, want at most 1.5s", got)
+	}
+}
+
+type countingReconciler struct {
+	reconciler.LeaderAwareFuncs
+	keys []string
+}
+
+func (r *countingReconciler) Reconcile(_ context.Context, key string) error {
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func TestConfigureController(t *testing.T) {
+	ctx := WithControllerConfig(context.Background(), ControllerConfig{
+		RetryInitialDelay: time.Second, RetryMaxDelay: time.Minute, WorkqueueQPS: 1, WorkqueueBurst: 1,
+		Workers:   map[string]int{"KnativeEventing": 8},
+		PerKeyQPS: 1, PerKeyBurst: 1,
+	})
+	r := &countingReconciler{}
+	generated := controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: "test"})
+
+	impl := ConfigureController(ctx, "KnativeEventing", generated)
+	util.AssertEqual(t, impl.Concurrency, 8)
+	if _, ok := impl.Reconciler.(reconciler.LeaderAware); !ok {
+		t.Error("The rate limited reconciler is not leader-aware")
+	}
+	impl = ConfigureController(ctx, "KnativeServing", generated)
+	util.AssertEqual(t, impl.Concurrency, controller.DefaultThreadsPerController)
+
+	// The second reconciliation of the key within a second is requeued, the other key is reconciled.
+	for _, key := range []string{"ns/a", "ns/a", "ns/b"} {
+		err := impl.Reconciler.Reconcile(ctx, key)
+		if ok, delay := controller.IsRequeueKey(err); ok && (key != "ns/a" || delay <= 0 || delay > time.Second) {
+			t.Errorf("Reconcile(%s) = %v", key, err)
+		}
+	}
+	util.AssertDeepEqual(t, r.keys, []string{"ns/a", "ns/b"})
+}
+
+func TestPerKeyRateLimitedReconcilerForgetsIdleKeys(t *testing.T) {
+	r := newPerKeyRateLimitedReconciler(&countingReconciler{}, &reconciler.LeaderAwareFuncs{}, 1, 2)
+	now := time.Now()
+	util.AssertEqual(t, r.reserve("ns/a", now), time.Duration(0))
+	util.AssertEqual(t, r.reserve("ns/b", now.Add(time.Second)), time.Duration(0))
+	// The limiter of ns/a is full again after a second, it is the same as a new one.
+	util.AssertEqual(t, len(r.limiters), 1)
+}
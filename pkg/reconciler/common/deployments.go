@@ -100,7 +100,7 @@ func CheckDeployments(ctx context.Context, manifest *mf.Manifest, instance base.
 		if err := scheme.Scheme.Convert(resource, deployment, nil); err != nil {
 			return err
 		}
-		if !isDeploymentAvailable(deployment) {
+		if !isDeploymentRolledOut(deployment) {
 			nonReadyDeployments = append(nonReadyDeployments, deployment.Name)
 		}
 	}
@@ -114,6 +114,21 @@ func CheckDeployments(ctx context.Context, manifest *mf.Manifest, instance base.
 	return nil
 }
 
+// isDeploymentRolledOut returns true if the deployment is available and its rollout completed, so
+// that the Ready condition only turns true, after all the pods run the applied spec. Scaling
+// the deployment up does not wait for the added pods.
+func isDeploymentRolledOut(d *appsv1.Deployment) bool {
+	if d.Status.ObservedGeneration < d.Generation {
+		// The deployment controller has not seen the applied spec yet.
+		return false
+	}
+	if d.Status.Replicas > d.Status.UpdatedReplicas {
+		// Pods of the previous spec are still running.
+		return false
+	}
+	return isDeploymentAvailable(d)
+}
+
 func isDeploymentAvailable(d *appsv1.Deployment) bool {
 	for _, c := range d.Status.Conditions {
 		if c.Type == appsv1.DeploymentAvailable && c.Status == corev1.ConditionTrue {
@@ -58,6 +58,18 @@ func TestCheckDeployments(t *testing.T) {
 		},
 	}
 
+	// The rollout of the applied spec is not observed yet.
+	notObservedDeployment := readyDeployment.DeepCopy()
+	notObservedDeployment.Name = "notObserved"
+	notObservedDeployment.Generation = 2
+	notObservedDeployment.Status.ObservedGeneration = 1
+
+	// Pods of the previous spec are still running.
+	rollingDeployment := readyDeployment.DeepCopy()
+	rollingDeployment.Name = "rolling"
+	rollingDeployment.Status.Replicas = 3
+	rollingDeployment.Status.UpdatedReplicas = 2
+
 	tests := []struct {
 		name       string
 		inManifest []unstructured.Unstructured
@@ -89,6 +101,22 @@ func TestCheckDeployments(t *testing.T) {
 		inAPI:      []runtime.Object{readyDeployment, notReadyDeployment},
 		wantError:  true,
 		wantStatus: corev1.ConditionFalse,
+	}, {
+		name: "deployment with unobserved spec",
+		inManifest: []unstructured.Unstructured{
+			*NamespacedResource("apps/v1", "Deployment", "test", "notObserved"),
+		},
+		inAPI:      []runtime.Object{notObservedDeployment},
+		wantError:  true,
+		wantStatus: corev1.ConditionFalse,
+	}, {
+		name: "deployment rolling out",
+		inManifest: []unstructured.Unstructured{
+			*NamespacedResource("apps/v1", "Deployment", "test", "rolling"),
+		},
+		inAPI:      []runtime.Object{rollingDeployment},
+		wantError:  true,
+		wantStatus: corev1.ConditionFalse,
 	}, {
 		name: "not found deployment",
 		inManifest: []unstructured.Unstructured{
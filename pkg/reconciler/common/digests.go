@@ -0,0 +1,436 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// manifestMediaTypes are the media types of the manifests, whose digests the images are pinned to.
+// An index is preferred, so that the digest fits to all the platforms of the image.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// ResolveDigests returns a Stage, which resolves the images of the manifest referenced by a tag to
+// their digests, if spec.registry.digestResolution enables it, and pins the images to them. The
+// digests are recorded in status.resolvedImages, and an image is only resolved again, once its
+// reference changes, so that the workloads are immune to a tag moved in the registry.
+func ResolveDigests(kubeClient kubernetes.Interface) Stage {
+	return resolveDigests(kubeClient, http.DefaultClient)
+}
+
+func resolveDigests(kubeClient kubernetes.Interface, client *http.Client) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		registry := instance.GetSpec().GetRegistry()
+		status := instance.GetStatus()
+		if registry == nil || registry.DigestResolution == nil || !registry.DigestResolution.Enabled {
+			status.SetResolvedImages(nil)
+			return nil
+		}
+
+		pinned := status.GetResolvedImages()
+		resolved := map[string]string{}
+		var config *dockerConfig
+		for _, image := range taggedImages(manifest, registry.Override) {
+			if digest, ok := pinned[image]; ok {
+				resolved[image] = digest
+				continue
+			}
+			if config == nil {
+				c, err := registryCredentials(ctx, kubeClient, instance.GetNamespace(), registry.DigestResolution.CredentialsSecret)
+				if err != nil {
+					status.MarkInstallFailed(err.Error())
+					return err
+				}
+				config = &c
+			}
+			digest, err := resolveDigest(ctx, client, image, *config)
+			if err != nil {
+				err = fmt.Errorf("failed to resolve the digest of the image %s: %w", image, err)
+				status.MarkInstallFailed(err.Error())
+				return err
+			}
+			logging.FromContext(ctx).Infow("Pinning the image to its digest", "image", image, "digest", digest)
+			resolved[image] = digest
+		}
+		status.SetResolvedImages(resolved)
+		if len(resolved) == 0 {
+			status.SetResolvedImages(nil)
+			return nil
+		}
+
+		m, err := manifest.Transform(pinImages(resolved, registry.Override))
+		if err != nil {
+			status.MarkInstallFailed(err.Error())
+			return err
+		}
+		*manifest = m
+		return nil
+	}
+}
+
+// visitImages calls visit with every image of the resource, i.e. the images of its containers, the
+// environment variables named in the overrides, which hold images, and the one of a caching Image.
+// The image is replaced by the one returned.
+func visitImages(u *unstructured.Unstructured, overrides map[string]string, visit func(string) string) error {
+	if u.GetKind() == "Image" && u.GetAPIVersion() == "caching.internal.knative.dev/v1alpha1" {
+		image, _, _ := unstructured.NestedString(u.Object, "spec", "image")
+		if image == "" {
+			return nil
+		}
+		return unstructured.SetNestedField(u.Object, visit(image), "spec", "image")
+	}
+	path, ok := podSpecPaths[u.GetKind()]
+	if !ok {
+		return nil
+	}
+	for _, field := range []string{"initContainers", "containers"} {
+		fields := append(append([]string{}, path...), field)
+		containers, found, err := unstructured.NestedSlice(u.Object, fields...)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if image, ok := container["image"].(string); ok && image != "" {
+				container["image"] = visit(image)
+			}
+			env, _ := container["env"].([]interface{})
+			for _, e := range env {
+				variable, ok := e.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _ := variable["name"].(string)
+				if value, ok := variable["value"].(string); ok && value != "" && overrides[name] != "" {
+					variable["value"] = visit(value)
+				}
+			}
+		}
+		if err := unstructured.SetNestedSlice(u.Object, containers, fields...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// taggedImages returns the images of the manifest, which are referenced by a tag.
+func taggedImages(manifest *mf.Manifest, overrides map[string]string) []string {
+	return manifestImages(manifest, overrides, isTagged)
+}
+
+// manifestImages returns the images of the manifest, which match the predicate.
+func manifestImages(manifest *mf.Manifest, overrides map[string]string, pred func(string) bool) []string {
+	images := map[string]struct{}{}
+	for _, u := range manifest.Resources() {
+		_ = visitImages(u.DeepCopy(), overrides, func(image string) string {
+			if pred(image) {
+				images[image] = struct{}{}
+			}
+			return image
+		})
+	}
+	list := make([]string, 0, len(images))
+	for image := range images {
+		list = append(list, image)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func isTagged(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	_, err := name.NewTag(image)
+	return err == nil
+}
+
+// pinImages replaces the images referenced by a tag by the resolved ones.
+func pinImages(resolved map[string]string, overrides map[string]string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		return visitImages(u, overrides, func(image string) string {
+			if digest, ok := resolved[image]; ok {
+				return digest
+			}
+			return image
+		})
+	}
+}
+
+// dockerConfig is the content of a secret of the type kubernetes.io/dockerconfigjson.
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// registryCredentials reads the credentials of the registries from the secret, no credentials are
+// returned without a secret.
+func registryCredentials(ctx context.Context, kubeClient kubernetes.Interface, namespace string, ref *corev1.LocalObjectReference) (dockerConfig, error) {
+	var config dockerConfig
+	if ref == nil || ref.Name == "" {
+		return config, nil
+	}
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return config, fmt.Errorf("failed to get the registry credentials %s/%s: %w", namespace, ref.Name, err)
+	}
+	data, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return config, fmt.Errorf("the registry credentials %s/%s have no key %s", namespace, ref.Name, corev1.DockerConfigJsonKey)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse the registry credentials %s/%s: %w", namespace, ref.Name, err)
+	}
+	return config, nil
+}
+
+// credentials returns the user name and the password for the registry.
+func (c dockerConfig) credentials(registry string) (string, string, bool) {
+	for key, auth := range c.Auths {
+		if normalizeRegistry(key) != normalizeRegistry(registry) {
+			continue
+		}
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				continue
+			}
+			if user, password, ok := strings.Cut(string(decoded), ":"); ok {
+				return user, password, true
+			}
+		}
+		if auth.Username != "" {
+			return auth.Username, auth.Password, true
+		}
+	}
+	return "", "", false
+}
+
+// normalizeRegistry returns the host of a registry in a docker config, e.g. of https://index.docker.io/v1/.
+func normalizeRegistry(registry string) string {
+	registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	registry, _, _ = strings.Cut(registry, "/")
+	switch registry {
+	case "docker.io", "registry-1.docker.io":
+		return name.DefaultRegistry
+	}
+	return registry
+}
+
+// resolveDigest returns the image pinned to the digest of the manifest, which its tag refers to.
+func resolveDigest(ctx context.Context, client *http.Client, image string, config dockerConfig) (string, error) {
+	tag, err := name.NewTag(image)
+	if err != nil {
+		return "", err
+	}
+	repo := tag.Context()
+	resp, manifestURL, authorization, err := headManifest(ctx, client, tag, config)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s of %s", resp.Status, manifestURL)
+	}
+
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		// The header is optional for HEAD requests, the digest is computed from the manifest then.
+		if digest, err = manifestDigest(ctx, client, manifestURL, authorization); err != nil {
+			return "", err
+		}
+	}
+	pinned, err := name.NewDigest(repo.Name() + "@" + digest)
+	if err != nil {
+		return "", err
+	}
+	return pinned.String(), nil
+}
+
+// headManifest requests the manifest of the image with the HEAD method, answering the challenge of
+// the registry with the credentials for it. It returns the response, the URL of the manifest and the
+// Authorization header used.
+func headManifest(ctx context.Context, client *http.Client, ref name.Reference, config dockerConfig) (*http.Response, string, string, error) {
+	repo := ref.Context()
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", repo.Scheme(), repo.RegistryStr(), repo.RepositoryStr(), ref.Identifier())
+
+	authorization := ""
+	resp, err := manifestRequest(ctx, client, http.MethodHead, manifestURL, authorization)
+	if err != nil {
+		return nil, "", "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized {
+		user, password, _ := config.credentials(repo.RegistryStr())
+		if authorization, err = authorize(ctx, client, resp.Header.Get("WWW-Authenticate"), repo.Scope("pull"), user, password); err != nil {
+			return nil, "", "", err
+		}
+		if resp, err = manifestRequest(ctx, client, http.MethodHead, manifestURL, authorization); err != nil {
+			return nil, "", "", err
+		}
+		resp.Body.Close()
+	}
+	return resp, manifestURL, authorization, nil
+}
+
+func manifestRequest(ctx context.Context, client *http.Client, method, manifestURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ","))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return client.Do(req)
+}
+
+func manifestDigest(ctx context.Context, client *http.Client, manifestURL, authorization string) (string, error) {
+	resp, err := manifestRequest(ctx, client, http.MethodGet, manifestURL, authorization)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s of %s", resp.Status, manifestURL)
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, resp.Body); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// authorize returns the Authorization header answering the challenge of the registry: the basic
+// credentials, or a bearer token issued for them by the token service of the registry.
+func authorize(ctx context.Context, client *http.Client, challenge, scope, user, password string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	basic := ""
+	if user != "" {
+		basic = "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+	}
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if basic == "" {
+			return "", errors.New("the registry requires credentials")
+		}
+		return basic, nil
+	case "bearer":
+	default:
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid realm in the authentication challenge %q", challenge)
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if s := params["scope"]; s != "" {
+		scope = s
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if basic != "" {
+		req.Header.Set("Authorization", basic)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s of the token service %s", resp.Status, realm.Host)
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode the token: %w", err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	if token.Token == "" {
+		return "", fmt.Errorf("the token service %s returned no token", realm.Host)
+	}
+	return "Bearer " + token.Token, nil
+}
+
+// parseChallenge returns the scheme and the parameters of a WWW-Authenticate header, e.g.
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io".
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			params[key] = value
+		}
+	}
+	return scheme, params
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+const (
+	testDigest   = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testManifest = `{"schemaVersion":2}`
+)
+
+// fakeRegistry serves the manifests of the tag v1 with a bearer token issued for the credentials
+// user:secret, and counts the manifest requests.
+type fakeRegistry struct {
+	*httptest.Server
+	requests int
+	noDigest bool
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	r := &fakeRegistry{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, req *http.Request) {
+		user, password, ok := req.BasicAuth()
+		if !ok || user != "user" || password != "secret" || req.URL.Query().Get("scope") != "repository:knative/controller:pull" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"token": "issued"})
+	})
+	mux.HandleFunc("/v2/knative/controller/manifests/", func(w http.ResponseWriter, req *http.Request) {
+		r.requests++
+		if req.Header.Get("Authorization") != "Bearer issued" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+r.URL+`/token",service="fake"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if !strings.HasSuffix(req.URL.Path, "/v1") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !r.noDigest {
+			w.Header().Set("Docker-Content-Digest", testDigest)
+		}
+		if req.Method == http.MethodGet {
+			w.Write([]byte(testManifest))
+		}
+	})
+	r.Server = httptest.NewServer(mux)
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.URL, "http://")
+}
+
+func digestDeployment(image string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "controller", "namespace": "knative-serving"},
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{
+				"name":  "controller",
+				"image": image,
+				"env": []interface{}{
+					map[string]interface{}{"name": "QUEUE_IMAGE", "value": image},
+					map[string]interface{}{"name": "OTHER", "value": image},
+				},
+			}},
+		}}},
+	}}
+}
+
+func TestResolveDigests(t *testing.T) {
+	registry := newFakeRegistry(t)
+	image := registry.host() + "/knative/controller:v1"
+	pinned := registry.host() + "/knative/controller@" + testDigest
+	computed := fmt.Sprintf("%s/knative/controller@sha256:%x", registry.host(), sha256.Sum256([]byte(testManifest)))
+	auth := base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "registry"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"http://` + registry.host() + `/v2/":{"auth":"` + auth + `"}}}`)},
+	}
+
+	tests := []struct {
+		name       string
+		resolution *base.DigestResolution
+		image      string
+		resolved   map[string]string
+		noDigest   bool
+		wantImage  string
+		wantEnv    string
+		wantStatus map[string]string
+		wantErr    string
+		requests   int
+	}{{
+		name:      "disabled",
+		image:     image,
+		resolved:  map[string]string{image: pinned},
+		wantImage: image,
+		wantEnv:   image,
+	}, {
+		name:       "resolved",
+		resolution: &base.DigestResolution{Enabled: true, CredentialsSecret: &corev1.LocalObjectReference{Name: "registry"}},
+		image:      image,
+		wantImage:  pinned,
+		wantEnv:    pinned,
+		wantStatus: map[string]string{image: pinned},
+		requests:   2,
+	}, {
+		name:       "digest of the manifest",
+		resolution: &base.DigestResolution{Enabled: true, CredentialsSecret: &corev1.LocalObjectReference{Name: "registry"}},
+		image:      image,
+		noDigest:   true,
+		wantImage:  computed,
+		wantEnv:    computed,
+		wantStatus: map[string]string{image: computed},
+		requests:   3,
+	}, {
+		name:       "pinned",
+		resolution: &base.DigestResolution{Enabled: true},
+		image:      image,
+		resolved:   map[string]string{image: registry.host() + "/knative/controller@sha256:" + strings.Repeat("a", 64), "removed:v1": "removed@" + testDigest},
+		wantImage:  registry.host() + "/knative/controller@sha256:" + strings.Repeat("a", 64),
+		wantEnv:    registry.host() + "/knative/controller@sha256:" + strings.Repeat("a", 64),
+		wantStatus: map[string]string{image: registry.host() + "/knative/controller@sha256:" + strings.Repeat("a", 64)},
+	}, {
+		name:       "digest already",
+		resolution: &base.DigestResolution{Enabled: true},
+		image:      pinned,
+		wantImage:  pinned,
+		wantEnv:    pinned,
+	}, {
+		name:       "without credentials",
+		resolution: &base.DigestResolution{Enabled: true},
+		image:      image,
+		wantErr:    "failed to resolve the digest of the image " + image + ": unexpected status 401 Unauthorized of the token service",
+		requests:   1,
+	}, {
+		name:       "unknown tag",
+		resolution: &base.DigestResolution{Enabled: true, CredentialsSecret: &corev1.LocalObjectReference{Name: "registry"}},
+		image:      registry.host() + "/knative/controller:v2",
+		wantErr:    "unexpected status 404 Not Found",
+		requests:   2,
+	}, {
+		name:       "missing secret",
+		resolution: &base.DigestResolution{Enabled: true, CredentialsSecret: &corev1.LocalObjectReference{Name: "missing"}},
+		image:      image,
+		wantErr:    "failed to get the registry credentials knative-serving/missing",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registry.requests = 0
+			registry.noDigest = test.noDigest
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Registry: base.Registry{
+					Override:         map[string]string{"QUEUE_IMAGE": test.image},
+					DigestResolution: test.resolution,
+				}}},
+				Status: v1beta1.KnativeServingStatus{ResolvedImages: test.resolved},
+			}
+			manifest, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{digestDeployment(test.image)}))
+
+			err := resolveDigests(kubefake.NewSimpleClientset(secret), registry.Client())(context.Background(), &manifest, ks)
+			util.AssertEqual(t, registry.requests, test.requests)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("ResolveDigests() = %v, want an error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveDigests() = %v", err)
+			}
+			util.AssertDeepEqual(t, ks.Status.ResolvedImages, test.wantStatus)
+			containers, _, _ := unstructured.NestedSlice(manifest.Resources()[0].Object, "spec", "template", "spec", "containers")
+			container := containers[0].(map[string]interface{})
+			util.AssertEqual(t, container["image"], interface{}(test.wantImage))
+			env := container["env"].([]interface{})
+			util.AssertEqual(t, env[0].(map[string]interface{})["value"], interface{}(test.wantEnv))
+			// Only the env vars overriding an image are pinned.
+			util.AssertEqual(t, env[1].(map[string]interface{})["value"], interface{}(test.image))
+		})
+	}
+}
+
+func TestDockerConfigCredentials(t *testing.T) {
+	config := dockerConfig{Auths: map[string]dockerAuth{
+		"https://index.docker.io/v1/": {Auth: base64.StdEncoding.EncodeToString([]byte("hub:password"))},
+		"gcr.io":                      {Username: "_json_key", Password: "key"},
+	}}
+	for _, test := range []struct {
+		registry, user, password string
+		ok                       bool
+	}{
+		{registry: "index.docker.io", user: "hub", password: "password", ok: true},
+		{registry: "gcr.io", user: "_json_key", password: "key", ok: true},
+		{registry: "quay.io"},
+	} {
+		user, password, ok := config.credentials(test.registry)
+		if user != test.user || password != test.password || ok != test.ok {
+			t.Errorf("credentials(%s) = %s, %s, %v, want %s, %s, %v", test.registry, user, password, ok, test.user, test.password, test.ok)
+		}
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/nginx:pull,push"`)
+	util.AssertEqual(t, scheme, "Bearer")
+	util.AssertDeepEqual(t, params, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/nginx:pull,push",
+	})
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	util.AssertEqual(t, scheme, "Basic")
+	util.AssertDeepEqual(t, params, map[string]string{"realm": "registry"})
+}
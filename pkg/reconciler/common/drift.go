@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	clientgocache "k8s.io/client-go/tools/cache"
+	kubefilteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// driftInformers returns the informers of the kinds of the installed resources, which are watched
+// for the feature gate WatchDriftRepair, in addition to the deployments and config maps.
+func driftInformers(factory informers.SharedInformerFactory) []clientgocache.SharedIndexInformer {
+	return []clientgocache.SharedIndexInformer{
+		factory.Core().V1().Services().Informer(),
+		factory.Core().V1().ServiceAccounts().Informer(),
+		factory.Rbac().V1().Roles().Informer(),
+		factory.Rbac().V1().RoleBindings().Informer(),
+		factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer(),
+		factory.Policy().V1().PodDisruptionBudgets().Informer(),
+	}
+}
+
+// WatchDrift enqueues the Knative component of the kind gvk, which owns an installed resource with
+// the label selector, whenever the resource changes, if the feature gate WatchDriftRepair is
+// enabled, so that the drift of the resource is repaired right away instead of on the next resync.
+// The informers are started here, as sharedmain only starts the injected ones.
+func WatchDrift(ctx context.Context, impl *controller.Impl, selector string, gvk schema.GroupVersionKind) {
+	if !FeatureEnabled(ctx, WatchDriftRepair) {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	handler := clientgocache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGVK(gvk),
+		Handler:    EnqueueDrifted(impl),
+	}
+	for _, informer := range driftInformers(kubefilteredfactory.Get(ctx, selector)) {
+		// The informers only enqueue the owner, so they do not need to cache the full resources.
+		if err := informer.SetTransform(TrimForEnqueue); err != nil {
+			logger.Warnw("Failed to trim the objects cached by the informer", zap.Error(err))
+		}
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			logger.Warnw("Failed to watch the drift of the installed resources", zap.Error(err))
+			continue
+		}
+		go informer.Run(ctx.Done())
+	}
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	clientgocache "k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// EcoIdleAnnotation marks the deployments, which spec.eco scaled down.
+	EcoIdleAnnotation = "operator.knative.dev/eco-idle"
+
+	// ecoRecheckDelay is the delay, after which a component is reconciled again, when the CRD of
+	// a resource, which needs one of its deployments, doesn't exist yet, e.g. because the CRD is
+	// installed together with the deployment.
+	ecoRecheckDelay = time.Minute
+)
+
+// ecoResource is a kind of resources, which needs an optional deployment.
+type ecoResource struct {
+	gvr schema.GroupVersionResource
+	// classAnnotation and class select the resources of a class, e.g. the brokers of a broker
+	// implementation. All the resources are selected without them.
+	classAnnotation string
+	class           string
+}
+
+// matches returns whether the object is one of the selected resources.
+func (r ecoResource) matches(obj interface{}) bool {
+	object, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	return r.classAnnotation == "" || object.GetAnnotations()[r.classAnnotation] == r.class
+}
+
+var (
+	domainMappings    = ecoResource{gvr: schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1beta1", Resource: "domainmappings"}}
+	hpaPodAutoscalers = ecoResource{
+		gvr:             schema.GroupVersionResource{Group: "autoscaling.internal.knative.dev", Version: "v1alpha1", Resource: "podautoscalers"},
+		classAnnotation: "autoscaling.knative.dev/class",
+		class:           "hpa.autoscaling.knative.dev",
+	}
+	inMemoryChannels = ecoResource{gvr: schema.GroupVersionResource{Group: "messaging.knative.dev", Version: "v1", Resource: "inmemorychannels"}}
+	mtBrokers        = ecoResource{
+		gvr:             schema.GroupVersionResource{Group: "eventing.knative.dev", Version: "v1", Resource: "brokers"},
+		classAnnotation: "eventing.knative.dev/broker.class",
+		class:           "MTChannelBasedBroker",
+	}
+	jobSinks       = ecoResource{gvr: schema.GroupVersionResource{Group: "sinks.knative.dev", Version: "v1alpha1", Resource: "jobsinks"}}
+	cephSources    = ecoResource{gvr: schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha1", Resource: "cephsources"}}
+	gitHubSources  = ecoResource{gvr: schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha1", Resource: "githubsources"}}
+	gitHubBindings = ecoResource{gvr: schema.GroupVersionResource{Group: "bindings.knative.dev", Version: "v1alpha1", Resource: "githubbindings"}}
+	rabbitSources  = ecoResource{gvr: schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha1", Resource: "rabbitmqsources"}}
+	redisSources   = ecoResource{gvr: schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha1", Resource: "redisstreamsources"}}
+)
+
+// ecoDeployments are the optional deployments, which spec.eco scales down, with the resources,
+// which need them. The webhooks are never scaled down, since the resources can't be created
+// without them.
+var ecoDeployments = map[string][]ecoResource{
+	"domain-mapping":              {domainMappings},
+	"autoscaler-hpa":              {hpaPodAutoscalers},
+	"imc-controller":              {inMemoryChannels},
+	"imc-dispatcher":              {inMemoryChannels},
+	"mt-broker-controller":        {mtBrokers},
+	"mt-broker-filter":            {mtBrokers},
+	"mt-broker-ingress":           {mtBrokers},
+	"job-sink":                    {jobSinks},
+	"ceph-controller":             {cephSources},
+	"github-controller-manager":   {gitHubSources, gitHubBindings},
+	"rabbitmq-controller-manager": {rabbitSources},
+	"redis-controller-manager":    {redisSources},
+}
+
+// EcoMode scales the optional deployments of the components with spec.eco down, while the cluster
+// has no resources for them. It watches the resources, once their CRDs exist, and reconciles the
+// components again, when they are created or deleted.
+type EcoMode struct {
+	// ctx is the context of the controller, which runs the informers.
+	ctx     context.Context
+	client  dynamic.Interface
+	impl    *controller.Impl
+	enqueue func()
+
+	mu        sync.Mutex
+	informers map[schema.GroupVersionResource]clientgocache.SharedIndexInformer
+}
+
+// NewEcoMode returns the EcoMode of a controller, which lists its components with list.
+func NewEcoMode[T base.KComponent](ctx context.Context, client dynamic.Interface, impl *controller.Impl, list func() ([]T, error)) *EcoMode {
+	return &EcoMode{
+		ctx:    ctx,
+		client: client,
+		impl:   impl,
+		enqueue: func() {
+			components, err := list()
+			if err != nil {
+				return
+			}
+			for _, component := range components {
+				if eco := component.GetSpec().GetEco(); eco != nil && eco.Enabled {
+					impl.Enqueue(component)
+				}
+			}
+		},
+		informers: map[schema.GroupVersionResource]clientgocache.SharedIndexInformer{},
+	}
+}
+
+// ScaleDownIdle is a Stage, which scales the optional deployments of the manifest down to zero
+// replicas, as long as the cluster has no resources for them. It leaves the manifest of a target
+// cluster unchanged.
+func (e *EcoMode) ScaleDownIdle(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	eco := instance.GetSpec().GetEco()
+	if eco == nil || !eco.Enabled || instance.GetSpec().GetTargetCluster() != nil {
+		return nil
+	}
+	used := map[schema.GroupVersionResource]bool{}
+	recheck := false
+	idle := sets.New[string]()
+	for _, u := range manifest.Filter(mf.ByKind("Deployment")).Resources() {
+		resources, ok := ecoDeployments[u.GetName()]
+		if !ok || slices.Contains(eco.Exclude, u.GetName()) {
+			continue
+		}
+		needed := false
+		for _, resource := range resources {
+			inUse, checked := used[resource.gvr]
+			if !checked {
+				var err error
+				inUse, err = e.inUse(ctx, resource)
+				if apierrors.IsNotFound(err) {
+					// The CRD doesn't exist (yet), so there are no resources.
+					recheck = true
+				} else if err != nil {
+					return fmt.Errorf("failed to list the %s: %w", resource.gvr.Resource, err)
+				}
+				used[resource.gvr] = inUse
+			}
+			needed = needed || inUse
+		}
+		if !needed {
+			idle.Insert(u.GetName())
+		}
+	}
+	if recheck {
+		e.impl.EnqueueAfter(instance, ecoRecheckDelay)
+	}
+	if idle.Len() == 0 {
+		return nil
+	}
+	logging.FromContext(ctx).Infow("Scaling down the idle deployments", "deployments", sets.List(idle))
+
+	transformed, err := manifest.Transform(func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Deployment" || !idle.Has(u.GetName()) {
+			return nil
+		}
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[EcoIdleAnnotation] = "true"
+		u.SetAnnotations(annotations)
+		return unstructured.SetNestedField(u.Object, int64(0), "spec", "replicas")
+	})
+	if err != nil {
+		return err
+	}
+	*manifest = transformed
+	return nil
+}
+
+// inUse returns whether the cluster has any of the resources. It lists them from the informer,
+// once it synced, and starts it after listing them successfully for the first time.
+func (e *EcoMode) inUse(ctx context.Context, resource ecoResource) (bool, error) {
+	e.mu.Lock()
+	informer := e.informers[resource.gvr]
+	e.mu.Unlock()
+	if informer != nil && informer.HasSynced() {
+		return slices.ContainsFunc(informer.GetStore().List(), resource.matches), nil
+	}
+
+	list, err := e.client.Resource(resource.gvr).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	if informer == nil {
+		e.watch(resource.gvr)
+	}
+	for i := range list.Items {
+		if resource.matches(&list.Items[i]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// watch starts an informer for the resources, which reconciles the components with spec.eco
+// again, when one is created or deleted.
+func (e *EcoMode) watch(gvr schema.GroupVersionResource) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.informers[gvr]; ok {
+		return
+	}
+	logger := logging.FromContext(e.ctx)
+	informer := dynamicinformer.NewFilteredDynamicInformer(e.client, gvr, metav1.NamespaceAll, 0, clientgocache.Indexers{}, nil).Informer()
+	// The informer only needs the class annotation of the resources.
+	if err := informer.SetTransform(trimForEco); err != nil {
+		logger.Warnw("Failed to trim the objects cached by the informer", zap.Error(err))
+	}
+	if _, err := informer.AddEventHandler(clientgocache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { e.enqueue() },
+		DeleteFunc: func(interface{}) { e.enqueue() },
+	}); err != nil {
+		logger.Warnw("Failed to watch the resources for spec.eco", zap.String("resource", gvr.String()), zap.Error(err))
+		return
+	}
+	e.informers[gvr] = informer
+	go informer.Run(e.ctx.Done())
+}
+
+// trimForEco keeps the name and the annotations of the resources.
+func trimForEco(obj interface{}) (interface{}, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return obj, nil
+	}
+	trimmed := &unstructured.Unstructured{}
+	trimmed.SetAPIVersion(u.GetAPIVersion())
+	trimmed.SetKind(u.GetKind())
+	trimmed.SetName(u.GetName())
+	trimmed.SetNamespace(u.GetNamespace())
+	trimmed.SetUID(u.GetUID())
+	trimmed.SetResourceVersion(u.GetResourceVersion())
+	trimmed.SetAnnotations(u.GetAnnotations())
+	return trimmed, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestEcoModeScaleDownIdle(t *testing.T) {
+	broker := func(name, class string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("eventing.knative.dev/v1")
+		u.SetKind("Broker")
+		u.SetNamespace("default")
+		u.SetName(name)
+		u.SetAnnotations(map[string]string{"eventing.knative.dev/broker.class": class})
+		return u
+	}
+	deployment := func(name string) unstructured.Unstructured {
+		return util.MakeUnstructured(t, &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "knative-eventing"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+		})
+	}
+
+	tests := []struct {
+		name    string
+		eco     *base.EcoMode
+		brokers []runtime.Object
+		idle    []string
+	}{{
+		name: "no eco mode",
+	}, {
+		name: "disabled",
+		eco:  &base.EcoMode{},
+	}, {
+		name: "no resources",
+		eco:  &base.EcoMode{Enabled: true},
+		idle: []string{"imc-dispatcher", "job-sink", "mt-broker-ingress"},
+	}, {
+		name:    "brokers of another class",
+		eco:     &base.EcoMode{Enabled: true},
+		brokers: []runtime.Object{broker("kafka", "Kafka")},
+		idle:    []string{"imc-dispatcher", "job-sink", "mt-broker-ingress"},
+	}, {
+		name:    "brokers",
+		eco:     &base.EcoMode{Enabled: true},
+		brokers: []runtime.Object{broker("kafka", "Kafka"), broker("default", "MTChannelBasedBroker")},
+		idle:    []string{"imc-dispatcher", "job-sink"},
+	}, {
+		name: "excluded",
+		eco:  &base.EcoMode{Enabled: true, Exclude: []string{"imc-dispatcher"}},
+		idle: []string{"job-sink", "mt-broker-ingress"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+				mtBrokers.gvr:        "BrokerList",
+				inMemoryChannels.gvr: "InMemoryChannelList",
+				jobSinks.gvr:         "JobSinkList",
+			}, test.brokers...)
+			// The CRD of the JobSinks is not installed.
+			client.PrependReactor("list", "jobsinks", func(clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewNotFound(jobSinks.gvr.GroupResource(), "")
+			})
+			impl := controller.NewContext(ctx, nil, controller.ControllerOptions{WorkQueueName: "test", Logger: logging.FromContext(ctx)})
+			defer impl.WorkQueue().ShutDown()
+			ke := &v1beta1.KnativeEventing{
+				ObjectMeta: metav1.ObjectMeta{Name: "knative-eventing", Namespace: "knative-eventing"},
+				Spec:       v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{Eco: test.eco}},
+			}
+			eco := NewEcoMode(ctx, client, impl, func() ([]*v1beta1.KnativeEventing, error) {
+				return []*v1beta1.KnativeEventing{ke}, nil
+			})
+
+			manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+				deployment("eventing-controller"),
+				deployment("imc-dispatcher"),
+				deployment("job-sink"),
+				deployment("mt-broker-ingress"),
+			}))
+			if err != nil {
+				t.Fatalf("ManifestFrom() = %v", err)
+			}
+			if err := eco.ScaleDownIdle(ctx, &manifest, ke); err != nil {
+				t.Fatalf("ScaleDownIdle() = %v", err)
+			}
+
+			var idle []string
+			for _, u := range manifest.Resources() {
+				replicas, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+				if replicas == 0 {
+					idle = append(idle, u.GetName())
+					util.AssertEqual(t, u.GetAnnotations()[EcoIdleAnnotation], "true")
+				}
+			}
+			util.AssertDeepEqual(t, idle, test.idle)
+		})
+	}
+}
+
+func TestEcoResourceMatches(t *testing.T) {
+	pa := &unstructured.Unstructured{}
+	pa.SetAnnotations(map[string]string{"autoscaling.knative.dev/class": "kpa.autoscaling.knative.dev"})
+	util.AssertEqual(t, hpaPodAutoscalers.matches(pa), false)
+	pa.SetAnnotations(map[string]string{"autoscaling.knative.dev/class": "hpa.autoscaling.knative.dev"})
+	util.AssertEqual(t, hpaPodAutoscalers.matches(pa), true)
+	util.AssertEqual(t, inMemoryChannels.matches(pa), true)
+}
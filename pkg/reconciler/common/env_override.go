@@ -17,26 +17,46 @@ limitations under the License.
 package common
 
 import (
+	"slices"
+
 	v1 "k8s.io/api/core/v1"
 	"knative.dev/operator/pkg/apis/operator/base"
 )
 
+// overrideEnv applies the env override to the env vars of a container: the env vars listed in
+// RemoveEnvVars are removed first, then each env var of EnvVars replaces the one of the same name,
+// including a value by a valueFrom and vice versa, or is appended.
+func overrideEnv(override *base.EnvRequirementsOverride, tgt *[]v1.EnvVar) {
+	if len(override.RemoveEnvVars) > 0 {
+		kept := (*tgt)[:0]
+		for _, tgtV := range *tgt {
+			if !slices.Contains(override.RemoveEnvVars, tgtV.Name) {
+				kept = append(kept, tgtV)
+			}
+		}
+		*tgt = kept
+	}
+	mergeEnv(&override.EnvVars, tgt)
+}
+
 func mergeEnv(src, tgt *[]v1.EnvVar) {
 	if len(*tgt) > 0 {
 		for _, srcV := range *src {
 			exists := false
 			for i, tgtV := range *tgt {
 				if srcV.Name == tgtV.Name {
-					(*tgt)[i] = srcV
+					(*tgt)[i] = *srcV.DeepCopy()
 					exists = true
 				}
 			}
 			if !exists {
-				*tgt = append(*tgt, srcV)
+				*tgt = append(*tgt, *srcV.DeepCopy())
 			}
 		}
 	} else {
-		*tgt = *src
+		for _, srcV := range *src {
+			*tgt = append(*tgt, *srcV.DeepCopy())
+		}
 	}
 }
 
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+func TestReplaceEnv(t *testing.T) {
+	fieldRef := &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}
+	secretKeyRef := &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "smtp"},
+		Key:                  "password",
+	}}
+	configMapKeyRef := &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "config-logging"},
+		Key:                  "loglevel.controller",
+	}}
+	upstream := func() []corev1.EnvVar {
+		return []corev1.EnvVar{
+			{Name: "SYSTEM_NAMESPACE", ValueFrom: fieldRef},
+			{Name: "CONFIG_LOGGING_NAME", Value: "config-logging"},
+			{Name: "METRICS_DOMAIN", Value: "knative.dev/internal/serving"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		override base.EnvRequirementsOverride
+		want     []corev1.EnvVar
+	}{{
+		name: "value replaced by valueFrom",
+		override: base.EnvRequirementsOverride{EnvVars: []corev1.EnvVar{
+			{Name: "CONFIG_LOGGING_NAME", ValueFrom: configMapKeyRef},
+			{Name: "SMTP_PASSWORD", ValueFrom: secretKeyRef},
+		}},
+		want: []corev1.EnvVar{
+			{Name: "SYSTEM_NAMESPACE", ValueFrom: fieldRef},
+			{Name: "CONFIG_LOGGING_NAME", ValueFrom: configMapKeyRef},
+			{Name: "METRICS_DOMAIN", Value: "knative.dev/internal/serving"},
+			{Name: "SMTP_PASSWORD", ValueFrom: secretKeyRef},
+		},
+	}, {
+		name: "valueFrom replaced by value",
+		override: base.EnvRequirementsOverride{EnvVars: []corev1.EnvVar{
+			{Name: "SYSTEM_NAMESPACE", Value: "knative-serving"},
+		}},
+		want: []corev1.EnvVar{
+			{Name: "SYSTEM_NAMESPACE", Value: "knative-serving"},
+			{Name: "CONFIG_LOGGING_NAME", Value: "config-logging"},
+			{Name: "METRICS_DOMAIN", Value: "knative.dev/internal/serving"},
+		},
+	}, {
+		name: "removed",
+		override: base.EnvRequirementsOverride{
+			RemoveEnvVars: []string{"METRICS_DOMAIN", "UNKNOWN"},
+		},
+		want: []corev1.EnvVar{
+			{Name: "SYSTEM_NAMESPACE", ValueFrom: fieldRef},
+			{Name: "CONFIG_LOGGING_NAME", Value: "config-logging"},
+		},
+	}, {
+		name: "removed and set again",
+		override: base.EnvRequirementsOverride{
+			EnvVars:       []corev1.EnvVar{{Name: "METRICS_DOMAIN", Value: "example.com"}},
+			RemoveEnvVars: []string{"METRICS_DOMAIN", "CONFIG_LOGGING_NAME"},
+		},
+		want: []corev1.EnvVar{
+			{Name: "SYSTEM_NAMESPACE", ValueFrom: fieldRef},
+			{Name: "METRICS_DOMAIN", Value: "example.com"},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, container := range []string{"controller", "migrate"} {
+				ps := &corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "migrate", Env: upstream()}},
+					Containers:     []corev1.Container{{Name: "controller", Env: upstream()}, {Name: "sidecar", Env: upstream()}},
+				}}
+				override := test.override
+				override.Container = container
+				replaceEnv(&base.WorkloadOverride{Env: []base.EnvRequirementsOverride{override}}, ps)
+
+				for _, c := range append(ps.Spec.InitContainers, ps.Spec.Containers...) {
+					want := upstream()
+					if c.Name == container {
+						want = test.want
+					}
+					if diff := cmp.Diff(want, c.Env); diff != "" {
+						t.Errorf("Env of %s with the override of %s (-want, +got): %s", c.Name, container, diff)
+					}
+				}
+			}
+		})
+	}
+}
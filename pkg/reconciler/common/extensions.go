@@ -24,10 +24,20 @@ import (
 	"knative.dev/pkg/controller"
 )
 
-// Extension enables platform-specific features
-type Extension interface {
+// ManifestSource provides the manifests, which are installed in addition to the ones of the component
+type ManifestSource interface {
 	Manifests(base.KComponent) ([]mf.Manifest, error)
+}
+
+// TransformerProvider provides the transformers, which run after the common ones
+type TransformerProvider interface {
 	Transformers(base.KComponent) []mf.Transformer
+}
+
+// Extension enables platform-specific features
+type Extension interface {
+	ManifestSource
+	TransformerProvider
 	Reconcile(context.Context, base.KComponent) error
 	Finalize(context.Context, base.KComponent) error
 }
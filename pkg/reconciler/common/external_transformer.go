@@ -0,0 +1,280 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// ExternalTransformersDirEnvKey is the environment variable of the directory, whose executables
+	// spec.externalTransformer.command refers to.
+	ExternalTransformersDirEnvKey = "EXTERNAL_TRANSFORMERS_DIR"
+	// DefaultExternalTransformersDir is the directory of the executables, if the environment
+	// variable is not set.
+	DefaultExternalTransformersDir = "/var/run/knative-operator/transformers"
+
+	defaultExternalTransformerTimeout = 10 * time.Second
+	maxExternalTransformerTimeout     = 30 * time.Second
+	// maxExternalTransformerResponse limits the manifest returned by the transformer.
+	maxExternalTransformerResponse = 32 << 20
+)
+
+// ExternalTransformRequest is the document, which the external transformer receives.
+type ExternalTransformRequest struct {
+	// Component is the KnativeServing, KnativeEventing, KnativeFunctions or KnativeNetworking.
+	Component base.KComponent `json:"component"`
+	// Resources are the rendered resources of the component.
+	Resources []map[string]interface{} `json:"resources"`
+}
+
+// ExternalTransformResponse is the document, which the external transformer returns.
+type ExternalTransformResponse struct {
+	// Resources are the mutated resources to install.
+	Resources []map[string]interface{} `json:"resources"`
+}
+
+// externalTransformerURL returns the URL of the service, it is replaced in the tests.
+var externalTransformerURL = func(svc *base.ExternalTransformerService) string {
+	port := int32(443)
+	if svc.Port != nil {
+		port = *svc.Port
+	}
+	return fmt.Sprintf("https://%s.%s.svc:%d/%s", svc.Name, svc.Namespace, port, strings.TrimPrefix(svc.Path, "/"))
+}
+
+// ExternalTransform hands the manifest to the transformer of spec.externalTransformer and replaces
+// it with the returned one. With the failure policy Ignore, a failing transformer is logged and
+// the manifest is installed as it is.
+func ExternalTransform(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	spec := instance.GetSpec().GetExternalTransformer()
+	if spec == nil {
+		return nil
+	}
+	transformed, err := externalTransform(ctx, spec, manifest, instance)
+	if err != nil {
+		err = fmt.Errorf("external transformer: %w", err)
+		if spec.FailurePolicy == base.ExternalTransformerIgnore {
+			logging.FromContext(ctx).Warnw("Installing the manifest without the external transformer", zap.Error(err))
+			return nil
+		}
+		instance.GetStatus().MarkInstallFailed(err.Error())
+		return err
+	}
+	*manifest = transformed
+	return nil
+}
+
+func externalTransform(ctx context.Context, spec *base.ExternalTransformer, manifest *mf.Manifest, instance base.KComponent) (mf.Manifest, error) {
+	if (spec.Service == nil) == (spec.Command == "") {
+		return mf.Manifest{}, fmt.Errorf("exactly one of service and command must be set")
+	}
+	timeout := defaultExternalTransformerTimeout
+	if spec.Timeout != nil {
+		timeout = spec.Timeout.Duration
+		if timeout <= 0 || timeout > maxExternalTransformerTimeout {
+			return mf.Manifest{}, fmt.Errorf("the timeout must be positive and at most %v, got %v", maxExternalTransformerTimeout, timeout)
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	request := ExternalTransformRequest{Component: instance}
+	for _, u := range manifest.Resources() {
+		request.Resources = append(request.Resources, u.Object)
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	var data []byte
+	if spec.Service != nil {
+		data, err = postManifest(ctx, spec.Service, body)
+	} else {
+		data, err = execManifest(ctx, spec.Command, body)
+	}
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+
+	response := ExternalTransformResponse{}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return mf.Manifest{}, fmt.Errorf("failed to parse the response: %w", err)
+	}
+	resources, err := validateTransformedResources(manifest.Resources(), response.Resources)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	transformed, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	transformed.Client = manifest.Client
+	return transformed, nil
+}
+
+func postManifest(ctx context.Context, svc *base.ExternalTransformerService, body []byte) ([]byte, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(svc.CABundle) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(svc.CABundle) {
+			return nil, fmt.Errorf("the CA bundle of the service contains no certificate")
+		}
+	}
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment}}
+	defer client.CloseIdleConnections()
+
+	url := externalTransformerURL(svc)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post the manifest to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	data, err := readLimited(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of %s: %w", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s: %s", url, resp.Status, truncate(string(data), 256))
+	}
+	return data, nil
+}
+
+func execManifest(ctx context.Context, command string, body []byte) ([]byte, error) {
+	if command != filepath.Base(command) || strings.HasPrefix(command, ".") {
+		return nil, fmt.Errorf("the command must be the name of an executable, got %q", command)
+	}
+	dir := os.Getenv(ExternalTransformersDirEnvKey)
+	if dir == "" {
+		dir = DefaultExternalTransformersDir
+	}
+	cmd := exec.CommandContext(ctx, filepath.Join(dir, command))
+	cmd.Stdin = bytes.NewReader(body)
+	// The children of a killed command may hold on to its output, give up on them.
+	cmd.WaitDelay = time.Second
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &limitedWriter{w: &stdout, n: maxExternalTransformerResponse, fail: true}
+	cmd.Stderr = &limitedWriter{w: &stderr, n: 4096}
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return nil, fmt.Errorf("the command %s failed: %w: %s", command, err, truncate(strings.TrimSpace(stderr.String()), 256))
+	}
+	return stdout.Bytes(), nil
+}
+
+// validateTransformedResources checks the resources returned by the transformer, which must not
+// move resources to other namespaces than the ones of the manifest.
+func validateTransformedResources(original []unstructured.Unstructured, objs []map[string]interface{}) ([]unstructured.Unstructured, error) {
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("the response contains no resources")
+	}
+	namespaces := map[string]bool{"": true}
+	for _, u := range original {
+		namespaces[u.GetNamespace()] = true
+	}
+	seen := map[string]bool{}
+	resources := make([]unstructured.Unstructured, 0, len(objs))
+	for i, obj := range objs {
+		u := unstructured.Unstructured{Object: obj}
+		if u.GetAPIVersion() == "" || u.GetKind() == "" {
+			return nil, fmt.Errorf("resources[%d]: apiVersion and kind are required", i)
+		}
+		if u.GetName() == "" {
+			return nil, fmt.Errorf("resources[%d]: metadata.name is required", i)
+		}
+		if errs := validation.IsDNS1123Subdomain(u.GetName()); len(errs) > 0 {
+			return nil, fmt.Errorf("resources[%d]: invalid name %q: %s", i, u.GetName(), strings.Join(errs, ", "))
+		}
+		if !namespaces[u.GetNamespace()] {
+			return nil, fmt.Errorf("resources[%d]: %s %s/%s is not in a namespace of the manifest", i, u.GetKind(), u.GetNamespace(), u.GetName())
+		}
+		key := u.GroupVersionKind().GroupKind().String() + "/" + u.GetNamespace() + "/" + u.GetName()
+		if seen[key] {
+			return nil, fmt.Errorf("resources[%d]: %s %s/%s is returned twice", i, u.GetKind(), u.GetNamespace(), u.GetName())
+		}
+		seen[key] = true
+		resources = append(resources, u)
+	}
+	return resources, nil
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxExternalTransformerResponse+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxExternalTransformerResponse {
+		return nil, fmt.Errorf("the response exceeds %d bytes", maxExternalTransformerResponse)
+	}
+	return data, nil
+}
+
+// limitedWriter writes at most n bytes. It fails beyond, or drops the rest, unless fail is set.
+type limitedWriter struct {
+	w    io.Writer
+	n    int
+	fail bool
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > l.n {
+		if l.fail {
+			return 0, fmt.Errorf("the output exceeds %d bytes", maxExternalTransformerResponse)
+		}
+		if _, err := l.w.Write(p[:l.n]); err != nil {
+			return 0, err
+		}
+		l.n = 0
+		return len(p), nil
+	}
+	l.n -= len(p)
+	return l.w.Write(p)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestExternalTransform(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := ExternalTransformRequest{Component: &v1beta1.KnativeServing{}}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/label":
+			for _, obj := range request.Resources {
+				u := unstructured.Unstructured{Object: obj}
+				u.SetLabels(map[string]string{"transformed": request.Component.GetName()})
+			}
+		case "/move":
+			unstructured.SetNestedField(request.Resources[0], "elsewhere", "metadata", "namespace")
+		default:
+			http.Error(w, "unknown path", http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(ExternalTransformResponse{Resources: request.Resources})
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	original := externalTransformerURL
+	defer func() { externalTransformerURL = original }()
+	externalTransformerURL = func(svc *base.ExternalTransformerService) string {
+		return server.URL + svc.Path
+	}
+
+	dir := t.TempDir()
+	t.Setenv(ExternalTransformersDirEnvKey, dir)
+	writeScript := func(name, script string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+			t.Fatalf("WriteFile() = %v", err)
+		}
+	}
+	writeScript("echo", `sed -e 's/"component":.*"resources"/"resources"/'`)
+	writeScript("sleep", "sleep 5")
+
+	service := func(path string) *base.ExternalTransformerService {
+		return &base.ExternalTransformerService{Namespace: "default", Name: "transformer", Path: path, CABundle: caBundle}
+	}
+	tests := []struct {
+		name        string
+		transformer *base.ExternalTransformer
+		wantLabel   string
+		wantErr     string
+	}{{
+		name: "none",
+	}, {
+		name:        "service",
+		transformer: &base.ExternalTransformer{Service: service("/label")},
+		wantLabel:   "knative-serving",
+	}, {
+		name:        "command",
+		transformer: &base.ExternalTransformer{Command: "echo"},
+	}, {
+		name:        "failing service",
+		transformer: &base.ExternalTransformer{Service: service("/fail")},
+		wantErr:     "500 Internal Server Error: unknown path",
+	}, {
+		name:        "ignored failing service",
+		transformer: &base.ExternalTransformer{Service: service("/fail"), FailurePolicy: base.ExternalTransformerIgnore},
+	}, {
+		name:        "untrusted service",
+		transformer: &base.ExternalTransformer{Service: &base.ExternalTransformerService{Namespace: "default", Name: "transformer", Path: "/label"}},
+		wantErr:     "certificate",
+	}, {
+		name:        "moved resource",
+		transformer: &base.ExternalTransformer{Service: service("/move")},
+		wantErr:     "ConfigMap elsewhere/config-network is not in a namespace of the manifest",
+	}, {
+		name:        "timeout",
+		transformer: &base.ExternalTransformer{Command: "sleep", Timeout: &metav1.Duration{Duration: 100 * time.Millisecond}},
+		wantErr:     "context deadline exceeded",
+	}, {
+		name:        "command outside of the directory",
+		transformer: &base.ExternalTransformer{Command: "../sleep"},
+		wantErr:     "the command must be the name of an executable",
+	}, {
+		name:        "service and command",
+		transformer: &base.ExternalTransformer{Service: service("/label"), Command: "echo"},
+		wantErr:     "exactly one of service and command must be set",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+				util.MakeUnstructured(t, &corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "config-network"},
+				}),
+			}))
+			if err != nil {
+				t.Fatalf("ManifestFrom() = %v", err)
+			}
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+				Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{ExternalTransformer: test.transformer}},
+			}
+			ks.Status.InitializeConditions()
+
+			err = ExternalTransform(context.Background(), &manifest, ks)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("ExternalTransform() = %v, want %q", err, test.wantErr)
+				}
+				util.AssertEqual(t, ks.Status.GetCondition(base.InstallSucceeded).IsFalse(), true)
+				return
+			}
+			if err != nil {
+				t.Fatalf("ExternalTransform() = %v", err)
+			}
+			util.AssertEqual(t, len(manifest.Resources()), 1)
+			util.AssertEqual(t, manifest.Resources()[0].GetLabels()["transformed"], test.wantLabel)
+		})
+	}
+}
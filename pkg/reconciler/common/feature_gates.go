@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// FeatureGate names an experimental behavior of the operator. All the feature gates are disabled
+// by default, and enabled per cluster with the key feature-gates of the config map config-operator.
+type FeatureGate string
+
+const (
+	// ParallelApply applies the resources of the manifests in parallel, with APPLY_CONCURRENCY
+	// workers, or defaultParallelApplyConcurrency, if it is not set.
+	ParallelApply FeatureGate = "ParallelApply"
+	// ServerSideApply applies the resources of the manifests with server-side apply, instead of the
+	// three-way merge of manifestival.
+	ServerSideApply FeatureGate = "ServerSideApply"
+	// WatchDriftRepair watches more kinds of the installed resources, so that their changes are
+	// reverted right away, instead of on the next resync. It is read when the operator starts.
+	WatchDriftRepair FeatureGate = "WatchDriftRepair"
+	// SkipUnchangedApply skips applying the resources, whose content did not change since the
+	// operator applied them for the Knative component, unless a change of them was observed. The
+	// periodic resync applies all the resources again.
+	SkipUnchangedApply FeatureGate = "SkipUnchangedApply"
+
+	// meterName is the instrumentation scope of the metrics of the operator.
+	meterName = "knative.dev/operator"
+	// featureGateMetric is the gauge of the state of the feature gates.
+	featureGateMetric = "kn.operator.feature_gate.enabled"
+	// defaultParallelApplyConcurrency is the number of workers of ParallelApply without APPLY_CONCURRENCY.
+	defaultParallelApplyConcurrency = 4
+)
+
+// FeatureGates are all the known feature gates.
+var FeatureGates = []FeatureGate{ParallelApply, ServerSideApply, WatchDriftRepair, SkipUnchangedApply}
+
+// FeatureEnabled returns whether the feature gate is enabled by the OperatorConfig in the context.
+func FeatureEnabled(ctx context.Context, gate FeatureGate) bool {
+	return GetOperatorConfig(ctx).FeatureGates[gate]
+}
+
+// registerFeatureGateMetrics reports the state of the feature gates of the store as a gauge, which
+// is 1 for the enabled gates and 0 for the disabled ones, by the name of the gate.
+func registerFeatureGateMetrics(store *OperatorConfigStore, provider metric.MeterProvider) error {
+	_, err := provider.Meter(meterName).Int64ObservableGauge(featureGateMetric,
+		metric.WithDescription("Whether the feature gate of the operator is enabled"),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			gates := store.Load().FeatureGates
+			for _, gate := range FeatureGates {
+				var enabled int64
+				if gates[gate] {
+					enabled = 1
+				}
+				observer.Observe(enabled, metric.WithAttributes(attribute.String("feature", string(gate))))
+			}
+			return nil
+		}))
+	return err
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	corev1 "k8s.io/api/core/v1"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func featureGateStore(gates string) *OperatorConfigStore {
+	store := NewOperatorConfigStore(ControllerConfig{})
+	store.OnConfigChanged(&corev1.ConfigMap{Data: map[string]string{FeatureGatesKey: gates}})
+	return store
+}
+
+func TestFeatureEnabled(t *testing.T) {
+	ctx := WithOperatorConfigStore(context.Background(), featureGateStore("ParallelApply=true,ServerSideApply=false"))
+	util.AssertEqual(t, FeatureEnabled(ctx, ParallelApply), true)
+	util.AssertEqual(t, FeatureEnabled(ctx, ServerSideApply), false)
+	// All the feature gates are disabled by default.
+	for _, gate := range FeatureGates {
+		util.AssertEqual(t, FeatureEnabled(context.Background(), gate), false)
+	}
+}
+
+func TestFeatureGateMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	store := featureGateStore("ServerSideApply=true")
+	if err := registerFeatureGateMetrics(store, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))); err != nil {
+		t.Fatalf("registerFeatureGateMetrics() = %v", err)
+	}
+
+	collect := func() map[string]int64 {
+		rm := metricdata.ResourceMetrics{}
+		if err := reader.Collect(context.Background(), &rm); err != nil {
+			t.Fatalf("Collect() = %v", err)
+		}
+		got := map[string]int64{}
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				if m.Name != featureGateMetric {
+					continue
+				}
+				for _, point := range m.Data.(metricdata.Gauge[int64]).DataPoints {
+					feature, _ := point.Attributes.Value("feature")
+					got[feature.AsString()] = point.Value
+				}
+			}
+		}
+		return got
+	}
+	util.AssertDeepEqual(t, collect(), map[string]int64{"ParallelApply": 0, "ServerSideApply": 1, "WatchDriftRepair": 0, "SkipUnchangedApply": 0})
+
+	// The gauge follows the changes of the config map.
+	store.OnConfigChanged(&corev1.ConfigMap{Data: map[string]string{FeatureGatesKey: "ParallelApply=true"}})
+	util.AssertDeepEqual(t, collect(), map[string]int64{"ParallelApply": 1, "ServerSideApply": 0, "WatchDriftRepair": 0, "SkipUnchangedApply": 0})
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// FeaturesConfigMapName is the ConfigMap of the feature flags of both Knative Serving and Knative
+// Eventing.
+const FeaturesConfigMapName = "config-features"
+
+// FeaturesTransform sets the feature flags of spec.features in config-features. It runs before
+// ConfigMapTransform, so that the same key in spec.config takes precedence.
+func FeaturesTransform(features map[string]string, log *zap.SugaredLogger) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if len(features) == 0 || u.GetKind() != "ConfigMap" || u.GetName() != FeaturesConfigMapName {
+			return nil
+		}
+		return UpdateConfigMap(u, features, log)
+	}
+}
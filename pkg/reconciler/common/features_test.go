@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestFeaturesTransform(t *testing.T) {
+	tests := []struct {
+		name     string
+		features map[string]string
+		config   base.ConfigMapData
+		cmName   string
+		expected map[string]string
+	}{{
+		name:     "no features",
+		cmName:   "config-features",
+		expected: map[string]string{"multi-container": "enabled"},
+	}, {
+		name:     "features",
+		features: map[string]string{"kubernetes.podspec-affinity": "enabled", "multi-container": "disabled"},
+		cmName:   "config-features",
+		expected: map[string]string{"kubernetes.podspec-affinity": "enabled", "multi-container": "disabled"},
+	}, {
+		name:     "spec.config takes precedence",
+		features: map[string]string{"kubernetes.podspec-affinity": "enabled"},
+		config:   base.ConfigMapData{"features": {"kubernetes.podspec-affinity": "allowed"}},
+		cmName:   "config-features",
+		expected: map[string]string{"kubernetes.podspec-affinity": "allowed", "multi-container": "enabled"},
+	}, {
+		name:     "other config map",
+		features: map[string]string{"kubernetes.podspec-affinity": "enabled"},
+		cmName:   "config-network",
+		expected: map[string]string{"multi-container": "enabled"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := createConfigMap(tt.cmName, map[string]string{"multi-container": "enabled"})
+			u := &unstructured.Unstructured{}
+			if err := scheme.Scheme.Convert(&cm, u, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			log := zap.NewNop().Sugar()
+			for _, transform := range []func(*unstructured.Unstructured) error{
+				FeaturesTransform(tt.features, log),
+				ConfigMapTransform(tt.config, log),
+			} {
+				if err := transform(u); err != nil {
+					t.Fatalf("transform() = %v", err)
+				}
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
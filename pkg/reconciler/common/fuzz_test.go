@@ -0,0 +1,344 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// The fuzz tests feed the transformers with the kind of manifests, which users add with
+// spec.additionalManifests. Besides the panics, they catch the loss of fields, which the transformers
+// do not own. The transformers working on the typed workloads drop the fields, which the vendored API
+// does not know, so those are compared against the typed round trip of the input instead.
+//
+// Run one of them with e.g.
+//
+//	go test -run '^$' -fuzz FuzzMergePodSpecEnv -fuzztime 1m ./pkg/reconciler/common/
+
+var fuzzWorkloads = []string{
+	`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: activator
+  labels:
+    app: activator
+spec:
+  selector:
+    matchLabels:
+      app: activator
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: gcr.io/knative-releases/init:v1
+      containers:
+      - name: activator
+        image: gcr.io/knative-releases/knative.dev/serving/cmd/activator@sha256:abc
+        env:
+        - name: SYSTEM_NAMESPACE
+          valueFrom:
+            fieldRef:
+              fieldPath: metadata.namespace
+        - name: QUEUE_IMAGE
+          value: gcr.io/knative-releases/queue:v1
+        resources:
+          requests:
+            cpu: 300m
+`,
+	`apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: eventing-controller
+spec:
+  template:
+    spec:
+      containers:
+      - name: eventing-controller
+        image: eventing-controller:latest
+        x-unknown-field: true
+      - name: sidecar
+`,
+	`apiVersion: batch/v1
+kind: Job
+metadata:
+  generateName: storage-version-migration-
+spec:
+  template:
+    spec:
+      containers: []
+`,
+	`apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: kourier
+spec:
+  template:
+    spec:
+      containers:
+      - env: [{}, {name: ""}, {name: A, value: "1"}, {name: A, value: "2"}]
+`,
+	`apiVersion: caching.internal.knative.dev/v1alpha1
+kind: Image
+metadata:
+  name: queue-proxy
+spec:
+  image: gcr.io/knative-releases/queue@sha256:abc
+`,
+	`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config-deployment
+data:
+  queue-sidecar-image: gcr.io/knative-releases/queue:v1
+`,
+	`kind: Deployment
+spec:
+  template:
+    spec:
+      containers: {}
+`,
+	`kind: Deployment
+spec: 42
+`,
+}
+
+func FuzzMergePodSpecEnv(f *testing.F) {
+	for _, workload := range fuzzWorkloads {
+		f.Add(workload, "KUBERNETES_MIN_VERSION", "v1.30.0")
+		f.Add(workload, "QUEUE_IMAGE", "")
+	}
+	f.Fuzz(func(t *testing.T, manifest, name, value string) {
+		u := fuzzUnstructured(t, manifest)
+		env, err := envToUnstructured([]corev1.EnvVar{{Name: name, Value: value}})
+		if err != nil {
+			t.Fatalf("envToUnstructured() = %v", err)
+		}
+		in := u.DeepCopy()
+		if err := mergePodSpecEnv(u, env); err != nil {
+			return
+		}
+
+		path, ok := podSpecPaths[u.GetKind()]
+		if !ok {
+			if diff := cmp.Diff(in.Object, u.Object); diff != "" {
+				t.Fatalf("Resource of kind %q changed (-want, +got): %s", u.GetKind(), diff)
+			}
+			return
+		}
+		for _, field := range []string{"containers", "initContainers"} {
+			containers, _, _ := unstructured.NestedSlice(u.Object, append(append([]string{}, path...), field)...)
+			for _, c := range containers {
+				if !hasEnvValue(c.(map[string]interface{}), name, value) {
+					t.Fatalf("%s %v misses the env var %s=%s", field, c, name, value)
+				}
+			}
+		}
+		// Only the env vars of the containers are owned by the merge.
+		if diff := cmp.Diff(withoutContainerFields(t, in, "env"), withoutContainerFields(t, u, "env")); diff != "" {
+			t.Fatalf("Fields other than the env vars changed (-want, +got): %s", diff)
+		}
+	})
+}
+
+func FuzzResourceOverrides(f *testing.F) {
+	for _, workload := range fuzzWorkloads {
+		f.Add(workload, "activator", "500m", "1Gi")
+		f.Add(workload, "", "0", "-1")
+	}
+	f.Fuzz(func(t *testing.T, manifest, container, cpu, memory string) {
+		u := fuzzUnstructured(t, manifest)
+		cpuQuantity, err := resource.ParseQuantity(cpu)
+		if err != nil {
+			return
+		}
+		memoryQuantity, err := resource.ParseQuantity(memory)
+		if err != nil {
+			return
+		}
+		name := u.GetName()
+		if u.GetKind() == "Job" {
+			name = u.GetGenerateName()
+		}
+		overrides := []base.WorkloadOverride{{
+			Name: name,
+			Resources: []base.ResourceRequirementsOverride{{
+				Container: container,
+				ResourceRequirements: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: cpuQuantity},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: memoryQuantity},
+				},
+			}},
+		}}
+		want, err := typedRoundTrip(u)
+		if err != nil {
+			return
+		}
+		in := u.DeepCopy()
+		if err := OverridesTransform(overrides, zap.NewNop().Sugar())(u); err != nil {
+			return
+		}
+
+		// The overrides convert only the deployments, stateful sets and jobs to their typed representation.
+		path := podSpecPaths[u.GetKind()]
+		if u.GetKind() != "Deployment" && u.GetKind() != "StatefulSet" && u.GetKind() != "Job" {
+			if diff := cmp.Diff(in.Object, u.Object); diff != "" {
+				t.Fatalf("Resource of kind %q changed (-want, +got): %s", u.GetKind(), diff)
+			}
+			return
+		}
+		containers, _, _ := unstructured.NestedSlice(u.Object, append(append([]string{}, path...), "containers")...)
+		for _, c := range containers {
+			c := c.(map[string]interface{})
+			if c["name"] != container {
+				continue
+			}
+			got, _, _ := unstructured.NestedString(c, "resources", "requests", "cpu")
+			if got != cpuQuantity.String() {
+				t.Fatalf("CPU request of the container %s = %q, want %q", container, got, cpuQuantity.String())
+			}
+		}
+		// Besides the resources of the containers, the override initializes the labels and annotations.
+		if diff := cmp.Diff(withoutOverridden(t, want), withoutOverridden(t, u)); diff != "" {
+			t.Fatalf("Fields other than the resources changed (-want, +got): %s", diff)
+		}
+	})
+}
+
+func FuzzImageTransform(f *testing.F) {
+	for _, workload := range fuzzWorkloads {
+		f.Add(workload, "gcr.io/knative-releases/*", "registry.example.com/knative/*", "")
+		f.Add(workload, "gcr.io/knative-releases/queue", "mirror.example.com/queue", "registry.example.com/${NAME}:v1")
+		f.Add(workload, "*", "", "${NAME}")
+	}
+	f.Fuzz(func(t *testing.T, manifest, from, to, registryDefault string) {
+		u := fuzzUnstructured(t, manifest)
+		registry := &base.Registry{
+			Default:  registryDefault,
+			Rewrites: []base.ImageRewrite{{From: from, To: to}},
+		}
+		want, err := typedRoundTrip(u)
+		if err != nil {
+			return
+		}
+		if err := ImageTransform(registry, zap.NewNop().Sugar())(u); err != nil {
+			return
+		}
+		if _, ok := podSpecPaths[u.GetKind()]; !ok {
+			return
+		}
+		// The transform owns the images and the env vars holding images.
+		if diff := cmp.Diff(withoutContainerFields(t, want, "image", "env"), withoutContainerFields(t, u, "image", "env")); diff != "" {
+			t.Fatalf("Fields other than the images changed (-want, +got): %s", diff)
+		}
+	})
+}
+
+// fuzzUnstructured parses the manifest of the fuzzer, or skips the input, if it is not a resource.
+func fuzzUnstructured(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	obj := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil || len(obj) == 0 {
+		t.Skip("Not a resource")
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+// typedRoundTrip converts the workload to its typed representation and back, the same way the
+// transformers do, without changing it.
+func typedRoundTrip(u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	gvk := u.GroupVersionKind()
+	if gvk.Version == "" {
+		return u.DeepCopy(), nil
+	}
+	obj, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return u.DeepCopy(), nil
+	}
+	if err := scheme.Scheme.Convert(u, obj, nil); err != nil {
+		return nil, err
+	}
+	result := &unstructured.Unstructured{}
+	if err := scheme.Scheme.Convert(obj, result, nil); err != nil {
+		return nil, err
+	}
+	result.SetCreationTimestamp(metav1.Time{})
+	return result, nil
+}
+
+// withoutContainerFields removes the fields from all the containers and init containers of the workload.
+func withoutContainerFields(t *testing.T, u *unstructured.Unstructured, fields ...string) map[string]interface{} {
+	t.Helper()
+	obj := runtime.DeepCopyJSON(u.Object)
+	path, ok := podSpecPaths[u.GetKind()]
+	if !ok {
+		return obj
+	}
+	for _, field := range []string{"containers", "initContainers"} {
+		containers, found, err := unstructured.NestedSlice(obj, append(append([]string{}, path...), field)...)
+		if err != nil || !found {
+			continue
+		}
+		for _, c := range containers {
+			if c, ok := c.(map[string]interface{}); ok {
+				for _, f := range fields {
+					delete(c, f)
+				}
+			}
+		}
+		if err := unstructured.SetNestedSlice(obj, containers, append(append([]string{}, path...), field)...); err != nil {
+			t.Fatalf("SetNestedSlice() = %v", err)
+		}
+	}
+	return obj
+}
+
+// withoutOverridden removes the fields, which the override of the resources owns or initializes.
+func withoutOverridden(t *testing.T, u *unstructured.Unstructured) map[string]interface{} {
+	t.Helper()
+	obj := withoutContainerFields(t, u, "resources")
+	unstructured.RemoveNestedField(obj, "metadata", "labels")
+	unstructured.RemoveNestedField(obj, "metadata", "annotations")
+	if path, ok := podSpecPaths[u.GetKind()]; ok {
+		template := path[:len(path)-1]
+		unstructured.RemoveNestedField(obj, append(append([]string{}, template...), "metadata", "labels")...)
+		unstructured.RemoveNestedField(obj, append(append([]string{}, template...), "metadata", "annotations")...)
+	}
+	return obj
+}
+
+func hasEnvValue(container map[string]interface{}, name, value string) bool {
+	env, _, _ := unstructured.NestedSlice(container, "env")
+	for _, e := range env {
+		if envName(e) == name {
+			v, _ := e.(map[string]interface{})["value"].(string)
+			return v == value
+		}
+	}
+	return false
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package common
 
 import (
+	"slices"
+
 	mf "github.com/manifestival/manifestival"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -24,12 +26,30 @@ import (
 	"knative.dev/operator/pkg/apis/operator/base"
 )
 
-func haUnSupported(name string) bool {
+// HighAvailabilitySingleReplica returns whether the deployment must run a single replica, so that it
+// is excluded from spec.high-availability. StatefulSets, e.g. the dispatchers of Kafka, are never
+// scaled by it.
+func HighAvailabilitySingleReplica(name string) bool {
 	return sets.NewString(
 		"pingsource-mt-adapter",
 	).Has(name)
 }
 
+// HighAvailabilityReplicas returns the replicas of spec.high-availability for the deployment: the
+// ones of spec.high-availability.deployments or spec.high-availability.replicas, nil if the
+// deployment keeps the replicas of the manifest.
+func HighAvailabilityReplicas(ha *base.HighAvailability, name string) *int32 {
+	if ha == nil || HighAvailabilitySingleReplica(name) || slices.Contains(ha.Exclude, name) {
+		return nil
+	}
+	for _, deployment := range ha.Deployments {
+		if deployment.Name == name {
+			return &deployment.Replicas
+		}
+	}
+	return ha.Replicas
+}
+
 // HighAvailabilityTransform mutates configmaps and replicacounts of certain
 // controllers when HA control plane is specified.
 func HighAvailabilityTransform(obj base.KComponent) mf.Transformer {
@@ -43,23 +63,33 @@ func HighAvailabilityTransform(obj base.KComponent) mf.Transformer {
 
 		// stash the HA object
 		ha := obj.GetSpec().GetHighAvailability()
-		if ha == nil || ha.Replicas == nil {
+		if ha == nil {
 			return nil
 		}
 
-		replicas := int64(*ha.Replicas)
-
 		// Transform deployments that support HA.
-		if u.GetKind() == "Deployment" && !haUnSupported(u.GetName()) && !hasHorizontalPodOrCustomAutoscaler(u.GetName()) {
-			if err := unstructured.SetNestedField(u.Object, replicas, "spec", "replicas"); err != nil {
-				return err
+		if u.GetKind() == "Deployment" && !hasHorizontalPodOrCustomAutoscaler(u.GetName()) {
+			if replicas := HighAvailabilityReplicas(ha, u.GetName()); replicas != nil {
+				if err := unstructured.SetNestedField(u.Object, int64(*replicas), "spec", "replicas"); err != nil {
+					return err
+				}
 			}
 		}
 
 		if u.GetKind() == "HorizontalPodAutoscaler" {
-			if err := hpaTransform(u, replicas); err != nil {
+			// The HPA scales the deployment of its target.
+			target, _, err := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "name")
+			if err != nil {
 				return err
 			}
+			if target == "" {
+				target = u.GetName()
+			}
+			if replicas := HighAvailabilityReplicas(ha, target); replicas != nil {
+				if err := hpaTransform(u, int64(*replicas)); err != nil {
+					return err
+				}
+			}
 		}
 
 		return nil
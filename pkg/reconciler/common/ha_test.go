@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 
 	util "knative.dev/operator/pkg/reconciler/common/testing"
 )
@@ -94,6 +95,52 @@ func TestHighAvailabilityTransform(t *testing.T) {
 		config:   nil,
 		in:       makeUnstructuredHPA(t, "activator", 2, 2),
 		expected: makeUnstructuredHPA(t, "activator", 2, 2),
+	}, {
+		name: "HA; replicas of the deployment",
+		config: &base.HighAvailability{
+			Replicas:    ptr.To(int32(2)),
+			Deployments: []base.HighAvailabilityDeployment{{Name: "autoscaler", Replicas: 3}},
+		},
+		in:       makeUnstructuredDeployment(t, "autoscaler"),
+		expected: makeUnstructuredDeploymentReplicas(t, "autoscaler", 3),
+	}, {
+		name: "HA; replicas of another deployment",
+		config: &base.HighAvailability{
+			Deployments: []base.HighAvailabilityDeployment{{Name: "autoscaler", Replicas: 3}},
+		},
+		in:       makeUnstructuredDeployment(t, "controller"),
+		expected: makeUnstructuredDeployment(t, "controller"),
+	}, {
+		name: "HA; excluded deployment",
+		config: &base.HighAvailability{
+			Replicas: ptr.To(int32(2)),
+			Exclude:  []string{"controller"},
+		},
+		in:       makeUnstructuredDeployment(t, "controller"),
+		expected: makeUnstructuredDeployment(t, "controller"),
+	}, {
+		name: "HA; single replica deployment",
+		config: &base.HighAvailability{
+			Deployments: []base.HighAvailabilityDeployment{{Name: "pingsource-mt-adapter", Replicas: 3}},
+		},
+		in:       makeUnstructuredDeployment(t, "pingsource-mt-adapter"),
+		expected: makeUnstructuredDeployment(t, "pingsource-mt-adapter"),
+	}, {
+		name: "HA; hpa of the deployment",
+		config: &base.HighAvailability{
+			Replicas:    ptr.To(int32(2)),
+			Deployments: []base.HighAvailabilityDeployment{{Name: "activator", Replicas: 4}},
+		},
+		in:       makeUnstructuredHPA(t, "activator", 1, 4),
+		expected: makeUnstructuredHPA(t, "activator", 4, 7),
+	}, {
+		name: "HA; hpa of an excluded deployment",
+		config: &base.HighAvailability{
+			Replicas: ptr.To(int32(2)),
+			Exclude:  []string{"activator"},
+		},
+		in:       makeUnstructuredHPA(t, "activator", 1, 4),
+		expected: makeUnstructuredHPA(t, "activator", 1, 4),
 	}}
 
 	for _, tc := range cases {
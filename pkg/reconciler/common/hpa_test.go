@@ -19,10 +19,7 @@ package common
 import (
 	"testing"
 
-	v2 "k8s.io/api/autoscaling/v2"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/client-go/kubernetes/scheme"
 	util "knative.dev/operator/pkg/reconciler/common/testing"
 )
 
@@ -76,21 +73,6 @@ func TestGetHPAName(t *testing.T) {
 }
 
 func makeUnstructuredHPA(t *testing.T, name string, minReplicas, maxReplicas int32) *unstructured.Unstructured {
-	hpa := &v2.HorizontalPodAutoscaler{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
-		},
-		Spec: v2.HorizontalPodAutoscalerSpec{
-			MinReplicas: &minReplicas,
-			MaxReplicas: maxReplicas,
-		},
-	}
-
-	result := &unstructured.Unstructured{}
-	err := scheme.Scheme.Convert(hpa, result, nil)
-	if err != nil {
-		t.Fatalf("Could not create unstructured HPA: %v, err: %v", hpa, err)
-	}
-
-	return result
+	result := util.MakeUnstructured(t, util.MakeHPA(name, minReplicas, maxReplicas))
+	return &result
 }
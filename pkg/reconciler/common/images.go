@@ -41,8 +41,16 @@ var (
 	// The string to be replaced by the container name
 	containerNameVariable = "${NAME}"
 	delimiter             = "/"
+	// The suffix of the prefixes in spec.registry.rewrites
+	rewriteWildcard = "*"
 )
 
+// configMapImages are the keys of the ConfigMaps, which hold images, by the name of the image, which
+// spec.registry.rewrites can except them with.
+var configMapImages = map[string]map[string]string{
+	"config-deployment": {"queue-sidecar-image": "queue-proxy"},
+}
+
 // ImageTransform updates image with a new registry and tag
 func ImageTransform(registry *base.Registry, log *zap.SugaredLogger) mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
@@ -50,6 +58,12 @@ func ImageTransform(registry *base.Registry, log *zap.SugaredLogger) mf.Transfor
 		if u.GetKind() == "Image" && u.GetAPIVersion() == "caching.internal.knative.dev/v1alpha1" {
 			return updateCachingImage(registry, u, log)
 		}
+		if u.GetKind() == "ConfigMap" {
+			return updateConfigMapImages(registry, u)
+		}
+		if u.GetKind() == "ServiceAccount" {
+			return updateServiceAccount(registry, u)
+		}
 
 		// Handle all resources that contain a PodSpec.
 		var podSpec *corev1.PodSpec
@@ -108,6 +122,8 @@ func ImageTransform(registry *base.Registry, log *zap.SugaredLogger) mf.Transfor
 				container.Image = image
 			} else if image, ok := registry.Override[container.Name]; ok {
 				container.Image = image
+			} else if image, ok := rewriteImage(registry, container.Image, container.Name, objName+delimiter+container.Name); ok {
+				container.Image = image
 			} else if registry.Default != "" {
 				// No matches found. Use default setting and replace potential container name placeholder.
 				imageName := getImageName(container.Image)
@@ -122,6 +138,8 @@ func ImageTransform(registry *base.Registry, log *zap.SugaredLogger) mf.Transfor
 				if image, ok := registry.Override[env.Name]; ok {
 					env.Value = image
 					env.ValueFrom = nil
+				} else if image, ok := rewriteImage(registry, env.Value, env.Name); ok {
+					env.Value = image
 				}
 			}
 		}
@@ -156,6 +174,8 @@ func updateCachingImage(registry *base.Registry, u *unstructured.Unstructured, l
 	// Replace direct image YAML references.
 	if image, ok := registry.Override[img.Name]; ok {
 		img.Spec.Image = image
+	} else if image, ok := rewriteImage(registry, img.Spec.Image, img.Name); ok {
+		img.Spec.Image = image
 	} else if registry.Default != "" {
 		// No matches found. Use default setting and replace potential container name placeholder.
 		imageName := getImageName(img.Spec.Image)
@@ -182,6 +202,105 @@ func updateCachingImage(registry *base.Registry, u *unstructured.Unstructured, l
 	return nil
 }
 
+// updateServiceAccount attaches the image pull secrets to the service account, if
+// spec.registry.serviceAccountPullSecrets enables it. The secrets attached already are kept.
+func updateServiceAccount(registry *base.Registry, u *unstructured.Unstructured) error {
+	if !registry.ServiceAccountPullSecrets || len(registry.ImagePullSecrets) == 0 {
+		return nil
+	}
+	secrets, _, err := unstructured.NestedSlice(u.Object, "imagePullSecrets")
+	if err != nil {
+		return err
+	}
+	attached := map[string]bool{}
+	for _, s := range secrets {
+		if secret, ok := s.(map[string]interface{}); ok {
+			name, _ := secret["name"].(string)
+			attached[name] = true
+		}
+	}
+	for _, secret := range registry.ImagePullSecrets {
+		if !attached[secret.Name] {
+			attached[secret.Name] = true
+			secrets = append(secrets, map[string]interface{}{"name": secret.Name})
+		}
+	}
+	return unstructured.SetNestedSlice(u.Object, secrets, "imagePullSecrets")
+}
+
+// updateConfigMapImages rewrites the images held by the keys of the ConfigMaps in configMapImages.
+// The values configured in spec.config are transformed later and take precedence.
+func updateConfigMapImages(registry *base.Registry, u *unstructured.Unstructured) error {
+	keys, ok := configMapImages[u.GetName()]
+	if !ok || len(registry.Rewrites) == 0 {
+		return nil
+	}
+	for key, name := range keys {
+		value, found, err := unstructured.NestedString(u.Object, "data", key)
+		if err != nil || !found {
+			continue
+		}
+		if image, ok := rewriteImage(registry, value, name); ok {
+			if err := unstructured.SetNestedField(u.Object, image, "data", key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rewriteImage applies the rule of spec.registry.rewrites with the longest prefix matching the
+// image, unless the rule excepts one of the names, which the image is known by. A prefix without the
+// wildcard matches the repository of the image, whose tag or digest is kept.
+func rewriteImage(registry *base.Registry, image string, names ...string) (string, bool) {
+	var rule *base.ImageRewrite
+	for i := range registry.Rewrites {
+		r := &registry.Rewrites[i]
+		if !matchesRewrite(r.From, image) || exceptsRewrite(r, names) {
+			continue
+		}
+		if rule == nil || len(r.From) > len(rule.From) {
+			rule = r
+		}
+	}
+	if rule == nil {
+		return "", false
+	}
+	if prefix, ok := strings.CutSuffix(rule.From, rewriteWildcard); ok {
+		return strings.TrimSuffix(rule.To, rewriteWildcard) + strings.TrimPrefix(image, prefix), true
+	}
+	return rule.To + strings.TrimPrefix(image, rule.From), true
+}
+
+func matchesRewrite(from, image string) bool {
+	if prefix, ok := strings.CutSuffix(from, rewriteWildcard); ok {
+		return prefix != "" && strings.HasPrefix(image, prefix)
+	}
+	return from != "" && imageRepository(image) == from
+}
+
+func exceptsRewrite(rule *base.ImageRewrite, names []string) bool {
+	for _, except := range rule.Except {
+		for _, name := range names {
+			if except == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// imageRepository returns the image without its tag or digest.
+func imageRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, delimiter) {
+		image = image[:i]
+	}
+	return image
+}
+
 func getImageName(fullImageURL string) string {
 	if !strings.Contains(fullImageURL, "/") {
 		return ""
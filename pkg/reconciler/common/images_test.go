@@ -21,6 +21,7 @@ import (
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	caching "knative.dev/caching/pkg/apis/caching/v1alpha1"
 	"knative.dev/operator/pkg/apis/operator/base"
@@ -229,6 +230,82 @@ func TestResourceTransform(t *testing.T) {
 			Name:  "container2",
 			Image: "new-registry.io/test/path/OverrideWithDeploymentName/container-2:new-tag",
 		}},
+	}, {
+		name: "RewritesPrefix",
+		containers: []corev1.Container{{
+			Name:  "queue",
+			Image: "gcr.io/knative-releases/knative.dev/serving/cmd/queue@sha256:1e40c99ff5977daa2d69873fff604c6d09651af1f9ff15aadf8849b3ee77ab45",
+			Env:   []corev1.EnvVar{{Name: "SOME_IMAGE", Value: "gcr.io/knative-releases/knative.dev/serving/cmd/migrate:v1.21.0"}},
+		}},
+		registry: base.Registry{
+			Default:  "new-registry.io/test/path/${NAME}:new-tag",
+			Rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/*", To: "registry.corp/knative/*"}},
+		},
+		expected: []corev1.Container{{
+			Name:  "queue",
+			Image: "registry.corp/knative/knative.dev/serving/cmd/queue@sha256:1e40c99ff5977daa2d69873fff604c6d09651af1f9ff15aadf8849b3ee77ab45",
+			Env:   []corev1.EnvVar{{Name: "SOME_IMAGE", Value: "registry.corp/knative/knative.dev/serving/cmd/migrate:v1.21.0"}},
+		}},
+	}, {
+		name: "RewritesLongestPrefix",
+		containers: []corev1.Container{{
+			Name:  "queue",
+			Image: "gcr.io/knative-releases/knative.dev/serving/cmd/queue:v1.21.0",
+		}, {
+			Name:  "controller",
+			Image: "gcr.io/knative-releases/knative.dev/serving/cmd/controller:v1.21.0",
+		}},
+		registry: base.Registry{
+			Rewrites: []base.ImageRewrite{
+				{From: "gcr.io/knative-releases/*", To: "registry.corp/knative/*"},
+				{From: "gcr.io/knative-releases/knative.dev/serving/cmd/queue", To: "registry.corp/queue-proxy"},
+			},
+		},
+		expected: []corev1.Container{{
+			Name:  "queue",
+			Image: "registry.corp/queue-proxy:v1.21.0",
+		}, {
+			Name:  "controller",
+			Image: "registry.corp/knative/knative.dev/serving/cmd/controller:v1.21.0",
+		}},
+	}, {
+		name: "RewritesExceptAndOverride",
+		containers: []corev1.Container{{
+			Name:  "container1",
+			Image: "gcr.io/knative-releases/cmd/queue:test",
+		}, {
+			Name:  "container2",
+			Image: "gcr.io/knative-releases/cmd/queue:test",
+		}, {
+			Name:  "container3",
+			Image: "gcr.io/knative-releases/cmd/queue:test",
+		}, {
+			Name:  "container4",
+			Image: "docker.io/cmd/queue:test",
+		}},
+		registry: base.Registry{
+			Override: map[string]string{
+				"container1": "new-registry.io/test/path/new-container-1:new-tag",
+			},
+			Rewrites: []base.ImageRewrite{{
+				From:   "gcr.io/knative-releases/*",
+				To:     "registry.corp/knative/*",
+				Except: []string{"RewritesExceptAndOverride/container2"},
+			}},
+		},
+		expected: []corev1.Container{{
+			Name:  "container1",
+			Image: "new-registry.io/test/path/new-container-1:new-tag",
+		}, {
+			Name:  "container2",
+			Image: "gcr.io/knative-releases/cmd/queue:test",
+		}, {
+			Name:  "container3",
+			Image: "registry.corp/knative/cmd/queue:test",
+		}, {
+			Name:  "container4",
+			Image: "docker.io/cmd/queue:test",
+		}},
 	}} {
 		t.Run(tt.name, func(t *testing.T) {
 			transform := ImageTransform(&tt.registry, log)
@@ -343,6 +420,24 @@ func TestImageTransform(t *testing.T) {
 				{Name: "new-secret"},
 			},
 		},
+	}, {
+		name: "RewritesImage",
+		in:   "gcr.io/knative-releases/github.com/knative/serving/cmd/queue:v1.2.0",
+		registry: base.Registry{
+			Rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/*", To: "registry.corp/knative/*"}},
+		},
+		expected: caching.ImageSpec{
+			Image: "registry.corp/knative/github.com/knative/serving/cmd/queue:v1.2.0",
+		},
+	}, {
+		name: "ExceptsImage",
+		in:   "gcr.io/knative-releases/github.com/knative/serving/cmd/queue:v1.2.0",
+		registry: base.Registry{
+			Rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/*", To: "registry.corp/knative/*", Except: []string{"ExceptsImage"}}},
+		},
+		expected: caching.ImageSpec{
+			Image: "gcr.io/knative-releases/github.com/knative/serving/cmd/queue:v1.2.0",
+		},
 	}} {
 		t.Run(tt.name, func(t *testing.T) {
 			unstructuredImage := util.MakeUnstructured(t, util.MakeImage(tt.name, tt.in))
@@ -435,3 +530,81 @@ func TestImagePullSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigMapImageRewrite(t *testing.T) {
+	registry := &base.Registry{
+		Rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/*", To: "registry.corp/knative/*"}},
+	}
+	for _, tt := range []struct {
+		name     string
+		data     map[string]string
+		expected map[string]string
+	}{{
+		name:     "config-deployment",
+		data:     map[string]string{"queue-sidecar-image": "gcr.io/knative-releases/knative.dev/serving/cmd/queue:v1.21.0", "progress-deadline": "600s"},
+		expected: map[string]string{"queue-sidecar-image": "registry.corp/knative/knative.dev/serving/cmd/queue:v1.21.0", "progress-deadline": "600s"},
+	}, {
+		name:     "config-other",
+		data:     map[string]string{"queue-sidecar-image": "gcr.io/knative-releases/knative.dev/serving/cmd/queue:v1.21.0"},
+		expected: map[string]string{"queue-sidecar-image": "gcr.io/knative-releases/knative.dev/serving/cmd/queue:v1.21.0"},
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			u := util.MakeUnstructured(t, &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: tt.name},
+				Data:       tt.data,
+			})
+			err := ImageTransform(registry, log)(&u)
+			util.AssertEqual(t, err, nil)
+			cm := &corev1.ConfigMap{}
+			err = scheme.Scheme.Convert(&u, cm, nil)
+			util.AssertEqual(t, err, nil)
+			util.AssertDeepEqual(t, cm.Data, tt.expected)
+		})
+	}
+}
+
+func TestServiceAccountPullSecrets(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		existingSecrets []corev1.LocalObjectReference
+		registry        base.Registry
+		expectedSecrets []corev1.LocalObjectReference
+	}{{
+		name:            "NotAttachedByDefault",
+		existingSecrets: []corev1.LocalObjectReference{{Name: "existing-secret"}},
+		registry: base.Registry{
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "new-secret"}},
+		},
+		expectedSecrets: []corev1.LocalObjectReference{{Name: "existing-secret"}},
+	}, {
+		name: "AttachesImagePullSecrets",
+		registry: base.Registry{
+			ImagePullSecrets:          []corev1.LocalObjectReference{{Name: "new-secret-1"}, {Name: "new-secret-2"}},
+			ServiceAccountPullSecrets: true,
+		},
+		expectedSecrets: []corev1.LocalObjectReference{{Name: "new-secret-1"}, {Name: "new-secret-2"}},
+	}, {
+		name:            "MergesWithAttachedSecrets",
+		existingSecrets: []corev1.LocalObjectReference{{Name: "existing-secret"}, {Name: "new-secret"}},
+		registry: base.Registry{
+			ImagePullSecrets:          []corev1.LocalObjectReference{{Name: "new-secret"}, {Name: "other-secret"}},
+			ServiceAccountPullSecrets: true,
+		},
+		expectedSecrets: []corev1.LocalObjectReference{{Name: "existing-secret"}, {Name: "new-secret"}, {Name: "other-secret"}},
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			u := util.MakeUnstructured(t, &corev1.ServiceAccount{
+				TypeMeta:         metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+				ObjectMeta:       metav1.ObjectMeta{Name: "controller", Namespace: "knative-serving"},
+				ImagePullSecrets: tt.existingSecrets,
+			})
+			err := ImageTransform(&tt.registry, log)(&u)
+			util.AssertEqual(t, err, nil)
+			sa := &corev1.ServiceAccount{}
+			err = scheme.Scheme.Convert(&u, sa, nil)
+			util.AssertEqual(t, err, nil)
+			util.AssertDeepEqual(t, sa.ImagePullSecrets, tt.expectedSecrets)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+)
+
+// TrimForEnqueue is a cache.TransformFunc for the informers, which only trigger the reconciliation
+// of the owning Knative component. It drops everything from the cached objects except the metadata
+// needed to enqueue the owner, because the operator reads the resources themselves from the API
+// server. The listers of such informers must not be used to read the full resources.
+func TrimForEnqueue(obj interface{}) (interface{}, error) {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return &appsv1.Deployment{TypeMeta: o.TypeMeta, ObjectMeta: trimObjectMeta(o.ObjectMeta)}, nil
+	case *corev1.ConfigMap:
+		return &corev1.ConfigMap{TypeMeta: o.TypeMeta, ObjectMeta: trimObjectMeta(o.ObjectMeta)}, nil
+	case *corev1.Secret:
+		return &corev1.Secret{TypeMeta: o.TypeMeta, ObjectMeta: trimObjectMeta(o.ObjectMeta)}, nil
+	case metav1.Object:
+		o.SetManagedFields(nil)
+		return o, nil
+	}
+	// E.g. cache.DeletedFinalStateUnknown, which only wraps an already trimmed object.
+	return obj, nil
+}
+
+func trimObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            meta.Name,
+		Namespace:       meta.Namespace,
+		UID:             meta.UID,
+		ResourceVersion: meta.ResourceVersion,
+		Generation:      meta.Generation,
+		Labels:          meta.Labels,
+		OwnerReferences: meta.OwnerReferences,
+	}
+}
+
+// EnqueueNamespace returns an event handler, which enqueues the components returned by list for the
+// namespace of the handled object, e.g. the KnativeServings next to a changed KnativeNetworking.
+func EnqueueNamespace[T metav1.Object](impl *controller.Impl, list func(namespace string) ([]T, error)) func(interface{}) {
+	return func(obj interface{}) {
+		object, err := kmeta.DeletionHandlingAccessor(obj)
+		if err != nil {
+			return
+		}
+		components, err := list(object.GetNamespace())
+		if err != nil {
+			return
+		}
+		for _, component := range components {
+			impl.Enqueue(component)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgocache "k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestTrimForEnqueue(t *testing.T) {
+	meta := metav1.ObjectMeta{
+		Name:            "controller",
+		Namespace:       "knative-serving",
+		UID:             "uid",
+		ResourceVersion: "42",
+		Generation:      2,
+		Labels:          map[string]string{"app.kubernetes.io/name": "knative-serving"},
+		Annotations:     map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"},
+		OwnerReferences: []metav1.OwnerReference{{Kind: "KnativeServing", Name: "knative-serving"}},
+		ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "operator"}},
+	}
+	want := metav1.ObjectMeta{
+		Name:            "controller",
+		Namespace:       "knative-serving",
+		UID:             "uid",
+		ResourceVersion: "42",
+		Generation:      2,
+		Labels:          map[string]string{"app.kubernetes.io/name": "knative-serving"},
+		OwnerReferences: []metav1.OwnerReference{{Kind: "KnativeServing", Name: "knative-serving"}},
+	}
+
+	deployment := util.MakeDeployment("controller", corev1.PodSpec{Containers: []corev1.Container{{Name: "controller"}}})
+	deployment.ObjectMeta = meta
+	got, err := TrimForEnqueue(deployment)
+	if err != nil {
+		t.Fatalf("TrimForEnqueue() = %v", err)
+	}
+	util.AssertDeepEqual(t, got, &appsv1.Deployment{TypeMeta: deployment.TypeMeta, ObjectMeta: want})
+
+	cm := &corev1.ConfigMap{ObjectMeta: meta, Data: map[string]string{"key": "value"}}
+	got, err = TrimForEnqueue(cm)
+	if err != nil {
+		t.Fatalf("TrimForEnqueue() = %v", err)
+	}
+	util.AssertDeepEqual(t, got, &corev1.ConfigMap{ObjectMeta: want})
+
+	// The certificates of the webhooks are not cached.
+	secret := &corev1.Secret{ObjectMeta: meta, Data: map[string][]byte{"key": nil}}
+	got, err = TrimForEnqueue(secret)
+	if err != nil {
+		t.Fatalf("TrimForEnqueue() = %v", err)
+	}
+	util.AssertDeepEqual(t, got, &corev1.Secret{ObjectMeta: want})
+
+	service := &corev1.Service{ObjectMeta: *meta.DeepCopy(), Spec: corev1.ServiceSpec{ClusterIP: "10.0.0.1"}}
+	got, err = TrimForEnqueue(service)
+	if err != nil {
+		t.Fatalf("TrimForEnqueue() = %v", err)
+	}
+	util.AssertEqual(t, len(got.(*corev1.Service).ManagedFields), 0)
+	util.AssertEqual(t, got.(*corev1.Service).Spec.ClusterIP, "10.0.0.1")
+
+	tombstone := clientgocache.DeletedFinalStateUnknown{Key: "knative-serving/controller"}
+	got, err = TrimForEnqueue(tombstone)
+	if err != nil {
+		t.Fatalf("TrimForEnqueue() = %v", err)
+	}
+	util.AssertDeepEqual(t, got, tombstone)
+}
+
+func TestEnqueueNamespace(t *testing.T) {
+	ctx := context.Background()
+	impl := controller.NewContext(ctx, nil, controller.ControllerOptions{WorkQueueName: "test", Logger: logging.FromContext(ctx)})
+	defer impl.WorkQueue().ShutDown()
+
+	var listed []string
+	handler := EnqueueNamespace(impl, func(namespace string) ([]*corev1.ConfigMap, error) {
+		listed = append(listed, namespace)
+		if namespace == "broken" {
+			return nil, errors.New("failed to list")
+		}
+		return []*corev1.ConfigMap{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "first"}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "second"}},
+		}, nil
+	})
+
+	handler(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "changed"}})
+	handler(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "broken", Name: "changed"}})
+	handler(clientgocache.DeletedFinalStateUnknown{
+		Key: "deleted/changed",
+		Obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "deleted", Name: "changed"}},
+	})
+	util.AssertDeepEqual(t, listed, []string{"knative-serving", "broken", "deleted"})
+	util.AssertEqual(t, impl.WorkQueue().Len(), 4)
+}
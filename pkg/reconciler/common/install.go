@@ -18,8 +18,10 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	mf "github.com/manifestival/manifestival"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -27,6 +29,7 @@ import (
 
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/logging"
 )
 
@@ -36,6 +39,8 @@ var (
 	webhook                   mf.Predicate = mf.Any(mf.ByKind("MutatingWebhookConfiguration"), mf.ByKind("ValidatingWebhookConfiguration"))
 	webhookDependentResources mf.Predicate = byGV(schema.GroupKind{Group: "networking.internal.knative.dev", Kind: "Certificate"})
 	gatewayNotMatch                        = "no matches for kind \"Gateway\""
+	// prerequisites are applied before the other resources, when the manifest is applied concurrently.
+	prerequisites mf.Predicate = mf.Any(mf.ByKind("Namespace"), mf.ByKind("CustomResourceDefinition"))
 )
 
 // Install applies the manifest resources for the given version and updates the given
@@ -44,14 +49,15 @@ func Install(ctx context.Context, manifest *mf.Manifest, instance base.KComponen
 	logger := logging.FromContext(ctx)
 	logger.Debug("Installing manifest")
 	status := instance.GetStatus()
+	appliedHashes.retain(instance, *manifest)
 	// The Operator needs a higher level of permissions if it 'bind's non-existent roles.
 	// To avoid this, we strictly order the manifest application as (Cluster)Roles, then
 	// (Cluster)RoleBindings, then the rest of the manifest.
-	if err := manifest.Filter(role).Apply(); err != nil {
+	if err := apply(ctx, instance, manifest.Filter(role)); err != nil {
 		status.MarkInstallFailed(err.Error())
 		return fmt.Errorf("failed to apply (cluster)roles: %w", err)
 	}
-	if err := manifest.Filter(rolebinding).Apply(); err != nil {
+	if err := apply(ctx, instance, manifest.Filter(rolebinding)); err != nil {
 		status.MarkInstallFailed(err.Error())
 		return fmt.Errorf("failed to apply (cluster)rolebindings: %w", err)
 	}
@@ -63,10 +69,10 @@ func Install(ctx context.Context, manifest *mf.Manifest, instance base.KComponen
 	if err := InstallWebhookConfigs(ctx, manifest, instance); err != nil {
 		return err
 	}
-	if err := manifest.Filter(mf.Not(mf.Any(role, rolebinding, webhook, webhookDependentResources))).Apply(); err != nil {
+	if err := apply(ctx, instance, manifest.Filter(mf.Not(mf.Any(role, rolebinding, webhook, webhookDependentResources)))); err != nil {
 		status.MarkInstallFailed(err.Error())
-		if ks, ok := instance.(*v1beta1.KnativeServing); ok && strings.Contains(err.Error(), gatewayNotMatch) &&
-			(ks.Spec.Ingress == nil || ks.Spec.Ingress.Istio.Enabled) {
+		if ingress, ok := ingressOf(instance); ok && strings.Contains(err.Error(), gatewayNotMatch) &&
+			(ingress == nil || ingress.Istio.Enabled) {
 			errMessage := fmt.Errorf("please install istio or disable the istio ingress plugin: %w", err)
 			status.MarkInstallFailed(errMessage.Error())
 			return errMessage
@@ -77,11 +83,22 @@ func Install(ctx context.Context, manifest *mf.Manifest, instance base.KComponen
 	return nil
 }
 
+// ingressOf returns spec.ingress of the components, which install the ingresses of Knative Serving.
+func ingressOf(instance base.KComponent) (*v1beta1.IngressConfigs, bool) {
+	switch instance := instance.(type) {
+	case *v1beta1.KnativeServing:
+		return instance.Spec.Ingress, true
+	case *v1beta1.KnativeNetworking:
+		return instance.Spec.Ingress, true
+	}
+	return nil, false
+}
+
 // InstallWebhookConfigs applies the Webhook manifest resources and updates the given status accordingly.
 func InstallWebhookConfigs(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
 	logging.FromContext(ctx).Debug("Installing webhook configurations")
 	status := instance.GetStatus()
-	if err := manifest.Filter(webhook).Apply(); err != nil {
+	if err := apply(ctx, instance, manifest.Filter(webhook)); err != nil {
 		status.MarkInstallFailed(err.Error())
 		return fmt.Errorf("failed to apply webhooks: %w", err)
 	}
@@ -92,7 +109,7 @@ func InstallWebhookConfigs(ctx context.Context, manifest *mf.Manifest, instance
 func InstallWebhookDependentResources(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
 	logging.FromContext(ctx).Debug("Installing webhook dependent resources")
 	status := instance.GetStatus()
-	if err := manifest.Filter(webhookDependentResources).Apply(); err != nil {
+	if err := apply(ctx, instance, manifest.Filter(webhookDependentResources)); err != nil {
 		status.MarkInstallFailed(err.Error())
 		return fmt.Errorf("failed to apply webhooks: %w", err)
 	}
@@ -107,6 +124,20 @@ func MarkStatusSuccess(ctx context.Context, manifest *mf.Manifest, instance base
 	return nil
 }
 
+// ObserveGeneration sets status.observedGeneration to the generation of the component, once its
+// conditions reflect that generation: the stages ran to completion, i.e. err is nil, or they failed
+// and the component is not ready. After a transient error, which leaves the Ready condition of the
+// previous generation in place, the previous generation is kept, so that GitOps tools, e.g. Argo CD
+// or Flux, don't report a spec as healthy, which was never applied. It never decreases.
+func ObserveGeneration(instance base.KComponent, status *duckv1.Status, err error) {
+	if err != nil && instance.GetStatus().IsReady() {
+		return
+	}
+	if generation := instance.GetGeneration(); generation > status.ObservedGeneration {
+		status.ObservedGeneration = generation
+	}
+}
+
 // Uninstall removes all resources except CRDs, which are never deleted automatically.
 func Uninstall(manifest *mf.Manifest) error {
 	if err := manifest.Filter(mf.NoCRDs, mf.Not(mf.Any(role, rolebinding))).Delete(mf.IgnoreNotFound(true)); err != nil {
@@ -119,6 +150,98 @@ func Uninstall(manifest *mf.Manifest) error {
 	return nil
 }
 
+// apply stamps the hash of their content on the resources of the manifest and applies them. With
+// the feature gate SkipUnchangedApply, the resources, which were applied with the same hash for the
+// Knative component before, are skipped without even reading them.
+func apply(ctx context.Context, instance base.KComponent, manifest mf.Manifest) error {
+	if FeatureEnabled(ctx, SkipUnchangedApply) {
+		manifest = manifest.Filter(mf.Not(appliedHashes.unchanged(instance)))
+	}
+	manifest, err := stampAppliedHashes(manifest)
+	if err != nil {
+		return err
+	}
+	if err := applyManifest(ctx, manifest); err != nil {
+		return err
+	}
+	appliedHashes.record(instance, manifest)
+	return nil
+}
+
+// applyManifest applies the resources of the manifest with the ApplyConcurrency of the
+// ControllerConfig, or in parallel with the feature gate ParallelApply, and with server-side apply
+// with the feature gate ServerSideApply. If resources are applied concurrently, namespaces and CRDs
+// are applied before all the others.
+func applyManifest(ctx context.Context, manifest mf.Manifest) error {
+	concurrency := GetControllerConfig(ctx).ApplyConcurrency
+	if concurrency <= 1 && FeatureEnabled(ctx, ParallelApply) {
+		concurrency = defaultParallelApplyConcurrency
+	}
+	applyOne := applyResource
+	applier, serverSide := manifest.Client.(ServerSideApplier)
+	if serverSide && FeatureEnabled(ctx, ServerSideApply) {
+		applyOne = func(_ mf.Client, u unstructured.Unstructured) error {
+			return applyServerSide(ctx, applier, u)
+		}
+	} else if concurrency <= 1 {
+		return manifest.Apply()
+	}
+	if concurrency <= 1 {
+		return forEachResource(manifest, func(u *unstructured.Unstructured) error {
+			return applyOne(manifest.Client, *u)
+		})
+	}
+	for _, phase := range []mf.Manifest{manifest.Filter(prerequisites), manifest.Filter(mf.Not(prerequisites))} {
+		if err := applyConcurrently(phase, concurrency, applyOne); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyConcurrently applies the resources of the manifest with at most concurrency workers and
+// returns all the errors.
+func applyConcurrently(manifest mf.Manifest, concurrency int, applyOne func(mf.Client, unstructured.Unstructured) error) error {
+	resources := make(chan unstructured.Unstructured)
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := range resources {
+				err := applyOne(manifest.Client, u)
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	_ = forEachResource(manifest, func(u *unstructured.Unstructured) error {
+		resources <- *u
+		return nil
+	})
+	close(resources)
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func applyResource(client mf.Client, u unstructured.Unstructured) error {
+	m, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{u}), mf.UseClient(client))
+	if err != nil {
+		return err
+	}
+	if err := m.Apply(); err != nil {
+		return fmt.Errorf("%s %s: %w", u.GetKind(), namespacedName(&u), err)
+	}
+	return nil
+}
+
 func byGV(gk schema.GroupKind) mf.Predicate {
 	return func(u *unstructured.Unstructured) bool {
 		return u.GroupVersionKind().GroupKind() == gk
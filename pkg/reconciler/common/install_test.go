@@ -19,12 +19,18 @@ package common
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 )
@@ -135,6 +141,64 @@ func TestInstallError(t *testing.T) {
 	}
 }
 
+func TestInstallConcurrently(t *testing.T) {
+	in := []unstructured.Unstructured{}
+	for i := 0; i < 10; i++ {
+		in = append(in, *NamespacedResource("apps/v1", "Deployment", "test", fmt.Sprintf("test-deployment-%d", i)))
+	}
+	in = append(in,
+		*ClusterScopedResource("apiextensions.k8s.io/v1", "CustomResourceDefinition", "test-crd"),
+		*ClusterScopedResource("v1", "Namespace", "test"),
+		*NamespacedResource("rbac.authorization.k8s.io/v1", "Role", "test", "test-role"))
+
+	var (
+		mu      sync.Mutex
+		creates []string
+	)
+	client := fake.Client{Stubs: fake.Stubs{
+		Get: func(u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+			return nil, apierrors.NewNotFound(schema.GroupResource{}, u.GetName())
+		},
+		Create: func(u *unstructured.Unstructured) error {
+			mu.Lock()
+			defer mu.Unlock()
+			creates = append(creates, u.GetKind())
+			if u.GetName() == "test-deployment-3" || u.GetName() == "test-deployment-7" {
+				return errors.New("test")
+			}
+			return nil
+		},
+	}}
+	manifest, err := mf.ManifestFrom(mf.Slice(in), mf.UseClient(client))
+	if err != nil {
+		t.Fatalf("Failed to generate manifest: %v", err)
+	}
+
+	instance := &v1beta1.KnativeEventing{}
+	ctx := WithControllerConfig(context.TODO(), ControllerConfig{ApplyConcurrency: 4})
+	err = Install(ctx, &manifest, instance)
+	if err == nil {
+		t.Fatal("Install() = nil, wanted an error")
+	}
+	for _, name := range []string{"test-deployment-3", "test-deployment-7"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("Install() = %v, want it to report %s", err, name)
+		}
+	}
+
+	// All resources are applied despite the errors, the role first and the namespace and CRD next.
+	if got, want := len(creates), len(in); got != want {
+		t.Fatalf("Got %d creates, want %d", got, want)
+	}
+	if creates[0] != "Role" {
+		t.Errorf("First create = %s, want Role", creates[0])
+	}
+	prerequisites := []string{creates[1], creates[2]}
+	if !cmp.Equal(prerequisites, []string{"CustomResourceDefinition", "Namespace"}) && !cmp.Equal(prerequisites, []string{"Namespace", "CustomResourceDefinition"}) {
+		t.Errorf("Creates after the role = %v, want the namespace and the CRD", prerequisites)
+	}
+}
+
 func TestUninstall(t *testing.T) {
 	// Resources in the manifest
 	deployment := *NamespacedResource("apps/v1", "Deployment", "test", "test-deployment")
@@ -194,3 +258,115 @@ func (f *fakeClient) Create(obj *unstructured.Unstructured, options ...mf.ApplyO
 func (f *fakeClient) Update(obj *unstructured.Unstructured, options ...mf.ApplyOption) error {
 	return f.err
 }
+
+// recordingServerSideApplier records the resources applied with server-side apply.
+type recordingServerSideApplier struct {
+	fake.Client
+
+	mu      sync.Mutex
+	applied []string
+}
+
+func (c *recordingServerSideApplier) ApplyServerSide(_ context.Context, obj *unstructured.Unstructured) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.applied = append(c.applied, obj.GetName())
+	if obj.GetName() == "test-deployment-1" {
+		return errors.New("test")
+	}
+	return nil
+}
+
+func TestInstallFeatureGates(t *testing.T) {
+	in := []unstructured.Unstructured{}
+	for i := 0; i < 3; i++ {
+		in = append(in, *NamespacedResource("apps/v1", "Deployment", "test", fmt.Sprintf("test-deployment-%d", i)))
+	}
+	tests := []struct {
+		name        string
+		gates       string
+		wantApplied int
+	}{{
+		name:        "server-side apply stops at the first error",
+		gates:       "ServerSideApply=true",
+		wantApplied: 2,
+	}, {
+		name:        "server-side apply in parallel",
+		gates:       "ServerSideApply=true,ParallelApply=true",
+		wantApplied: 3,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &recordingServerSideApplier{}
+			manifest, err := mf.ManifestFrom(mf.Slice(in), mf.UseClient(client))
+			if err != nil {
+				t.Fatalf("Failed to generate manifest: %v", err)
+			}
+			ctx := WithOperatorConfigStore(context.Background(), featureGateStore(test.gates))
+			if err := Install(ctx, &manifest, &v1beta1.KnativeEventing{}); err == nil || !strings.Contains(err.Error(), "test-deployment-1") {
+				t.Errorf("Install() = %v, want it to report test-deployment-1", err)
+			}
+			if got := len(client.applied); got != test.wantApplied {
+				t.Errorf("Got %d server-side applies, want %d", got, test.wantApplied)
+			}
+		})
+	}
+}
+
+func TestObserveGeneration(t *testing.T) {
+	ready := func() *v1beta1.KnativeServing {
+		ks := &v1beta1.KnativeServing{}
+		ks.Generation = 2
+		ks.Status.ObservedGeneration = 1
+		ks.Status.InitializeConditions()
+		ks.Status.MarkVersionMigrationEligible()
+		ks.Status.MarkDeploymentsAvailable()
+		ks.Status.MarkInstallSucceeded()
+		return ks
+	}
+	errTransient := errors.New("transient")
+
+	tests := []struct {
+		name string
+		ks   func() *v1beta1.KnativeServing
+		err  error
+		want int64
+	}{{
+		name: "completed",
+		ks:   ready,
+		want: 2,
+	}, {
+		name: "transient error, the Ready condition is the one of the previous generation",
+		ks:   ready,
+		err:  errTransient,
+		want: 1,
+	}, {
+		name: "failed",
+		ks: func() *v1beta1.KnativeServing {
+			ks := ready()
+			ks.Status.MarkInstallFailed("failed")
+			return ks
+		},
+		err:  errTransient,
+		want: 2,
+	}, {
+		name: "never decreases",
+		ks: func() *v1beta1.KnativeServing {
+			ks := ready()
+			ks.Status.ObservedGeneration = 3
+			return ks
+		},
+		want: 3,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := test.ks()
+			ObserveGeneration(ks, &ks.Status.Status, test.err)
+			if got := ks.Status.ObservedGeneration; got != test.want {
+				t.Errorf("ObservedGeneration = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
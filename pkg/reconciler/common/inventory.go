@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// InventoryConfigMapSuffix is appended to the name of the Knative component to name the
+	// ConfigMap, which lists the resources managed for it.
+	InventoryConfigMapSuffix = "-inventory"
+	// InventoryLabel marks the inventory ConfigMaps, its value is the lower-case kind of the
+	// Knative component.
+	InventoryLabel = "operator.knative.dev/inventory"
+	// InventoryResourcesKey is the key of the inventory ConfigMap containing the resources.
+	InventoryResourcesKey = "resources"
+	// InventoryDigestKey is the key of the inventory ConfigMap containing the digest of the
+	// applied resources, as in status.applied.digest.
+	InventoryDigestKey = "digest"
+
+	// maxInventorySize keeps the inventory ConfigMap well below the size limit of 1MiB.
+	maxInventorySize = 900 * 1024
+)
+
+// InventoryResource identifies a resource managed for a Knative component.
+type InventoryResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// InventoryConfigMapName returns the name of the ConfigMap listing the resources managed for the
+// Knative component.
+func InventoryConfigMapName(instance base.KComponent) string {
+	return instance.GetName() + InventoryConfigMapSuffix
+}
+
+// PublishInventory returns a Stage, which lists the resources of the applied manifest in a
+// ConfigMap next to the Knative component, so that external tools, e.g. for backups or policy
+// scans, discover the resources belonging to it. The ConfigMap is owned by the Knative component,
+// so it is deleted together with it. It runs after SetAppliedManifest.
+func PublishInventory(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		cm, err := inventoryConfigMap(manifest, instance)
+		if err != nil {
+			return err
+		}
+		return createOrUpdateConfigMap(ctx, kubeClient, cm)
+	}
+}
+
+// createOrUpdateConfigMap creates the ConfigMap, or updates the labels, the owner references and
+// the data of the existing one, if they differ.
+func createOrUpdateConfigMap(ctx context.Context, kubeClient kubernetes.Interface, cm *corev1.ConfigMap) error {
+	configMaps := kubeClient.CoreV1().ConfigMaps(cm.Namespace)
+	existing, err := configMaps.Get(ctx, cm.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(existing.Labels, cm.Labels) &&
+		equality.Semantic.DeepEqual(existing.OwnerReferences, cm.OwnerReferences) &&
+		equality.Semantic.DeepEqual(existing.Data, cm.Data) {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	existing.Labels = cm.Labels
+	existing.OwnerReferences = cm.OwnerReferences
+	existing.Data = cm.Data
+	_, err = configMaps.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+func inventoryConfigMap(manifest *mf.Manifest, instance base.KComponent) (*corev1.ConfigMap, error) {
+	resources := []InventoryResource{}
+	_ = forEachResource(*manifest, func(u *unstructured.Unstructured) error {
+		resources = append(resources, InventoryResource{
+			APIVersion: u.GetAPIVersion(),
+			Kind:       u.GetKind(),
+			Namespace:  u.GetNamespace(),
+			Name:       u.GetName(),
+		})
+		return nil
+	})
+	sort.Slice(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	resourcesYaml, err := yaml.Marshal(resources)
+	if err != nil {
+		return nil, err
+	}
+	if len(resourcesYaml) > maxInventorySize {
+		return nil, fmt.Errorf("the inventory of %d resources exceeds the size of a ConfigMap", len(resources))
+	}
+
+	data := map[string]string{InventoryResourcesKey: string(resourcesYaml)}
+	if applied := instance.GetStatus().GetAppliedManifest(); applied != nil && applied.Digest != "" {
+		data[InventoryDigestKey] = applied.Digest
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            InventoryConfigMapName(instance),
+			Namespace:       instance.GetNamespace(),
+			Labels:          map[string]string{InventoryLabel: strings.ToLower(instance.GroupVersionKind().Kind)},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(instance, instance.GroupVersionKind())},
+		},
+		Data: data,
+	}, nil
+}
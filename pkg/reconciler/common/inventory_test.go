@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestPublishInventory(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		util.MakeUnstructured(t, &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "webhook"},
+		}),
+		util.MakeUnstructured(t, &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "controller"},
+		}),
+		util.MakeUnstructured(t, &corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: "knative-serving"},
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	ks.Status.SetAppliedManifest(&base.AppliedManifest{Digest: "sha256:abc"})
+
+	kubeClient := kubefake.NewSimpleClientset()
+	stage := PublishInventory(kubeClient)
+	for i := 0; i < 2; i++ {
+		if err := stage(context.Background(), &manifest, ks); err != nil {
+			t.Fatalf("PublishInventory() = %v", err)
+		}
+	}
+	// The unchanged inventory is not updated again.
+	for _, action := range kubeClient.Actions() {
+		if action.GetVerb() == "update" {
+			t.Errorf("unexpected update of %v", action.(clientgotesting.UpdateAction).GetObject())
+		}
+	}
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("knative-serving").Get(context.Background(), "knative-serving-inventory", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	util.AssertEqual(t, cm.Labels[InventoryLabel], "knativeserving")
+	util.AssertEqual(t, len(cm.OwnerReferences), 1)
+	util.AssertEqual(t, cm.OwnerReferences[0].Kind, "KnativeServing")
+	util.AssertEqual(t, cm.Data[InventoryDigestKey], "sha256:abc")
+	var resources []InventoryResource
+	if err := yaml.Unmarshal([]byte(cm.Data[InventoryResourcesKey]), &resources); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+	util.AssertDeepEqual(t, resources, []InventoryResource{
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "knative-serving", Name: "controller"},
+		{APIVersion: "v1", Kind: "Namespace", Name: "knative-serving"},
+		{APIVersion: "v1", Kind: "Service", Namespace: "knative-serving", Name: "webhook"},
+	})
+
+	// The inventory follows the applied manifest.
+	ks.Status.SetAppliedManifest(&base.AppliedManifest{Digest: "sha256:def"})
+	if err := stage(context.Background(), &manifest, ks); err != nil {
+		t.Fatalf("PublishInventory() = %v", err)
+	}
+	cm, err = kubeClient.CoreV1().ConfigMaps("knative-serving").Get(context.Background(), "knative-serving-inventory", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	util.AssertEqual(t, cm.Data[InventoryDigestKey], "sha256:def")
+}
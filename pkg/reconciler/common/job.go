@@ -39,8 +39,11 @@ func JobTransform(obj base.KComponent) mf.Transformer {
 			}
 
 			component := "serving"
-			if _, ok := obj.(*v1beta1.KnativeEventing); ok {
+			switch obj.(type) {
+			case *v1beta1.KnativeEventing:
 				component = "eventing"
+			case *v1beta1.KnativeFunctions:
+				component = "functions"
 			}
 			if job.GetName() == "" {
 				job.SetName(fmt.Sprintf("%s%s-%s", job.GetGenerateName(), component, TargetVersion(obj)))
@@ -20,12 +20,8 @@ import (
 	"os"
 
 	mf "github.com/manifestival/manifestival"
-	appsv1 "k8s.io/api/apps/v1"
-	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/client-go/kubernetes/scheme"
 	"knative.dev/pkg/version"
 )
 
@@ -39,70 +35,16 @@ func KubernetesMinVersionTransform() mf.Transformer {
 		}
 	}
 
-	minVersionEnv := []corev1.EnvVar{{
+	// The env var is merged into the unstructured workloads directly, as the transformer runs on
+	// every workload in each reconciliation.
+	minVersionEnv, err := envToUnstructured([]corev1.EnvVar{{
 		Name:  version.KubernetesMinVersionKey,
 		Value: minVersion,
-	}}
-
+	}})
 	return func(u *unstructured.Unstructured) error {
-		var podSpec *corev1.PodSpec
-
-		switch u.GetKind() {
-		case "Deployment":
-			deployment := &appsv1.Deployment{}
-			if err := scheme.Scheme.Convert(u, deployment, nil); err != nil {
-				return err
-			}
-			podSpec = &deployment.Spec.Template.Spec
-			applyMinVersionEnvVar(podSpec, minVersionEnv)
-			if err := scheme.Scheme.Convert(deployment, u, nil); err != nil {
-				return err
-			}
-		case "StatefulSet":
-			ss := &appsv1.StatefulSet{}
-			if err := scheme.Scheme.Convert(u, ss, nil); err != nil {
-				return err
-			}
-			podSpec = &ss.Spec.Template.Spec
-			applyMinVersionEnvVar(podSpec, minVersionEnv)
-			if err := scheme.Scheme.Convert(ss, u, nil); err != nil {
-				return err
-			}
-		case "DaemonSet":
-			ds := &appsv1.DaemonSet{}
-			if err := scheme.Scheme.Convert(u, ds, nil); err != nil {
-				return err
-			}
-			podSpec = &ds.Spec.Template.Spec
-			applyMinVersionEnvVar(podSpec, minVersionEnv)
-			if err := scheme.Scheme.Convert(ds, u, nil); err != nil {
-				return err
-			}
-		case "Job":
-			job := &batchv1.Job{}
-			if err := scheme.Scheme.Convert(u, job, nil); err != nil {
-				return err
-			}
-			podSpec = &job.Spec.Template.Spec
-			applyMinVersionEnvVar(podSpec, minVersionEnv)
-			if err := scheme.Scheme.Convert(job, u, nil); err != nil {
-				return err
-			}
-		default:
-			return nil
+		if err != nil {
+			return err
 		}
-
-		// Avoid superfluous updates from converted zero defaults.
-		u.SetCreationTimestamp(metav1.Time{})
-		return nil
-	}
-}
-
-func applyMinVersionEnvVar(podSpec *corev1.PodSpec, minVersionEnv []corev1.EnvVar) {
-	for i := range podSpec.Containers {
-		mergeEnv(&minVersionEnv, &podSpec.Containers[i].Env)
-	}
-	for i := range podSpec.InitContainers {
-		mergeEnv(&minVersionEnv, &podSpec.InitContainers[i].Env)
+		return mergePodSpecEnv(u, minVersionEnv)
 	}
 }
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sleaderelection "k8s.io/client-go/tools/leaderelection"
+	"knative.dev/pkg/leaderelection"
+	"knative.dev/pkg/system"
+)
+
+const (
+	// LeaderElectionDisabledEnvKey is the environment variable to disable the leader election, e.g.
+	// for a single replica in a development environment.
+	LeaderElectionDisabledEnvKey = "LEADER_ELECTION_DISABLED"
+	// LeaseDurationEnvKey is the environment variable to specify how long the other replicas wait,
+	// before they try to take over the lease of the leader.
+	LeaseDurationEnvKey = "LEADER_ELECTION_LEASE_DURATION"
+	// RenewDeadlineEnvKey is the environment variable to specify how long the leader tries to renew
+	// its lease, before it gives up the leadership.
+	RenewDeadlineEnvKey = "LEADER_ELECTION_RENEW_DEADLINE"
+	// RetryPeriodEnvKey is the environment variable to specify the interval between the attempts to
+	// acquire or renew a lease.
+	RetryPeriodEnvKey = "LEADER_ELECTION_RETRY_PERIOD"
+)
+
+// LeaderElectionDisabled returns whether the leader election is disabled with the environment
+// variable LEADER_ELECTION_DISABLED.
+func LeaderElectionDisabled() (bool, error) {
+	v := os.Getenv(LeaderElectionDisabledEnvKey)
+	if v == "" {
+		return false, nil
+	}
+	disabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", LeaderElectionDisabledEnvKey, err)
+	}
+	return disabled, nil
+}
+
+// LeaderElectionConfig returns the leader election config of the config map in the namespace of
+// the operator, with the lease timings overridden by the environment variables.
+func LeaderElectionConfig(ctx context.Context, kubeClient kubernetes.Interface) (*leaderelection.Config, error) {
+	cm, err := kubeClient.CoreV1().ConfigMaps(system.Namespace()).Get(ctx, leaderelection.ConfigMapName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get the config map %s: %w", leaderelection.ConfigMapName(), err)
+	}
+	cfg, err := leaderelection.NewConfigFromMap(cm.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the config map %s: %w", leaderelection.ConfigMapName(), err)
+	}
+
+	if cfg.LeaseDuration, err = durationFromEnv(LeaseDurationEnvKey, cfg.LeaseDuration); err != nil {
+		return nil, err
+	}
+	if cfg.RenewDeadline, err = durationFromEnv(RenewDeadlineEnvKey, cfg.RenewDeadline); err != nil {
+		return nil, err
+	}
+	if cfg.RetryPeriod, err = durationFromEnv(RetryPeriodEnvKey, cfg.RetryPeriod); err != nil {
+		return nil, err
+	}
+	// The same constraints are enforced by the leader elector of client-go, but only once it starts.
+	if cfg.RetryPeriod <= 0 {
+		return nil, fmt.Errorf("the retry period must be positive, got %v", cfg.RetryPeriod)
+	}
+	if cfg.RenewDeadline <= time.Duration(k8sleaderelection.JitterFactor*float64(cfg.RetryPeriod)) {
+		return nil, fmt.Errorf("the renew deadline %v must be greater than %v times the retry period %v",
+			cfg.RenewDeadline, k8sleaderelection.JitterFactor, cfg.RetryPeriod)
+	}
+	if cfg.LeaseDuration <= cfg.RenewDeadline {
+		return nil, fmt.Errorf("the lease duration %v must be greater than the renew deadline %v", cfg.LeaseDuration, cfg.RenewDeadline)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/system"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestLeaderElectionConfig(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-operator", Name: "config-leader-election"},
+		Data:       map[string]string{"buckets": "3", "lease-duration": "45s"},
+	}
+
+	tests := []struct {
+		name              string
+		objs              []runtime.Object
+		env               map[string]string
+		wantBuckets       uint32
+		wantLeaseDuration time.Duration
+		wantRenewDeadline time.Duration
+		wantRetryPeriod   time.Duration
+		wantErr           bool
+	}{{
+		name:              "defaults without config map",
+		wantBuckets:       1,
+		wantLeaseDuration: 60 * time.Second,
+		wantRenewDeadline: 40 * time.Second,
+		wantRetryPeriod:   10 * time.Second,
+	}, {
+		name:              "config map",
+		objs:              []runtime.Object{cm},
+		wantBuckets:       3,
+		wantLeaseDuration: 45 * time.Second,
+		wantRenewDeadline: 40 * time.Second,
+		wantRetryPeriod:   10 * time.Second,
+	}, {
+		name: "environment overrides config map",
+		objs: []runtime.Object{cm},
+		env: map[string]string{
+			LeaseDurationEnvKey: "15s",
+			RenewDeadlineEnvKey: "10s",
+			RetryPeriodEnvKey:   "2s",
+		},
+		wantBuckets:       3,
+		wantLeaseDuration: 15 * time.Second,
+		wantRenewDeadline: 10 * time.Second,
+		wantRetryPeriod:   2 * time.Second,
+	}, {
+		name:    "invalid duration",
+		env:     map[string]string{RetryPeriodEnvKey: "often"},
+		wantErr: true,
+	}, {
+		name:    "lease shorter than renew deadline",
+		objs:    []runtime.Object{cm},
+		env:     map[string]string{LeaseDurationEnvKey: "30s"},
+		wantErr: true,
+	}, {
+		name:    "retry period too long",
+		env:     map[string]string{RetryPeriodEnvKey: "35s"},
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(system.NamespaceEnvKey, "knative-operator")
+			for _, key := range []string{LeaseDurationEnvKey, RenewDeadlineEnvKey, RetryPeriodEnvKey} {
+				t.Setenv(key, test.env[key])
+			}
+			cfg, err := LeaderElectionConfig(context.Background(), kubefake.NewSimpleClientset(test.objs...))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LeaderElectionConfig() = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			util.AssertEqual(t, cfg.Buckets, test.wantBuckets)
+			util.AssertEqual(t, cfg.LeaseDuration, test.wantLeaseDuration)
+			util.AssertEqual(t, cfg.RenewDeadline, test.wantRenewDeadline)
+			util.AssertEqual(t, cfg.RetryPeriod, test.wantRetryPeriod)
+		})
+	}
+}
+
+func TestLeaderElectionDisabled(t *testing.T) {
+	for value, want := range map[string]bool{"": false, "false": false, "true": true} {
+		t.Setenv(LeaderElectionDisabledEnvKey, value)
+		got, err := LeaderElectionDisabled()
+		if err != nil {
+			t.Fatalf("LeaderElectionDisabled() = %v", err)
+		}
+		util.AssertEqual(t, got, want)
+	}
+	t.Setenv(LeaderElectionDisabledEnvKey, "maybe")
+	if _, err := LeaderElectionDisabled(); err == nil {
+		t.Fatal("LeaderElectionDisabled() = nil, want an error")
+	}
+}
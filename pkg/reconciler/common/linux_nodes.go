@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// LinuxNodesTransform restricts the pods of the workloads to the Linux nodes, unless
+// spec.linuxNodesOnly is false, as the images of Knative are only built for Linux. A node selector
+// on the operating system, which is set already, e.g. by spec.workloads, is kept.
+func LinuxNodesTransform(instance base.KComponent) mf.Transformer {
+	if !instance.GetSpec().GetLinuxNodesOnly() {
+		return nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		path, ok := podSpecPaths[u.GetKind()]
+		if !ok {
+			return nil
+		}
+		selectorPath := append(append([]string{}, path...), "nodeSelector")
+		selector, _, err := unstructured.NestedStringMap(u.Object, selectorPath...)
+		if err != nil {
+			return err
+		}
+		if _, ok := selector[corev1.LabelOSStable]; ok {
+			return nil
+		}
+		if selector == nil {
+			selector = map[string]string{}
+		}
+		selector[corev1.LabelOSStable] = string(corev1.Linux)
+		return unstructured.SetNestedStringMap(u.Object, selector, selectorPath...)
+	}
+}
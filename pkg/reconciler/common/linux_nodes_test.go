@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestLinuxNodesTransform(t *testing.T) {
+	tests := []struct {
+		name           string
+		linuxNodesOnly *bool
+		nodeSelector   map[string]string
+		want           map[string]string
+	}{{
+		name: "default",
+		want: map[string]string{corev1.LabelOSStable: "linux"},
+	}, {
+		name:         "other labels",
+		nodeSelector: map[string]string{"pool": "knative"},
+		want:         map[string]string{"pool": "knative", corev1.LabelOSStable: "linux"},
+	}, {
+		name:         "operating system selected already",
+		nodeSelector: map[string]string{corev1.LabelOSStable: "windows"},
+		want:         map[string]string{corev1.LabelOSStable: "windows"},
+	}, {
+		name:           "disabled",
+		linuxNodesOnly: ptr.Bool(false),
+		nodeSelector:   map[string]string{"pool": "knative"},
+		want:           map[string]string{"pool": "knative"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{LinuxNodesOnly: test.linuxNodesOnly}},
+			}
+			u := util.MakeUnstructured(t, &appsv1.StatefulSet{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"},
+				ObjectMeta: metav1.ObjectMeta{Name: "eventing-kafka-dispatcher", Namespace: "knative-eventing"},
+				Spec: appsv1.StatefulSetSpec{
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{NodeSelector: test.nodeSelector}},
+				},
+			})
+			if transform := LinuxNodesTransform(instance); transform != nil {
+				if err := transform(&u); err != nil {
+					t.Fatalf("LinuxNodesTransform() = %v", err)
+				}
+			}
+			got := &appsv1.StatefulSet{}
+			if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			util.AssertDeepEqual(t, got.Spec.Template.Spec.NodeSelector, test.want)
+		})
+	}
+}
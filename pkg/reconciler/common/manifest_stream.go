@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"sync"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// forEachResource calls fn with each resource of the manifest, until it returns an error. Unlike
+// Manifest.Resources, which copies all the resources at once, it copies one resource at a time,
+// so that a large manifest is not held in memory twice.
+func forEachResource(manifest mf.Manifest, fn func(*unstructured.Unstructured) error) error {
+	var err error
+	manifest.Filter(func(u *unstructured.Unstructured) bool {
+		if err == nil {
+			err = fn(u)
+		}
+		return false
+	})
+	return err
+}
+
+// maxPooledDigestBuffer is the capacity of the buffer of a digester, above which it is not pooled,
+// so that a single huge resource, e.g. a CRD, does not keep its buffer allocated.
+const maxPooledDigestBuffer = 1 << 20
+
+// digester hashes the resources one by one, encoding each of them into the same buffer.
+type digester struct {
+	hash    hash.Hash
+	buffer  bytes.Buffer
+	encoder *json.Encoder
+}
+
+var digesters = sync.Pool{New: func() interface{} {
+	d := &digester{hash: sha256.New()}
+	d.encoder = json.NewEncoder(&d.buffer)
+	return d
+}}
+
+// add adds the resource to the digest.
+func (d *digester) add(u *unstructured.Unstructured) error {
+	d.buffer.Reset()
+	if err := d.encoder.Encode(u.Object); err != nil {
+		return fmt.Errorf("failed to hash the resource %s/%s: %w", u.GetNamespace(), u.GetName(), err)
+	}
+	d.hash.Write(d.buffer.Bytes())
+	return nil
+}
+
+func (d *digester) sum() string {
+	return "sha256:" + hex.EncodeToString(d.hash.Sum(nil))
+}
+
+// withDigester calls fn with a reset digester from the pool.
+func withDigester(fn func(*digester) error) error {
+	d := digesters.Get().(*digester)
+	defer func() {
+		if d.buffer.Cap() <= maxPooledDigestBuffer {
+			digesters.Put(d)
+		}
+	}()
+	d.hash.Reset()
+	return fn(d)
+}
+
+// resourceDigest returns the sha256 digest of the resource.
+func resourceDigest(u *unstructured.Unstructured) (string, error) {
+	var digest string
+	err := withDigester(func(d *digester) error {
+		if err := d.add(u); err != nil {
+			return err
+		}
+		digest = d.sum()
+		return nil
+	})
+	return digest, err
+}
+
+// resourcesDigest returns the sha256 digest of the resources of the manifest, in their order. It is
+// the digest of the concatenation of the resources encoded as JSON, one per line.
+func resourcesDigest(manifest mf.Manifest) (string, error) {
+	var digest string
+	err := withDigester(func(d *digester) error {
+		if err := forEachResource(manifest, d.add); err != nil {
+			return err
+		}
+		digest = d.sum()
+		return nil
+	})
+	return digest, err
+}
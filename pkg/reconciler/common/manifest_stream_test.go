@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestForEachResource(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		*NamespacedResource("v1", "ConfigMap", "knative-serving", "first"),
+		*NamespacedResource("v1", "ConfigMap", "knative-serving", "second"),
+		*NamespacedResource("v1", "ConfigMap", "knative-serving", "third"),
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+
+	var names []string
+	errStop := errors.New("stop")
+	err = forEachResource(manifest, func(u *unstructured.Unstructured) error {
+		names = append(names, u.GetName())
+		// The resources are copies.
+		u.SetName("changed")
+		if len(names) == 2 {
+			return errStop
+		}
+		return nil
+	})
+	util.AssertEqual(t, err, errStop)
+	util.AssertDeepEqual(t, names, []string{"first", "second"})
+	util.AssertEqual(t, manifest.Resources()[0].GetName(), "first")
+}
+
+func TestResourcesDigest(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		*NamespacedResource("v1", "ConfigMap", "knative-serving", "first"),
+		*NamespacedResource("v1", "Service", "knative-serving", "second"),
+	}
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+
+	// The digest is the one of the resources encoded as JSON one per line, which is recorded in
+	// status.applied, so it must not change between releases.
+	hash := sha256.New()
+	encoder := json.NewEncoder(hash)
+	for _, u := range resources {
+		if err := encoder.Encode(u.Object); err != nil {
+			t.Fatalf("Encode() = %v", err)
+		}
+	}
+	want := "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	for i := 0; i < 2; i++ {
+		got, err := resourcesDigest(manifest)
+		if err != nil {
+			t.Fatalf("resourcesDigest() = %v", err)
+		}
+		util.AssertEqual(t, got, want)
+	}
+
+	single, err := resourceDigest(&resources[0])
+	if err != nil {
+		t.Fatalf("resourceDigest() = %v", err)
+	}
+	first, err := mf.ManifestFrom(mf.Slice(resources[:1]))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	want, err = resourcesDigest(first)
+	if err != nil {
+		t.Fatalf("resourcesDigest() = %v", err)
+	}
+	util.AssertEqual(t, single, want)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// NetworkPolicySuffix is appended to the name of a service for the name of its NetworkPolicy.
+	NetworkPolicySuffix = "-network-policy"
+	// NetworkPolicyLabel marks the NetworkPolicies generated by the operator.
+	NetworkPolicyLabel = "operator.knative.dev/network-policy"
+)
+
+// namespaceServices are the services, which only the pods in the namespace of the component
+// connect to, e.g. the activator to the autoscaler. All other services are reached from other
+// namespaces, e.g. the activator or the brokers by the ingresses and the event sources.
+var namespaceServices = sets.New("autoscaler")
+
+// AppendNetworkPolicies appends a NetworkPolicy for each service of the manifest to it, if
+// spec.networkPolicies enables it. As the ports are taken from the services, the policies match
+// the version of the manifest. The webhooks are reachable by the API server, whose addresses
+// can't be selected, from everywhere, the metrics ports from the namespaces of
+// metricsNamespaceSelector and the profiling ports not at all.
+func AppendNetworkPolicies(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	config := instance.GetSpec().GetNetworkPolicies()
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	webhooks := webhookServices(manifest)
+	var resources []unstructured.Unstructured
+	for _, u := range manifest.Filter(mf.ByKind("Service")).Resources() {
+		svc := &corev1.Service{}
+		if err := scheme.Scheme.Convert(&u, svc, nil); err != nil {
+			return err
+		}
+		if len(svc.Spec.Selector) == 0 {
+			// Without a selector, the service has no pods to allow the ingress to.
+			continue
+		}
+		policy := networkPolicy(svc, config, webhooks.Has(svc.Name))
+		if len(policy.Spec.Ingress) == 0 {
+			continue
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, unstructured.Unstructured{Object: obj})
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	m, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return err
+	}
+	*manifest = manifest.Append(m)
+	return nil
+}
+
+// networkPolicy returns the NetworkPolicy allowing the ingress to the pods of the service.
+func networkPolicy(svc *corev1.Service, config *base.NetworkPolicies, webhook bool) *networkingv1.NetworkPolicy {
+	var metrics, other []networkingv1.NetworkPolicyPort
+	for _, port := range svc.Spec.Ports {
+		target := port.TargetPort
+		if target.Type == intstr.Int && target.IntVal == 0 {
+			target = intstr.FromInt32(port.Port)
+		}
+		protocol := port.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		policyPort := networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &target}
+		switch {
+		case strings.Contains(port.Name, "profiling"):
+		case strings.Contains(port.Name, "metrics"):
+			metrics = append(metrics, policyPort)
+		default:
+			other = append(other, policyPort)
+		}
+	}
+
+	var rules []networkingv1.NetworkPolicyIngressRule
+	if len(other) > 0 {
+		rule := networkingv1.NetworkPolicyIngressRule{Ports: other}
+		if !webhook && namespaceServices.Has(svc.Name) {
+			rule.From = []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}
+		}
+		rules = append(rules, rule)
+	}
+	if len(metrics) > 0 {
+		rule := networkingv1.NetworkPolicyIngressRule{Ports: metrics}
+		if config.MetricsNamespaceSelector != nil {
+			rule.From = []networkingv1.NetworkPolicyPeer{{NamespaceSelector: config.MetricsNamespaceSelector.DeepCopy()}}
+		}
+		rules = append(rules, rule)
+	}
+	labels := map[string]string{NetworkPolicyLabel: "true"}
+	for key, value := range svc.Labels {
+		labels[key] = value
+	}
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "NetworkPolicy"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svc.Name + NetworkPolicySuffix,
+			Namespace: svc.Namespace,
+			Labels:    labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: svc.Spec.Selector},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     rules,
+		},
+	}
+}
+
+// DeleteObsoleteNetworkPolicies returns a Stage, which deletes the generated NetworkPolicies, which
+// the manifest doesn't contain anymore, e.g. once spec.networkPolicies is disabled. They are not
+// part of the manifests of the installed version, which the obsolete resources are found by.
+func DeleteObsoleteNetworkPolicies(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		client := kubeClient.NetworkingV1().NetworkPolicies(instance.GetNamespace())
+		policies, err := client.List(ctx, metav1.ListOptions{LabelSelector: NetworkPolicyLabel + "=true"})
+		if err != nil {
+			return fmt.Errorf("failed to list the NetworkPolicies: %w", err)
+		}
+		wanted := sets.New[string]()
+		for _, u := range manifest.Filter(mf.ByKind("NetworkPolicy")).Resources() {
+			wanted.Insert(u.GetName())
+		}
+		for _, policy := range policies.Items {
+			if wanted.Has(policy.Name) {
+				continue
+			}
+			if err := client.Delete(ctx, policy.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete the NetworkPolicy %s: %w", policy.Name, err)
+			}
+		}
+		return nil
+	}
+}
+
+// webhookServices returns the names of the services, which the webhook configurations and the
+// conversion webhooks of the CustomResourceDefinitions of the manifest refer to.
+func webhookServices(manifest *mf.Manifest) sets.Set[string] {
+	names := sets.New[string]()
+	for _, u := range manifest.Resources() {
+		switch u.GetKind() {
+		case "MutatingWebhookConfiguration", "ValidatingWebhookConfiguration":
+			webhooks, _, _ := unstructured.NestedSlice(u.Object, "webhooks")
+			for _, w := range webhooks {
+				if webhook, ok := w.(map[string]interface{}); ok {
+					if name, _, _ := unstructured.NestedString(webhook, "clientConfig", "service", "name"); name != "" {
+						names.Insert(name)
+					}
+				}
+			}
+		case "CustomResourceDefinition":
+			if name, _, _ := unstructured.NestedString(u.Object, "spec", "conversion", "webhook", "clientConfig", "service", "name"); name != "" {
+				names.Insert(name)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestAppendNetworkPolicies(t *testing.T) {
+	service := func(name string, selector map[string]string, ports ...corev1.ServicePort) unstructured.Unstructured {
+		return util.MakeUnstructured(t, &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "knative-serving"},
+			Spec:       corev1.ServiceSpec{Selector: selector, Ports: ports},
+		})
+	}
+	metrics := corev1.ServicePort{Name: "http-metrics", Port: 9090, TargetPort: intstr.FromInt32(9090)}
+	profiling := corev1.ServicePort{Name: "http-profiling", Port: 8008, TargetPort: intstr.FromInt32(8008)}
+	webhookConfig := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "admissionregistration.k8s.io/v1",
+		"kind":       "ValidatingWebhookConfiguration",
+		"metadata":   map[string]interface{}{"name": "config.webhook.serving.knative.dev"},
+		"webhooks": []interface{}{map[string]interface{}{
+			"name":         "config.webhook.serving.knative.dev",
+			"clientConfig": map[string]interface{}{"service": map[string]interface{}{"name": "webhook", "namespace": "knative-serving"}},
+		}},
+	}}
+	resources := []unstructured.Unstructured{
+		webhookConfig,
+		service("activator-service", map[string]string{"app": "activator"}, metrics, profiling,
+			corev1.ServicePort{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8012)}),
+		service("autoscaler", map[string]string{"app": "autoscaler"}, metrics, profiling,
+			corev1.ServicePort{Name: "http", Port: 8080}),
+		service("webhook", map[string]string{"app": "webhook"}, metrics,
+			corev1.ServicePort{Name: "https-webhook", Port: 443, TargetPort: intstr.FromString("https-webhook")}),
+		service("controller", map[string]string{"app": "controller"}, profiling),
+		service("external", nil, corev1.ServicePort{Name: "http", Port: 80}),
+	}
+	tcp := corev1.ProtocolTCP
+	port := func(p intstr.IntOrString) networkingv1.NetworkPolicyPort {
+		return networkingv1.NetworkPolicyPort{Protocol: &tcp, Port: &p}
+	}
+	prometheus := &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "monitoring"}}
+
+	tests := []struct {
+		name   string
+		config *base.NetworkPolicies
+		want   map[string][]networkingv1.NetworkPolicyIngressRule
+	}{{
+		name: "disabled",
+		want: map[string][]networkingv1.NetworkPolicyIngressRule{},
+	}, {
+		name:   "enabled",
+		config: &base.NetworkPolicies{Enabled: true},
+		want: map[string][]networkingv1.NetworkPolicyIngressRule{
+			"activator-service-network-policy": {
+				{Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(8012))}},
+				{Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(9090))}},
+			},
+			"autoscaler-network-policy": {
+				{
+					Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(8080))},
+					From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+				},
+				{Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(9090))}},
+			},
+			"webhook-network-policy": {
+				{Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromString("https-webhook"))}},
+				{Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(9090))}},
+			},
+		},
+	}, {
+		name:   "metrics namespaces",
+		config: &base.NetworkPolicies{Enabled: true, MetricsNamespaceSelector: prometheus},
+		want: map[string][]networkingv1.NetworkPolicyIngressRule{
+			"activator-service-network-policy": {
+				{Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(8012))}},
+				{
+					Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(9090))},
+					From:  []networkingv1.NetworkPolicyPeer{{NamespaceSelector: prometheus}},
+				},
+			},
+			"autoscaler-network-policy": {
+				{
+					Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(8080))},
+					From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+				},
+				{
+					Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(9090))},
+					From:  []networkingv1.NetworkPolicyPeer{{NamespaceSelector: prometheus}},
+				},
+			},
+			"webhook-network-policy": {
+				{Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromString("https-webhook"))}},
+				{
+					Ports: []networkingv1.NetworkPolicyPort{port(intstr.FromInt32(9090))},
+					From:  []networkingv1.NetworkPolicyPeer{{NamespaceSelector: prometheus}},
+				},
+			},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest, err := mf.ManifestFrom(mf.Slice(resources))
+			if err != nil {
+				t.Fatalf("Failed to generate manifest: %v", err)
+			}
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{NetworkPolicies: test.config},
+				},
+			}
+			if err := AppendNetworkPolicies(context.Background(), &manifest, ks); err != nil {
+				t.Fatalf("AppendNetworkPolicies() = %v", err)
+			}
+
+			got := map[string][]networkingv1.NetworkPolicyIngressRule{}
+			for _, u := range manifest.Filter(mf.ByKind("NetworkPolicy")).Resources() {
+				policy := &networkingv1.NetworkPolicy{}
+				if err := scheme.Scheme.Convert(&u, policy, nil); err != nil {
+					t.Fatalf("Failed to convert %v: %v", u, err)
+				}
+				util.AssertEqual(t, policy.Namespace, "knative-serving")
+				util.AssertDeepEqual(t, policy.Spec.PolicyTypes, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress})
+				got[policy.Name] = policy.Spec.Ingress
+			}
+			util.AssertDeepEqual(t, got, test.want)
+		})
+	}
+}
+
+func TestDeleteObsoleteNetworkPolicies(t *testing.T) {
+	policy := func(name string, labels map[string]string) *networkingv1.NetworkPolicy {
+		return &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "knative-serving", Labels: labels}}
+	}
+	generated := map[string]string{NetworkPolicyLabel: "true"}
+	kubeClient := kubefake.NewSimpleClientset(
+		policy("activator-service-network-policy", generated),
+		policy("autoscaler-network-policy", generated),
+		policy("default-deny", nil),
+	)
+	kept := util.MakeUnstructured(t, policy("activator-service-network-policy", generated))
+	kept.SetAPIVersion("networking.k8s.io/v1")
+	kept.SetKind("NetworkPolicy")
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{kept}))
+	if err != nil {
+		t.Fatalf("Failed to generate manifest: %v", err)
+	}
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+
+	if err := DeleteObsoleteNetworkPolicies(kubeClient)(context.Background(), &manifest, ks); err != nil {
+		t.Fatalf("DeleteObsoleteNetworkPolicies() = %v", err)
+	}
+	policies, err := kubeClient.NetworkingV1().NetworkPolicies("knative-serving").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list the NetworkPolicies: %v", err)
+	}
+	var names []string
+	for _, p := range policies.Items {
+		names = append(names, p.Name)
+	}
+	util.AssertDeepEqual(t, names, []string{"activator-service-network-policy", "default-deny"})
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// OfflineClient returns a manifestival client, which renders manifests without a cluster. To the
+// transformers every resource looks absent, and any write is silently discarded.
+func OfflineClient() mf.Client {
+	return offlineClient{}
+}
+
+type offlineClient struct{}
+
+var _ mf.Client = offlineClient{}
+
+func (offlineClient) Create(*unstructured.Unstructured, ...mf.ApplyOption) error {
+	return nil
+}
+
+func (offlineClient) Update(*unstructured.Unstructured, ...mf.ApplyOption) error {
+	return nil
+}
+
+func (offlineClient) Delete(*unstructured.Unstructured, ...mf.DeleteOption) error {
+	return nil
+}
+
+func (offlineClient) Get(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	gvk := obj.GroupVersionKind()
+	return nil, apierrors.NewNotFound(gvk.GroupVersion().WithResource(gvk.Kind).GroupResource(), obj.GetName())
+}
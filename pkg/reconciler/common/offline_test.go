@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestOfflineClient(t *testing.T) {
+	u := NamespacedResource("apps/v1", "Deployment", "knative-serving", "controller")
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*u}), mf.UseClient(OfflineClient()))
+	if err != nil {
+		t.Fatalf("Failed to generate manifest: %v", err)
+	}
+
+	if _, err := manifest.Client.Get(u); !apierrors.IsNotFound(err) {
+		t.Fatalf("Get() = %v, want NotFound", err)
+	}
+	util.AssertEqual(t, manifest.Apply(), nil)
+	util.AssertEqual(t, manifest.Delete(), nil)
+}
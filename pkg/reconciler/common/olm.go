@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// OperatorConditionNameEnvKey is the environment variable, which OLM sets to the name of the
+	// OperatorCondition of the operator.
+	OperatorConditionNameEnvKey = "OPERATOR_CONDITION_NAME"
+	// UpgradeableCondition is the condition of the OperatorCondition, which tells OLM whether the
+	// operator may be replaced by a newer version.
+	UpgradeableCondition = "Upgradeable"
+
+	upgradeInProgressReason = "OperandUpgradeInProgress"
+	upgradeableReason       = "AsExpected"
+)
+
+// OperatorConditionResource is the resource of the OperatorConditions of OLM.
+var OperatorConditionResource = schema.GroupVersionResource{Group: "operators.coreos.com", Version: "v2", Resource: "operatorconditions"}
+
+type upgradeGateKey struct{}
+
+// upgradeGate sets the Upgradeable condition of the OperatorCondition to false, while any
+// Knative component is installed or upgraded, so that OLM doesn't replace the operator in the
+// middle of it.
+type upgradeGate struct {
+	client dynamic.Interface
+	key    types.NamespacedName
+
+	mu sync.Mutex
+	// upgrades are the messages of the components being upgraded by their componentID.
+	upgrades map[string]string
+	// written is the message of the condition last written, empty when it is upgradeable.
+	written *string
+}
+
+// WithUpgradeGate attaches the gate of the Upgradeable condition to the context, when the operator
+// is installed by OLM, i.e. the environment variable OPERATOR_CONDITION_NAME is set. Otherwise,
+// the context is returned unchanged and ReportUpgrade does nothing.
+func WithUpgradeGate(ctx context.Context, client dynamic.Interface) context.Context {
+	name := os.Getenv(OperatorConditionNameEnvKey)
+	if name == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, upgradeGateKey{}, &upgradeGate{
+		client:   client,
+		key:      types.NamespacedName{Namespace: system.Namespace(), Name: name},
+		upgrades: map[string]string{},
+	})
+}
+
+// ReportUpgrade records, whether the Knative component is being installed or upgraded, and updates
+// the Upgradeable condition of the OperatorCondition, when that changes the result for all the
+// components. It is called at the end of each reconciliation. A failed update is logged and
+// retried with the next reconciliation.
+func ReportUpgrade(ctx context.Context, instance base.KComponent) {
+	gate, ok := ctx.Value(upgradeGateKey{}).(*upgradeGate)
+	if !ok {
+		return
+	}
+	gate.report(ctx, componentID(instance), upgradeMessage(instance))
+}
+
+// ForgetUpgrade removes the deleted Knative component from the Upgradeable condition.
+func ForgetUpgrade(ctx context.Context, instance base.KComponent) {
+	gate, ok := ctx.Value(upgradeGateKey{}).(*upgradeGate)
+	if !ok {
+		return
+	}
+	gate.report(ctx, componentID(instance), "")
+}
+
+// upgradeMessage describes the installation or the upgrade of the Knative component in progress,
+// empty if its version is installed, or it is not being changed: it is paused, in dry-run mode, or
+// the version is not eligible for the migration.
+func upgradeMessage(instance base.KComponent) string {
+	if IsPaused(instance) || IsDryRun(instance) || IsVersionValidMigrationEligible(instance) != nil {
+		return ""
+	}
+	target, installed := TargetVersion(instance), instance.GetStatus().GetVersion()
+	name := instance.GetNamespace() + "/" + instance.GetName()
+	switch {
+	case target == installed:
+		return ""
+	case installed == "":
+		return fmt.Sprintf("%s %s is installing version %s", instance.GroupVersionKind().Kind, name, target)
+	default:
+		return fmt.Sprintf("%s %s is upgrading from version %s to %s", instance.GroupVersionKind().Kind, name, installed, target)
+	}
+}
+
+func (g *upgradeGate) report(ctx context.Context, id, message string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if message == "" {
+		delete(g.upgrades, id)
+	} else {
+		g.upgrades[id] = message
+	}
+
+	messages := make([]string, 0, len(g.upgrades))
+	for _, m := range g.upgrades {
+		messages = append(messages, m)
+	}
+	sort.Strings(messages)
+	joined := strings.Join(messages, "; ")
+	if g.written != nil && *g.written == joined {
+		return
+	}
+	if err := g.write(ctx, joined); err != nil {
+		logging.FromContext(ctx).Warnw("Failed to update the Upgradeable condition of the OperatorCondition", zap.Error(err))
+		return
+	}
+	g.written = &joined
+}
+
+// write sets the Upgradeable condition in spec.conditions of the OperatorCondition, where OLM
+// expects the conditions set by the operator.
+func (g *upgradeGate) write(ctx context.Context, message string) error {
+	condition := metav1.Condition{
+		Type:   UpgradeableCondition,
+		Status: metav1.ConditionTrue,
+		Reason: upgradeableReason,
+	}
+	if message != "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = upgradeInProgressReason
+		condition.Message = message
+	}
+
+	client := g.client.Resource(OperatorConditionResource).Namespace(g.key.Namespace)
+	oc, err := client.Get(ctx, g.key.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	raw, _, err := unstructured.NestedSlice(oc.Object, "spec", "conditions")
+	if err != nil {
+		return err
+	}
+	conditions := make([]metav1.Condition, len(raw))
+	for i := range raw {
+		u, ok := raw[i].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid condition %v of the OperatorCondition %s", raw[i], g.key)
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, &conditions[i]); err != nil {
+			return err
+		}
+	}
+	meta.SetStatusCondition(&conditions, condition)
+	raw = make([]interface{}, 0, len(conditions))
+	for i := range conditions {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&conditions[i])
+		if err != nil {
+			return err
+		}
+		raw = append(raw, u)
+	}
+	if err := unstructured.SetNestedSlice(oc.Object, raw, "spec", "conditions"); err != nil {
+		return err
+	}
+	_, err = client.Update(ctx, oc, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"knative.dev/pkg/system"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func upgradingServing(target, installed string) *v1beta1.KnativeServing {
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{Version: target},
+		},
+	}
+	ks.Status.SetVersion(installed)
+	return ks
+}
+
+func TestUpgradeMessage(t *testing.T) {
+	paused := upgradingServing("1.16.0", "1.15.0")
+	paused.Annotations = map[string]string{base.PausedAnnotation: "true"}
+
+	tests := []struct {
+		name string
+		ks   *v1beta1.KnativeServing
+		want string
+	}{{
+		name: "installed",
+		ks:   upgradingServing("1.16.0", "1.16.0"),
+	}, {
+		name: "installing",
+		ks:   upgradingServing("1.16.0", ""),
+		want: "KnativeServing knative-serving/knative-serving is installing version 1.16.0",
+	}, {
+		name: "upgrading",
+		ks:   upgradingServing("1.16.0", "1.15.0"),
+		want: "KnativeServing knative-serving/knative-serving is upgrading from version 1.15.0 to 1.16.0",
+	}, {
+		name: "paused",
+		ks:   paused,
+	}, {
+		name: "not eligible for the migration",
+		ks:   upgradingServing("1.16.0", "1.13.0"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			util.AssertEqual(t, upgradeMessage(test.ks), test.want)
+		})
+	}
+}
+
+// operatorConditionServer serves a single OperatorCondition and counts its updates.
+type operatorConditionServer struct {
+	mu      sync.Mutex
+	object  map[string]interface{}
+	updates int
+}
+
+func (s *operatorConditionServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.URL.Path != "/apis/operators.coreos.com/v2/namespaces/knative-operator/operatorconditions/knative-operator.v1.16.0" {
+		http.NotFound(w, req)
+		return
+	}
+	if req.Method == http.MethodPut {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.object = nil
+		if err := json.Unmarshal(body, &s.object); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.updates++
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.object)
+}
+
+func (s *operatorConditionServer) condition(t *testing.T, conditionType string) map[string]interface{} {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conditions, _, err := unstructured.NestedSlice(s.object, "spec", "conditions")
+	if err != nil {
+		t.Fatalf("NestedSlice() = %v", err)
+	}
+	for _, c := range conditions {
+		if c := c.(map[string]interface{}); c["type"] == conditionType {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestReportUpgrade(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	t.Setenv(OperatorConditionNameEnvKey, "knative-operator.v1.16.0")
+	server := &operatorConditionServer{object: map[string]interface{}{
+		"apiVersion": "operators.coreos.com/v2",
+		"kind":       "OperatorCondition",
+		"metadata":   map[string]interface{}{"namespace": "knative-operator", "name": "knative-operator.v1.16.0"},
+		"spec": map[string]interface{}{
+			"conditions": []interface{}{map[string]interface{}{
+				"type": "Other", "status": "True", "reason": "Other", "message": "", "lastTransitionTime": "2026-01-01T00:00:00Z",
+			}},
+		},
+	}}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	client, err := dynamic.NewForConfig(&rest.Config{Host: httpServer.URL})
+	if err != nil {
+		t.Fatalf("NewForConfig() = %v", err)
+	}
+	ctx := WithUpgradeGate(context.Background(), client)
+
+	ks := upgradingServing("1.16.0", "1.15.0")
+	ke := &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"}}
+	ke.Spec.Version = "1.16.0"
+	ke.Status.SetVersion("1.16.0")
+
+	ReportUpgrade(ctx, ks)
+	ReportUpgrade(ctx, ke)
+	// The unchanged condition is not written again.
+	ReportUpgrade(ctx, ks)
+	util.AssertEqual(t, server.updates, 1)
+	upgradeable := server.condition(t, UpgradeableCondition)
+	util.AssertEqual(t, upgradeable["status"], "False")
+	util.AssertEqual(t, upgradeable["message"], "KnativeServing knative-serving/knative-serving is upgrading from version 1.15.0 to 1.16.0")
+	// The conditions of others are kept.
+	util.AssertEqual(t, server.condition(t, "Other")["status"], "True")
+
+	ks.Status.SetVersion("1.16.0")
+	ReportUpgrade(ctx, ks)
+	util.AssertEqual(t, server.updates, 2)
+	util.AssertEqual(t, server.condition(t, UpgradeableCondition)["status"], "True")
+
+	// A deleted component does not hold back the upgrade.
+	ks.Status.SetVersion("1.15.0")
+	ReportUpgrade(ctx, ks)
+	util.AssertEqual(t, server.condition(t, UpgradeableCondition)["status"], "False")
+	ForgetUpgrade(ctx, ks)
+	util.AssertEqual(t, server.condition(t, UpgradeableCondition)["status"], "True")
+}
+
+func TestReportUpgradeWithoutOLM(t *testing.T) {
+	t.Setenv(OperatorConditionNameEnvKey, "")
+	ctx := WithUpgradeGate(context.Background(), nil)
+	// Without the gate, nothing is written, which would panic with the nil client.
+	ReportUpgrade(ctx, upgradingServing("1.16.0", "1.15.0"))
+	ForgetUpgrade(ctx, upgradingServing("1.16.0", "1.15.0"))
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"regexp"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// releasedNamespace returns the namespace, which the released manifests of the component are
+// written for.
+func releasedNamespace(instance base.KComponent) string {
+	switch instance.(type) {
+	case *v1beta1.KnativeServing:
+		return "knative-serving"
+	case *v1beta1.KnativeEventing:
+		return "knative-eventing"
+	}
+	return ""
+}
+
+// OperandNamespaceTransform rewrites the references to the namespace of the released manifests of
+// KnativeServing and KnativeEventing, which mf.InjectNamespace leaves unchanged, to the namespace
+// of the component: the hostnames and gateway keys in the data of the ConfigMaps, the DNS names of
+// the cert-manager Certificates, the env vars of the pod templates set to the namespace, and the
+// namespace selectors of the webhooks.
+func OperandNamespaceTransform(instance base.KComponent) mf.Transformer {
+	from, to := releasedNamespace(instance), instance.GetNamespace()
+	if from == "" || to == "" || from == to {
+		return nil
+	}
+	// The namespace must be a whole label of a name, e.g. not the prefix of knative-serving-certs.
+	pattern := regexp.MustCompile(`(^|[^a-z0-9-])` + regexp.QuoteMeta(from) + `($|[^a-z0-9-])`)
+	replace := func(s string) string {
+		// Adjacent references share a separator, which the first match consumes.
+		for {
+			replaced := pattern.ReplaceAllString(s, "${1}"+to+"${2}")
+			if replaced == s {
+				return s
+			}
+			s = replaced
+		}
+	}
+
+	return func(u *unstructured.Unstructured) error {
+		switch u.GetKind() {
+		case "ConfigMap":
+			data, found, err := unstructured.NestedStringMap(u.Object, "data")
+			if err != nil || !found {
+				return err
+			}
+			rewritten := make(map[string]string, len(data))
+			for key, value := range data {
+				if key == "_example" {
+					// The webhooks of Knative reject changes of the example.
+					rewritten[key] = value
+					continue
+				}
+				rewritten[replace(key)] = replace(value)
+			}
+			return unstructured.SetNestedStringMap(u.Object, rewritten, "data")
+		case "Certificate":
+			if !strings.HasPrefix(u.GetAPIVersion(), "cert-manager.io/") {
+				return nil
+			}
+			names, found, err := unstructured.NestedStringSlice(u.Object, "spec", "dnsNames")
+			if err != nil || !found {
+				return err
+			}
+			for i := range names {
+				names[i] = replace(names[i])
+			}
+			return unstructured.SetNestedStringSlice(u.Object, names, "spec", "dnsNames")
+		case "ValidatingWebhookConfiguration", "MutatingWebhookConfiguration":
+			hooks, _, _ := unstructured.NestedFieldNoCopy(u.Object, "webhooks")
+			webhooks, _ := hooks.([]interface{})
+			for _, hook := range webhooks {
+				if webhook, ok := hook.(map[string]interface{}); ok {
+					replaceNamespaceSelector(webhook, from, to)
+				}
+			}
+		case "Deployment", "StatefulSet", "DaemonSet", "Job":
+			for _, field := range []string{"initContainers", "containers"} {
+				containers, _, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "template", "spec", field)
+				list, _ := containers.([]interface{})
+				for _, container := range list {
+					c, ok := container.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					env, _ := c["env"].([]interface{})
+					for _, envVar := range env {
+						if v, ok := envVar.(map[string]interface{}); ok && v["value"] == from {
+							v["value"] = to
+						}
+					}
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// replaceNamespaceSelector selects the namespace to instead of from in the namespaceSelector of
+// the webhook, which selects namespaces by their name label.
+func replaceNamespaceSelector(webhook map[string]interface{}, from, to string) {
+	selector, _ := webhook["namespaceSelector"].(map[string]interface{})
+	if selector == nil {
+		return
+	}
+	if labels, ok := selector["matchLabels"].(map[string]interface{}); ok && labels[corev1.LabelMetadataName] == from {
+		labels[corev1.LabelMetadataName] = to
+	}
+	expressions, _ := selector["matchExpressions"].([]interface{})
+	for _, expression := range expressions {
+		e, ok := expression.(map[string]interface{})
+		if !ok || e["key"] != corev1.LabelMetadataName {
+			continue
+		}
+		values, _ := e["values"].([]interface{})
+		for i, value := range values {
+			if value == from {
+				values[i] = to
+			}
+		}
+	}
+}
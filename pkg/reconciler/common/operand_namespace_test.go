@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestOperandNamespaceTransform(t *testing.T) {
+	tests := []struct {
+		name      string
+		instance  base.KComponent
+		in        map[string]interface{}
+		expected  map[string]interface{}
+		unchanged bool
+	}{{
+		name:     "released namespace",
+		instance: &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving"}},
+		in: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"data":       map[string]interface{}{"gateway.knative-serving.knative-ingress-gateway": "istio-ingressgateway.istio-system.svc.cluster.local"},
+		},
+		unchanged: true,
+	}, {
+		name:     "other component",
+		instance: &v1beta1.KnativeFunctions{ObjectMeta: metav1.ObjectMeta{Namespace: "serverless"}},
+		in: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"data":       map[string]interface{}{"namespace": "knative-serving"},
+		},
+		unchanged: true,
+	}, {
+		name:     "config map",
+		instance: &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "serverless"}},
+		in: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"data": map[string]interface{}{
+				"gateway.knative-serving.knative-ingress-gateway": "istio-ingressgateway.istio-system.svc.cluster.local",
+				"envoy-bootstrap.yaml":                            `address: "net-kourier-controller.knative-serving"`,
+				"secret":                                          "knative-serving-certs",
+				"_example":                                        "gateway.knative-serving.knative-ingress-gateway",
+			},
+		},
+		expected: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"data": map[string]interface{}{
+				"gateway.serverless.knative-ingress-gateway": "istio-ingressgateway.istio-system.svc.cluster.local",
+				"envoy-bootstrap.yaml":                       `address: "net-kourier-controller.serverless"`,
+				"secret":                                     "knative-serving-certs",
+				"_example":                                   "gateway.knative-serving.knative-ingress-gateway",
+			},
+		},
+	}, {
+		name:     "certificate",
+		instance: &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "events"}},
+		in: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"spec": map[string]interface{}{
+				"dnsNames": []interface{}{"job-sink.knative-eventing.svc.cluster.local", "job-sink.knative-eventing.svc"},
+			},
+		},
+		expected: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"spec": map[string]interface{}{
+				"dnsNames": []interface{}{"job-sink.events.svc.cluster.local", "job-sink.events.svc"},
+			},
+		},
+	}, {
+		name:     "webhook",
+		instance: &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "events"}},
+		in: map[string]interface{}{
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "ValidatingWebhookConfiguration",
+			"webhooks": []interface{}{map[string]interface{}{
+				"name": "config.webhook.eventing.knative.dev",
+				"namespaceSelector": map[string]interface{}{
+					"matchExpressions": []interface{}{map[string]interface{}{
+						"key":      "kubernetes.io/metadata.name",
+						"operator": "In",
+						"values":   []interface{}{"knative-eventing"},
+					}},
+				},
+				"objectSelector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{"app.kubernetes.io/name": "knative-eventing"},
+				},
+			}},
+		},
+		expected: map[string]interface{}{
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "ValidatingWebhookConfiguration",
+			"webhooks": []interface{}{map[string]interface{}{
+				"name": "config.webhook.eventing.knative.dev",
+				"namespaceSelector": map[string]interface{}{
+					"matchExpressions": []interface{}{map[string]interface{}{
+						"key":      "kubernetes.io/metadata.name",
+						"operator": "In",
+						"values":   []interface{}{"events"},
+					}},
+				},
+				"objectSelector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{"app.kubernetes.io/name": "knative-eventing"},
+				},
+			}},
+		},
+	}, {
+		name:     "env vars",
+		instance: &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "events"}},
+		in: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{
+					"name": "controller",
+					"env": []interface{}{
+						map[string]interface{}{"name": "BROKER_DATA_PLANE_CONFIG_MAP_NAMESPACE", "value": "knative-eventing"},
+						map[string]interface{}{"name": "NAME", "value": "knative-eventing-controller"},
+					},
+				}},
+			}}},
+		},
+		expected: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{
+					"name": "controller",
+					"env": []interface{}{
+						map[string]interface{}{"name": "BROKER_DATA_PLANE_CONFIG_MAP_NAMESPACE", "value": "events"},
+						map[string]interface{}{"name": "NAME", "value": "knative-eventing-controller"},
+					},
+				}},
+			}}},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transform := OperandNamespaceTransform(test.instance)
+			if test.unchanged {
+				if transform != nil {
+					t.Fatal("OperandNamespaceTransform() = a transformer, want nil")
+				}
+				return
+			}
+			u := &unstructured.Unstructured{Object: test.in}
+			if err := transform(u); err != nil {
+				t.Fatalf("transform() = %v", err)
+			}
+			if diff := cmp.Diff(test.expected, u.Object); diff != "" {
+				t.Errorf("transform() (-want, +got): %s", diff)
+			}
+		})
+	}
+}
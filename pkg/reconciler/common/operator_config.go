@@ -0,0 +1,348 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	clientgocache "k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// OperatorConfigName is the name of the config map of the operator in its namespace, whose
+	// changes are applied without restarting the operator.
+	OperatorConfigName = "config-operator"
+
+	// LogLevelKey is the key of the level of the logs of the operator, which overrides the level of
+	// loglevel.knative-operator in config-logging.
+	LogLevelKey = "log-level"
+	// ResyncPeriodKey is the key of the interval, in which all Knative components are reconciled
+	// again, even without any change.
+	ResyncPeriodKey = "resync-period"
+	// FeatureGatesKey is the key of the comma separated list of FeatureGates of the operator, e.g.
+	// "ParallelApply=true,ServerSideApply=false".
+	FeatureGatesKey = "feature-gates"
+	// DefaultPlatformKey is the key of the platform profile of the Knative components, which don't
+	// set spec.platform. The platform is detected from the cluster, if it is empty.
+	DefaultPlatformKey = "default-platform"
+)
+
+// OperatorConfig is the configuration of the operator in the config map config-operator.
+type OperatorConfig struct {
+	// LogLevel overrides the level of config-logging, if set.
+	LogLevel *zapcore.Level
+	// ResyncPeriod is the interval of the periodic reconciliation.
+	ResyncPeriod time.Duration
+	// FeatureGates enables or disables the experimental behaviors of the operator.
+	FeatureGates map[FeatureGate]bool
+	// DefaultPlatform is the platform profile of the Knative components without spec.platform.
+	DefaultPlatform base.Platform
+}
+
+// defaultOperatorConfig returns the OperatorConfig of an empty config map. The resync period of
+// the environment variable RESYNC_PERIOD is kept for compatibility.
+func defaultOperatorConfig(cfg ControllerConfig) *OperatorConfig {
+	resyncPeriod := cfg.ResyncPeriod
+	if resyncPeriod == 0 {
+		resyncPeriod = controller.DefaultResyncPeriod
+	}
+	return &OperatorConfig{ResyncPeriod: resyncPeriod}
+}
+
+// NewOperatorConfigFromConfigMap parses the config map config-operator. The keys, which are not
+// set, keep the values of the defaults.
+func NewOperatorConfigFromConfigMap(cm *corev1.ConfigMap, defaults *OperatorConfig) (*OperatorConfig, error) {
+	cfg := *defaults
+	if v := strings.TrimSpace(cm.Data[LogLevelKey]); v != "" {
+		level := zapcore.InfoLevel
+		if err := level.UnmarshalText([]byte(v)); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", LogLevelKey, err)
+		}
+		cfg.LogLevel = &level
+	}
+	if v := strings.TrimSpace(cm.Data[ResyncPeriodKey]); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", ResyncPeriodKey, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("%s must be positive, got %v", ResyncPeriodKey, d)
+		}
+		cfg.ResyncPeriod = d
+	}
+	gates, err := parseFeatureGates(cm.Data[FeatureGatesKey])
+	if err != nil {
+		return nil, err
+	}
+	cfg.FeatureGates = gates
+	if v := base.Platform(strings.TrimSpace(cm.Data[DefaultPlatformKey])); v != "" {
+		if v != base.PlatformGKEAutopilot && v != base.PlatformNone {
+			return nil, fmt.Errorf("%s must be empty, %q or %q, got %q", DefaultPlatformKey, base.PlatformGKEAutopilot, base.PlatformNone, v)
+		}
+		cfg.DefaultPlatform = v
+	}
+	return &cfg, nil
+}
+
+// parseFeatureGates parses a comma separated list of name=bool pairs of the known FeatureGates.
+func parseFeatureGates(value string) (map[FeatureGate]bool, error) {
+	var gates map[FeatureGate]bool
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, v, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%s must be a list of name=true or name=false, got %q", FeatureGatesKey, pair)
+		}
+		if !slices.Contains(FeatureGates, FeatureGate(name)) {
+			return nil, fmt.Errorf("unknown feature gate %q, known are %v", name, FeatureGates)
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the feature gate %s: %w", name, err)
+		}
+		if gates == nil {
+			gates = map[FeatureGate]bool{}
+		}
+		gates[FeatureGate(name)] = enabled
+	}
+	return gates, nil
+}
+
+// OperatorConfigStore holds the latest valid OperatorConfig of the config map config-operator.
+type OperatorConfigStore struct {
+	defaults *OperatorConfig
+	config   atomic.Pointer[OperatorConfig]
+	logLevel atomic.Pointer[zapcore.Level]
+
+	mu      sync.Mutex
+	changed chan struct{}
+	logger  *zap.SugaredLogger
+	watched bool
+}
+
+// NewOperatorConfigStore returns a store, which holds the defaults until the config map is observed.
+func NewOperatorConfigStore(cfg ControllerConfig) *OperatorConfigStore {
+	s := &OperatorConfigStore{
+		defaults: defaultOperatorConfig(cfg),
+		changed:  make(chan struct{}),
+		logger:   zap.NewNop().Sugar(),
+	}
+	s.config.Store(s.defaults)
+	return s
+}
+
+// Load returns the current OperatorConfig, which must not be modified.
+func (s *OperatorConfigStore) Load() *OperatorConfig {
+	return s.config.Load()
+}
+
+// SetLogLevel overrides the log level of the OperatorConfig at runtime, e.g. by the admin endpoint,
+// until it is reset with nil.
+func (s *OperatorConfigStore) SetLogLevel(level *zapcore.Level) {
+	s.logLevel.Store(level)
+}
+
+// LogLevelOverride returns the log level, which is set with SetLogLevel, nil if it is not set.
+func (s *OperatorConfigStore) LogLevelOverride() *zapcore.Level {
+	return s.logLevel.Load()
+}
+
+// LogLevel returns the log level, which is set with SetLogLevel, or the one of the OperatorConfig.
+// The level of config-logging applies, if it is nil.
+func (s *OperatorConfigStore) LogLevel() *zapcore.Level {
+	if level := s.logLevel.Load(); level != nil {
+		return level
+	}
+	return s.Load().LogLevel
+}
+
+// Changed returns a channel, which is closed on the next change of the OperatorConfig.
+func (s *OperatorConfigStore) Changed() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.changed
+}
+
+// OnConfigChanged is the configmap.Observer of the config map. An invalid config map is logged and
+// the previous OperatorConfig is kept, so that a typo doesn't break the running operator.
+func (s *OperatorConfigStore) OnConfigChanged(cm *corev1.ConfigMap) {
+	cfg, err := NewOperatorConfigFromConfigMap(cm, s.defaults)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.logger.Errorw("Failed to parse the config map, keeping the previous configuration", zap.String("configmap", OperatorConfigName), zap.Error(err))
+		return
+	}
+	s.logger.Infow("Updated the operator configuration", zap.String("configmap", OperatorConfigName), zap.Any("config", cfg))
+	s.config.Store(cfg)
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// watch registers the store with the config map watcher once. The config map is optional.
+func (s *OperatorConfigStore) watch(ctx context.Context, cmw configmap.Watcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.watched {
+		return
+	}
+	s.watched = true
+	s.logger = logging.FromContext(ctx).Named(OperatorConfigName)
+	if dw, ok := cmw.(configmap.DefaultingWatcher); ok {
+		dw.WatchWithDefault(corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigName, Namespace: system.Namespace()},
+		}, s.OnConfigChanged)
+		return
+	}
+	cmw.Watch(OperatorConfigName, s.OnConfigChanged)
+}
+
+type operatorConfigStoreKey struct{}
+
+// WithOperatorConfigStore attaches the OperatorConfigStore to the context.
+func WithOperatorConfigStore(ctx context.Context, store *OperatorConfigStore) context.Context {
+	return context.WithValue(ctx, operatorConfigStoreKey{}, store)
+}
+
+// GetOperatorConfigStore returns the OperatorConfigStore attached to the context, or a store of the
+// defaults of the ControllerConfig in the context.
+func GetOperatorConfigStore(ctx context.Context) *OperatorConfigStore {
+	if store, ok := ctx.Value(operatorConfigStoreKey{}).(*OperatorConfigStore); ok {
+		return store
+	}
+	return NewOperatorConfigStore(GetControllerConfig(ctx))
+}
+
+// GetOperatorConfig returns the current OperatorConfig of the store attached to the context.
+func GetOperatorConfig(ctx context.Context) *OperatorConfig {
+	return GetOperatorConfigStore(ctx).Load()
+}
+
+// WatchOperatorConfig attaches an OperatorConfigStore to the context and wraps the controller
+// constructors, so that the store watches the config map config-operator and the loggers of the
+// controllers follow its log level. The returned context and controller constructors replace the
+// passed ones in sharedmain. The config map is read once beforehand, so that the constructors see
+// its FeatureGates, and it must be valid at that point.
+func WatchOperatorConfig(ctx context.Context, kubeClient kubernetes.Interface, ctors ...injection.ControllerConstructor) (context.Context, []injection.ControllerConstructor, error) {
+	store := NewOperatorConfigStore(GetControllerConfig(ctx))
+	cm, err := kubeClient.CoreV1().ConfigMaps(system.Namespace()).Get(ctx, OperatorConfigName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to get the config map %s: %w", OperatorConfigName, err)
+	}
+	if err == nil {
+		cfg, err := NewOperatorConfigFromConfigMap(cm, store.defaults)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse the config map %s: %w", OperatorConfigName, err)
+		}
+		store.config.Store(cfg)
+	}
+	if err := registerFeatureGateMetrics(store, otel.GetMeterProvider()); err != nil {
+		return nil, nil, fmt.Errorf("failed to register the metrics of the feature gates: %w", err)
+	}
+	wrapped := make([]injection.ControllerConstructor, 0, len(ctors))
+	for _, ctor := range ctors {
+		wrapped = append(wrapped, func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+			store.watch(ctx, cmw)
+			logger := logging.FromContext(ctx).Desugar().WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+				return &levelCore{Core: core, store: store}
+			}))
+			return ctor(logging.WithLogger(ctx, logger.Sugar()), cmw)
+		})
+	}
+	return WithOperatorConfigStore(ctx, store), wrapped, nil
+}
+
+// levelCore overrides the level of the wrapped core with the log level of the store. The level of
+// the wrapped core, i.e. of config-logging, applies, if it is not set.
+type levelCore struct {
+	zapcore.Core
+	store *OperatorConfigStore
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	if l := c.store.LogLevel(); l != nil {
+		return l.Enabled(level)
+	}
+	return c.Core.Enabled(level)
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), store: c.store}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	l := c.store.LogLevel()
+	if l == nil {
+		return c.Core.Check(entry, checked)
+	}
+	if l.Enabled(entry.Level) {
+		// The wrapped core writes the entry regardless of its own level.
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+// ResyncPeriodically reconciles all the Knative components of the informer again after the resync
+// period of the OperatorConfig in the context. It replaces the resync of the informers, whose
+// period can't be changed once they are created, so that a new period takes effect right away.
+// It forgets the hashes of the resources applied for the components first, so that the changes of
+// the resources, which no informer watches, are reverted with SkipUnchangedApply as well.
+func ResyncPeriodically(ctx context.Context, impl *controller.Impl, informer clientgocache.SharedInformer) {
+	store := GetOperatorConfigStore(ctx)
+	go func() {
+		last := time.Now()
+		for {
+			changed := store.Changed()
+			timer := time.NewTimer(time.Until(last.Add(store.Load().ResyncPeriod)))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-changed:
+				timer.Stop()
+			case <-timer.C:
+				forgetAppliedHashesOf(informer.GetStore().List())
+				impl.GlobalResync(informer)
+				last = time.Now()
+			}
+		}
+	}()
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestNewOperatorConfigFromConfigMap(t *testing.T) {
+	debug := zapcore.DebugLevel
+	defaults := &OperatorConfig{ResyncPeriod: time.Hour}
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    *OperatorConfig
+		wantErr bool
+	}{{
+		name: "defaults",
+		want: defaults,
+	}, {
+		name: "all set",
+		data: map[string]string{
+			LogLevelKey:        "debug",
+			ResyncPeriodKey:    "30m",
+			FeatureGatesKey:    "ParallelApply=true, ServerSideApply=false,",
+			DefaultPlatformKey: "gke-autopilot",
+		},
+		want: &OperatorConfig{
+			LogLevel:        &debug,
+			ResyncPeriod:    30 * time.Minute,
+			FeatureGates:    map[FeatureGate]bool{ParallelApply: true, ServerSideApply: false},
+			DefaultPlatform: base.PlatformGKEAutopilot,
+		},
+	}, {
+		name:    "invalid log level",
+		data:    map[string]string{LogLevelKey: "verbose"},
+		wantErr: true,
+	}, {
+		name:    "invalid resync period",
+		data:    map[string]string{ResyncPeriodKey: "often"},
+		wantErr: true,
+	}, {
+		name:    "zero resync period",
+		data:    map[string]string{ResyncPeriodKey: "0s"},
+		wantErr: true,
+	}, {
+		name:    "feature gate without value",
+		data:    map[string]string{FeatureGatesKey: "ParallelApply"},
+		wantErr: true,
+	}, {
+		name:    "invalid feature gate",
+		data:    map[string]string{FeatureGatesKey: "ParallelApply=maybe"},
+		wantErr: true,
+	}, {
+		name:    "unknown feature gate",
+		data:    map[string]string{FeatureGatesKey: "Teleport=true"},
+		wantErr: true,
+	}, {
+		name:    "unknown platform",
+		data:    map[string]string{DefaultPlatformKey: "openshift"},
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewOperatorConfigFromConfigMap(&corev1.ConfigMap{Data: test.data}, defaults)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("NewOperatorConfigFromConfigMap() = %v, wantErr %v", err, test.wantErr)
+			}
+			if !test.wantErr {
+				util.AssertDeepEqual(t, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOperatorConfigStore(t *testing.T) {
+	store := NewOperatorConfigStore(ControllerConfig{})
+	util.AssertEqual(t, store.Load().ResyncPeriod, controller.DefaultResyncPeriod)
+
+	changed := store.Changed()
+	store.OnConfigChanged(&corev1.ConfigMap{Data: map[string]string{ResyncPeriodKey: "1h"}})
+	select {
+	case <-changed:
+	default:
+		t.Error("Changed() is not closed after a change")
+	}
+	util.AssertEqual(t, store.Load().ResyncPeriod, time.Hour)
+
+	// An invalid config map keeps the previous configuration.
+	store.OnConfigChanged(&corev1.ConfigMap{Data: map[string]string{ResyncPeriodKey: "often"}})
+	util.AssertEqual(t, store.Load().ResyncPeriod, time.Hour)
+
+	// The keys removed from the config map fall back to the defaults.
+	store.OnConfigChanged(&corev1.ConfigMap{})
+	util.AssertEqual(t, store.Load().ResyncPeriod, controller.DefaultResyncPeriod)
+}
+
+func TestWatchOperatorConfig(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigName, Namespace: "knative-operator"},
+		Data:       map[string]string{FeatureGatesKey: "WatchDriftRepair=true"},
+	})
+	var buf bytes.Buffer
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&buf), zapcore.InfoLevel)
+	ctx := logging.WithLogger(context.Background(), zap.New(core).Sugar())
+
+	var ctorCtx context.Context
+	ctx, ctors, err := WatchOperatorConfig(ctx, kubeClient, func(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+		// The constructors see the config map, before it is watched.
+		util.AssertEqual(t, FeatureEnabled(ctx, WatchDriftRepair), true)
+		ctorCtx = ctx
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WatchOperatorConfig() = %v", err)
+	}
+	cmw := &configmap.ManualWatcher{}
+	ctors[0](ctx, cmw)
+	logger := logging.FromContext(ctorCtx)
+
+	logger.Debug("hidden")
+	cmw.OnChange(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigName},
+		Data:       map[string]string{LogLevelKey: "debug", DefaultPlatformKey: "none"},
+	})
+	logger.Debug("shown")
+	util.AssertEqual(t, GetOperatorConfig(ctx).DefaultPlatform, base.PlatformNone)
+
+	cmw.OnChange(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigName},
+		Data:       map[string]string{LogLevelKey: "error"},
+	})
+	logger.Info("hidden")
+	// The level of the wrapped logger applies again without the log level.
+	cmw.OnChange(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigName}})
+	logger.Debug("hidden")
+	logger.With("key", "value").Info("shown")
+
+	util.AssertEqual(t, bytes.Count(buf.Bytes(), []byte(`"msg":"shown"`)), 2)
+	util.AssertEqual(t, bytes.Count(buf.Bytes(), []byte(`"msg":"hidden"`)), 0)
+}
+
+func TestWatchOperatorConfigInvalid(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigName, Namespace: "knative-operator"},
+		Data:       map[string]string{ResyncPeriodKey: "often"},
+	})
+	if _, _, err := WatchOperatorConfig(context.Background(), kubeClient); err == nil {
+		t.Error("WatchOperatorConfig() = nil, wanted an error")
+	}
+}
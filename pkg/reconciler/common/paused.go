@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// IsPaused returns true if the reconciliation of the Knative component is paused by annotation.
+func IsPaused(instance base.KComponent) bool {
+	return strings.EqualFold(instance.GetAnnotations()[base.PausedAnnotation], "true")
+}
+
+// CheckPaused updates the Paused status of the Knative component and returns true, if nothing
+// may be changed in the cluster.
+func CheckPaused(instance base.KComponent) bool {
+	if IsPaused(instance) {
+		instance.GetStatus().MarkPaused()
+		return true
+	}
+	instance.GetStatus().ClearPaused()
+	return false
+}
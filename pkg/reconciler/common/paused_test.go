@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestCheckPaused(t *testing.T) {
+	ke := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{base.PausedAnnotation: "True"},
+		},
+	}
+	ke.Status.InitializeConditions()
+
+	util.AssertEqual(t, CheckPaused(ke), true)
+	util.AssertEqual(t, ke.Status.GetCondition(base.Paused).IsTrue(), true)
+
+	ke.Annotations[base.PausedAnnotation] = "false"
+	util.AssertEqual(t, CheckPaused(ke), false)
+	if c := ke.Status.GetCondition(base.Paused); c != nil {
+		t.Errorf("GetCondition(Paused) = %v, want nil", c)
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// autopilotGroup is the API group, which is only served by GKE Autopilot clusters.
+const autopilotGroup = "auto.gke.io"
+
+var (
+	// The minimum requests of a container and the range of the ratio of its memory to its CPU,
+	// which GKE Autopilot accepts without changing the requests itself.
+	autopilotMinCPU       = resource.MustParse("50m")
+	autopilotMinMemory    = resource.MustParse("52Mi")
+	autopilotMinMemPerCPU = 1.0 * (1 << 30)
+	autopilotMaxMemPerCPU = 6.5 * (1 << 30)
+)
+
+// ApplyPlatform returns a Stage, which adjusts the manifest to the constraints of the platform of
+// spec.platform, of default-platform of the config map config-operator, or of the platform
+// detected from the cluster, if both are unset. As the detection depends on the cluster, and the
+// default on the config map, the Stage has to run after the cached stages.
+func ApplyPlatform(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		platform := instance.GetSpec().GetPlatform()
+		if platform == "" {
+			platform = GetOperatorConfig(ctx).DefaultPlatform
+		}
+		if platform == "" {
+			var err error
+			if platform, err = detectPlatform(kubeClient); err != nil {
+				return err
+			}
+		}
+		if platform != base.PlatformGKEAutopilot {
+			return nil
+		}
+		logging.FromContext(ctx).Debugw("Adjusting the manifest to the platform", "platform", platform)
+		m, err := manifest.Transform(AutopilotTransform())
+		if err != nil {
+			instance.GetStatus().MarkInstallFailed(err.Error())
+			return err
+		}
+		*manifest = m
+		return nil
+	}
+}
+
+// detectPlatform returns the platform of the cluster, PlatformNone if it is none of the known
+// ones or if there is no cluster, when rendering offline.
+func detectPlatform(kubeClient kubernetes.Interface) (base.Platform, error) {
+	if kubeClient == nil {
+		return base.PlatformNone, nil
+	}
+	groups, err := kubeClient.Discovery().ServerGroups()
+	if err != nil {
+		return "", fmt.Errorf("failed to discover the API groups: %w", err)
+	}
+	for _, group := range groups.Groups {
+		if group.Name == autopilotGroup {
+			return base.PlatformGKEAutopilot, nil
+		}
+	}
+	return base.PlatformNone, nil
+}
+
+// AutopilotTransform adjusts the workloads to the policies of GKE Autopilot, which rejects pods
+// with privileged containers, host ports or namespaces and tolerations of taints, which their
+// node selector doesn't select, and changes the requests of the containers, which are missing or
+// out of the accepted ratios. The requests are set here instead, and the limits to the requests,
+// so that the operator doesn't revert the changes of Autopilot on every reconciliation.
+func AutopilotTransform() mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		path, ok := podSpecPaths[u.GetKind()]
+		if !ok {
+			return nil
+		}
+		podSpec, found, err := unstructured.NestedMap(u.Object, path...)
+		if err != nil || !found {
+			return err
+		}
+		for _, field := range []string{"hostNetwork", "hostPID", "hostIPC"} {
+			delete(podSpec, field)
+		}
+		if err := autopilotTolerations(podSpec); err != nil {
+			return err
+		}
+		for _, field := range []string{"containers", "initContainers"} {
+			containers, found, err := unstructured.NestedSlice(podSpec, field)
+			if err != nil {
+				return err
+			}
+			if !found {
+				continue
+			}
+			for i := range containers {
+				container, ok := containers[i].(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("%s of %s %s is not an object", field, u.GetKind(), u.GetName())
+				}
+				if err := autopilotContainer(container); err != nil {
+					return fmt.Errorf("failed to adjust %s %s: %w", u.GetKind(), u.GetName(), err)
+				}
+			}
+			if err := unstructured.SetNestedSlice(podSpec, containers, field); err != nil {
+				return err
+			}
+		}
+		return unstructured.SetNestedMap(u.Object, podSpec, path...)
+	}
+}
+
+// autopilotTolerations removes the tolerations, whose key is not selected by the node selector
+// of the unstructured pod spec. The tolerations of the taints of Kubernetes itself are kept.
+func autopilotTolerations(podSpec map[string]interface{}) error {
+	tolerations, found, err := unstructured.NestedSlice(podSpec, "tolerations")
+	if err != nil || !found {
+		return err
+	}
+	selector, _, err := unstructured.NestedStringMap(podSpec, "nodeSelector")
+	if err != nil {
+		return err
+	}
+	var kept []interface{}
+	for _, t := range tolerations {
+		toleration, _ := t.(map[string]interface{})
+		key, _, _ := unstructured.NestedString(toleration, "key")
+		if _, selected := selector[key]; selected || strings.HasPrefix(key, "node.kubernetes.io/") {
+			kept = append(kept, t)
+		}
+	}
+	if len(kept) == 0 {
+		delete(podSpec, "tolerations")
+		return nil
+	}
+	return unstructured.SetNestedSlice(podSpec, kept, "tolerations")
+}
+
+// autopilotContainer removes the privileges and the host ports of the unstructured container and
+// sets its requests within the bounds of Autopilot.
+func autopilotContainer(container map[string]interface{}) error {
+	unstructured.RemoveNestedField(container, "securityContext", "privileged")
+	ports, _, err := unstructured.NestedSlice(container, "ports")
+	if err != nil {
+		return err
+	}
+	for _, p := range ports {
+		if port, ok := p.(map[string]interface{}); ok {
+			delete(port, "hostPort")
+			delete(port, "hostIP")
+		}
+	}
+	if len(ports) > 0 {
+		if err := unstructured.SetNestedSlice(container, ports, "ports"); err != nil {
+			return err
+		}
+	}
+
+	current, _, err := unstructured.NestedMap(container, "resources")
+	if err != nil {
+		return err
+	}
+	resources := corev1.ResourceRequirements{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(current, &resources); err != nil {
+		return err
+	}
+	requests := autopilotRequests(resources.Requests)
+	if resources.Requests == nil {
+		resources.Requests = corev1.ResourceList{}
+	}
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+	for name, quantity := range requests {
+		resources.Requests[name] = quantity
+		resources.Limits[name] = quantity
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&resources)
+	if err != nil {
+		return err
+	}
+	container["resources"] = u
+	return nil
+}
+
+// autopilotRequests returns the CPU and memory requests, raised to the minimum of Autopilot and
+// to the range of the ratio of the memory to the CPU.
+func autopilotRequests(requests corev1.ResourceList) corev1.ResourceList {
+	cpu, memory := requests.Cpu().DeepCopy(), requests.Memory().DeepCopy()
+	if cpu.Cmp(autopilotMinCPU) < 0 {
+		cpu = autopilotMinCPU.DeepCopy()
+	}
+	if memory.Cmp(autopilotMinMemory) < 0 {
+		memory = autopilotMinMemory.DeepCopy()
+	}
+	cores := cpu.AsApproximateFloat64()
+	if minMemory := int64(cores * autopilotMinMemPerCPU); memory.Value() < minMemory {
+		memory = *resource.NewQuantity(minMemory, resource.BinarySI)
+	}
+	if maxMemory := int64(cores * autopilotMaxMemPerCPU); memory.Value() > maxMemory {
+		minCPU := float64(memory.Value()) / autopilotMaxMemPerCPU
+		cpu = *resource.NewMilliQuantity(int64(minCPU*1000)+1, resource.DecimalSI)
+	}
+	return corev1.ResourceList{corev1.ResourceCPU: cpu, corev1.ResourceMemory: memory}
+}
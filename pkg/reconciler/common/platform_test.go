@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func autopilotDeployment(t *testing.T) unstructured.Unstructured {
+	return util.MakeUnstructured(t, &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "controller", Namespace: "knative-serving"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					HostNetwork:  true,
+					NodeSelector: map[string]string{"pool": "knative"},
+					Tolerations: []corev1.Toleration{
+						{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "knative", Effect: corev1.TaintEffectNoSchedule},
+						{Key: "dedicated", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+						{Key: "node.kubernetes.io/not-ready", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+					},
+					Containers: []corev1.Container{{
+						Name:  "controller",
+						Image: "controller",
+						Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080, HostPort: 8080}},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("100m"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+							Limits: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("1"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{Privileged: ptr.Bool(true)},
+					}},
+				},
+			},
+		},
+	})
+}
+
+func TestAutopilotTransform(t *testing.T) {
+	u := autopilotDeployment(t)
+	if err := AutopilotTransform()(&u); err != nil {
+		t.Fatalf("AutopilotTransform() = %v", err)
+	}
+	got := &appsv1.Deployment{}
+	if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	podSpec := got.Spec.Template.Spec
+	util.AssertEqual(t, podSpec.HostNetwork, false)
+	util.AssertDeepEqual(t, podSpec.Tolerations, []corev1.Toleration{
+		{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "knative", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "node.kubernetes.io/not-ready", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	})
+
+	container := podSpec.Containers[0]
+	util.AssertEqual(t, container.Ports[0].HostPort, int32(0))
+	util.AssertDeepEqual(t, container.SecurityContext, &corev1.SecurityContext{})
+	// 1Gi of memory needs at least 1Gi / 6.5 of CPU, and the limits equal the requests.
+	util.AssertEqual(t, container.Resources.Requests.Cpu().String(), "154m")
+	util.AssertEqual(t, container.Resources.Requests.Memory().String(), "1Gi")
+	util.AssertDeepEqual(t, container.Resources.Limits, container.Resources.Requests)
+}
+
+func TestAutopilotRequests(t *testing.T) {
+	tests := []struct {
+		name       string
+		requests   corev1.ResourceList
+		wantCPU    string
+		wantMemory string
+	}{{
+		name:       "missing",
+		wantCPU:    "50m",
+		wantMemory: "52Mi",
+	}, {
+		name: "within the ratio",
+		requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+			corev1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+		wantCPU:    "500m",
+		wantMemory: "1Gi",
+	}, {
+		name: "too little memory",
+		requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+		wantCPU:    "2",
+		wantMemory: "2Gi",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := autopilotRequests(test.requests)
+			util.AssertEqual(t, got.Cpu().String(), test.wantCPU)
+			util.AssertEqual(t, got.Memory().String(), test.wantMemory)
+		})
+	}
+}
+
+func TestApplyPlatform(t *testing.T) {
+	tests := []struct {
+		name            string
+		platform        base.Platform
+		defaultPlatform base.Platform
+		autopilot       bool
+		want            bool
+	}{{
+		name: "not detected",
+	}, {
+		name:      "detected",
+		autopilot: true,
+		want:      true,
+	}, {
+		name:     "selected",
+		platform: base.PlatformGKEAutopilot,
+		want:     true,
+	}, {
+		name:      "detection disabled",
+		platform:  base.PlatformNone,
+		autopilot: true,
+	}, {
+		name:            "default",
+		defaultPlatform: base.PlatformGKEAutopilot,
+		want:            true,
+	}, {
+		name:            "detection disabled by default",
+		defaultPlatform: base.PlatformNone,
+		autopilot:       true,
+	}, {
+		name:            "default overridden",
+		platform:        base.PlatformNone,
+		defaultPlatform: base.PlatformGKEAutopilot,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			if test.autopilot {
+				kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+					GroupVersion: "auto.gke.io/v1",
+					APIResources: []metav1.APIResource{{Kind: "AllowlistSynchronizer"}},
+				}}
+			}
+			instance := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Platform: test.platform}},
+			}
+			manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{autopilotDeployment(t)}))
+			if err != nil {
+				t.Fatalf("ManifestFrom() = %v", err)
+			}
+			store := NewOperatorConfigStore(ControllerConfig{})
+			store.OnConfigChanged(&corev1.ConfigMap{Data: map[string]string{DefaultPlatformKey: string(test.defaultPlatform)}})
+			ctx := WithOperatorConfigStore(context.Background(), store)
+			if err := ApplyPlatform(kubeClient)(ctx, &manifest, instance); err != nil {
+				t.Fatalf("ApplyPlatform() = %v", err)
+			}
+			hostNetwork, _, _ := unstructured.NestedBool(manifest.Resources()[0].Object, "spec", "template", "spec", "hostNetwork")
+			util.AssertEqual(t, !hostNetwork, test.want)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// PodSecurityEnforceLabel is the label of the namespaces with the level of the Pod Security
+	// Standards, which the Pod Security Admission enforces.
+	PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+
+	seccompRuntimeDefault = "RuntimeDefault"
+)
+
+// PodSecurityTransform labels the namespaces of the operands with the Pod Security Standard of
+// spec.security.podSecurityStandard. In restricted mode, it also hardens the security contexts of
+// all the containers of the operands, so that they are admitted by the restricted namespaces:
+// they run as non-root with the RuntimeDefault seccomp profile, without privilege escalation and
+// without any capabilities.
+func PodSecurityTransform(instance base.KComponent) mf.Transformer {
+	standard := instance.GetSpec().GetPodSecurityStandard()
+	if standard == "" {
+		return nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() == "Namespace" {
+			labels := u.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[PodSecurityEnforceLabel] = string(standard)
+			u.SetLabels(labels)
+			return nil
+		}
+		if standard != base.PodSecurityStandardRestricted {
+			return nil
+		}
+		path, ok := podSpecPaths[u.GetKind()]
+		if !ok {
+			return nil
+		}
+		podSpec, found, err := unstructured.NestedMap(u.Object, path...)
+		if err != nil || !found {
+			return err
+		}
+		if err := restrictPodSpec(podSpec); err != nil {
+			return fmt.Errorf("failed to restrict %s %s: %w", u.GetKind(), u.GetName(), err)
+		}
+		return unstructured.SetNestedMap(u.Object, podSpec, path...)
+	}
+}
+
+// restrictPodSpec sets the fields of the unstructured pod spec and its containers, which the
+// restricted Pod Security Standard requires. The other fields are kept.
+func restrictPodSpec(podSpec map[string]interface{}) error {
+	if err := unstructured.SetNestedField(podSpec, true, "securityContext", "runAsNonRoot"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(podSpec, seccompRuntimeDefault, "securityContext", "seccompProfile", "type"); err != nil {
+		return err
+	}
+	for _, field := range []string{"containers", "initContainers"} {
+		containers, found, err := unstructured.NestedSlice(podSpec, field)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		for i := range containers {
+			container, ok := containers[i].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s is not an object", field)
+			}
+			if err := restrictContainer(container); err != nil {
+				return err
+			}
+		}
+		if err := unstructured.SetNestedSlice(podSpec, containers, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restrictContainer hardens the security context of the unstructured container.
+func restrictContainer(container map[string]interface{}) error {
+	fields := map[string]interface{}{
+		"runAsNonRoot":             true,
+		"allowPrivilegeEscalation": false,
+		"privileged":               false,
+	}
+	for name, value := range fields {
+		if err := unstructured.SetNestedField(container, value, "securityContext", name); err != nil {
+			return err
+		}
+	}
+	if err := unstructured.SetNestedStringSlice(container, []string{"ALL"}, "securityContext", "capabilities", "drop"); err != nil {
+		return err
+	}
+	// Only NET_BIND_SERVICE may be added back in restricted namespaces.
+	added, _, err := unstructured.NestedStringSlice(container, "securityContext", "capabilities", "add")
+	if err != nil {
+		return err
+	}
+	var allowed []string
+	for _, capability := range added {
+		if capability == "NET_BIND_SERVICE" {
+			allowed = append(allowed, capability)
+		}
+	}
+	if len(allowed) == 0 {
+		unstructured.RemoveNestedField(container, "securityContext", "capabilities", "add")
+	} else if err := unstructured.SetNestedStringSlice(container, allowed, "securityContext", "capabilities", "add"); err != nil {
+		return err
+	}
+	// A container profile overrides the one of the pod, so it must not be unconfined.
+	profile, _, err := unstructured.NestedString(container, "securityContext", "seccompProfile", "type")
+	if err != nil {
+		return err
+	}
+	if profile != "" && profile != seccompRuntimeDefault && profile != "Localhost" {
+		return unstructured.SetNestedField(container, seccompRuntimeDefault, "securityContext", "seccompProfile", "type")
+	}
+	return nil
+}
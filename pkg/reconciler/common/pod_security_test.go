@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestPodSecurityTransform(t *testing.T) {
+	restricted := &corev1.SecurityContext{
+		RunAsNonRoot:             ptr.Bool(true),
+		AllowPrivilegeEscalation: ptr.Bool(false),
+		Privileged:               ptr.Bool(false),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+	tests := []struct {
+		name          string
+		standard      base.PodSecurityStandard
+		container     *corev1.SecurityContext
+		wantLabel     string
+		wantPod       *corev1.PodSecurityContext
+		wantContainer *corev1.SecurityContext
+		wantInit      *corev1.SecurityContext
+	}{{
+		name: "unset",
+		container: &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+		},
+		wantContainer: &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+		},
+	}, {
+		name:     "baseline",
+		standard: base.PodSecurityStandardBaseline,
+		container: &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+		},
+		wantLabel: "baseline",
+		wantContainer: &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+		},
+	}, {
+		name:      "restricted",
+		standard:  base.PodSecurityStandardRestricted,
+		wantLabel: "restricted",
+		wantPod: &corev1.PodSecurityContext{
+			RunAsNonRoot:   ptr.Bool(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		wantContainer: restricted,
+		wantInit:      restricted,
+	}, {
+		name:     "restricted keeps the allowed fields",
+		standard: base.PodSecurityStandardRestricted,
+		container: &corev1.SecurityContext{
+			RunAsUser:              ptr.Int64(65532),
+			ReadOnlyRootFilesystem: ptr.Bool(true),
+			Capabilities: &corev1.Capabilities{
+				Add:  []corev1.Capability{"NET_BIND_SERVICE", "NET_ADMIN"},
+				Drop: []corev1.Capability{"NET_RAW"},
+			},
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+		},
+		wantLabel: "restricted",
+		wantPod: &corev1.PodSecurityContext{
+			RunAsNonRoot:   ptr.Bool(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		wantContainer: &corev1.SecurityContext{
+			RunAsUser:                ptr.Int64(65532),
+			RunAsNonRoot:             ptr.Bool(true),
+			ReadOnlyRootFilesystem:   ptr.Bool(true),
+			AllowPrivilegeEscalation: ptr.Bool(false),
+			Privileged:               ptr.Bool(false),
+			Capabilities: &corev1.Capabilities{
+				Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+				Drop: []corev1.Capability{"ALL"},
+			},
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		wantInit: restricted,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeEventing{
+				ObjectMeta: metav1.ObjectMeta{Name: "knative-eventing", Namespace: "knative-eventing"},
+			}
+			if test.standard != "" {
+				instance.Spec.Security = &base.PodSecurityConfiguration{PodSecurityStandard: test.standard}
+			}
+			ns := util.MakeUnstructured(t, &corev1.Namespace{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+				ObjectMeta: metav1.ObjectMeta{Name: "knative-eventing", Labels: map[string]string{"app": "knative"}},
+			})
+			deployment := util.MakeUnstructured(t, &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "eventing-controller", Namespace: "knative-eventing"},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{{Name: "init", Image: "init"}},
+							Containers: []corev1.Container{{
+								Name:            "controller",
+								Image:           "controller",
+								SecurityContext: test.container,
+							}},
+						},
+					},
+				},
+			})
+
+			if transform := PodSecurityTransform(instance); transform != nil {
+				for _, u := range []*unstructured.Unstructured{&ns, &deployment} {
+					if err := transform(u); err != nil {
+						t.Fatalf("PodSecurityTransform() = %v", err)
+					}
+				}
+			}
+
+			wantLabels := map[string]string{"app": "knative"}
+			if test.wantLabel != "" {
+				wantLabels[PodSecurityEnforceLabel] = test.wantLabel
+			}
+			if diff := cmp.Diff(wantLabels, ns.GetLabels()); diff != "" {
+				t.Errorf("namespace labels (-want, +got): %s", diff)
+			}
+
+			got := &appsv1.Deployment{}
+			if err := scheme.Scheme.Convert(&deployment, got, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			podSpec := got.Spec.Template.Spec
+			if diff := cmp.Diff(test.wantPod, podSpec.SecurityContext); diff != "" {
+				t.Errorf("pod security context (-want, +got): %s", diff)
+			}
+			if diff := cmp.Diff(test.wantContainer, podSpec.Containers[0].SecurityContext); diff != "" {
+				t.Errorf("container security context (-want, +got): %s", diff)
+			}
+			if diff := cmp.Diff(test.wantInit, podSpec.InitContainers[0].SecurityContext); diff != "" {
+				t.Errorf("init container security context (-want, +got): %s", diff)
+			}
+		})
+	}
+}
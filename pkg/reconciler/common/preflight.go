@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	mf "github.com/manifestival/manifestival"
+	"golang.org/x/mod/semver"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/version"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// KubernetesMaxVersionKey is the environment variable to specify the highest minor version of
+// Kubernetes, which the Knative components may be installed on.
+const KubernetesMaxVersionKey = "KUBERNETES_MAX_VERSION"
+
+// Preflight returns a Stage, which validates that the cluster meets the requirements of the manifest
+// before the Knative component is installed or upgraded. All violations are listed in the
+// PreflightChecksPassed condition. The checks do not run again, once the target version is installed.
+func Preflight(kubeClient kubernetes.Interface) Stage {
+	return preflight(kubeClient, http.DefaultClient)
+}
+
+func preflight(kubeClient kubernetes.Interface, client *http.Client) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		status := instance.GetStatus()
+		if status.GetVersion() == TargetVersion(instance) {
+			return nil
+		}
+		if strings.EqualFold(instance.GetAnnotations()[base.SkipPreflightAnnotation], "true") {
+			logging.FromContext(ctx).Warnw("Skipping the pre-flight checks", "annotation", base.SkipPreflightAnnotation)
+			status.MarkPreflightChecksPassed()
+			return nil
+		}
+
+		checks := []func() ([]string, error){
+			func() ([]string, error) { return checkKubernetesVersion(kubeClient) },
+			func() ([]string, error) { return checkAPIResources(kubeClient, manifest) },
+			func() ([]string, error) { return checkCapacity(ctx, kubeClient, manifest) },
+			func() ([]string, error) { return checkImages(ctx, kubeClient, client, manifest, instance) },
+			func() ([]string, error) { return checkArchitectures(ctx, kubeClient, client, manifest, instance) },
+		}
+		if status.GetVersion() == "" {
+			// Only a fresh install may conflict with resources, which the operator did not create.
+			checks = append(checks, func() ([]string, error) { return checkConflicts(manifest) })
+		}
+
+		var violations []string
+		for _, check := range checks {
+			v, err := check()
+			if err != nil {
+				return err
+			}
+			violations = append(violations, v...)
+		}
+		if len(violations) > 0 {
+			status.MarkPreflightChecksFailed(violations)
+			msg := "pre-flight checks failed: " + strings.Join(violations, "; ")
+			status.MarkInstallFailed(msg)
+			return fmt.Errorf("%s", msg)
+		}
+		status.MarkPreflightChecksPassed()
+		return nil
+	}
+}
+
+// checkKubernetesVersion validates the version of Kubernetes against KUBERNETES_MIN_VERSION and
+// KUBERNETES_MAX_VERSION.
+func checkKubernetesVersion(kubeClient kubernetes.Interface) ([]string, error) {
+	var violations []string
+	if err := version.CheckMinimumVersion(kubeClient.Discovery()); err != nil {
+		violations = append(violations, err.Error())
+	}
+	maxVersion := os.Getenv(KubernetesMaxVersionKey)
+	if maxVersion == "" {
+		return violations, nil
+	}
+	serverVersion, err := kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the Kubernetes version: %w", err)
+	}
+	current := semver.MajorMinor(SanitizeSemver(serverVersion.GitVersion))
+	max := semver.MajorMinor(SanitizeSemver(maxVersion))
+	if current != "" && max != "" && semver.Compare(current, max) > 0 {
+		violations = append(violations, fmt.Sprintf("kubernetes version %q is not supported, need at most %q (this can be overridden with the env var %q)",
+			serverVersion.GitVersion, maxVersion, KubernetesMaxVersionKey))
+	}
+	return violations, nil
+}
+
+// checkAPIResources validates that the cluster serves all the kinds in the manifest, except those
+// defined by a CustomResourceDefinition in the manifest itself.
+func checkAPIResources(kubeClient kubernetes.Interface, manifest *mf.Manifest) ([]string, error) {
+	defined := sets.New[schema.GroupKind]()
+	for _, u := range manifest.Filter(mf.ByKind("CustomResourceDefinition")).Resources() {
+		group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(u.Object, "spec", "names", "kind")
+		defined.Insert(schema.GroupKind{Group: group, Kind: kind})
+	}
+
+	required := map[schema.GroupVersion]sets.Set[string]{}
+	for _, u := range manifest.Resources() {
+		gvk := u.GroupVersionKind()
+		if defined.Has(gvk.GroupKind()) {
+			continue
+		}
+		if required[gvk.GroupVersion()] == nil {
+			required[gvk.GroupVersion()] = sets.New[string]()
+		}
+		required[gvk.GroupVersion()].Insert(gvk.Kind)
+	}
+
+	var violations []string
+	for _, gv := range sortedGroupVersions(required) {
+		served := sets.New[string]()
+		resources, err := kubeClient.Discovery().ServerResourcesForGroupVersion(gv.String())
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to discover the resources of %s: %w", gv, err)
+		}
+		if resources != nil {
+			for _, r := range resources.APIResources {
+				served.Insert(r.Kind)
+			}
+		}
+		if missing := required[gv].Difference(served); missing.Len() > 0 {
+			violations = append(violations, fmt.Sprintf("the API %s is not served for %s", gv, strings.Join(sets.List(missing), ", ")))
+		}
+	}
+	return violations, nil
+}
+
+func sortedGroupVersions(gvs map[schema.GroupVersion]sets.Set[string]) []schema.GroupVersion {
+	result := make([]schema.GroupVersion, 0, len(gvs))
+	for gv := range gvs {
+		result = append(result, gv)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result
+}
+
+// checkConflicts validates that no CustomResourceDefinition or webhook configuration in the manifest
+// exists already, without having been created by the operator, e.g. by a manual install.
+func checkConflicts(manifest *mf.Manifest) ([]string, error) {
+	var violations []string
+	pred := mf.Any(mf.ByKind("CustomResourceDefinition"), mf.ByKind("MutatingWebhookConfiguration"), mf.ByKind("ValidatingWebhookConfiguration"))
+	for _, u := range manifest.Filter(pred).Resources() {
+		current, err := manifest.Client.Get(&u)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s %s: %w", u.GetKind(), u.GetName(), err)
+		}
+		if _, ok := current.GetAnnotations()["manifestival"]; !ok {
+			violations = append(violations, fmt.Sprintf("%s %s already exists and was not installed by the operator", u.GetKind(), u.GetName()))
+		}
+	}
+	return violations, nil
+}
+
+// checkCapacity validates that the schedulable nodes can accommodate the resources requested by the
+// workloads in the manifest. Resources requested by other pods are not taken into account.
+func checkCapacity(ctx context.Context, kubeClient kubernetes.Interface, manifest *mf.Manifest) ([]string, error) {
+	requested := corev1.ResourceList{}
+	for _, u := range manifest.Filter(mf.Any(mf.ByKind("Deployment"), mf.ByKind("StatefulSet"))).Resources() {
+		replicas, podSpec, err := workloadPodSpec(&u)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range podSpec.Containers {
+			for name, quantity := range c.Resources.Requests {
+				if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+					continue
+				}
+				total := requested[name]
+				for i := int32(0); i < replicas; i++ {
+					total.Add(quantity)
+				}
+				requested[name] = total
+			}
+		}
+	}
+	if len(requested) == 0 {
+		return nil, nil
+	}
+
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the nodes: %w", err)
+	}
+	allocatable := corev1.ResourceList{}
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		for name, quantity := range node.Status.Allocatable {
+			total := allocatable[name]
+			total.Add(quantity)
+			allocatable[name] = total
+		}
+	}
+	if len(nodes.Items) == 0 {
+		// Clusters provisioning nodes on demand do not report any capacity upfront.
+		return nil, nil
+	}
+
+	var violations []string
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		want, ok := requested[name]
+		if !ok {
+			continue
+		}
+		have := allocatable[name]
+		if want.Cmp(have) > 0 {
+			violations = append(violations, fmt.Sprintf("the workloads request %s %s, but the schedulable nodes only provide %s", want.String(), name, have.String()))
+		}
+	}
+	return violations, nil
+}
+
+// checkImages validates that the registries serve the images of the manifest with the credentials of
+// spec.registry.imagePullSecrets, if spec.registry.verifyImages enables it, so that the new version
+// does not leave pods failing to pull their images.
+func checkImages(ctx context.Context, kubeClient kubernetes.Interface, client *http.Client, manifest *mf.Manifest, instance base.KComponent) ([]string, error) {
+	registry := instance.GetSpec().GetRegistry()
+	if registry == nil || !registry.VerifyImages {
+		return nil, nil
+	}
+	config, violations := pullSecretsConfig(ctx, kubeClient, instance)
+	all := func(string) bool { return true }
+	for _, image := range manifestImages(manifest, registry.Override, all) {
+		if err := checkImage(ctx, client, image, config); err != nil {
+			violations = append(violations, fmt.Sprintf("the image %s is not available: %v", image, err))
+		}
+	}
+	return violations, nil
+}
+
+// pullSecretsConfig merges the credentials of spec.registry.imagePullSecrets. The secrets, which
+// can't be read, are returned as violations.
+func pullSecretsConfig(ctx context.Context, kubeClient kubernetes.Interface, instance base.KComponent) (dockerConfig, []string) {
+	var violations []string
+	config := dockerConfig{Auths: map[string]dockerAuth{}}
+	registry := instance.GetSpec().GetRegistry()
+	for i := range registry.ImagePullSecrets {
+		c, err := registryCredentials(ctx, kubeClient, instance.GetNamespace(), &registry.ImagePullSecrets[i])
+		if err != nil {
+			violations = append(violations, err.Error())
+			continue
+		}
+		for server, auth := range c.Auths {
+			config.Auths[server] = auth
+		}
+	}
+	return config, violations
+}
+
+// checkImage requests the manifest of the image from its registry.
+func checkImage(ctx context.Context, client *http.Client, image string, config dockerConfig) error {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return err
+	}
+	resp, manifestURL, _, err := headManifest(ctx, client, ref, config)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s of %s", resp.Status, manifestURL)
+	}
+	return nil
+}
+
+func workloadPodSpec(u *unstructured.Unstructured) (int32, *corev1.PodSpec, error) {
+	switch u.GetKind() {
+	case "Deployment":
+		d := &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(u, d, nil); err != nil {
+			return 0, nil, err
+		}
+		return replicasOrDefault(d.Spec.Replicas), &d.Spec.Template.Spec, nil
+	default:
+		ss := &appsv1.StatefulSet{}
+		if err := scheme.Scheme.Convert(u, ss, nil); err != nil {
+			return 0, nil, err
+		}
+		return replicasOrDefault(ss.Spec.Replicas), &ss.Spec.Template.Spec, nil
+	}
+}
+
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	fake "github.com/manifestival/manifestival/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestPreflight(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "controller"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "controller",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+						},
+					}},
+				},
+			},
+		},
+	}
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "services.serving.knative.dev"},
+		"spec": map[string]interface{}{
+			"group": "serving.knative.dev",
+			"names": map[string]interface{}{"kind": "Service"},
+		},
+	}}
+	service := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "serving.knative.dev/v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"namespace": "knative-serving", "name": "hello"},
+	}}
+	gateway := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1",
+		"kind":       "Gateway",
+		"metadata":   map[string]interface{}{"namespace": "knative-serving", "name": "knative-ingress-gateway"},
+	}}
+	node := func(cpu string, unschedulable bool) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-" + cpu},
+			Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+			},
+		}
+	}
+	served := []*metav1.APIResourceList{{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{{Kind: "Deployment"}},
+	}, {
+		GroupVersion: "apiextensions.k8s.io/v1",
+		APIResources: []metav1.APIResource{{Kind: "CustomResourceDefinition"}},
+	}}
+
+	tests := []struct {
+		name           string
+		resources      []runtime.Object
+		nodes          []runtime.Object
+		existing       []runtime.Object
+		serverVersion  string
+		maxVersion     string
+		installed      string
+		annotations    map[string]string
+		wantViolations []string
+	}{{
+		name:          "all checks pass",
+		resources:     []runtime.Object{deployment, crd, service},
+		nodes:         []runtime.Object{node("4", false)},
+		serverVersion: "v1.34.1",
+	}, {
+		name:          "too old and too new",
+		resources:     []runtime.Object{deployment},
+		serverVersion: "v1.33.0",
+		maxVersion:    "1.32",
+		wantViolations: []string{
+			`kubernetes version "1.33.0" is not compatible`,
+			`kubernetes version "v1.33.0" is not supported, need at most "1.32"`,
+		},
+	}, {
+		name:           "missing API",
+		resources:      []runtime.Object{deployment, gateway},
+		serverVersion:  "v1.34.1",
+		wantViolations: []string{"the API networking.istio.io/v1 is not served for Gateway"},
+	}, {
+		name:           "insufficient capacity",
+		resources:      []runtime.Object{deployment},
+		nodes:          []runtime.Object{node("1", false), node("8", true)},
+		serverVersion:  "v1.34.1",
+		wantViolations: []string{"the workloads request 2 cpu, but the schedulable nodes only provide 1"},
+	}, {
+		name:           "conflicting CRD on fresh install",
+		resources:      []runtime.Object{crd},
+		existing:       []runtime.Object{crd.DeepCopy()},
+		serverVersion:  "v1.34.1",
+		wantViolations: []string{"CustomResourceDefinition services.serving.knative.dev already exists and was not installed by the operator"},
+	}, {
+		name:          "existing CRD on upgrade",
+		resources:     []runtime.Object{crd},
+		existing:      []runtime.Object{crd.DeepCopy()},
+		serverVersion: "v1.34.1",
+		installed:     "1.17.0",
+	}, {
+		name:          "skipped",
+		resources:     []runtime.Object{gateway},
+		serverVersion: "v1.0.0",
+		annotations:   map[string]string{base.SkipPreflightAnnotation: "true"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(KubernetesMaxVersionKey, test.maxVersion)
+			kubeClient := kubefake.NewSimpleClientset(test.nodes...)
+			discovery := kubeClient.Discovery().(*fakediscovery.FakeDiscovery)
+			discovery.FakedServerVersion = &version.Info{GitVersion: test.serverVersion}
+			discovery.Resources = served
+
+			resources := make([]unstructured.Unstructured, 0, len(test.resources))
+			for _, obj := range test.resources {
+				m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				if err != nil {
+					t.Fatalf("Failed to convert %v: %v", obj, err)
+				}
+				resources = append(resources, unstructured.Unstructured{Object: m})
+			}
+			manifest, err := mf.ManifestFrom(mf.Slice(resources), mf.UseClient(fake.New(test.existing...)))
+			if err != nil {
+				t.Fatalf("Failed to generate manifest: %v", err)
+			}
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations},
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Version: "1.18.0"},
+				},
+				Status: v1beta1.KnativeServingStatus{Version: test.installed},
+			}
+			ks.Status.InitializeConditions()
+
+			err = Preflight(kubeClient)(context.Background(), &manifest, ks)
+			condition := ks.Status.GetCondition(base.PreflightChecksPassed)
+			if len(test.wantViolations) == 0 {
+				if err != nil {
+					t.Fatalf("Preflight() = %v", err)
+				}
+				if !condition.IsTrue() {
+					t.Fatalf("PreflightChecksPassed = %v, want True", condition)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Preflight() = nil, want an error")
+			}
+			if !condition.IsFalse() || condition.Reason != "PreflightFailed" {
+				t.Fatalf("PreflightChecksPassed = %v, want False with reason PreflightFailed", condition)
+			}
+			for _, violation := range test.wantViolations {
+				if !strings.Contains(condition.Message, violation) {
+					t.Errorf("PreflightChecksPassed message %q does not contain %q", condition.Message, violation)
+				}
+			}
+			if !ks.Status.GetCondition(base.InstallSucceeded).IsFalse() {
+				t.Error("InstallSucceeded must be False")
+			}
+		})
+	}
+}
+
+func TestPreflightInstalled(t *testing.T) {
+	// The checks must not even contact the cluster once the target version is installed.
+	kubeClient := kubefake.NewSimpleClientset()
+	discovery := kubeClient.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.FakedServerVersion = &version.Info{GitVersion: "v1.0.0"}
+
+	manifest, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{}))
+	ks := &v1beta1.KnativeServing{
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{Version: "1.18.0"},
+		},
+		Status: v1beta1.KnativeServingStatus{Version: "1.18.0"},
+	}
+	ks.Status.InitializeConditions()
+	if err := Preflight(kubeClient)(context.Background(), &manifest, ks); err != nil {
+		t.Fatalf("Preflight() = %v", err)
+	}
+	if got := len(kubeClient.Actions()); got != 0 {
+		t.Fatalf("Got %d actions, want none", got)
+	}
+}
+
+func TestPreflightImages(t *testing.T) {
+	registry := newFakeRegistry(t)
+	auth := base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "registry"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + registry.host() + `":{"auth":"` + auth + `"}}}`)},
+	}
+	served := []*metav1.APIResourceList{{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{{Kind: "Deployment"}},
+	}}
+
+	tests := []struct {
+		name           string
+		image          string
+		verify         bool
+		secrets        []corev1.LocalObjectReference
+		wantViolations []string
+		requests       int
+	}{{
+		name:  "disabled",
+		image: registry.host() + "/knative/controller:v2",
+	}, {
+		name:     "available by tag",
+		image:    registry.host() + "/knative/controller:v1",
+		verify:   true,
+		secrets:  []corev1.LocalObjectReference{{Name: "registry"}},
+		requests: 2,
+	}, {
+		name:           "missing tag",
+		image:          registry.host() + "/knative/controller:v2",
+		verify:         true,
+		secrets:        []corev1.LocalObjectReference{{Name: "registry"}},
+		wantViolations: []string{"the image " + registry.host() + "/knative/controller:v2 is not available: unexpected status 404 Not Found"},
+		requests:       2,
+	}, {
+		name:           "without the pull secret",
+		image:          registry.host() + "/knative/controller:v1",
+		verify:         true,
+		wantViolations: []string{"the image " + registry.host() + "/knative/controller:v1 is not available: unexpected status 401 Unauthorized of the token service"},
+		requests:       1,
+	}, {
+		name:    "missing pull secret",
+		image:   registry.host() + "/knative/controller:v1",
+		verify:  true,
+		secrets: []corev1.LocalObjectReference{{Name: "missing"}},
+		wantViolations: []string{
+			"failed to get the registry credentials knative-serving/missing",
+			"the image " + registry.host() + "/knative/controller:v1 is not available",
+		},
+		requests: 1,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registry.requests = 0
+			kubeClient := kubefake.NewSimpleClientset(secret)
+			discovery := kubeClient.Discovery().(*fakediscovery.FakeDiscovery)
+			discovery.FakedServerVersion = &version.Info{GitVersion: "v1.34.1"}
+			discovery.Resources = served
+
+			manifest, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{digestDeployment(test.image)}))
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{
+						Version:  "1.18.0",
+						Registry: base.Registry{VerifyImages: test.verify, ImagePullSecrets: test.secrets},
+					},
+				},
+			}
+			ks.Status.InitializeConditions()
+
+			err := preflight(kubeClient, registry.Client())(context.Background(), &manifest, ks)
+			if registry.requests != test.requests {
+				t.Errorf("Got %d manifest requests, want %d", registry.requests, test.requests)
+			}
+			condition := ks.Status.GetCondition(base.PreflightChecksPassed)
+			if len(test.wantViolations) == 0 {
+				if err != nil {
+					t.Fatalf("Preflight() = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Preflight() = nil, want an error")
+			}
+			for _, violation := range test.wantViolations {
+				if !strings.Contains(condition.Message, violation) {
+					t.Errorf("PreflightChecksPassed message %q does not contain %q", condition.Message, violation)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// PreviewConfigMapSuffix is appended to the name of the Knative component to name the ConfigMap,
+	// which contains the preview of the changes in dry-run mode.
+	PreviewConfigMapSuffix = "-preview"
+	// PreviewSummaryKey is the key of the preview ConfigMap containing the summary of the changes.
+	PreviewSummaryKey = "summary"
+	// PreviewChangesKey is the key of the preview ConfigMap containing the changes per resource.
+	PreviewChangesKey = "changes"
+
+	// maxPreviewChangesSize keeps the preview ConfigMap well below the size limit of 1MiB.
+	maxPreviewChangesSize = 900 * 1024
+)
+
+// PreviewAction is the action that applying the manifest would take on a resource.
+type PreviewAction string
+
+const (
+	// PreviewActionCreate means that the resource does not exist yet.
+	PreviewActionCreate PreviewAction = "create"
+	// PreviewActionUpdate means that the live resource differs from the rendered one.
+	PreviewActionUpdate PreviewAction = "update"
+	// PreviewActionUnchanged means that the live resource matches the rendered one.
+	PreviewActionUnchanged PreviewAction = "unchanged"
+)
+
+// PreviewChange describes the change, which applying the manifest would make to a single resource.
+type PreviewChange struct {
+	APIVersion string        `json:"apiVersion"`
+	Kind       string        `json:"kind"`
+	Namespace  string        `json:"namespace,omitempty"`
+	Name       string        `json:"name"`
+	Action     PreviewAction `json:"action"`
+	// Patch is the merge patch, which would be applied to the live resource.
+	Patch string `json:"patch,omitempty"`
+	// Error is the reason, why the API server rejected the resource with a server-side dry-run.
+	Error string `json:"error,omitempty"`
+}
+
+// PreviewSummary counts the changes per action.
+type PreviewSummary struct {
+	Create    int  `json:"create"`
+	Update    int  `json:"update"`
+	Unchanged int  `json:"unchanged"`
+	Rejected  int  `json:"rejected"`
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// pendingChanges are the changes outside of the manifest, e.g. the regeneration of a webhook
+// certificate, which the stages before Preview skipped in dry-run mode, by Knative component.
+var pendingChanges = &pendingChangeTracker{changes: map[string][]PreviewChange{}}
+
+type pendingChangeTracker struct {
+	mu      sync.Mutex
+	changes map[string][]PreviewChange
+}
+
+func pendingChangeKey(instance base.KComponent) string {
+	return fmt.Sprintf("%T/%s/%s", instance, instance.GetNamespace(), instance.GetName())
+}
+
+// set replaces the pending changes of the Knative component.
+func (t *pendingChangeTracker) set(instance base.KComponent, changes []PreviewChange) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(changes) == 0 {
+		delete(t.changes, pendingChangeKey(instance))
+	} else {
+		t.changes[pendingChangeKey(instance)] = changes
+	}
+}
+
+// take returns and forgets the pending changes of the Knative component.
+func (t *pendingChangeTracker) take(instance base.KComponent) []PreviewChange {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	changes := t.changes[pendingChangeKey(instance)]
+	delete(t.changes, pendingChangeKey(instance))
+	return changes
+}
+
+type previewCompletedError struct{}
+
+var _ error = previewCompletedError{}
+
+// Error implements the Error() interface of error.
+func (err previewCompletedError) Error() string {
+	return "preview completed"
+}
+
+// IsPreviewCompletedError returns true if the given error is a previewCompletedError.
+func IsPreviewCompletedError(err error) bool {
+	return errors.Is(err, previewCompletedError{})
+}
+
+// IsDryRun returns true if the Knative component is annotated to run in dry-run mode.
+func IsDryRun(instance base.KComponent) bool {
+	return strings.EqualFold(instance.GetAnnotations()[base.DryRunAnnotation], "true")
+}
+
+// PreviewConfigMapName returns the name of the ConfigMap containing the preview for the Knative component.
+func PreviewConfigMapName(instance base.KComponent) string {
+	return instance.GetName() + PreviewConfigMapSuffix
+}
+
+// Preview returns a Stage, which computes the changes the manifest would make to the cluster and
+// publishes them into a ConfigMap next to the Knative component, if the component is in dry-run mode.
+// The changes outside of the manifest, which the stages before skipped, are published as well.
+// No stage after Preview is executed in dry-run mode. Once the dry-run mode is disabled, the
+// ConfigMap of the last preview is deleted, as it would look current.
+func Preview(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		status := instance.GetStatus()
+		pending := pendingChanges.take(instance)
+		if !IsDryRun(instance) {
+			if getter, ok := status.(conditionsGetter); ok && getter.GetCondition(base.PreviewReady) == nil {
+				// No preview was published since the dry-run mode was disabled last.
+				return nil
+			}
+			status.ClearPreview()
+			err := kubeClient.CoreV1().ConfigMaps(instance.GetNamespace()).Delete(ctx, PreviewConfigMapName(instance), metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete the preview ConfigMap: %w", err)
+			}
+			return nil
+		}
+		logger := logging.FromContext(ctx)
+		logger.Info("Dry-run mode is active, rendering a preview instead of applying the manifest")
+
+		changes, err := previewChanges(manifest)
+		if err != nil {
+			status.MarkPreviewFailed(err.Error())
+			return err
+		}
+		changes = append(changes, pending...)
+		cm, err := previewConfigMap(instance, changes)
+		if err != nil {
+			status.MarkPreviewFailed(err.Error())
+			return err
+		}
+		if err := publishPreview(ctx, kubeClient, cm); err != nil {
+			status.MarkPreviewFailed(err.Error())
+			return err
+		}
+		status.MarkPreviewReady(cm.Name)
+		return previewCompletedError{}
+	}
+}
+
+// previewChanges computes the change for each resource in the manifest, one resource at a time.
+// Resources, which would be created or updated, are validated with a server-side dry-run.
+func previewChanges(manifest *mf.Manifest) ([]PreviewChange, error) {
+	changes := []PreviewChange{}
+	err := forEachResource(*manifest, func(u *unstructured.Unstructured) error {
+		change := PreviewChange{
+			APIVersion: u.GetAPIVersion(),
+			Kind:       u.GetKind(),
+			Namespace:  u.GetNamespace(),
+			Name:       u.GetName(),
+		}
+		single, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*u}), mf.UseClient(manifest.Client))
+		if err != nil {
+			return err
+		}
+		if u.GetName() == "" {
+			// Resources with a generated name are always created.
+			change.Name = u.GetGenerateName()
+			change.Action = PreviewActionCreate
+		} else if _, err := manifest.Client.Get(u); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return fmt.Errorf("failed to get %s %s/%s: %w", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+			}
+			change.Action = PreviewActionCreate
+		} else {
+			patches, err := single.DryRun()
+			if err != nil {
+				return fmt.Errorf("failed to compute the diff of %s %s/%s: %w", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+			}
+			change.Action = PreviewActionUnchanged
+			if len(patches) > 0 {
+				change.Action = PreviewActionUpdate
+				patch, err := json.Marshal(patches[0])
+				if err != nil {
+					return err
+				}
+				change.Patch = string(patch)
+			}
+		}
+		if change.Action != PreviewActionUnchanged {
+			if err := single.Apply(mf.DryRunAll); err != nil {
+				change.Error = err.Error()
+			}
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	return changes, err
+}
+
+func previewConfigMap(instance base.KComponent, changes []PreviewChange) (*corev1.ConfigMap, error) {
+	summary := PreviewSummary{}
+	for _, change := range changes {
+		switch change.Action {
+		case PreviewActionCreate:
+			summary.Create++
+		case PreviewActionUpdate:
+			summary.Update++
+		case PreviewActionUnchanged:
+			summary.Unchanged++
+		}
+		if change.Error != "" {
+			summary.Rejected++
+		}
+	}
+
+	changesYaml, err := yaml.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+	if len(changesYaml) > maxPreviewChangesSize {
+		// Drop the patches, so that at least the list of affected resources fits into the ConfigMap.
+		for i := range changes {
+			changes[i].Patch = ""
+		}
+		summary.Truncated = true
+		if changesYaml, err = yaml.Marshal(changes); err != nil {
+			return nil, err
+		}
+	}
+	summaryYaml, err := yaml.Marshal(summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            PreviewConfigMapName(instance),
+			Namespace:       instance.GetNamespace(),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(instance, instance.GroupVersionKind())},
+		},
+		Data: map[string]string{
+			PreviewSummaryKey: string(summaryYaml),
+			PreviewChangesKey: string(changesYaml),
+		},
+	}, nil
+}
+
+func publishPreview(ctx context.Context, kubeClient kubernetes.Interface, cm *corev1.ConfigMap) error {
+	configMaps := kubeClient.CoreV1().ConfigMaps(cm.Namespace)
+	existing, err := configMaps.Get(ctx, cm.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	existing = existing.DeepCopy()
+	existing.OwnerReferences = cm.OwnerReferences
+	existing.Data = cm.Data
+	_, err = configMaps.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
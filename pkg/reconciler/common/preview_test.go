@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	fake "github.com/manifestival/manifestival/fake"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestPreview(t *testing.T) {
+	unchanged := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "unchanged"},
+		Data:       map[string]string{"key": "value"},
+	}
+	updated := unchanged.DeepCopy()
+	updated.Name = "updated"
+	live := updated.DeepCopy()
+	live.Data = map[string]string{"key": "old"}
+	created := unchanged.DeepCopy()
+	created.Name = "created"
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantPreview bool
+		wantSummary PreviewSummary
+	}{{
+		name:        "dry-run disabled",
+		annotations: nil,
+	}, {
+		name:        "dry-run enabled",
+		annotations: map[string]string{base.DryRunAnnotation: "true"},
+		wantPreview: true,
+		wantSummary: PreviewSummary{Create: 1, Update: 1, Unchanged: 1},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.New([]runtime.Object{unchanged, live}...)
+			// The server-side dry-run must not persist anything.
+			dryRuns := 0
+			client.Stubs.Create = func(*unstructured.Unstructured) error {
+				dryRuns++
+				return nil
+			}
+			client.Stubs.Update = client.Stubs.Create
+			resources := []unstructured.Unstructured{}
+			for _, obj := range []*corev1.ConfigMap{unchanged, updated, created} {
+				u := unstructured.Unstructured{}
+				m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				if err != nil {
+					t.Fatalf("Failed to convert ConfigMap: %v", err)
+				}
+				u.SetUnstructuredContent(m)
+				resources = append(resources, u)
+			}
+			manifest, err := mf.ManifestFrom(mf.Slice(resources), mf.UseClient(client))
+			if err != nil {
+				t.Fatalf("Failed to generate manifest: %v", err)
+			}
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "knative-serving",
+					Name:        "knative-serving",
+					Annotations: test.annotations,
+				},
+			}
+			ks.Status.InitializeConditions()
+			kubeClient := kubefake.NewSimpleClientset()
+
+			err = Preview(kubeClient)(context.Background(), &manifest, ks)
+			if test.wantPreview != IsPreviewCompletedError(err) {
+				t.Fatalf("Preview() = %v, want preview completed: %v", err, test.wantPreview)
+			}
+
+			cm, getErr := kubeClient.CoreV1().ConfigMaps("knative-serving").Get(context.Background(), "knative-serving-preview", metav1.GetOptions{})
+			condition := ks.Status.GetCondition(base.PreviewReady)
+			if !test.wantPreview {
+				if getErr == nil {
+					t.Fatal("Preview ConfigMap must not be created without dry-run mode")
+				}
+				if condition != nil {
+					t.Fatalf("PreviewReady = %v, want nil", condition)
+				}
+				return
+			}
+			if getErr != nil {
+				t.Fatalf("Failed to get preview ConfigMap: %v", getErr)
+			}
+			if condition == nil || condition.Status != corev1.ConditionTrue {
+				t.Fatalf("PreviewReady = %v, want True", condition)
+			}
+			summary := PreviewSummary{}
+			if err := yaml.Unmarshal([]byte(cm.Data[PreviewSummaryKey]), &summary); err != nil {
+				t.Fatalf("Failed to parse the summary: %v", err)
+			}
+			if summary != test.wantSummary {
+				t.Fatalf("summary = %+v, want %+v", summary, test.wantSummary)
+			}
+
+			if dryRuns != test.wantSummary.Create+test.wantSummary.Update {
+				t.Fatalf("dry-runs = %d, want %d", dryRuns, test.wantSummary.Create+test.wantSummary.Update)
+			}
+		})
+	}
+}
+
+func TestPreviewDisabled(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{}), mf.UseClient(fake.New()))
+	if err != nil {
+		t.Fatalf("Failed to generate manifest: %v", err)
+	}
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "knative-serving",
+			Name:        "knative-serving",
+			Annotations: map[string]string{base.DryRunAnnotation: "true"},
+		},
+	}
+	ks.Status.InitializeConditions()
+	kubeClient := kubefake.NewSimpleClientset()
+	ctx := context.Background()
+
+	if err := Preview(kubeClient)(ctx, &manifest, ks); !IsPreviewCompletedError(err) {
+		t.Fatalf("Preview() = %v, want preview completed", err)
+	}
+	if _, err := kubeClient.CoreV1().ConfigMaps("knative-serving").Get(ctx, "knative-serving-preview", metav1.GetOptions{}); err != nil {
+		t.Fatalf("Failed to get preview ConfigMap: %v", err)
+	}
+
+	// The stale preview is deleted, once the dry-run mode is disabled.
+	ks.Annotations = nil
+	if err := Preview(kubeClient)(ctx, &manifest, ks); err != nil {
+		t.Fatalf("Preview() = %v", err)
+	}
+	if _, err := kubeClient.CoreV1().ConfigMaps("knative-serving").Get(ctx, "knative-serving-preview", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("Get() = %v, want the preview ConfigMap deleted", err)
+	}
+	if condition := ks.Status.GetCondition(base.PreviewReady); condition != nil {
+		t.Fatalf("PreviewReady = %v, want nil", condition)
+	}
+	if err := Preview(kubeClient)(ctx, &manifest, ks); err != nil {
+		t.Fatalf("Preview() = %v", err)
+	}
+}
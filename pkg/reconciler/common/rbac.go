@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	mf "github.com/manifestival/manifestival"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// hasAggregationRule selects the aggregated ClusterRoles, whose rules are filled in by the
+// controller manager.
+var hasAggregationRule mf.Predicate = func(u *unstructured.Unstructured) bool {
+	return u.GetKind() == "ClusterRole" && u.Object["aggregationRule"] != nil
+}
+
+// AggregateClusterRoles returns a Stage, which sets the rules of the aggregated ClusterRoles of
+// the manifest to the rules of the ClusterRoles, which their label selectors select, the way the
+// controller manager aggregates them. The rules of the ClusterRoles, which are no longer selected,
+// e.g. when an upgrade changes the selectors, are pruned right away, and the rules don't change,
+// when the controller manager aggregates them again, which would trigger needless updates. As the
+// rules depend on the cluster, the Stage has to run after the cached stages.
+func AggregateClusterRoles(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		if kubeClient == nil || len(manifest.Filter(hasAggregationRule).Resources()) == 0 {
+			return nil
+		}
+		list, err := kubeClient.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list the ClusterRoles: %w", err)
+		}
+		roles := make(map[string]*rbacv1.ClusterRole, len(list.Items))
+		for i := range list.Items {
+			roles[list.Items[i].Name] = &list.Items[i]
+		}
+		// The ClusterRoles of the manifest replace the ones of the cluster, as they are applied
+		// together. The aggregated ones keep their current rules.
+		for _, u := range manifest.Filter(mf.ByKind("ClusterRole"), mf.Not(hasAggregationRule)).Resources() {
+			role := &rbacv1.ClusterRole{}
+			if err := scheme.Scheme.Convert(&u, role, nil); err != nil {
+				return err
+			}
+			roles[role.Name] = role
+		}
+
+		m, err := manifest.Transform(func(u *unstructured.Unstructured) error {
+			if !hasAggregationRule(u) {
+				return nil
+			}
+			role := &rbacv1.ClusterRole{}
+			if err := scheme.Scheme.Convert(u, role, nil); err != nil {
+				return err
+			}
+			rules, err := aggregatedRules(role, roles)
+			if err != nil {
+				return fmt.Errorf("failed to aggregate the rules of the ClusterRole %s: %w", role.Name, err)
+			}
+			if len(rules) == 0 {
+				return nil
+			}
+			result := make([]interface{}, 0, len(rules))
+			for i := range rules {
+				rule, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&rules[i])
+				if err != nil {
+					return err
+				}
+				result = append(result, rule)
+			}
+			return unstructured.SetNestedSlice(u.Object, result, "rules")
+		})
+		if err != nil {
+			instance.GetStatus().MarkInstallFailed(err.Error())
+			return err
+		}
+		*manifest = m
+		return nil
+	}
+}
+
+// aggregatedRules returns the rules of the ClusterRoles, which the aggregation rule of the role
+// selects, in the order of the selectors and the names of the roles, without duplicates.
+func aggregatedRules(role *rbacv1.ClusterRole, roles map[string]*rbacv1.ClusterRole) ([]rbacv1.PolicyRule, error) {
+	names := make([]string, 0, len(roles))
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var rules []rbacv1.PolicyRule
+	for i := range role.AggregationRule.ClusterRoleSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&role.AggregationRule.ClusterRoleSelectors[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			selected := roles[name]
+			if name == role.Name || !selector.Matches(labels.Set(selected.Labels)) {
+				continue
+			}
+			for _, rule := range selected.Rules {
+				if !containsRule(rules, rule) {
+					rules = append(rules, rule)
+				}
+			}
+		}
+	}
+	return rules, nil
+}
+
+func containsRule(rules []rbacv1.PolicyRule, rule rbacv1.PolicyRule) bool {
+	for _, r := range rules {
+		if equality.Semantic.DeepEqual(r, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPermissions records the permissions, which the ClusterRoleBindings and RoleBindings of the
+// manifest grant to the service accounts, in the status of the component, so that they can be
+// reviewed without collecting the roles and bindings from the cluster.
+func SetPermissions(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	rules := map[string][]rbacv1.PolicyRule{}
+	for _, u := range manifest.Filter(mf.Any(mf.ByKind("ClusterRole"), mf.ByKind("Role"))).Resources() {
+		rs, _, err := unstructured.NestedSlice(u.Object, "rules")
+		if err != nil {
+			return err
+		}
+		role := &rbacv1.Role{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(map[string]interface{}{"rules": rs}, role); err != nil {
+			return err
+		}
+		rules[roleKey(u.GetKind(), u.GetNamespace(), u.GetName())] = role.Rules
+	}
+
+	permissions := map[string]*base.ServiceAccountPermissions{}
+	for _, u := range manifest.Filter(mf.Any(mf.ByKind("ClusterRoleBinding"), mf.ByKind("RoleBinding"))).Resources() {
+		binding := &rbacv1.RoleBinding{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, binding); err != nil {
+			return err
+		}
+		namespace := binding.Namespace
+		if u.GetKind() == "ClusterRoleBinding" {
+			namespace = ""
+		}
+		grant := base.RoleGrant{
+			Role:      binding.RoleRef.Kind + "/" + binding.RoleRef.Name,
+			Namespace: namespace,
+			Rules:     rules[roleKey(binding.RoleRef.Kind, namespace, binding.RoleRef.Name)],
+		}
+		for _, subject := range binding.Subjects {
+			if subject.Kind != rbacv1.ServiceAccountKind {
+				continue
+			}
+			name := subject.Namespace + "/" + subject.Name
+			if permissions[name] == nil {
+				permissions[name] = &base.ServiceAccountPermissions{ServiceAccount: name}
+			}
+			permissions[name].Grants = append(permissions[name].Grants, *grant.DeepCopy())
+		}
+	}
+
+	result := make([]base.ServiceAccountPermissions, 0, len(permissions))
+	for _, p := range permissions {
+		sort.Slice(p.Grants, func(i, j int) bool {
+			if p.Grants[i].Role != p.Grants[j].Role {
+				return p.Grants[i].Role < p.Grants[j].Role
+			}
+			return p.Grants[i].Namespace < p.Grants[j].Namespace
+		})
+		result = append(result, *p)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ServiceAccount < result[j].ServiceAccount })
+	if len(result) == 0 {
+		result = nil
+	}
+	instance.GetStatus().SetPermissions(result)
+	return nil
+}
+
+// roleKey identifies a Role by its namespace and name, and a ClusterRole by its name.
+func roleKey(kind, namespace, name string) string {
+	if kind == "ClusterRole" {
+		return kind + "/" + name
+	}
+	return kind + "/" + namespace + "/" + name
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+var (
+	servicesRule = rbacv1.PolicyRule{APIGroups: []string{"serving.knative.dev"}, Resources: []string{"services"}, Verbs: []string{"get", "list", "watch"}}
+	routesRule   = rbacv1.PolicyRule{APIGroups: []string{"serving.knative.dev"}, Resources: []string{"routes"}, Verbs: []string{"get"}}
+	podsRule     = rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}
+)
+
+func clusterRole(name string, labels map[string]string, rules ...rbacv1.PolicyRule) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Rules:      rules,
+	}
+}
+
+func aggregatedRole(labels map[string]string) *rbacv1.ClusterRole {
+	role := clusterRole("knative-serving-admin", nil)
+	role.AggregationRule = &rbacv1.AggregationRule{ClusterRoleSelectors: []metav1.LabelSelector{{MatchLabels: labels}}}
+	return role
+}
+
+func TestAggregateClusterRoles(t *testing.T) {
+	controller := map[string]string{"serving.knative.dev/controller": "true"}
+	other := map[string]string{"other": "true"}
+
+	current := aggregatedRole(other)
+	current.Rules = []rbacv1.PolicyRule{podsRule}
+	kubeClient := kubefake.NewSimpleClientset(
+		current,
+		clusterRole("knative-serving-core", controller, servicesRule),
+		clusterRole("knative-serving-podspecable-binding", controller, servicesRule, routesRule),
+		clusterRole("pods-reader", other, podsRule),
+	)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		util.MakeUnstructured(t, aggregatedRole(controller)),
+		// The ClusterRole of the manifest replaces the one of the cluster.
+		util.MakeUnstructured(t, clusterRole("knative-serving-podspecable-binding", controller, routesRule)),
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+
+	if err := AggregateClusterRoles(kubeClient)(context.Background(), &manifest, &v1beta1.KnativeServing{}); err != nil {
+		t.Fatalf("AggregateClusterRoles() = %v", err)
+	}
+	u := manifest.Filter(mf.ByName("knative-serving-admin")).Resources()[0]
+	got := &rbacv1.ClusterRole{}
+	if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	// The rules of the pods-reader, which the previous selector selected, are pruned.
+	util.AssertDeepEqual(t, got.Rules, []rbacv1.PolicyRule{servicesRule, routesRule})
+}
+
+func TestAggregateClusterRolesWithoutCluster(t *testing.T) {
+	role := util.MakeUnstructured(t, aggregatedRole(map[string]string{"serving.knative.dev/controller": "true"}))
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{role}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	if err := AggregateClusterRoles(nil)(context.Background(), &manifest, &v1beta1.KnativeServing{}); err != nil {
+		t.Fatalf("AggregateClusterRoles() = %v", err)
+	}
+	util.AssertDeepEqual(t, manifest.Resources()[0].Object, role.Object)
+}
+
+func TestSetPermissions(t *testing.T) {
+	controller := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "controller", Namespace: "knative-serving"}
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		util.MakeUnstructured(t, clusterRole("knative-serving-admin", nil, servicesRule)),
+		util.MakeUnstructured(t, &rbacv1.Role{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "Role"},
+			ObjectMeta: metav1.ObjectMeta{Name: "leases", Namespace: "knative-serving"},
+			Rules:      []rbacv1.PolicyRule{podsRule},
+		}),
+		util.MakeUnstructured(t, &rbacv1.ClusterRoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding"},
+			ObjectMeta: metav1.ObjectMeta{Name: "knative-serving-controller-admin"},
+			Subjects:   []rbacv1.Subject{controller, {Kind: rbacv1.GroupKind, Name: "system:authenticated"}},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "knative-serving-admin"},
+		}),
+		util.MakeUnstructured(t, &rbacv1.RoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"},
+			ObjectMeta: metav1.ObjectMeta{Name: "leases", Namespace: "knative-serving"},
+			Subjects:   []rbacv1.Subject{controller},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "leases"},
+		}),
+		util.MakeUnstructured(t, &rbacv1.RoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"},
+			ObjectMeta: metav1.ObjectMeta{Name: "auth-reader", Namespace: "kube-system"},
+			Subjects:   []rbacv1.Subject{controller},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "extension-apiserver-authentication-reader"},
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+
+	ks := &v1beta1.KnativeServing{}
+	if err := SetPermissions(context.Background(), &manifest, ks); err != nil {
+		t.Fatalf("SetPermissions() = %v", err)
+	}
+	util.AssertDeepEqual(t, ks.Status.Permissions, []base.ServiceAccountPermissions{{
+		ServiceAccount: "knative-serving/controller",
+		Grants: []base.RoleGrant{
+			{Role: "ClusterRole/knative-serving-admin", Rules: []rbacv1.PolicyRule{servicesRule}},
+			{Role: "Role/extension-apiserver-authentication-reader", Namespace: "kube-system"},
+			{Role: "Role/leases", Namespace: "knative-serving", Rules: []rbacv1.PolicyRule{podsRule}},
+		},
+	}})
+}
@@ -24,6 +24,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	mf "github.com/manifestival/manifestival"
 	"golang.org/x/mod/semver"
@@ -42,7 +43,23 @@ const (
 	LATEST_VERSION = "latest"
 )
 
-var cache = map[string]mf.Manifest{}
+// unusedManifestCacheSize bounds the number of manifests in the cache, whose paths no Knative
+// component uses at the moment, e.g. the ones of the previous version after an upgrade, so that
+// they do not stay in memory. The cache is sized from the paths in use instead of a fixed size, as
+// the paths of a component vary, e.g. a KnativeServing with several ingresses and the security
+// guard next to a KnativeEventing with source bundles uses more than a dozen, and a cache smaller
+// than them would parse them again on every reconciliation. The paths in use are never evicted.
+const unusedManifestCacheSize = 16
+
+var (
+	cache = map[string]mf.Manifest{}
+	// cacheOrder lists the paths in the cache from the least to the most recently used.
+	cacheOrder []string
+	// pathsInUse are the paths of the target, additional and installed manifests, which each
+	// Knative component fetched last.
+	pathsInUse = map[string][]string{}
+	cacheMu    sync.Mutex
+)
 
 // TargetVersion returns the version of the manifest to be installed
 // per the spec in the component. If spec.version is empty, the latest
@@ -70,6 +87,7 @@ func TargetVersion(instance base.KComponent) string {
 // with spec.manifests
 func TargetManifest(instance base.KComponent) (mf.Manifest, error) {
 	manifestsPath := targetManifestPath(instance)
+	useManifestPaths(instance, "target", manifestsPath)
 	if len(instance.GetSpec().GetManifests()) == 0 {
 		return getManifestWithVersionValidation(manifestsPath, instance, FetchManifest)
 	}
@@ -80,8 +98,10 @@ func TargetManifest(instance base.KComponent) (mf.Manifest, error) {
 func TargetAdditionalManifest(instance base.KComponent) (mf.Manifest, error) {
 	additionalManifestsPath := additionalManifestPath(instance)
 	if additionalManifestsPath == "" {
+		useManifestPaths(instance, "additional")
 		return mf.Manifest{}, nil
 	}
+	useManifestPaths(instance, "additional", additionalManifestsPath)
 	return getManifestWithVersionValidation(additionalManifestsPath, instance, fetchManifestFromPath)
 }
 
@@ -99,6 +119,7 @@ func InstalledManifest(instance base.KComponent) (mf.Manifest, error) {
 	// Read the path one by one, in order to leverage the cache, because the whole comma-separated path is
 	// not saved in the cache, but each path is saved as the key of the cache.
 	paths := installedManifestPath(current, instance)
+	useManifestPaths(instance, "installed", paths...)
 	if len(paths) == 0 {
 		return mf.Manifest{}, nil
 	}
@@ -221,12 +242,12 @@ func abs(x int) int {
 // FetchManifest returns the manifest by either getting it from the cache, or reading them from the path.
 // The manifest is saved in the cache, if it is not available.
 func FetchManifest(path string) (mf.Manifest, error) {
-	if m, ok := cache[path]; ok {
+	if m, ok := cacheGet(path); ok {
 		return m, nil
 	}
 	result, err := mf.NewManifest(path)
 	if err == nil {
-		cache[path] = result
+		cachePut(path, result)
 	}
 	return result, err
 }
@@ -252,14 +273,79 @@ func FetchManifestFromArray(paths []string) (mf.Manifest, error) {
 func fetchManifestFromPath(path string) (mf.Manifest, error) {
 	result, err := mf.NewManifest(path)
 	if err == nil {
-		cache[path] = result
+		cachePut(path, result)
 	}
 	return result, err
 }
 
 // ClearCache removes all the records saved in the cache.
 func ClearCache() {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
 	cache = map[string]mf.Manifest{}
+	cacheOrder = nil
+	// The remaining Knative components record their paths again on their next reconciliation.
+	pathsInUse = map[string][]string{}
+}
+
+// useManifestPaths records the paths of the manifests of the kind, e.g. the target ones, which the
+// Knative component uses, so that they are not evicted from the cache.
+func useManifestPaths(instance base.KComponent, kind string, paths ...string) {
+	key := fmt.Sprintf("%T/%s/%s/%s", instance, instance.GetNamespace(), instance.GetName(), kind)
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if len(paths) == 0 {
+		delete(pathsInUse, key)
+		return
+	}
+	pathsInUse[key] = paths
+}
+
+func cacheGet(path string) (mf.Manifest, bool) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	m, ok := cache[path]
+	if ok {
+		touchCachePath(path)
+	}
+	return m, ok
+}
+
+func cachePut(path string, m mf.Manifest) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	cache[path] = m
+	touchCachePath(path)
+	inUse := map[string]struct{}{}
+	for _, paths := range pathsInUse {
+		for _, p := range paths {
+			if _, ok := cache[p]; ok {
+				inUse[p] = struct{}{}
+			}
+		}
+	}
+	// Evict the least recently used paths, which are not in use.
+	unused := len(cacheOrder) - len(inUse)
+	for i := 0; i < len(cacheOrder) && unused > unusedManifestCacheSize; {
+		if _, ok := inUse[cacheOrder[i]]; ok {
+			i++
+			continue
+		}
+		delete(cache, cacheOrder[i])
+		cacheOrder = append(cacheOrder[:i], cacheOrder[i+1:]...)
+		unused--
+	}
+}
+
+// touchCachePath marks the path as the most recently used one.
+func touchCachePath(path string) {
+	for i, p := range cacheOrder {
+		if p == path {
+			cacheOrder = append(cacheOrder[:i], cacheOrder[i+1:]...)
+			break
+		}
+	}
+	cacheOrder = append(cacheOrder, path)
 }
 
 func componentDir(instance base.KComponent) string {
@@ -269,6 +355,11 @@ func componentDir(instance base.KComponent) string {
 		return filepath.Join(koDataDir, "knative-serving")
 	case *v1beta1.KnativeEventing:
 		return filepath.Join(koDataDir, "knative-eventing")
+	case *v1beta1.KnativeFunctions:
+		return filepath.Join(koDataDir, "knative-functions")
+	case *v1beta1.KnativeNetworking:
+		// The ingresses are released per minor version, shared with the ones of KnativeServing.
+		return componentIngressDir()
 	}
 	return ""
 }
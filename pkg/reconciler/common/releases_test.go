@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	util "knative.dev/operator/pkg/reconciler/common/testing"
@@ -1166,3 +1168,56 @@ func TestCache(t *testing.T) {
 	ClearCache()
 	util.AssertEqual(t, len(cache), 0)
 }
+
+func TestCacheEviction(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+	for i := 0; i < unusedManifestCacheSize; i++ {
+		cachePut(fmt.Sprintf("path-%d", i), mf.Manifest{})
+	}
+	// Using the oldest path keeps it in the cache, so that the second oldest one is evicted instead.
+	if _, ok := cacheGet("path-0"); !ok {
+		t.Fatal("path-0 is not in the cache")
+	}
+	cachePut("path-new", mf.Manifest{})
+
+	util.AssertEqual(t, len(cache), unusedManifestCacheSize)
+	for path, want := range map[string]bool{"path-0": true, "path-1": false, "path-2": true, "path-new": true} {
+		if _, ok := cacheGet(path); ok != want {
+			t.Errorf("%s in the cache = %v, want %v", path, ok, want)
+		}
+	}
+}
+
+func TestCacheEvictionPathsInUse(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	var used []string
+	for i := 0; i < 2*unusedManifestCacheSize; i++ {
+		used = append(used, fmt.Sprintf("used-%d", i))
+	}
+	useManifestPaths(ks, "target", used...)
+	for _, path := range used {
+		cachePut(path, mf.Manifest{})
+	}
+	for i := 0; i < 2*unusedManifestCacheSize; i++ {
+		cachePut(fmt.Sprintf("unused-%d", i), mf.Manifest{})
+	}
+
+	// More paths than unusedManifestCacheSize are in use, none of them is evicted.
+	util.AssertEqual(t, len(cache), 3*unusedManifestCacheSize)
+	for _, path := range used {
+		if _, ok := cacheGet(path); !ok {
+			t.Errorf("%s in use is not in the cache", path)
+		}
+	}
+	if _, ok := cacheGet("unused-0"); ok {
+		t.Error("unused-0 is in the cache, want it evicted")
+	}
+
+	// Once the component uses other paths, the previous ones are evicted like the unused ones.
+	useManifestPaths(ks, "target", "used-0")
+	cachePut("unused-new", mf.Manifest{})
+	util.AssertEqual(t, len(cache), unusedManifestCacheSize+1)
+}
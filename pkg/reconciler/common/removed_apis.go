@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"golang.org/x/mod/semver"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// removedAPI describes an API version of a kind, which is no longer served by Kubernetes.
+type removedAPI struct {
+	// removedIn is the minor version of Kubernetes, which stopped serving the API version.
+	removedIn string
+	// replacement is the API version to use instead, if the kind is still served.
+	replacement string
+	// safe is true, if the schema of the replacement is identical, so that only the apiVersion
+	// needs to be rewritten.
+	safe bool
+}
+
+// removedAPIs lists the API versions removed from Kubernetes, see
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/.
+var removedAPIs = map[schema.GroupVersionKind]removedAPI{
+	// v1.16
+	{Group: "extensions", Version: "v1beta1", Kind: "Deployment"}:    {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "DaemonSet"}:     {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "ReplicaSet"}:    {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta1", Kind: "Deployment"}:          {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta1", Kind: "StatefulSet"}:         {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "Deployment"}:          {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "StatefulSet"}:         {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "DaemonSet"}:           {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "apps", Version: "v1beta2", Kind: "ReplicaSet"}:          {removedIn: "v1.16", replacement: "apps/v1"},
+	{Group: "extensions", Version: "v1beta1", Kind: "NetworkPolicy"}: {removedIn: "v1.16", replacement: "networking.k8s.io/v1"},
+	// v1.22
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "MutatingWebhookConfiguration"}:   {removedIn: "v1.22", replacement: "admissionregistration.k8s.io/v1"},
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "ValidatingWebhookConfiguration"}: {removedIn: "v1.22", replacement: "admissionregistration.k8s.io/v1"},
+	{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}:               {removedIn: "v1.22", replacement: "apiextensions.k8s.io/v1"},
+	{Group: "apiregistration.k8s.io", Version: "v1beta1", Kind: "APIService"}:                           {removedIn: "v1.22", replacement: "apiregistration.k8s.io/v1", safe: true},
+	{Group: "certificates.k8s.io", Version: "v1beta1", Kind: "CertificateSigningRequest"}:               {removedIn: "v1.22", replacement: "certificates.k8s.io/v1"},
+	{Group: "coordination.k8s.io", Version: "v1beta1", Kind: "Lease"}:                                   {removedIn: "v1.22", replacement: "coordination.k8s.io/v1", safe: true},
+	{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}:                                          {removedIn: "v1.22", replacement: "networking.k8s.io/v1"},
+	{Group: "networking.k8s.io", Version: "v1beta1", Kind: "Ingress"}:                                   {removedIn: "v1.22", replacement: "networking.k8s.io/v1"},
+	{Group: "networking.k8s.io", Version: "v1beta1", Kind: "IngressClass"}:                              {removedIn: "v1.22", replacement: "networking.k8s.io/v1", safe: true},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "ClusterRole"}:                       {removedIn: "v1.22", replacement: "rbac.authorization.k8s.io/v1", safe: true},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "ClusterRoleBinding"}:                {removedIn: "v1.22", replacement: "rbac.authorization.k8s.io/v1", safe: true},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "Role"}:                              {removedIn: "v1.22", replacement: "rbac.authorization.k8s.io/v1", safe: true},
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "RoleBinding"}:                       {removedIn: "v1.22", replacement: "rbac.authorization.k8s.io/v1", safe: true},
+	{Group: "scheduling.k8s.io", Version: "v1beta1", Kind: "PriorityClass"}:                             {removedIn: "v1.22", replacement: "scheduling.k8s.io/v1", safe: true},
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "CSIDriver"}:                                    {removedIn: "v1.22", replacement: "storage.k8s.io/v1"},
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "CSINode"}:                                      {removedIn: "v1.22", replacement: "storage.k8s.io/v1"},
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "StorageClass"}:                                 {removedIn: "v1.22", replacement: "storage.k8s.io/v1", safe: true},
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "VolumeAttachment"}:                             {removedIn: "v1.22", replacement: "storage.k8s.io/v1", safe: true},
+	// v1.25
+	{Group: "batch", Version: "v1beta1", Kind: "CronJob"}:                       {removedIn: "v1.25", replacement: "batch/v1", safe: true},
+	{Group: "discovery.k8s.io", Version: "v1beta1", Kind: "EndpointSlice"}:      {removedIn: "v1.25", replacement: "discovery.k8s.io/v1"},
+	{Group: "events.k8s.io", Version: "v1beta1", Kind: "Event"}:                 {removedIn: "v1.25", replacement: "events.k8s.io/v1"},
+	{Group: "autoscaling", Version: "v2beta1", Kind: "HorizontalPodAutoscaler"}: {removedIn: "v1.25", replacement: "autoscaling/v2"},
+	{Group: "policy", Version: "v1beta1", Kind: "PodDisruptionBudget"}:          {removedIn: "v1.25", replacement: "policy/v1", safe: true},
+	{Group: "policy", Version: "v1beta1", Kind: "PodSecurityPolicy"}:            {removedIn: "v1.25"},
+	{Group: "node.k8s.io", Version: "v1beta1", Kind: "RuntimeClass"}:            {removedIn: "v1.25", replacement: "node.k8s.io/v1", safe: true},
+	// v1.26
+	{Group: "autoscaling", Version: "v2beta2", Kind: "HorizontalPodAutoscaler"}:                     {removedIn: "v1.26", replacement: "autoscaling/v2", safe: true},
+	{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1", Kind: "FlowSchema"}:                 {removedIn: "v1.26", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1", Kind: "PriorityLevelConfiguration"}: {removedIn: "v1.26", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	// v1.27
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "CSIStorageCapacity"}: {removedIn: "v1.27", replacement: "storage.k8s.io/v1", safe: true},
+	// v1.29
+	{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2", Kind: "FlowSchema"}:                 {removedIn: "v1.29", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2", Kind: "PriorityLevelConfiguration"}: {removedIn: "v1.29", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	// v1.32
+	{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Kind: "FlowSchema"}:                 {removedIn: "v1.32", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Kind: "PriorityLevelConfiguration"}: {removedIn: "v1.32", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+}
+
+// UpgradeRemovedAPIs returns a Stage, which scans the custom manifests for API versions no longer
+// served by the version of Kubernetes of the cluster. Kinds, whose replacement has the identical
+// schema, are rewritten to the replacement. Any other usage fails the installation with a report.
+func UpgradeRemovedAPIs(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		spec := instance.GetSpec()
+		if len(spec.GetManifests()) == 0 && len(spec.GetAdditionalManifests()) == 0 {
+			// The manifests shipped with the operator do not use any removed API.
+			return nil
+		}
+		if kubeClient == nil {
+			// Rendering offline, the version of Kubernetes is not known.
+			return nil
+		}
+		serverVersion, err := kubeClient.Discovery().ServerVersion()
+		if err != nil {
+			return fmt.Errorf("failed to get the Kubernetes version: %w", err)
+		}
+
+		m, violations, err := upgradeRemovedAPIs(ctx, manifest, semver.MajorMinor(SanitizeSemver(serverVersion.GitVersion)))
+		if err != nil {
+			return err
+		}
+		if len(violations) > 0 {
+			msg := fmt.Sprintf("the manifests use API versions removed in Kubernetes %s: %s", serverVersion.GitVersion, strings.Join(violations, "; "))
+			instance.GetStatus().MarkInstallFailed(msg)
+			return fmt.Errorf("%s", msg)
+		}
+		*manifest = m
+		return nil
+	}
+}
+
+func upgradeRemovedAPIs(ctx context.Context, manifest *mf.Manifest, kubernetesVersion string) (mf.Manifest, []string, error) {
+	logger := logging.FromContext(ctx)
+	var violations []string
+	m, err := manifest.Transform(func(u *unstructured.Unstructured) error {
+		api, ok := removedAPIs[u.GroupVersionKind()]
+		if !ok || kubernetesVersion == "" || semver.Compare(kubernetesVersion, api.removedIn) < 0 {
+			return nil
+		}
+		if api.safe {
+			logger.Infow("Upgrading removed API version", "kind", u.GetKind(), "name", u.GetName(),
+				"from", u.GetAPIVersion(), "to", api.replacement)
+			u.SetAPIVersion(api.replacement)
+			return nil
+		}
+		violation := fmt.Sprintf("%s %s of %s was removed in %s", u.GetKind(), namespacedName(u), u.GetAPIVersion(), api.removedIn)
+		if api.replacement != "" {
+			violation += ", migrate to " + api.replacement
+		} else {
+			violation += " without replacement"
+		}
+		violations = append(violations, violation)
+		return nil
+	})
+	return m, violations, err
+}
+
+func namespacedName(u *unstructured.Unstructured) string {
+	if u.GetNamespace() == "" {
+		return u.GetName()
+	}
+	return u.GetNamespace() + "/" + u.GetName()
+}
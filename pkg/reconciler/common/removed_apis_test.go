@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestUpgradeRemovedAPIs(t *testing.T) {
+	pdb := NamespacedResource("policy/v1beta1", "PodDisruptionBudget", "knative-serving", "activator-pdb")
+	psp := NamespacedResource("policy/v1beta1", "PodSecurityPolicy", "", "restricted")
+	hpa := NamespacedResource("autoscaling/v2beta1", "HorizontalPodAutoscaler", "knative-serving", "activator")
+	deployment := NamespacedResource("apps/v1", "Deployment", "knative-serving", "activator")
+
+	tests := []struct {
+		name              string
+		kubernetesVersion string
+		custom            bool
+		resources         []unstructured.Unstructured
+		wantAPIVersions   []string
+		wantViolations    []string
+	}{{
+		name:              "no custom manifests",
+		kubernetesVersion: "v1.34.0",
+		resources:         []unstructured.Unstructured{*pdb},
+		wantAPIVersions:   []string{"policy/v1beta1"},
+	}, {
+		name:              "still served",
+		kubernetesVersion: "v1.24.3",
+		custom:            true,
+		resources:         []unstructured.Unstructured{*pdb, *psp},
+		wantAPIVersions:   []string{"policy/v1beta1", "policy/v1beta1"},
+	}, {
+		name:              "safe upgrade",
+		kubernetesVersion: "v1.34.0-gke.1",
+		custom:            true,
+		resources:         []unstructured.Unstructured{*pdb, *deployment},
+		wantAPIVersions:   []string{"policy/v1", "apps/v1"},
+	}, {
+		name:              "removed",
+		kubernetesVersion: "v1.34.0",
+		custom:            true,
+		resources:         []unstructured.Unstructured{*pdb, *psp, *hpa},
+		wantViolations: []string{
+			"PodSecurityPolicy restricted of policy/v1beta1 was removed in v1.25 without replacement",
+			"HorizontalPodAutoscaler knative-serving/activator of autoscaling/v2beta1 was removed in v1.25, migrate to autoscaling/v2",
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			kubeClient.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: test.kubernetesVersion}
+			manifest, err := mf.ManifestFrom(mf.Slice(test.resources))
+			if err != nil {
+				t.Fatalf("Failed to generate manifest: %v", err)
+			}
+			ks := &v1beta1.KnativeServing{}
+			if test.custom {
+				ks.Spec.AdditionalManifests = []base.Manifest{{Url: "https://example.com/custom.yaml"}}
+			}
+			ks.Status.InitializeConditions()
+
+			err = UpgradeRemovedAPIs(kubeClient)(context.Background(), &manifest, ks)
+			if len(test.wantViolations) > 0 {
+				if err == nil {
+					t.Fatal("UpgradeRemovedAPIs() = nil, want an error")
+				}
+				for _, violation := range test.wantViolations {
+					if !strings.Contains(err.Error(), violation) {
+						t.Errorf("UpgradeRemovedAPIs() = %v, want it to contain %q", err, violation)
+					}
+				}
+				if !ks.Status.GetCondition(base.InstallSucceeded).IsFalse() {
+					t.Error("InstallSucceeded must be False")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpgradeRemovedAPIs() = %v", err)
+			}
+			var apiVersions []string
+			for _, u := range manifest.Resources() {
+				apiVersions = append(apiVersions, u.GetAPIVersion())
+			}
+			util.AssertDeepEqual(t, apiVersions, test.wantAPIVersions)
+		})
+	}
+}
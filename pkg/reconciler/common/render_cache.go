@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	mf "github.com/manifestival/manifestival"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// RenderCache caches the manifests rendered for the Knative components. A manifest is only rendered
+// again, if the target version, the spec or the annotations of the component, or the environment of
+// the operator changed. The cached stages must only depend on these inputs, transformers reading the
+// current state of the cluster have to run after the cached stages.
+type RenderCache struct {
+	mu      sync.Mutex
+	entries map[string]renderCacheEntry
+}
+
+type renderCacheEntry struct {
+	key      string
+	manifest mf.Manifest
+}
+
+// NewRenderCache returns an empty RenderCache.
+func NewRenderCache() *RenderCache {
+	return &RenderCache{entries: map[string]renderCacheEntry{}}
+}
+
+// Stage returns a Stage, which executes the stages and caches the resulting manifest, or replaces
+// the manifest with the cached one, if the inputs did not change. A nil RenderCache executes the
+// stages every time.
+func (c *RenderCache) Stage(stages Stages) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		if c == nil {
+			return stages.Execute(ctx, manifest, instance)
+		}
+		key, err := RenderKey(instance)
+		if err != nil {
+			return err
+		}
+		id := componentID(instance)
+		c.mu.Lock()
+		entry, ok := c.entries[id]
+		c.mu.Unlock()
+		if ok && entry.key == key {
+			logging.FromContext(ctx).Debugw("Using the cached manifest", "key", key)
+			*manifest = entry.manifest.Append()
+			return nil
+		}
+
+		if err := stages.Execute(ctx, manifest, instance); err != nil {
+			return err
+		}
+		c.mu.Lock()
+		c.entries[id] = renderCacheEntry{key: key, manifest: manifest.Append()}
+		c.mu.Unlock()
+		return nil
+	}
+}
+
+// Delete removes the cached manifest of the component, so that it is rendered again by the next
+// reconciliation.
+func (c *RenderCache) Delete(instance base.KComponent) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, componentID(instance))
+}
+
+// RenderKey returns the hash of the inputs, from which the manifest of the component is rendered:
+// the target version, the spec and the annotations of the component, and the environment variables
+// of the operator.
+func RenderKey(instance base.KComponent) (string, error) {
+	env := os.Environ()
+	sort.Strings(env)
+	inputs := struct {
+		Version     string            `json:"version"`
+		Spec        interface{}       `json:"spec"`
+		Annotations map[string]string `json:"annotations"`
+		Env         []string          `json:"env"`
+	}{
+		Version:     TargetVersion(instance),
+		Spec:        instance.GetSpec(),
+		Annotations: instance.GetAnnotations(),
+		Env:         env,
+	}
+	b, err := json.Marshal(inputs)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash the inputs of the manifest: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func componentID(instance base.KComponent) string {
+	return fmt.Sprintf("%T/%s/%s", instance, instance.GetNamespace(), instance.GetName())
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestRenderCache(t *testing.T) {
+	renders := 0
+	var renderErr error
+	render := func(_ context.Context, manifest *mf.Manifest, _ base.KComponent) error {
+		renders++
+		if renderErr != nil {
+			return renderErr
+		}
+		m, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*NamespacedResource("apps/v1", "Deployment", "knative-serving", "controller")}))
+		*manifest = manifest.Append(m)
+		return nil
+	}
+
+	cache := NewRenderCache()
+	stage := cache.Stage(Stages{render})
+	ks := &v1beta1.KnativeServing{
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{Version: "1.18.0"},
+		},
+	}
+	ks.Name = "knative-serving"
+	ks.Namespace = "knative-serving"
+
+	steps := []struct {
+		name        string
+		change      func()
+		wantRenders int
+	}{{
+		name:        "first",
+		change:      func() {},
+		wantRenders: 1,
+	}, {
+		name:        "unchanged",
+		change:      func() {},
+		wantRenders: 1,
+	}, {
+		name:        "spec changed",
+		change:      func() { ks.Spec.Version = "1.18.1" },
+		wantRenders: 2,
+	}, {
+		name:        "annotations changed",
+		change:      func() { ks.Annotations = map[string]string{"foo": "bar"} },
+		wantRenders: 3,
+	}, {
+		name:        "environment changed",
+		change:      func() { t.Setenv("TEST_RENDER_CACHE", "true") },
+		wantRenders: 4,
+	}, {
+		name:        "deleted",
+		change:      func() { cache.Delete(ks) },
+		wantRenders: 5,
+	}, {
+		name:        "status changed",
+		change:      func() { ks.Status.Version = "1.18.1" },
+		wantRenders: 5,
+	}}
+
+	for _, step := range steps {
+		step.change()
+		manifest := mf.Manifest{}
+		if err := stage(context.Background(), &manifest, ks); err != nil {
+			t.Fatalf("%s: Stage() = %v", step.name, err)
+		}
+		if renders != step.wantRenders {
+			t.Errorf("%s: Got %d renders, want %d", step.name, renders, step.wantRenders)
+		}
+		util.AssertEqual(t, len(manifest.Resources()), 1)
+		util.AssertEqual(t, manifest.Resources()[0].GetName(), "controller")
+	}
+
+	// Errors are not cached.
+	renderErr = errors.New("test")
+	ks.Spec.Version = "1.17.0"
+	for i := 0; i < 2; i++ {
+		if err := stage(context.Background(), &mf.Manifest{}, ks); err == nil {
+			t.Fatal("Stage() = nil, want an error")
+		}
+	}
+	util.AssertEqual(t, renders, 7)
+}
+
+func TestNilRenderCache(t *testing.T) {
+	renders := 0
+	stage := (*RenderCache)(nil).Stage(Stages{func(context.Context, *mf.Manifest, base.KComponent) error {
+		renders++
+		return nil
+	}})
+	ks := &v1beta1.KnativeServing{}
+	for i := 0; i < 2; i++ {
+		if err := stage(context.Background(), &mf.Manifest{}, ks); err != nil {
+			t.Fatalf("Stage() = %v", err)
+		}
+	}
+	(*RenderCache)(nil).Delete(ks)
+	util.AssertEqual(t, renders, 2)
+}
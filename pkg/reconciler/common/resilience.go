@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// ZonalCriticalReplicas is the number of replicas of the critical deployments in the zonal
+	// resilience profile, one for each of the usual three zones of a region.
+	ZonalCriticalReplicas = 3
+	// ZonalReplicas is the number of replicas of the other deployments in the zonal resilience
+	// profile, so that a replica in another zone takes over the leases of the controllers.
+	ZonalReplicas = 2
+
+	// ResiliencePodDisruptionBudgetSuffix is appended to the name of a deployment for the name of
+	// the PodDisruptionBudget generated by the zonal resilience profile.
+	ResiliencePodDisruptionBudgetSuffix = "-zonal-pdb"
+	// ResiliencePodDisruptionBudgetLabel marks the PodDisruptionBudgets generated by the zonal
+	// resilience profile.
+	ResiliencePodDisruptionBudgetLabel = "operator.knative.dev/zonal-pdb"
+)
+
+// zonalCritical returns whether the deployment is on the critical path of the requests, so that the
+// zonal resilience profile runs a replica of it in each zone: the activator, the webhooks and the
+// ingress gateways of Kourier and of the brokers.
+func zonalCritical(name string) bool {
+	switch name {
+	case "activator", "3scale-kourier-gateway", "mt-broker-ingress":
+		return true
+	}
+	return strings.HasSuffix(name, "webhook")
+}
+
+// zonal returns whether the zonal resilience profile is selected.
+func zonal(instance base.KComponent) bool {
+	return instance.GetSpec().GetResilience() == base.ResilienceZonal
+}
+
+// ResilienceTransform applies the zonal resilience profile of spec.resilience to the deployments:
+// the critical ones run at least ZonalCriticalReplicas replicas, spread evenly over the zones and
+// preferably over the nodes, the other ones at least ZonalReplicas. The replicas of
+// spec.high-availability and spec.workloads take precedence, as do the topology spread
+// constraints and the affinity of spec.workloads, which replace the ones of the profile.
+func ResilienceTransform(instance base.KComponent) mf.Transformer {
+	if !zonal(instance) {
+		return nil
+	}
+	scaled := func(name string) bool {
+		for _, override := range instance.GetSpec().GetWorkloadOverrides() {
+			if override.Replicas != nil && override.Name == name {
+				return true
+			}
+		}
+		return HighAvailabilityReplicas(instance.GetSpec().GetHighAvailability(), name) != nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() == "HorizontalPodAutoscaler" {
+			target, _, err := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "name")
+			if err != nil {
+				return err
+			}
+			if zonalCritical(target) && !scaled(target) {
+				return hpaTransform(u, ZonalCriticalReplicas)
+			}
+			return nil
+		}
+		if u.GetKind() != "Deployment" || HighAvailabilitySingleReplica(u.GetName()) {
+			return nil
+		}
+		deployment := &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(u, deployment, nil); err != nil {
+			return err
+		}
+		critical := zonalCritical(deployment.Name)
+		if !scaled(deployment.Name) && !hasHorizontalPodOrCustomAutoscaler(deployment.Name) {
+			replicas := int32(ZonalReplicas)
+			if critical {
+				replicas = ZonalCriticalReplicas
+			}
+			if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas < replicas {
+				deployment.Spec.Replicas = &replicas
+			}
+		}
+		if critical && deployment.Spec.Selector != nil && len(deployment.Spec.Selector.MatchLabels) > 0 {
+			spreadOverZones(&deployment.Spec.Template.Spec, deployment.Spec.Selector.MatchLabels)
+		}
+		if err := scheme.Scheme.Convert(deployment, u, nil); err != nil {
+			return err
+		}
+		// Avoid superfluous updates from converted zero defaults
+		u.SetCreationTimestamp(metav1.Time{})
+		return nil
+	}
+}
+
+// spreadOverZones spreads the pods selected by the labels evenly over the zones, and prefers to
+// place them on different nodes within a zone. Nodes without the zone label don't run the pods.
+func spreadOverZones(podSpec *corev1.PodSpec, labels map[string]string) {
+	selector := &metav1.LabelSelector{MatchLabels: labels}
+	spread := true
+	for _, constraint := range podSpec.TopologySpreadConstraints {
+		if constraint.TopologyKey == corev1.LabelTopologyZone {
+			spread = false
+		}
+	}
+	if spread {
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     selector,
+		})
+	}
+
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.PodAntiAffinity == nil {
+		podSpec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+	antiAffinity := podSpec.Affinity.PodAntiAffinity
+	for _, term := range antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		if term.PodAffinityTerm.TopologyKey == corev1.LabelHostname {
+			return
+		}
+	}
+	antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+		corev1.WeightedPodAffinityTerm{
+			Weight: 100,
+			PodAffinityTerm: corev1.PodAffinityTerm{
+				LabelSelector: selector,
+				TopologyKey:   corev1.LabelHostname,
+			},
+		})
+}
+
+// ResiliencePodDisruptionBudgets makes sure, that the pods of each critical deployment of the zonal
+// resilience profile are evicted one at a time: the PodDisruptionBudgets of the manifest for their
+// pods allow one pod to be unavailable, instead of e.g. a percentage, which allows none of the
+// replicas of the profile to be evicted, and a PodDisruptionBudget is appended for the deployments
+// without one. spec.podDisruptionBudgets still overrides them.
+func ResiliencePodDisruptionBudgets(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	if !zonal(instance) {
+		return nil
+	}
+	var critical []*appsv1.Deployment
+	for _, u := range manifest.Filter(mf.ByKind("Deployment")).Resources() {
+		deployment := &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(&u, deployment, nil); err != nil {
+			return err
+		}
+		if zonalCritical(deployment.Name) && deployment.Spec.Selector != nil && len(deployment.Spec.Selector.MatchLabels) > 0 {
+			critical = append(critical, deployment)
+		}
+	}
+	criticalSelector := func(labels map[string]string) bool {
+		for _, deployment := range critical {
+			if equality.Semantic.DeepEqual(deployment.Spec.Selector.MatchLabels, labels) {
+				return true
+			}
+		}
+		return false
+	}
+
+	covered := map[*appsv1.Deployment]bool{}
+	transformed, err := manifest.Transform(func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "PodDisruptionBudget" {
+			return nil
+		}
+		labels, _, err := unstructured.NestedStringMap(u.Object, "spec", "selector", "matchLabels")
+		if err != nil || !criticalSelector(labels) {
+			return err
+		}
+		for _, deployment := range critical {
+			if equality.Semantic.DeepEqual(deployment.Spec.Selector.MatchLabels, labels) {
+				covered[deployment] = true
+			}
+		}
+		unstructured.RemoveNestedField(u.Object, "spec", "minAvailable")
+		return unstructured.SetNestedField(u.Object, int64(1), "spec", "maxUnavailable")
+	})
+	if err != nil {
+		return err
+	}
+
+	var resources []unstructured.Unstructured
+	for _, deployment := range critical {
+		if covered[deployment] {
+			continue
+		}
+		maxUnavailable := intstr.FromInt32(1)
+		labels := map[string]string{ResiliencePodDisruptionBudgetLabel: "true"}
+		for key, value := range deployment.Labels {
+			labels[key] = value
+		}
+		pdb := &policyv1.PodDisruptionBudget{
+			TypeMeta: metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deployment.Name + ResiliencePodDisruptionBudgetSuffix,
+				Namespace: deployment.Namespace,
+				Labels:    labels,
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+				Selector:       &metav1.LabelSelector{MatchLabels: deployment.Spec.Selector.MatchLabels},
+			},
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pdb)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, unstructured.Unstructured{Object: obj})
+	}
+	if len(resources) > 0 {
+		m, err := mf.ManifestFrom(mf.Slice(resources))
+		if err != nil {
+			return err
+		}
+		transformed = transformed.Append(m)
+	}
+	*manifest = transformed
+	return nil
+}
+
+// DeleteObsoleteResiliencePodDisruptionBudgets returns a Stage, which deletes the
+// PodDisruptionBudgets generated by the zonal resilience profile, which the manifest doesn't
+// contain anymore, e.g. once spec.resilience is reset.
+func DeleteObsoleteResiliencePodDisruptionBudgets(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		return deleteObsoletePodDisruptionBudgets(ctx, kubeClient, manifest, instance, ResiliencePodDisruptionBudgetLabel)
+	}
+}
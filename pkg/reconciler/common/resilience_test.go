@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func zonalServing(spec base.CommonSpec) *v1beta1.KnativeServing {
+	spec.Resilience = base.ResilienceZonal
+	return &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+		Spec:       v1beta1.KnativeServingSpec{CommonSpec: spec},
+	}
+}
+
+func TestResilienceTransform(t *testing.T) {
+	selector := func(name string) *metav1.LabelSelector {
+		return &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}}
+	}
+	spread := func(name string) []corev1.TopologySpreadConstraint {
+		return []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     selector(name),
+		}}
+	}
+	antiAffinity := func(name string) *corev1.Affinity {
+		return &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight:          100,
+				PodAffinityTerm: corev1.PodAffinityTerm{LabelSelector: selector(name), TopologyKey: corev1.LabelHostname},
+			}},
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		deployment   string
+		replicas     int32
+		spec         base.CommonSpec
+		wantReplicas int32
+		wantSpread   bool
+	}{{
+		name:         "critical",
+		deployment:   "activator",
+		replicas:     1,
+		wantReplicas: 1, // scaled by its HPA
+		wantSpread:   true,
+	}, {
+		name:         "critical webhook",
+		deployment:   "net-istio-webhook",
+		replicas:     1,
+		wantReplicas: ZonalCriticalReplicas,
+		wantSpread:   true,
+	}, {
+		name:         "other",
+		deployment:   "controller",
+		replicas:     1,
+		wantReplicas: ZonalReplicas,
+	}, {
+		name:         "more replicas",
+		deployment:   "controller",
+		replicas:     4,
+		wantReplicas: 4,
+	}, {
+		name:         "single replica",
+		deployment:   "pingsource-mt-adapter",
+		replicas:     1,
+		wantReplicas: 1,
+	}, {
+		name:         "high availability",
+		deployment:   "net-istio-webhook",
+		replicas:     1,
+		spec:         base.CommonSpec{HighAvailability: &base.HighAvailability{Deployments: []base.HighAvailabilityDeployment{{Name: "net-istio-webhook", Replicas: 2}}}},
+		wantReplicas: 1,
+		wantSpread:   true,
+	}, {
+		name:         "workload replicas",
+		deployment:   "controller",
+		replicas:     1,
+		spec:         base.CommonSpec{Workloads: []base.WorkloadOverride{{Name: "controller", Replicas: ptr.To(int32(1))}}},
+		wantReplicas: 1,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u := util.MakeUnstructured(t, &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: test.deployment, Namespace: "knative-serving"},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(test.replicas), Selector: selector(test.deployment)},
+			})
+			if err := ResilienceTransform(zonalServing(test.spec))(&u); err != nil {
+				t.Fatalf("ResilienceTransform() = %v", err)
+			}
+			got := &appsv1.Deployment{}
+			if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			util.AssertEqual(t, *got.Spec.Replicas, test.wantReplicas)
+			if test.wantSpread {
+				util.AssertDeepEqual(t, got.Spec.Template.Spec.TopologySpreadConstraints, spread(test.deployment))
+				util.AssertDeepEqual(t, got.Spec.Template.Spec.Affinity, antiAffinity(test.deployment))
+			} else {
+				util.AssertEqual(t, len(got.Spec.Template.Spec.TopologySpreadConstraints), 0)
+				util.AssertEqual(t, got.Spec.Template.Spec.Affinity == nil, true)
+			}
+		})
+	}
+
+	t.Run("hpa", func(t *testing.T) {
+		hpa := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "autoscaling/v2",
+			"kind":       "HorizontalPodAutoscaler",
+			"metadata":   map[string]interface{}{"name": "activator", "namespace": "knative-serving"},
+			"spec": map[string]interface{}{
+				"minReplicas":    int64(1),
+				"maxReplicas":    int64(20),
+				"scaleTargetRef": map[string]interface{}{"name": "activator"},
+			},
+		}}
+		if err := ResilienceTransform(zonalServing(base.CommonSpec{}))(hpa); err != nil {
+			t.Fatalf("ResilienceTransform() = %v", err)
+		}
+		minReplicas, _, _ := unstructured.NestedInt64(hpa.Object, "spec", "minReplicas")
+		util.AssertEqual(t, minReplicas, int64(ZonalCriticalReplicas))
+	})
+
+	if ResilienceTransform(&v1beta1.KnativeServing{}) != nil {
+		t.Error("ResilienceTransform() != nil without spec.resilience")
+	}
+}
+
+func TestResiliencePodDisruptionBudgets(t *testing.T) {
+	pdb := util.MakeUnstructured(t, &policyv1.PodDisruptionBudget{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+		ObjectMeta: metav1.ObjectMeta{Name: "activator-pdb", Namespace: "knative-serving"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: ptr.To(intstr.FromString("80%")),
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "activator"}},
+		},
+	})
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		spotDeployment(t, "activator"),
+		spotDeployment(t, "webhook"),
+		spotDeployment(t, "controller"),
+		pdb,
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	if err := ResiliencePodDisruptionBudgets(context.Background(), &manifest, zonalServing(base.CommonSpec{})); err != nil {
+		t.Fatalf("ResiliencePodDisruptionBudgets() = %v", err)
+	}
+
+	var names []string
+	for _, u := range manifest.Filter(mf.ByKind("PodDisruptionBudget")).Resources() {
+		names = append(names, u.GetName())
+	}
+	util.AssertDeepEqual(t, names, []string{"activator-pdb", "webhook-zonal-pdb"})
+
+	for _, name := range names {
+		got := &policyv1.PodDisruptionBudget{}
+		u := manifest.Filter(mf.ByName(name)).Resources()[0]
+		if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+			t.Fatalf("Convert() = %v", err)
+		}
+		util.AssertEqual(t, got.Spec.MinAvailable == nil, true)
+		util.AssertEqual(t, got.Spec.MaxUnavailable.IntValue(), 1)
+	}
+	generated := manifest.Filter(mf.ByName("webhook-zonal-pdb")).Resources()[0]
+	util.AssertEqual(t, generated.GetLabels()[ResiliencePodDisruptionBudgetLabel], "true")
+
+	unchanged := manifest
+	if err := ResiliencePodDisruptionBudgets(context.Background(), &unchanged, &v1beta1.KnativeServing{}); err != nil {
+		t.Fatalf("ResiliencePodDisruptionBudgets() = %v", err)
+	}
+	util.AssertEqual(t, len(unchanged.Resources()), len(manifest.Resources()))
+}
+
+func TestDeleteObsoleteResiliencePodDisruptionBudgets(t *testing.T) {
+	labels := map[string]string{ResiliencePodDisruptionBudgetLabel: "true"}
+	kubeClient := kubefake.NewSimpleClientset(
+		&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "webhook-zonal-pdb", Namespace: "knative-serving", Labels: labels}},
+		&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "activator-pdb", Namespace: "knative-serving"}},
+	)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	if err := DeleteObsoleteResiliencePodDisruptionBudgets(kubeClient)(context.Background(), &manifest, &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+	}); err != nil {
+		t.Fatalf("DeleteObsoleteResiliencePodDisruptionBudgets() = %v", err)
+	}
+
+	pdbs, err := kubeClient.PolicyV1().PodDisruptionBudgets("knative-serving").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() = %v", err)
+	}
+	var names []string
+	for _, pdb := range pdbs.Items {
+		names = append(names, pdb.Name)
+	}
+	util.AssertDeepEqual(t, names, []string{"activator-pdb"})
+}
@@ -0,0 +1,497 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// ResourceRecommendationsConfigMapSuffix is appended to the name of the Knative component to
+	// name the ConfigMap, which holds the recommended resources of its containers.
+	ResourceRecommendationsConfigMapSuffix = "-resource-recommendations"
+	// ResourceRecommendationsLabel marks the ConfigMaps of the recommended resources, its value is
+	// the lower-case kind of the Knative component.
+	ResourceRecommendationsLabel = "operator.knative.dev/resource-recommendations"
+	// ResourceRecommendationsKey is the key of the ConfigMap containing the recommendations.
+	ResourceRecommendationsKey = "recommendations"
+	// ResourceRecommendationsAppliedKey is the key of the ConfigMap containing the recommendations
+	// set on the containers by spec.resourceRecommendations.apply.
+	ResourceRecommendationsAppliedKey = "applied"
+	// resourceUsageKey is the key of the ConfigMap containing the samples of the usage.
+	resourceUsageKey = "usage"
+
+	// ResourceSampleInterval is the interval, in which the usage of the containers is sampled.
+	ResourceSampleInterval = 5 * time.Minute
+	// maxResourceSamples keeps the samples of a day.
+	maxResourceSamples = 288
+	// minResourceSamples are the samples of an hour, which are needed for a recommendation.
+	minResourceSamples = 12
+	// resourceMarginPercent is the recommended request in percent of the usage.
+	resourceMarginPercent = 115
+	// resourceChangeThreshold is the relative change of a recommended request, which replaces
+	// the applied recommendation, so that the deployments don't roll out for every sample.
+	resourceChangeThreshold = 0.2
+	// minRecommendedCPU and minRecommendedMemory are the lowest requests recommended.
+	minRecommendedCPU    = 10       // millicores
+	minRecommendedMemory = 16 << 20 // bytes
+	memoryRounding       = 1 << 20  // bytes
+)
+
+var podMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+// ResourceRecommendation are the recommended resources of a container.
+type ResourceRecommendation struct {
+	Deployment string `json:"deployment"`
+	Container  string `json:"container"`
+	// Samples is the number of samples of the usage, on which the recommendation is based.
+	Samples  int                 `json:"samples"`
+	Requests corev1.ResourceList `json:"requests"`
+	Limits   corev1.ResourceList `json:"limits,omitempty"`
+}
+
+// resourceUsage are the samples of the usage of the containers.
+type resourceUsage struct {
+	LastSample metav1.Time      `json:"lastSample"`
+	Containers []containerUsage `json:"containers,omitempty"`
+}
+
+// containerUsage are the samples of the usage of a container, the highest of its pods, oldest
+// first.
+type containerUsage struct {
+	Deployment string `json:"deployment"`
+	Container  string `json:"container"`
+	// CPU is in millicores.
+	CPU []int64 `json:"cpu"`
+	// Memory is in bytes.
+	Memory []int64 `json:"memory"`
+}
+
+// ResourceRecommendationsConfigMapName returns the name of the ConfigMap holding the recommended
+// resources of the containers of the Knative component.
+func ResourceRecommendationsConfigMapName(instance base.KComponent) string {
+	return instance.GetName() + ResourceRecommendationsConfigMapSuffix
+}
+
+// ResourceRecommender samples the usage of the containers of the components with
+// spec.resourceRecommendations from the metrics API, and recommends their resources.
+type ResourceRecommender struct {
+	kubeClient    kubernetes.Interface
+	metricsClient dynamic.Interface
+	impl          *controller.Impl
+	now           func() time.Time
+}
+
+// NewResourceRecommender returns the ResourceRecommender of a controller.
+func NewResourceRecommender(kubeClient kubernetes.Interface, metricsClient dynamic.Interface, impl *controller.Impl) *ResourceRecommender {
+	return &ResourceRecommender{kubeClient: kubeClient, metricsClient: metricsClient, impl: impl, now: time.Now}
+}
+
+// Recommend is a Stage, which samples the usage of the containers of the manifest, at most once
+// per ResourceSampleInterval, keeps the samples of the last day in a ConfigMap next to the
+// component, and publishes the recommended resources in it. With spec.resourceRecommendations.apply,
+// it sets them on the containers, whose resources spec.workloads doesn't set. It leaves the
+// manifest of a target cluster unchanged.
+func (r *ResourceRecommender) Recommend(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	config := instance.GetSpec().GetResourceRecommendations()
+	if config == nil || !config.Enabled || instance.GetSpec().GetTargetCluster() != nil {
+		return nil
+	}
+	logger := logging.FromContext(ctx)
+	var deployments []*appsv1.Deployment
+	for _, u := range manifest.Filter(mf.ByKind("Deployment")).Resources() {
+		deployment := &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(&u, deployment, nil); err != nil {
+			return err
+		}
+		deployments = append(deployments, deployment)
+	}
+
+	var usage resourceUsage
+	var applied []ResourceRecommendation
+	existing, err := r.kubeClient.CoreV1().ConfigMaps(instance.GetNamespace()).Get(ctx, ResourceRecommendationsConfigMapName(instance), metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		// Samples, which can't be read, are collected again.
+		if err := yaml.Unmarshal([]byte(existing.Data[resourceUsageKey]), &usage); err != nil {
+			usage = resourceUsage{}
+		}
+		if err := yaml.Unmarshal([]byte(existing.Data[ResourceRecommendationsAppliedKey]), &applied); err != nil {
+			applied = nil
+		}
+	}
+
+	now := r.now()
+	if now.Sub(usage.LastSample.Time) >= ResourceSampleInterval {
+		if err := r.sample(ctx, deployments, &usage); err != nil {
+			// Without the metrics API, the recommendations just don't change.
+			logger.Warnw("Failed to sample the usage of the containers", zap.Error(err))
+		} else {
+			usage.LastSample = metav1.NewTime(now)
+		}
+	}
+	r.impl.EnqueueAfter(instance, ResourceSampleInterval)
+
+	recommendations := recommendResources(usage, deployments, config)
+	if config.Apply {
+		applied = updateApplied(applied, recommendations)
+	} else {
+		applied = nil
+	}
+	cm, err := resourceRecommendationsConfigMap(instance, usage, recommendations, applied)
+	if err != nil {
+		return err
+	}
+	if err := createOrUpdateConfigMap(ctx, r.kubeClient, cm); err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+
+	transformed, err := manifest.Transform(applyRecommendations(instance, applied))
+	if err != nil {
+		return err
+	}
+	*manifest = transformed
+	return nil
+}
+
+// sample appends the usage of the containers of the deployments from the metrics API to the
+// samples. The pods of the metrics API carry the labels of the pods, which the selectors of the
+// deployments match.
+func (r *ResourceRecommender) sample(ctx context.Context, deployments []*appsv1.Deployment, usage *resourceUsage) error {
+	type key struct{ deployment, container string }
+	cpu := map[key]int64{}
+	memory := map[key]int64{}
+	namespaces := map[string][]*appsv1.Deployment{}
+	for _, deployment := range deployments {
+		namespaces[deployment.Namespace] = append(namespaces[deployment.Namespace], deployment)
+	}
+	for namespace, deployments := range namespaces {
+		list, err := r.metricsClient.Resource(podMetricsResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, pod := range list.Items {
+			deployment := selectingDeployment(deployments, pod.GetLabels())
+			if deployment == nil {
+				continue
+			}
+			containers, _, _ := unstructured.NestedSlice(pod.Object, "containers")
+			for _, c := range containers {
+				container, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _, _ := unstructured.NestedString(container, "name")
+				values, _, _ := unstructured.NestedStringMap(container, "usage")
+				k := key{deployment.Name, name}
+				if q, err := resource.ParseQuantity(values[string(corev1.ResourceCPU)]); err == nil {
+					cpu[k] = max(cpu[k], q.MilliValue())
+				}
+				if q, err := resource.ParseQuantity(values[string(corev1.ResourceMemory)]); err == nil {
+					memory[k] = max(memory[k], q.Value())
+				}
+			}
+		}
+	}
+
+	for k := range cpu {
+		i := slices.IndexFunc(usage.Containers, func(c containerUsage) bool {
+			return c.Deployment == k.deployment && c.Container == k.container
+		})
+		if i < 0 {
+			usage.Containers = append(usage.Containers, containerUsage{Deployment: k.deployment, Container: k.container})
+			i = len(usage.Containers) - 1
+		}
+		c := &usage.Containers[i]
+		c.CPU = append(c.CPU, cpu[k])
+		c.Memory = append(c.Memory, memory[k])
+		if len(c.CPU) > maxResourceSamples {
+			c.CPU = c.CPU[len(c.CPU)-maxResourceSamples:]
+			c.Memory = c.Memory[len(c.Memory)-maxResourceSamples:]
+		}
+	}
+	sort.Slice(usage.Containers, func(i, j int) bool {
+		a, b := usage.Containers[i], usage.Containers[j]
+		if a.Deployment != b.Deployment {
+			return a.Deployment < b.Deployment
+		}
+		return a.Container < b.Container
+	})
+	return nil
+}
+
+// selectingDeployment returns the deployment, whose selector matches the labels of a pod.
+func selectingDeployment(deployments []*appsv1.Deployment, podLabels map[string]string) *appsv1.Deployment {
+	for _, deployment := range deployments {
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err == nil && !selector.Empty() && selector.Matches(labels.Set(podLabels)) {
+			return deployment
+		}
+	}
+	return nil
+}
+
+// recommendResources recommends the resources of the containers of the deployments with enough
+// samples: the 90th percentile of the CPU usage and the highest memory usage with a margin as the
+// requests, within the bounds of the configuration. The limits keep the ratio to the requests of
+// the containers, and are only recommended for the resources, which the containers limit.
+func recommendResources(usage resourceUsage, deployments []*appsv1.Deployment, config *base.ResourceRecommendations) []ResourceRecommendation {
+	var recommendations []ResourceRecommendation
+	for _, c := range usage.Containers {
+		if len(c.CPU) < minResourceSamples {
+			continue
+		}
+		container := findContainer(deployments, c.Deployment, c.Container)
+		if container == nil {
+			continue
+		}
+		cpu := slices.Clone(c.CPU)
+		slices.Sort(cpu)
+		requests := corev1.ResourceList{
+			corev1.ResourceCPU: *resource.NewMilliQuantity(
+				max(scale(cpu[(len(cpu)*9-1)/10], resourceMarginPercent, 100), minRecommendedCPU), resource.DecimalSI),
+			corev1.ResourceMemory: *resource.NewQuantity(
+				max(roundUp(scale(slices.Max(c.Memory), resourceMarginPercent, 100), memoryRounding), minRecommendedMemory), resource.BinarySI),
+		}
+		for name, request := range requests {
+			if bound, ok := config.MinAllowed[name]; ok && request.Cmp(bound) < 0 {
+				requests[name] = bound.DeepCopy()
+			}
+			if bound, ok := config.MaxAllowed[name]; ok && request.Cmp(bound) > 0 {
+				requests[name] = bound.DeepCopy()
+			}
+		}
+
+		var limits corev1.ResourceList
+		for name, limit := range container.Resources.Limits {
+			request, ok := requests[name]
+			if !ok {
+				continue
+			}
+			// Without a request, the limit is the request of the container.
+			current, ok := container.Resources.Requests[name]
+			if !ok || current.Cmp(limit) > 0 || current.IsZero() {
+				current = limit
+			}
+			if limits == nil {
+				limits = corev1.ResourceList{}
+			}
+			if name == corev1.ResourceMemory {
+				// In MiB, which doesn't overflow.
+				mebibytes := roundUp(request.Value(), memoryRounding) / memoryRounding
+				limits[name] = *resource.NewQuantity(scale(mebibytes, limit.Value(), current.Value())*memoryRounding, resource.BinarySI)
+			} else {
+				limits[name] = *resource.NewMilliQuantity(scale(request.MilliValue(), limit.MilliValue(), current.MilliValue()), resource.DecimalSI)
+			}
+		}
+		recommendations = append(recommendations, ResourceRecommendation{
+			Deployment: c.Deployment,
+			Container:  c.Container,
+			Samples:    len(c.CPU),
+			Requests:   requests,
+			Limits:     limits,
+		})
+	}
+	return recommendations
+}
+
+// updateApplied returns the recommendations to apply: the applied ones, unless a recommended
+// request changed by more than resourceChangeThreshold.
+func updateApplied(applied, recommendations []ResourceRecommendation) []ResourceRecommendation {
+	var updated []ResourceRecommendation
+	for _, recommendation := range recommendations {
+		i := slices.IndexFunc(applied, func(a ResourceRecommendation) bool {
+			return a.Deployment == recommendation.Deployment && a.Container == recommendation.Container
+		})
+		if i >= 0 && !changedRequests(applied[i].Requests, recommendation.Requests) {
+			updated = append(updated, applied[i])
+			continue
+		}
+		updated = append(updated, recommendation)
+	}
+	return updated
+}
+
+func changedRequests(applied, recommended corev1.ResourceList) bool {
+	for name, request := range recommended {
+		current, ok := applied[name]
+		if !ok || current.IsZero() {
+			return true
+		}
+		if math.Abs(float64(request.MilliValue()-current.MilliValue()))/float64(current.MilliValue()) > resourceChangeThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRecommendations sets the applied recommendations on the containers, whose resources
+// neither spec.workloads nor spec.resources set.
+func applyRecommendations(instance base.KComponent, applied []ResourceRecommendation) mf.Transformer {
+	overridden := func(deployment, container string) bool {
+		if find(instance.GetSpec().GetResources(), container) != nil {
+			return true
+		}
+		for _, override := range instance.GetSpec().GetWorkloadOverrides() {
+			if override.Name == deployment && find(override.Resources, container) != nil {
+				return true
+			}
+		}
+		return false
+	}
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Deployment" {
+			return nil
+		}
+		fields := []string{"spec", "template", "spec", "containers"}
+		containers, found, err := unstructured.NestedSlice(u.Object, fields...)
+		if err != nil || !found {
+			return err
+		}
+		changed := false
+		for i := range containers {
+			container, ok := containers[i].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("containers of Deployment %s is not an object", u.GetName())
+			}
+			name, _ := container["name"].(string)
+			j := slices.IndexFunc(applied, func(a ResourceRecommendation) bool {
+				return a.Deployment == u.GetName() && a.Container == name
+			})
+			if j < 0 || overridden(u.GetName(), name) {
+				continue
+			}
+			if err := mergeUnstructuredResources(container, "requests", applied[j].Requests); err != nil {
+				return err
+			}
+			if err := mergeUnstructuredResources(container, "limits", applied[j].Limits); err != nil {
+				return err
+			}
+			changed = true
+		}
+		if !changed {
+			return nil
+		}
+		return unstructured.SetNestedSlice(u.Object, containers, fields...)
+	}
+}
+
+// mergeUnstructuredResources sets the quantities of src in the requests or limits of the
+// unstructured container, the same way as merge.
+func mergeUnstructuredResources(container map[string]interface{}, field string, src corev1.ResourceList) error {
+	if len(src) == 0 {
+		return nil
+	}
+	tgt, _, err := unstructured.NestedMap(container, "resources", field)
+	if err != nil {
+		return err
+	}
+	if tgt == nil {
+		tgt = map[string]interface{}{}
+	}
+	for name, quantity := range src {
+		tgt[string(name)] = quantity.String()
+	}
+	return unstructured.SetNestedMap(container, tgt, "resources", field)
+}
+
+func findContainer(deployments []*appsv1.Deployment, deployment, name string) *corev1.Container {
+	for _, d := range deployments {
+		if d.Name != deployment {
+			continue
+		}
+		for i := range d.Spec.Template.Spec.Containers {
+			if d.Spec.Template.Spec.Containers[i].Name == name {
+				return &d.Spec.Template.Spec.Containers[i]
+			}
+		}
+	}
+	return nil
+}
+
+// scale returns value * numerator / denominator, rounded up.
+func scale(value, numerator, denominator int64) int64 {
+	return (value*numerator + denominator - 1) / denominator
+}
+
+func roundUp(value, multiple int64) int64 {
+	return (value + multiple - 1) / multiple * multiple
+}
+
+func resourceRecommendationsConfigMap(instance base.KComponent, usage resourceUsage, recommendations, applied []ResourceRecommendation) (*corev1.ConfigMap, error) {
+	usageYaml, err := yaml.Marshal(usage)
+	if err != nil {
+		return nil, err
+	}
+	if recommendations == nil {
+		recommendations = []ResourceRecommendation{}
+	}
+	recommendationsYaml, err := yaml.Marshal(recommendations)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]string{
+		ResourceRecommendationsKey: string(recommendationsYaml),
+		resourceUsageKey:           string(usageYaml),
+	}
+	if len(applied) > 0 {
+		appliedYaml, err := yaml.Marshal(applied)
+		if err != nil {
+			return nil, err
+		}
+		data[ResourceRecommendationsAppliedKey] = string(appliedYaml)
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ResourceRecommendationsConfigMapName(instance),
+			Namespace:       instance.GetNamespace(),
+			Labels:          map[string]string{ResourceRecommendationsLabel: strings.ToLower(instance.GroupVersionKind().Kind)},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(instance, instance.GroupVersionKind())},
+		},
+		Data: data,
+	}, nil
+}
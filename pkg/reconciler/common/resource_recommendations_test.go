@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+var quantities = cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })
+
+func recommendationsDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "controller", Namespace: "knative-serving"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "controller"}},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: "controller",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("100Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1000Mi")},
+				},
+			}}}},
+		},
+	}
+}
+
+// controllerUsage returns 11 samples of the controller, the 12th one is needed for a
+// recommendation.
+func controllerUsage() containerUsage {
+	usage := containerUsage{Deployment: "controller", Container: "controller"}
+	for i := int64(1); i <= 11; i++ {
+		usage.CPU = append(usage.CPU, i*10)
+		usage.Memory = append(usage.Memory, 50<<20)
+	}
+	return usage
+}
+
+func TestResourceRecommenderRecommend(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+		Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{
+			ResourceRecommendations: &base.ResourceRecommendations{Enabled: true, Apply: true},
+		}},
+	}
+
+	usage, err := yaml.Marshal(resourceUsage{
+		LastSample: metav1.NewTime(now.Add(-10 * time.Minute)),
+		Containers: []containerUsage{controllerUsage()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving-resource-recommendations", Namespace: "knative-serving"},
+		Data:       map[string]string{resourceUsageKey: string(usage)},
+	})
+	podMetrics := func(name, app, cpu, memory string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "metrics.k8s.io/v1beta1",
+			"kind":       "PodMetrics",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "knative-serving",
+				"labels":    map[string]interface{}{"app": app},
+			},
+			"containers": []interface{}{map[string]interface{}{
+				"name":  "controller",
+				"usage": map[string]interface{}{"cpu": cpu, "memory": memory},
+			}},
+		}}
+	}
+	metricsClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podMetricsResource: "PodMetricsList"})
+	for _, obj := range []runtime.Object{
+		podMetrics("controller-1", "controller", "50m", "60Mi"),
+		podMetrics("controller-2", "controller", "120m", "80Mi"),
+		podMetrics("other", "other", "4", "4Gi"),
+	} {
+		// The resource of the PodMetrics is pods, which the fake client doesn't guess from the kind.
+		if err := metricsClient.Tracker().Create(podMetricsResource, obj, "knative-serving"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	impl := controller.NewContext(ctx, nil, controller.ControllerOptions{WorkQueueName: "test", Logger: logging.FromContext(ctx)})
+	defer impl.WorkQueue().ShutDown()
+	recommender := NewResourceRecommender(kubeClient, metricsClient, impl)
+	recommender.now = func() time.Time { return now }
+
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{util.MakeUnstructured(t, recommendationsDeployment())}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	rendered := manifest
+	if err := recommender.Recommend(ctx, &manifest, ks); err != nil {
+		t.Fatalf("Recommend() = %v", err)
+	}
+
+	want := []ResourceRecommendation{{
+		Deployment: "controller",
+		Container:  "controller",
+		Samples:    12,
+		Requests:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("127m"), corev1.ResourceMemory: resource.MustParse("92Mi")},
+		Limits:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1270m"), corev1.ResourceMemory: resource.MustParse("920Mi")},
+	}}
+	cm, err := kubeClient.CoreV1().ConfigMaps("knative-serving").Get(ctx, "knative-serving-resource-recommendations", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	util.AssertEqual(t, cm.Labels[ResourceRecommendationsLabel], "knativeserving")
+	var got, applied []ResourceRecommendation
+	if err := yaml.Unmarshal([]byte(cm.Data[ResourceRecommendationsKey]), &got); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+	if err := yaml.Unmarshal([]byte(cm.Data[ResourceRecommendationsAppliedKey]), &applied); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+	if diff := cmp.Diff(want, got, quantities); diff != "" {
+		t.Errorf("Recommendations (-want, +got): %s", diff)
+	}
+	if diff := cmp.Diff(want, applied, quantities); diff != "" {
+		t.Errorf("Applied (-want, +got): %s", diff)
+	}
+
+	deployment := &appsv1.Deployment{}
+	u := manifest.Resources()[0]
+	if err := scheme.Scheme.Convert(&u, deployment, nil); err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	if diff := cmp.Diff(corev1.ResourceRequirements{Requests: want[0].Requests, Limits: want[0].Limits},
+		deployment.Spec.Template.Spec.Containers[0].Resources, quantities); diff != "" {
+		t.Errorf("Resources (-want, +got): %s", diff)
+	}
+
+	// The next reconciliation within the interval takes no sample.
+	manifest = rendered
+	if err := recommender.Recommend(ctx, &manifest, ks); err != nil {
+		t.Fatalf("Recommend() = %v", err)
+	}
+	cm, err = kubeClient.CoreV1().ConfigMaps("knative-serving").Get(ctx, "knative-serving-resource-recommendations", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	var sampled resourceUsage
+	if err := yaml.Unmarshal([]byte(cm.Data[resourceUsageKey]), &sampled); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+	util.AssertEqual(t, len(sampled.Containers[0].CPU), 12)
+}
+
+func TestRecommendResources(t *testing.T) {
+	usage := controllerUsage()
+	usage.CPU = append(usage.CPU, 120)
+	usage.Memory = append(usage.Memory, 80<<20)
+	deployments := []*appsv1.Deployment{recommendationsDeployment()}
+
+	tests := []struct {
+		name   string
+		usage  containerUsage
+		config base.ResourceRecommendations
+		want   []ResourceRecommendation
+	}{{
+		name:  "not enough samples",
+		usage: controllerUsage(),
+	}, {
+		name:  "recommended",
+		usage: usage,
+		want: []ResourceRecommendation{{
+			Deployment: "controller",
+			Container:  "controller",
+			Samples:    12,
+			Requests:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("127m"), corev1.ResourceMemory: resource.MustParse("92Mi")},
+			Limits:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1270m"), corev1.ResourceMemory: resource.MustParse("920Mi")},
+		}},
+	}, {
+		name:  "bounded",
+		usage: usage,
+		config: base.ResourceRecommendations{
+			MinAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+			MaxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		},
+		want: []ResourceRecommendation{{
+			Deployment: "controller",
+			Container:  "controller",
+			Samples:    12,
+			Requests:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			Limits:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1280Mi")},
+		}},
+	}, {
+		name:  "unknown container",
+		usage: containerUsage{Deployment: "controller", Container: "sidecar", CPU: usage.CPU, Memory: usage.Memory},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := recommendResources(resourceUsage{Containers: []containerUsage{test.usage}}, deployments, &test.config)
+			if diff := cmp.Diff(test.want, got, quantities); diff != "" {
+				t.Errorf("recommendResources() (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateApplied(t *testing.T) {
+	recommendation := func(cpu string) ResourceRecommendation {
+		return ResourceRecommendation{
+			Deployment: "controller",
+			Container:  "controller",
+			Requests:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+		}
+	}
+	applied := []ResourceRecommendation{recommendation("100m")}
+
+	util.AssertDeepEqual(t, updateApplied(applied, []ResourceRecommendation{recommendation("110m")}), applied)
+	util.AssertDeepEqual(t, updateApplied(applied, []ResourceRecommendation{recommendation("130m")}), []ResourceRecommendation{recommendation("130m")})
+	util.AssertDeepEqual(t, updateApplied(applied, []ResourceRecommendation{recommendation("70m")}), []ResourceRecommendation{recommendation("70m")})
+	util.AssertEqual(t, len(updateApplied(applied, nil)), 0)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	mfc "github.com/manifestival/client-go-client"
+	mfdynamic "github.com/manifestival/client-go-client/pkg/dynamic"
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+)
+
+// FieldManager is the field manager of the resources applied with server-side apply.
+const FieldManager = "knative-operator"
+
+// ServerSideApplier is implemented by the manifestival clients, which apply resources with
+// server-side apply for the feature gate ServerSideApply.
+type ServerSideApplier interface {
+	ApplyServerSide(ctx context.Context, obj *unstructured.Unstructured) error
+}
+
+// serverSideApplyClient is the manifestival client of client-go, which also applies resources with
+// server-side apply.
+type serverSideApplyClient struct {
+	mf.Client
+	resources mfdynamic.ResourceGetter
+}
+
+var _ ServerSideApplier = (*serverSideApplyClient)(nil)
+
+// NewManifestClient returns the manifestival client of the reconcilers for the config.
+func NewManifestClient(cfg *rest.Config) (mf.Client, error) {
+	client, err := mfc.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := mfdynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &serverSideApplyClient{Client: client, resources: resources}, nil
+}
+
+// ApplyServerSide implements ServerSideApplier. The conflicts with other field managers are forced,
+// as the operator owns the fields of its manifests.
+func (c *serverSideApplyClient) ApplyServerSide(ctx context.Context, obj *unstructured.Unstructured) error {
+	resource, err := c.resources.ResourceInterface(obj)
+	if err != nil {
+		return err
+	}
+	_, err = resource.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{FieldManager: FieldManager, Force: true})
+	return err
+}
+
+func applyServerSide(ctx context.Context, applier ServerSideApplier, u unstructured.Unstructured) error {
+	if err := applier.ApplyServerSide(ctx, &u); err != nil {
+		return fmt.Errorf("%s %s: %w", u.GetKind(), namespacedName(&u), err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// ServiceAccountsTransform transforms ServiceAccounts based on the configuration in `spec.serviceAccounts`,
+// e.g. to bind the controllers to the identities of a cloud provider with annotations.
+func ServiceAccountsTransform(obj base.KComponent) mf.Transformer {
+	overrides := obj.GetSpec().GetServiceAccountOverride()
+	if overrides == nil {
+		return nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ServiceAccount" {
+			return nil
+		}
+		for _, override := range overrides {
+			if u.GetName() != override.Name {
+				continue
+			}
+			u.SetLabels(mergeStrings(u.GetLabels(), override.Labels))
+			u.SetAnnotations(mergeStrings(u.GetAnnotations(), override.Annotations))
+		}
+		return nil
+	}
+}
+
+// mergeStrings returns the entries of to, overridden by the ones of from.
+func mergeStrings(to, from map[string]string) map[string]string {
+	if len(from) == 0 {
+		return to
+	}
+	if to == nil {
+		to = make(map[string]string, len(from))
+	}
+	for key, value := range from {
+		to[key] = value
+	}
+	return to
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestServiceAccountsTransform(t *testing.T) {
+	tests := []struct {
+		name            string
+		kind            string
+		overrides       []base.ServiceAccountOverride
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+	}{{
+		name:            "no override",
+		kind:            "ServiceAccount",
+		wantLabels:      map[string]string{"app.kubernetes.io/name": "knative-serving"},
+		wantAnnotations: map[string]string{"existing": "value"},
+	}, {
+		name: "workload identity",
+		kind: "ServiceAccount",
+		overrides: []base.ServiceAccountOverride{{
+			Name:        "controller",
+			Labels:      map[string]string{"team": "platform"},
+			Annotations: map[string]string{"iam.gke.io/gcp-service-account": "knative@project.iam.gserviceaccount.com"},
+		}},
+		wantLabels:      map[string]string{"app.kubernetes.io/name": "knative-serving", "team": "platform"},
+		wantAnnotations: map[string]string{"existing": "value", "iam.gke.io/gcp-service-account": "knative@project.iam.gserviceaccount.com"},
+	}, {
+		name: "other service account",
+		kind: "ServiceAccount",
+		overrides: []base.ServiceAccountOverride{{
+			Name:        "webhook",
+			Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/knative"},
+		}},
+		wantLabels:      map[string]string{"app.kubernetes.io/name": "knative-serving"},
+		wantAnnotations: map[string]string{"existing": "value"},
+	}, {
+		name: "other kind",
+		kind: "Service",
+		overrides: []base.ServiceAccountOverride{{
+			Name:        "controller",
+			Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/knative"},
+		}},
+		wantLabels:      map[string]string{"app.kubernetes.io/name": "knative-serving"},
+		wantAnnotations: map[string]string{"existing": "value"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sa := util.MakeUnstructured(t, &corev1.ServiceAccount{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "controller",
+					Namespace:   "knative-serving",
+					Labels:      map[string]string{"app.kubernetes.io/name": "knative-serving"},
+					Annotations: map[string]string{"existing": "value"},
+				},
+			})
+			sa.SetKind(test.kind)
+			manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{sa}))
+			if err != nil {
+				t.Fatalf("Failed to generate manifest: %v", err)
+			}
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{ServiceAccountOverride: test.overrides},
+				},
+			}
+			manifest, err = manifest.Transform(ServiceAccountsTransform(ks))
+			if err != nil {
+				t.Fatalf("Failed to transform manifest: %v", err)
+			}
+			got := manifest.Resources()[0]
+			util.AssertDeepEqual(t, got.GetLabels(), test.wantLabels)
+			util.AssertDeepEqual(t, got.GetAnnotations(), test.wantAnnotations)
+		})
+	}
+}
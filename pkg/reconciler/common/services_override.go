@@ -82,3 +82,48 @@ func overrideSelectors(override *base.ServiceOverride, service *corev1.Service)
 		service.Spec.Selector[key] = val
 	}
 }
+
+// IPFamiliesTransform sets the IP families of the services to the ones of their `spec.services`
+// override, or of the spec. The services of type ExternalName have no IP families.
+func IPFamiliesTransform(obj base.KComponent) mf.Transformer {
+	defaults := obj.GetSpec().GetIPFamilies()
+	overrides := map[string]base.IPFamilyConfiguration{}
+	for _, override := range obj.GetSpec().GetServiceOverride() {
+		if len(override.IPFamilies) > 0 || override.IPFamilyPolicy != nil {
+			overrides[override.Name] = override.IPFamilyConfiguration
+		}
+	}
+	if len(defaults.IPFamilies) == 0 && defaults.IPFamilyPolicy == nil && len(overrides) == 0 {
+		return nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Service" {
+			return nil
+		}
+		if serviceType, _, _ := unstructured.NestedString(u.Object, "spec", "type"); serviceType == string(corev1.ServiceTypeExternalName) {
+			return nil
+		}
+		config := defaults
+		if override, ok := overrides[u.GetName()]; ok {
+			if len(override.IPFamilies) > 0 {
+				config.IPFamilies = override.IPFamilies
+			}
+			if override.IPFamilyPolicy != nil {
+				config.IPFamilyPolicy = override.IPFamilyPolicy
+			}
+		}
+		if len(config.IPFamilies) > 0 {
+			families := make([]string, 0, len(config.IPFamilies))
+			for _, family := range config.IPFamilies {
+				families = append(families, string(family))
+			}
+			if err := unstructured.SetNestedStringSlice(u.Object, families, "spec", "ipFamilies"); err != nil {
+				return err
+			}
+		}
+		if config.IPFamilyPolicy != nil {
+			return unstructured.SetNestedField(u.Object, string(*config.IPFamilyPolicy), "spec", "ipFamilyPolicy")
+		}
+		return nil
+	}
+}
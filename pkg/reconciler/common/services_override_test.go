@@ -109,3 +109,54 @@ func TestServicesTransform(t *testing.T) {
 		})
 	}
 }
+
+func TestIPFamiliesTransform(t *testing.T) {
+	dualStack := corev1.IPFamilyPolicyPreferDualStack
+	singleStack := corev1.IPFamilyPolicySingleStack
+	ks := &servingv1beta1.KnativeServing{
+		Spec: servingv1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{
+				IPFamilyConfiguration: base.IPFamilyConfiguration{
+					IPFamilies:     []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol},
+					IPFamilyPolicy: &dualStack,
+				},
+				ServiceOverride: []base.ServiceOverride{{
+					Name: "controller",
+					IPFamilyConfiguration: base.IPFamilyConfiguration{
+						IPFamilies:     []corev1.IPFamily{corev1.IPv6Protocol},
+						IPFamilyPolicy: &singleStack,
+					},
+				}},
+			},
+		},
+	}
+	manifest, err := mf.NewManifest("testdata/manifest.yaml")
+	if err != nil {
+		t.Fatalf("Failed to create manifest: %v", err)
+	}
+	manifest, err = manifest.Transform(IPFamiliesTransform(ks))
+	if err != nil {
+		t.Fatalf("Failed to transform manifest: %v", err)
+	}
+
+	want := map[string]corev1.ServiceSpec{
+		"controller":        {IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol}, IPFamilyPolicy: &singleStack},
+		"activator-service": {IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}, IPFamilyPolicy: &dualStack},
+	}
+	for _, u := range manifest.Filter(mf.ByKind("Service")).Resources() {
+		expected, ok := want[u.GetName()]
+		if !ok {
+			continue
+		}
+		got := &corev1.Service{}
+		if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+			t.Fatalf("Failed to convert unstructured to service: %v", err)
+		}
+		if diff := cmp.Diff(got.Spec.IPFamilies, expected.IPFamilies); diff != "" {
+			t.Errorf("Unexpected IP families of %s: %v", u.GetName(), diff)
+		}
+		if diff := cmp.Diff(got.Spec.IPFamilyPolicy, expected.IPFamilyPolicy); diff != "" {
+			t.Errorf("Unexpected IP family policy of %s: %v", u.GetName(), diff)
+		}
+	}
+}
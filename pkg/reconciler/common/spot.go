@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// SpotPodDisruptionBudgetSuffix is appended to the name of a deployment for the name of the
+	// PodDisruptionBudget generated by the spot profile.
+	SpotPodDisruptionBudgetSuffix = "-spot-pdb"
+	// SpotPodDisruptionBudgetLabel marks the PodDisruptionBudgets generated by the spot profile.
+	SpotPodDisruptionBudgetLabel = "operator.knative.dev/spot-pdb"
+)
+
+// onDemand returns whether the deployment is kept on the on-demand nodes by the spot profile:
+// the activator and the webhooks, whose requests are lost when their node is preempted, and the
+// deployments of spec.spot.onDemand.
+func onDemand(spot *base.SpotConfiguration, name string) bool {
+	if name == "activator" || strings.HasSuffix(name, "webhook") {
+		return true
+	}
+	for _, n := range spot.OnDemand {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SpotTransform places the deployments on the spot nodes of spec.spot, if it is enabled: their
+// pods tolerate the taints of the spot nodes and prefer them, so that they still run on the
+// on-demand nodes, when there is no spot capacity. The pods of the on-demand deployments are
+// kept off the spot nodes.
+func SpotTransform(instance base.KComponent) mf.Transformer {
+	spot := instance.GetSpec().GetSpot()
+	if spot == nil || !spot.Enabled {
+		return nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Deployment" {
+			return nil
+		}
+		deployment := &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(u, deployment, nil); err != nil {
+			return err
+		}
+		podSpec := &deployment.Spec.Template.Spec
+		if podSpec.Affinity == nil {
+			podSpec.Affinity = &corev1.Affinity{}
+		}
+		if podSpec.Affinity.NodeAffinity == nil {
+			podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+		}
+		if onDemand(spot, deployment.Name) {
+			avoidSpotNodes(podSpec.Affinity.NodeAffinity, spot.NodeSelector)
+		} else {
+			preferSpotNodes(podSpec, spot)
+		}
+		if err := scheme.Scheme.Convert(deployment, u, nil); err != nil {
+			return err
+		}
+		// Avoid superfluous updates from converted zero defaults
+		u.SetCreationTimestamp(metav1.Time{})
+		return nil
+	}
+}
+
+// preferSpotNodes adds the tolerations of the spot nodes and a preferred node affinity to them.
+func preferSpotNodes(podSpec *corev1.PodSpec, spot *base.SpotConfiguration) {
+	for _, toleration := range spot.Tolerations {
+		found := false
+		for _, t := range podSpec.Tolerations {
+			if equality.Semantic.DeepEqual(t, toleration) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			podSpec.Tolerations = append(podSpec.Tolerations, toleration)
+		}
+	}
+	if len(spot.NodeSelector) == 0 {
+		return
+	}
+	term := corev1.NodeSelectorTerm{}
+	for _, key := range sets.List(sets.KeySet(spot.NodeSelector)) {
+		term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+			Key:      key,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{spot.NodeSelector[key]},
+		})
+	}
+	affinity := podSpec.Affinity.NodeAffinity
+	affinity.PreferredDuringSchedulingIgnoredDuringExecution = append(affinity.PreferredDuringSchedulingIgnoredDuringExecution,
+		corev1.PreferredSchedulingTerm{Weight: 100, Preference: term})
+}
+
+// avoidSpotNodes adds a requirement against each label of the spot nodes to all the terms of the
+// required node affinity. NotIn matches the nodes without the label, too.
+func avoidSpotNodes(affinity *corev1.NodeAffinity, nodeSelector map[string]string) {
+	if len(nodeSelector) == 0 {
+		return
+	}
+	required := affinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil {
+		required = &corev1.NodeSelector{}
+		affinity.RequiredDuringSchedulingIgnoredDuringExecution = required
+	}
+	if len(required.NodeSelectorTerms) == 0 {
+		required.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range required.NodeSelectorTerms {
+		term := &required.NodeSelectorTerms[i]
+		for _, key := range sets.List(sets.KeySet(nodeSelector)) {
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpNotIn,
+				Values:   []string{nodeSelector[key]},
+			})
+		}
+	}
+}
+
+// AppendSpotPodDisruptionBudgets appends a PodDisruptionBudget for each deployment, which the spot
+// profile places on the spot nodes, to the manifest, unless the manifest has one for its pods
+// already. They allow one pod of the deployment to be evicted at a time, so that draining the
+// spot nodes, e.g. by the cluster autoscaler, doesn't evict all the pods together.
+func AppendSpotPodDisruptionBudgets(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	spot := instance.GetSpec().GetSpot()
+	if spot == nil || !spot.Enabled {
+		return nil
+	}
+	var selectors []map[string]string
+	for _, u := range manifest.Filter(mf.ByKind("PodDisruptionBudget")).Resources() {
+		labels, _, _ := unstructured.NestedStringMap(u.Object, "spec", "selector", "matchLabels")
+		selectors = append(selectors, labels)
+	}
+	covered := func(labels map[string]string) bool {
+		for _, selector := range selectors {
+			if equality.Semantic.DeepEqual(selector, labels) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var resources []unstructured.Unstructured
+	for _, u := range manifest.Filter(mf.ByKind("Deployment")).Resources() {
+		deployment := &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(&u, deployment, nil); err != nil {
+			return err
+		}
+		selector := deployment.Spec.Selector
+		if onDemand(spot, deployment.Name) || selector == nil || len(selector.MatchLabels) == 0 || covered(selector.MatchLabels) {
+			continue
+		}
+		maxUnavailable := intstr.FromInt32(1)
+		labels := map[string]string{SpotPodDisruptionBudgetLabel: "true"}
+		for key, value := range deployment.Labels {
+			labels[key] = value
+		}
+		pdb := &policyv1.PodDisruptionBudget{
+			TypeMeta: metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deployment.Name + SpotPodDisruptionBudgetSuffix,
+				Namespace: deployment.Namespace,
+				Labels:    labels,
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+				Selector:       &metav1.LabelSelector{MatchLabels: selector.MatchLabels},
+			},
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pdb)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, unstructured.Unstructured{Object: obj})
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	m, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return err
+	}
+	*manifest = manifest.Append(m)
+	return nil
+}
+
+// DeleteObsoleteSpotPodDisruptionBudgets returns a Stage, which deletes the PodDisruptionBudgets
+// generated by the spot profile, which the manifest doesn't contain anymore, e.g. once spec.spot
+// is disabled.
+func DeleteObsoleteSpotPodDisruptionBudgets(kubeClient kubernetes.Interface) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		return deleteObsoletePodDisruptionBudgets(ctx, kubeClient, manifest, instance, SpotPodDisruptionBudgetLabel)
+	}
+}
+
+// deleteObsoletePodDisruptionBudgets deletes the PodDisruptionBudgets in the namespace of the
+// instance with the label, which the manifest doesn't contain.
+func deleteObsoletePodDisruptionBudgets(ctx context.Context, kubeClient kubernetes.Interface, manifest *mf.Manifest, instance base.KComponent, label string) error {
+	client := kubeClient.PolicyV1().PodDisruptionBudgets(instance.GetNamespace())
+	pdbs, err := client.List(ctx, metav1.ListOptions{LabelSelector: label + "=true"})
+	if err != nil {
+		return fmt.Errorf("failed to list the PodDisruptionBudgets: %w", err)
+	}
+	wanted := sets.New[string]()
+	for _, u := range manifest.Filter(mf.ByKind("PodDisruptionBudget")).Resources() {
+		wanted.Insert(u.GetName())
+	}
+	for _, pdb := range pdbs.Items {
+		if wanted.Has(pdb.Name) {
+			continue
+		}
+		if err := client.Delete(ctx, pdb.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete the PodDisruptionBudget %s: %w", pdb.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+var spotToleration = corev1.Toleration{Key: "cloud.google.com/gke-spot", Operator: corev1.TolerationOpEqual, Value: "true", Effect: corev1.TaintEffectNoSchedule}
+
+func spotServing() *v1beta1.KnativeServing {
+	return &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+		Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Spot: &base.SpotConfiguration{
+			Enabled:      true,
+			NodeSelector: map[string]string{"cloud.google.com/gke-spot": "true"},
+			Tolerations:  []corev1.Toleration{spotToleration},
+			OnDemand:     []string{"3scale-kourier-gateway"},
+		}}},
+	}
+}
+
+func spotDeployment(t *testing.T, name string) unstructured.Unstructured {
+	return util.MakeUnstructured(t, &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "knative-serving"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+		},
+	})
+}
+
+func TestSpotTransform(t *testing.T) {
+	spotTerm := corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+		{Key: "cloud.google.com/gke-spot", Operator: corev1.NodeSelectorOpIn, Values: []string{"true"}},
+	}}
+	onDemandTerm := corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+		{Key: "cloud.google.com/gke-spot", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"true"}},
+	}}
+	tests := []struct {
+		name            string
+		wantTolerations []corev1.Toleration
+		wantAffinity    *corev1.NodeAffinity
+	}{{
+		name:            "autoscaler",
+		wantTolerations: []corev1.Toleration{spotToleration},
+		wantAffinity: &corev1.NodeAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{Weight: 100, Preference: spotTerm}},
+		},
+	}, {
+		name: "activator",
+		wantAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{onDemandTerm}},
+		},
+	}, {
+		name: "webhook",
+		wantAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{onDemandTerm}},
+		},
+	}, {
+		name: "3scale-kourier-gateway",
+		wantAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{onDemandTerm}},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u := spotDeployment(t, test.name)
+			if err := SpotTransform(spotServing())(&u); err != nil {
+				t.Fatalf("SpotTransform() = %v", err)
+			}
+			got := &appsv1.Deployment{}
+			if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			util.AssertDeepEqual(t, got.Spec.Template.Spec.Tolerations, test.wantTolerations)
+			util.AssertDeepEqual(t, got.Spec.Template.Spec.Affinity.NodeAffinity, test.wantAffinity)
+		})
+	}
+
+	if SpotTransform(&v1beta1.KnativeServing{}) != nil {
+		t.Error("SpotTransform() != nil without spec.spot")
+	}
+}
+
+func TestAppendSpotPodDisruptionBudgets(t *testing.T) {
+	pdb := util.MakeUnstructured(t, &policyv1.PodDisruptionBudget{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+		ObjectMeta: metav1.ObjectMeta{Name: "controller-pdb", Namespace: "knative-serving"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "controller"}},
+		},
+	})
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		spotDeployment(t, "autoscaler"),
+		spotDeployment(t, "controller"),
+		spotDeployment(t, "activator"),
+		pdb,
+	}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	if err := AppendSpotPodDisruptionBudgets(context.Background(), &manifest, spotServing()); err != nil {
+		t.Fatalf("AppendSpotPodDisruptionBudgets() = %v", err)
+	}
+
+	var names []string
+	for _, u := range manifest.Filter(mf.ByKind("PodDisruptionBudget")).Resources() {
+		names = append(names, u.GetName())
+	}
+	util.AssertDeepEqual(t, names, []string{"controller-pdb", "autoscaler-spot-pdb"})
+
+	got := &policyv1.PodDisruptionBudget{}
+	generated := manifest.Filter(mf.ByName("autoscaler-spot-pdb")).Resources()[0]
+	if err := scheme.Scheme.Convert(&generated, got, nil); err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	util.AssertEqual(t, got.Spec.MaxUnavailable.IntValue(), 1)
+	util.AssertDeepEqual(t, got.Spec.Selector.MatchLabels, map[string]string{"app": "autoscaler"})
+	util.AssertEqual(t, got.Labels[SpotPodDisruptionBudgetLabel], "true")
+}
+
+func TestDeleteObsoleteSpotPodDisruptionBudgets(t *testing.T) {
+	labels := map[string]string{SpotPodDisruptionBudgetLabel: "true"}
+	kubeClient := kubefake.NewSimpleClientset(
+		&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "autoscaler-spot-pdb", Namespace: "knative-serving", Labels: labels}},
+		&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "controller-spot-pdb", Namespace: "knative-serving", Labels: labels}},
+		&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "activator-pdb", Namespace: "knative-serving"}},
+	)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{util.MakeUnstructured(t, &policyv1.PodDisruptionBudget{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+		ObjectMeta: metav1.ObjectMeta{Name: "autoscaler-spot-pdb", Namespace: "knative-serving"},
+	})}))
+	if err != nil {
+		t.Fatalf("ManifestFrom() = %v", err)
+	}
+	if err := DeleteObsoleteSpotPodDisruptionBudgets(kubeClient)(context.Background(), &manifest, spotServing()); err != nil {
+		t.Fatalf("DeleteObsoleteSpotPodDisruptionBudgets() = %v", err)
+	}
+
+	pdbs, err := kubeClient.PolicyV1().PodDisruptionBudgets("knative-serving").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() = %v", err)
+	}
+	var names []string
+	for _, pdb := range pdbs.Items {
+		names = append(names, pdb.Name)
+	}
+	util.AssertDeepEqual(t, names, []string{"activator-pdb", "autoscaler-spot-pdb"})
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// StagePosition is the position of a custom stage in the pipeline of the reconcilers, which
+// fetches, transforms, applies the manifest and checks the health of the deployments.
+type StagePosition string
+
+const (
+	// StagePositionAfterRender runs the stage once the manifest is fetched, transformed and checked,
+	// before it is applied. In dry-run mode, it runs before the preview is published.
+	StagePositionAfterRender StagePosition = "AfterRender"
+	// StagePositionAfterInstall runs the stage once the manifest is applied, before the deployments
+	// are checked.
+	StagePositionAfterInstall StagePosition = "AfterInstall"
+	// StagePositionAfterReady runs the stage once the deployments are ready.
+	StagePositionAfterReady StagePosition = "AfterReady"
+)
+
+const stageDurationMetric = "kn.operator.stage.duration"
+
+// operatorConditions are the conditions of the operator, which a custom stage must not take over.
+var operatorConditions = []apis.ConditionType{
+	apis.ConditionReady,
+	base.DependenciesInstalled,
+	base.InstallSucceeded,
+	base.DeploymentsAvailable,
+	base.VersionMigrationEligible,
+	base.PreviewReady,
+	base.PreflightChecksPassed,
+	base.Paused,
+	base.VersionSkewWarning,
+	base.WebhookCertificateWarning,
+	base.ConformancePassed,
+	base.UnmanagedConfigMapsWarning,
+	base.UnknownConfigWarning,
+}
+
+// stageNamePattern restricts the names of the custom stages to condition types.
+var stageNamePattern = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// CustomStage is a stage, which an extension inserts into the pipeline of the reconcilers.
+type CustomStage struct {
+	// Name is the type of the condition, which reports the outcome of the stage, e.g.
+	// ServiceCatalogRegistered, and the stage of its metrics. It is unique among the stages.
+	Name string
+	// Position is the position of the stage in the pipeline. The stages at the same position run
+	// in the order of their registration.
+	Position StagePosition
+	// Kinds restricts the stage to the components of these kinds, e.g. KnativeServing. It runs for
+	// all the components, if empty.
+	Kinds []string
+	// Run is the stage. An error fails the reconciliation, which is retried, and the stages after
+	// it don't run.
+	Run Stage
+}
+
+type stageRegistry struct {
+	mu       sync.RWMutex
+	stages   []CustomStage
+	duration metric.Float64Histogram
+}
+
+var customStages = &stageRegistry{}
+
+// RegisterStage inserts the stage into the pipeline of the reconcilers. It is meant to be called
+// before the controllers are started, e.g. in an init function.
+func RegisterStage(stage CustomStage) error {
+	if err := customStages.register(stage); err != nil {
+		return err
+	}
+	registerStageMetricsOnce()
+	return nil
+}
+
+func (r *stageRegistry) register(stage CustomStage) error {
+	if !stageNamePattern.MatchString(stage.Name) {
+		return fmt.Errorf("the name of the stage must be a condition type like ServiceCatalogRegistered, got %q", stage.Name)
+	}
+	if slices.Contains(operatorConditions, apis.ConditionType(stage.Name)) {
+		return fmt.Errorf("the stage %s conflicts with a condition of the operator", stage.Name)
+	}
+	if stage.Position != StagePositionAfterRender && stage.Position != StagePositionAfterInstall && stage.Position != StagePositionAfterReady {
+		return fmt.Errorf("the stage %s has the unknown position %q", stage.Name, stage.Position)
+	}
+	if stage.Run == nil {
+		return fmt.Errorf("the stage %s has nothing to run", stage.Name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.stages {
+		if s.Name == stage.Name {
+			return fmt.Errorf("the stage %s is already registered", stage.Name)
+		}
+	}
+	r.stages = append(r.stages, stage)
+	return nil
+}
+
+func (r *stageRegistry) unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stages = slices.DeleteFunc(r.stages, func(s CustomStage) bool { return s.Name == name })
+}
+
+func (r *stageRegistry) at(position StagePosition, kind string) []CustomStage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var stages []CustomStage
+	for _, s := range r.stages {
+		if s.Position == position && (len(s.Kinds) == 0 || slices.Contains(s.Kinds, kind)) {
+			stages = append(stages, s)
+		}
+	}
+	return stages
+}
+
+var registerStageMetricsOnce = sync.OnceFunc(func() {
+	if err := customStages.registerMetrics(otel.GetMeterProvider()); err != nil {
+		logging.FromContext(context.Background()).Warnw("Failed to register the metrics of the custom stages", zap.Error(err))
+	}
+})
+
+// registerMetrics records the duration of the custom stages as a histogram by the stage, the kind
+// of the component and the result: success, error, or waiting for the deployments.
+func (r *stageRegistry) registerMetrics(provider metric.MeterProvider) error {
+	duration, err := provider.Meter(meterName).Float64Histogram(stageDurationMetric,
+		metric.WithDescription("The duration of the custom stages of the extensions"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.duration = duration
+	return nil
+}
+
+func (r *stageRegistry) record(ctx context.Context, stage, kind string, d time.Duration, result string) {
+	r.mu.RLock()
+	duration := r.duration
+	r.mu.RUnlock()
+	if duration == nil {
+		return
+	}
+	duration.Record(ctx, d.Seconds(), metric.WithAttributes(
+		attribute.String("stage", stage),
+		attribute.String("kind", kind),
+		attribute.String("result", result)))
+}
+
+// CustomStages returns the stage running the custom stages at the position. Each reports its
+// outcome in its condition and its duration in the metric kn.operator.stage.duration. A stage
+// waiting for the deployments doesn't fail its condition.
+func CustomStages(position StagePosition) Stage {
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		kind := instance.GroupVersionKind().Kind
+		stages := customStages.at(position, kind)
+		if len(stages) == 0 {
+			return nil
+		}
+		for _, stage := range stages {
+			start := time.Now()
+			err := stage.Run(ctx, manifest, instance)
+			switch {
+			case err == nil:
+				customStages.record(ctx, stage.Name, kind, time.Since(start), "success")
+				instance.GetStatus().MarkStageSucceeded(apis.ConditionType(stage.Name))
+			case IsDeploymentsNotReadyError(err) || IsPreviewCompletedError(err):
+				customStages.record(ctx, stage.Name, kind, time.Since(start), "waiting")
+				return err
+			default:
+				customStages.record(ctx, stage.Name, kind, time.Since(start), "error")
+				instance.GetStatus().MarkStageFailed(apis.ConditionType(stage.Name), err.Error())
+				return fmt.Errorf("stage %s: %w", stage.Name, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestRegisterStage(t *testing.T) {
+	run := func(context.Context, *mf.Manifest, base.KComponent) error { return nil }
+	if err := RegisterStage(CustomStage{Name: "Registered", Position: StagePositionAfterReady, Run: run}); err != nil {
+		t.Fatalf("RegisterStage() = %v", err)
+	}
+	defer customStages.unregister("Registered")
+
+	for _, stage := range []CustomStage{
+		{Name: "Registered", Position: StagePositionAfterReady, Run: run},
+		{Name: "registered-lowercase", Position: StagePositionAfterReady, Run: run},
+		{Name: "InstallSucceeded", Position: StagePositionAfterReady, Run: run},
+		{Name: "Elsewhere", Position: "Elsewhere", Run: run},
+		{Name: "NothingToRun", Position: StagePositionAfterReady},
+	} {
+		if err := RegisterStage(stage); err == nil {
+			t.Errorf("RegisterStage(%s) = nil, want an error", stage.Name)
+		}
+	}
+}
+
+func TestCustomStages(t *testing.T) {
+	var ran []string
+	stage := func(name string, err error) Stage {
+		return func(context.Context, *mf.Manifest, base.KComponent) error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+	for _, s := range []CustomStage{
+		{Name: "First", Position: StagePositionAfterInstall, Run: stage("First", nil)},
+		{Name: "Eventing", Position: StagePositionAfterInstall, Kinds: []string{"KnativeEventing"}, Run: stage("Eventing", nil)},
+		{Name: "Failing", Position: StagePositionAfterInstall, Run: stage("Failing", errors.New("unreachable"))},
+		{Name: "Last", Position: StagePositionAfterInstall, Run: stage("Last", nil)},
+		{Name: "Waiting", Position: StagePositionAfterReady, Run: stage("Waiting", deploymentsNotReadyError{})},
+	} {
+		if err := RegisterStage(s); err != nil {
+			t.Fatalf("RegisterStage() = %v", err)
+		}
+		defer customStages.unregister(s.Name)
+	}
+	reader := sdkmetric.NewManualReader()
+	if err := customStages.registerMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))); err != nil {
+		t.Fatalf("registerMetrics() = %v", err)
+	}
+
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	ks.Status.InitializeConditions()
+	manifest, _ := mf.ManifestFrom(mf.Slice{})
+
+	err := CustomStages(StagePositionAfterInstall)(context.Background(), &manifest, ks)
+	if err == nil || err.Error() != "stage Failing: unreachable" {
+		t.Fatalf("CustomStages() = %v, want the error of the stage Failing", err)
+	}
+	// The stages of other kinds and after the failing one don't run.
+	util.AssertDeepEqual(t, ran, []string{"First", "Failing"})
+	util.AssertEqual(t, ks.Status.GetCondition("First").Status, corev1.ConditionTrue)
+	failing := ks.Status.GetCondition("Failing")
+	util.AssertEqual(t, failing.Status, corev1.ConditionFalse)
+	util.AssertEqual(t, failing.Message, "unreachable")
+	// A custom stage doesn't affect the readiness of the component.
+	util.AssertEqual(t, ks.Status.GetCondition(apis.ConditionReady).IsFalse(), false)
+
+	err = CustomStages(StagePositionAfterReady)(context.Background(), &manifest, ks)
+	if !IsDeploymentsNotReadyError(err) {
+		t.Fatalf("CustomStages() = %v, want the deployments not to be ready", err)
+	}
+	util.AssertEqual(t, ks.Status.GetCondition("Waiting") == nil, true)
+
+	rm := metricdata.ResourceMetrics{}
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() = %v", err)
+	}
+	got := map[string]uint64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != stageDurationMetric {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Histogram[float64]).DataPoints {
+				stage, _ := point.Attributes.Value("stage")
+				kind, _ := point.Attributes.Value("kind")
+				result, _ := point.Attributes.Value("result")
+				got[stage.AsString()+"/"+kind.AsString()+"/"+result.AsString()] = point.Count
+			}
+		}
+	}
+	util.AssertDeepEqual(t, got, map[string]uint64{
+		"First/KnativeServing/success":   1,
+		"Failing/KnativeServing/error":   1,
+		"Waiting/KnativeServing/waiting": 1,
+	})
+}
@@ -38,7 +38,7 @@ type Stages []Stage
 func (stages Stages) Execute(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
 	for _, stage := range stages {
 		if err := stage(ctx, manifest, instance); err != nil {
-			if IsDeploymentsNotReadyError(err) {
+			if IsDeploymentsNotReadyError(err) || IsPreviewCompletedError(err) {
 				break
 			}
 			return err
@@ -117,12 +117,16 @@ func DeleteObsoleteResources(ctx context.Context, instance base.KComponent, fetc
 		return NoOp
 	}
 	return func(_ context.Context, manifest *mf.Manifest, _ base.KComponent) error {
-		for _, r := range installed.Filter(mf.NoCRDs, mf.Not(mf.In(*manifest))).Resources() {
-			m, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{r}), mf.UseClient(manifest.Client))
+		rendered := mf.In(*manifest)
+		return forEachResource(*installed, func(r *unstructured.Unstructured) error {
+			if mf.CRDs(r) || rendered(r) {
+				return nil
+			}
+			m, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*r}), mf.UseClient(manifest.Client))
 			if err := m.Delete(); err != nil && !meta.IsNoMatchError(err) {
 				return fmt.Errorf("failed to delete obsolete resources: %w", err)
 			}
-		}
-		return nil
+			return nil
+		})
 	}
 }
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	clientset "knative.dev/operator/pkg/client/clientset/versioned"
+)
+
+// PatchStatusOptions configures a generated reconciler to skip its status updates, which are
+// written by PatchStatus instead.
+func PatchStatusOptions(*controller.Impl) controller.Options {
+	return controller.Options{SkipStatusUpdates: true}
+}
+
+// PatchStatus writes the status of the Knative component with a JSON merge patch, if it changed
+// semantically since the original was read. The conditions are compared regardless of their
+// order, and a condition, which only changed its last transition time, keeps the original time,
+// so that a resync, which observes the same state, does not write the status.
+func PatchStatus(ctx context.Context, client clientset.Interface, original, instance base.KComponent) error {
+	before, after := statusOf(original), statusOf(instance)
+	if before == nil || after == nil {
+		return fmt.Errorf("unsupported Knative component %T", instance)
+	}
+	normalizeConditions(conditionsOf(original), conditionsOf(instance))
+	if equality.Semantic.DeepEqual(before, after) {
+		return nil
+	}
+
+	patch, err := statusMergePatch(before, after)
+	if err != nil {
+		return err
+	}
+	logging.FromContext(ctx).Debugw("Patching the status", zap.ByteString("patch", patch))
+	namespace, name := instance.GetNamespace(), instance.GetName()
+	operator := client.OperatorV1beta1()
+	switch instance.(type) {
+	case *v1beta1.KnativeServing:
+		_, err = operator.KnativeServings(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	case *v1beta1.KnativeEventing:
+		_, err = operator.KnativeEventings(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	case *v1beta1.KnativeFunctions:
+		_, err = operator.KnativeFunctions(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	case *v1beta1.KnativeNetworking:
+		_, err = operator.KnativeNetworkings(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to patch the status of %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
+
+// statusOf returns the status of the known types.
+func statusOf(instance base.KComponent) interface{} {
+	switch c := instance.(type) {
+	case *v1beta1.KnativeServing:
+		return &c.Status
+	case *v1beta1.KnativeEventing:
+		return &c.Status
+	case *v1beta1.KnativeFunctions:
+		return &c.Status
+	case *v1beta1.KnativeNetworking:
+		return &c.Status
+	}
+	return nil
+}
+
+// conditionsOf returns the conditions of the status of the known types.
+func conditionsOf(instance base.KComponent) *duckv1.Conditions {
+	switch c := instance.(type) {
+	case *v1beta1.KnativeServing:
+		return &c.Status.Conditions
+	case *v1beta1.KnativeEventing:
+		return &c.Status.Conditions
+	case *v1beta1.KnativeFunctions:
+		return &c.Status.Conditions
+	case *v1beta1.KnativeNetworking:
+		return &c.Status.Conditions
+	}
+	return nil
+}
+
+// normalizeConditions sorts the conditions by their type like the original ones, and carries over
+// the last transition time of the conditions, which did not change otherwise.
+func normalizeConditions(original, conditions *duckv1.Conditions) {
+	if original == nil || conditions == nil {
+		return
+	}
+	previous := make(map[apis.ConditionType]apis.Condition, len(*original))
+	for _, cond := range *original {
+		previous[cond.Type] = cond
+	}
+	for i, cond := range *conditions {
+		if prev, ok := previous[cond.Type]; ok {
+			cond.LastTransitionTime = prev.LastTransitionTime
+			if equality.Semantic.DeepEqual(prev, cond) {
+				(*conditions)[i] = cond
+			}
+		}
+	}
+	sortConditions(*original)
+	sortConditions(*conditions)
+}
+
+func sortConditions(conditions duckv1.Conditions) {
+	sort.SliceStable(conditions, func(i, j int) bool { return conditions[i].Type < conditions[j].Type })
+}
+
+// statusMergePatch returns the JSON merge patch of the status from before to after.
+func statusMergePatch(before, after interface{}) ([]byte, error) {
+	beforeJSON, err := json.Marshal(map[string]interface{}{"status": before})
+	if err != nil {
+		return nil, err
+	}
+	afterJSON, err := json.Marshal(map[string]interface{}{"status": after})
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.CreateMergePatch(beforeJSON, afterJSON)
+}
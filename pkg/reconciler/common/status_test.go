@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgotesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	operatorfake "knative.dev/operator/pkg/client/clientset/versioned/fake"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestPatchStatus(t *testing.T) {
+	installed := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	installed.Status.InitializeConditions()
+	installed.Status.MarkInstallSucceeded()
+	installed.Status.MarkDeploymentsAvailable()
+	installed.Status.Version = "1.21.0"
+	earlier := apis.VolatileTime{Inner: metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))}
+	for i := range installed.Status.Conditions {
+		installed.Status.Conditions[i].LastTransitionTime = earlier
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(*v1beta1.KnativeServing)
+		wantPatch string
+	}{{
+		name:   "unchanged",
+		mutate: func(*v1beta1.KnativeServing) {},
+	}, {
+		name: "only the transition time and the order of the conditions changed",
+		mutate: func(ks *v1beta1.KnativeServing) {
+			conditions := ks.Status.Conditions
+			for i := range conditions {
+				conditions[i].LastTransitionTime = apis.VolatileTime{Inner: metav1.Now()}
+			}
+			conditions[0], conditions[len(conditions)-1] = conditions[len(conditions)-1], conditions[0]
+		},
+	}, {
+		name: "changed field",
+		mutate: func(ks *v1beta1.KnativeServing) {
+			ks.Status.Version = "1.22.0"
+		},
+		wantPatch: `{"status":{"version":"1.22.0"}}`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := operatorfake.NewSimpleClientset(installed.DeepCopy())
+			ks := installed.DeepCopy()
+			test.mutate(ks)
+			if err := PatchStatus(context.Background(), client, installed.DeepCopy(), ks); err != nil {
+				t.Fatalf("PatchStatus() = %v", err)
+			}
+
+			var patches []string
+			for _, action := range client.Actions() {
+				if patch, ok := action.(clientgotesting.PatchAction); ok {
+					util.AssertEqual(t, patch.GetSubresource(), "status")
+					util.AssertEqual(t, patch.GetPatchType(), types.MergePatchType)
+					patches = append(patches, string(patch.GetPatch()))
+				} else {
+					t.Errorf("unexpected action %v", action)
+				}
+			}
+			if test.wantPatch == "" {
+				util.AssertEqual(t, len(patches), 0)
+			} else {
+				util.AssertDeepEqual(t, patches, []string{test.wantPatch})
+			}
+		})
+	}
+}
+
+func TestPatchStatusConditionChanged(t *testing.T) {
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	ks.Status.InitializeConditions()
+	client := operatorfake.NewSimpleClientset(ks.DeepCopy())
+	original := ks.DeepCopy()
+	ks.Status.MarkInstallFailed("boom")
+
+	if err := PatchStatus(context.Background(), client, original, ks); err != nil {
+		t.Fatalf("PatchStatus() = %v", err)
+	}
+	got, err := client.OperatorV1beta1().KnativeServings("knative-serving").Get(context.Background(), "knative-serving", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	cond := got.Status.GetCondition(base.InstallSucceeded)
+	if cond == nil || cond.Message != "Install failed with message: boom" {
+		t.Errorf("GetCondition(InstallSucceeded) = %v, want the failure", cond)
+	}
+}
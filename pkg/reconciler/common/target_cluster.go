@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// DefaultKubeconfigKey is the key of the kubeconfig in the secret of a target cluster, if the
+// component does not specify one.
+const DefaultKubeconfigKey = "kubeconfig"
+
+// TargetClusterPollInterval is the interval, in which a component is reconciled again, until its
+// resources in the target cluster are ready.
+const TargetClusterPollInterval = 15 * time.Second
+
+// TargetClusterClients are the clients to install a component into its target cluster.
+type TargetClusterClients struct {
+	Kube     kubernetes.Interface
+	Manifest mf.Client
+}
+
+// TargetClusters builds the clients of the target clusters from the kubeconfig secrets, and caches
+// them until the secrets change.
+type TargetClusters struct {
+	kubeClient kubernetes.Interface
+	// newClients builds the clients for a kubeconfig, it is replaced in the tests.
+	newClients func(*rest.Config) (*TargetClusterClients, error)
+
+	mu      sync.Mutex
+	clients map[string]targetClusterEntry
+	// published are the statuses of the components last written to the ConfigMap of the target
+	// clusters, by their kind, namespace and name.
+	published map[string]publishedTargetClusterStatus
+}
+
+type targetClusterEntry struct {
+	resourceVersion string
+	// server is the URL of the API server of the target cluster.
+	server  string
+	clients *TargetClusterClients
+}
+
+// NewTargetClusters returns TargetClusters reading the kubeconfig secrets with the kubeClient.
+func NewTargetClusters(kubeClient kubernetes.Interface) *TargetClusters {
+	return &TargetClusters{
+		kubeClient: kubeClient,
+		newClients: newTargetClusterClients,
+		clients:    map[string]targetClusterEntry{},
+		published:  map[string]publishedTargetClusterStatus{},
+	}
+}
+
+// Get returns the clients of the target cluster of the component, or nil, if the component is
+// installed into the cluster of the operator.
+func (t *TargetClusters) Get(ctx context.Context, instance base.KComponent) (*TargetClusterClients, error) {
+	target := instance.GetSpec().GetTargetCluster()
+	if t == nil || target == nil {
+		return nil, nil
+	}
+	key := target.Key
+	if key == "" {
+		key = DefaultKubeconfigKey
+	}
+	secret, err := t.kubeClient.CoreV1().Secrets(instance.GetNamespace()).Get(ctx, target.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the kubeconfig secret %s of the target cluster: %w", target.SecretName, err)
+	}
+
+	id := targetClusterKey(instance)
+	t.mu.Lock()
+	entry, ok := t.clients[id]
+	t.mu.Unlock()
+	if ok && entry.resourceVersion == secret.ResourceVersion {
+		return entry.clients, nil
+	}
+
+	kubeconfig, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("the secret %s of the target cluster has no key %q", target.SecretName, key)
+	}
+	cfg, err := restConfigFromKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the kubeconfig of the target cluster in the secret %s: %w", target.SecretName, err)
+	}
+	clients, err := t.newClients(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the clients of the target cluster in the secret %s: %w", target.SecretName, err)
+	}
+	t.mu.Lock()
+	t.clients[id] = targetClusterEntry{resourceVersion: secret.ResourceVersion, server: cfg.Host, clients: clients}
+	t.mu.Unlock()
+	return clients, nil
+}
+
+// targetClusterKey identifies the kubeconfig of the target cluster of the component as
+// <namespace>.<secret>.<key>, which is a valid key of a ConfigMap.
+func targetClusterKey(instance base.KComponent) string {
+	target := instance.GetSpec().GetTargetCluster()
+	key := target.Key
+	if key == "" {
+		key = DefaultKubeconfigKey
+	}
+	return instance.GetNamespace() + "." + target.SecretName + "." + key
+}
+
+// SameTargetCluster returns whether both components are installed into the same cluster.
+func SameTargetCluster(a, b base.KComponent) bool {
+	ta, tb := a.GetSpec().GetTargetCluster(), b.GetSpec().GetTargetCluster()
+	if ta == nil || tb == nil {
+		return ta == nil && tb == nil
+	}
+	keyA, keyB := ta.Key, tb.Key
+	if keyA == "" {
+		keyA = DefaultKubeconfigKey
+	}
+	if keyB == "" {
+		keyB = DefaultKubeconfigKey
+	}
+	return a.GetNamespace() == b.GetNamespace() && ta.SecretName == tb.SecretName && keyA == keyB
+}
+
+// restConfigFromKubeconfig parses the kubeconfig of a target cluster. Its users can only
+// authenticate with a token or a client certificate contained in the kubeconfig: exec plugins and
+// auth providers would run commands and code in the operator, and the paths of files would read the
+// files of the operator pod, e.g. the token of its service account.
+func restConfigFromKubeconfig(kubeconfig []byte) (*rest.Config, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	for name, user := range config.AuthInfos {
+		switch {
+		case user.Exec != nil:
+			return nil, fmt.Errorf("the user %s runs an exec plugin, only a token or a client certificate is supported", name)
+		case user.AuthProvider != nil:
+			return nil, fmt.Errorf("the user %s uses an auth provider, only a token or a client certificate is supported", name)
+		case user.TokenFile != "" || user.ClientCertificate != "" || user.ClientKey != "":
+			return nil, fmt.Errorf("the user %s refers to files, the token or the client certificate must be contained in the kubeconfig", name)
+		case user.Username != "" || user.Password != "":
+			return nil, fmt.Errorf("the user %s uses basic authentication, only a token or a client certificate is supported", name)
+		}
+	}
+	for name, cluster := range config.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return nil, fmt.Errorf("the cluster %s refers to a file, the certificate authority must be contained in the kubeconfig", name)
+		}
+	}
+	return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+func newTargetClusterClients(cfg *rest.Config) (*TargetClusterClients, error) {
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	manifestClient, err := NewManifestClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &TargetClusterClients{Kube: kubeClient, Manifest: manifestClient}, nil
+}
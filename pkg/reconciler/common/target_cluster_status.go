@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"sort"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// TargetClustersConfigMapName is the name of the ConfigMap in the namespace of the operator, which
+// aggregates the status of the components per target cluster. Its keys are
+// <namespace>.<secret>.<key> of the kubeconfig of a target cluster.
+const TargetClustersConfigMapName = "knative-operator-target-clusters"
+
+// TargetClusterComponent is the status of a component in the ConfigMap of the target clusters.
+type TargetClusterComponent struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Server is the URL of the API server of the target cluster.
+	Server  string `json:"server,omitempty"`
+	Version string `json:"version,omitempty"`
+	Ready   bool   `json:"ready"`
+	// Reason and Message are the ones of the Ready condition, while the component is not ready.
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// conditionsGetter is implemented by the statuses of the components, which embed duckv1.Status.
+type conditionsGetter interface {
+	GetCondition(apis.ConditionType) *apis.Condition
+}
+
+// publishedTargetClusterStatus is the status of a component, which was last written to the
+// ConfigMap of the target clusters, empty for a component without target cluster.
+type publishedTargetClusterStatus struct {
+	cluster string
+	entry   TargetClusterComponent
+}
+
+// PublishStatus aggregates the status of the component under its target cluster in the ConfigMap
+// TargetClustersConfigMapName, next to the other components installed into the same cluster, and
+// removes it from the cluster it targeted before. The ConfigMap, which all the reconcilers share, is
+// only read and written, if the status changed since it was published last. Conflicts are retried,
+// other failures are logged, they don't fail the reconciliation, and the next reconciliation
+// publishes the status again.
+func (t *TargetClusters) PublishStatus(ctx context.Context, instance base.KComponent) {
+	if t == nil {
+		return
+	}
+	var entry *TargetClusterComponent
+	status := publishedTargetClusterStatus{}
+	if instance.GetSpec().GetTargetCluster() != nil {
+		entry = t.statusOf(instance)
+		status = publishedTargetClusterStatus{cluster: targetClusterKey(instance), entry: *entry}
+	}
+	id := targetClusterComponentKey(instance)
+	t.mu.Lock()
+	published, ok := t.published[id]
+	t.mu.Unlock()
+	if ok && published == status {
+		return
+	}
+	if err := t.updateStatus(ctx, instance, entry); err != nil {
+		logging.FromContext(ctx).Warnw("Failed to publish the status of the target cluster", zap.Error(err))
+		return
+	}
+	t.mu.Lock()
+	t.published[id] = status
+	t.mu.Unlock()
+}
+
+// ForgetStatus removes the component from the ConfigMap of the target clusters, once it is deleted.
+func (t *TargetClusters) ForgetStatus(ctx context.Context, instance base.KComponent) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	delete(t.published, targetClusterComponentKey(instance))
+	t.mu.Unlock()
+	if err := t.updateStatus(ctx, instance, nil); err != nil {
+		logging.FromContext(ctx).Warnw("Failed to remove the status of the target cluster", zap.Error(err))
+	}
+}
+
+// targetClusterComponentKey identifies the component in the published statuses.
+func targetClusterComponentKey(instance base.KComponent) string {
+	return instance.GroupVersionKind().Kind + "/" + instance.GetNamespace() + "/" + instance.GetName()
+}
+
+// statusOf returns the status of the component in its target cluster.
+func (t *TargetClusters) statusOf(instance base.KComponent) *TargetClusterComponent {
+	status := instance.GetStatus()
+	entry := &TargetClusterComponent{
+		Kind:      instance.GroupVersionKind().Kind,
+		Namespace: instance.GetNamespace(),
+		Name:      instance.GetName(),
+		Version:   status.GetVersion(),
+		Ready:     status.IsReady(),
+	}
+	t.mu.Lock()
+	if cached, ok := t.clients[targetClusterKey(instance)]; ok {
+		entry.Server = cached.server
+	}
+	t.mu.Unlock()
+	if getter, ok := status.(conditionsGetter); ok && !entry.Ready {
+		if ready := getter.GetCondition(apis.ConditionReady); ready != nil {
+			entry.Reason = ready.Reason
+			entry.Message = ready.Message
+		}
+	}
+	return entry
+}
+
+// updateStatus removes the component from all the target clusters of the ConfigMap, and adds the
+// entry under its target cluster, unless it is nil. The ConfigMap is only created for an entry.
+// The update is retried, if another reconciler updated or created the ConfigMap concurrently.
+func (t *TargetClusters) updateStatus(ctx context.Context, instance base.KComponent, entry *TargetClusterComponent) error {
+	configMaps := t.kubeClient.CoreV1().ConfigMaps(system.Namespace())
+	concurrent := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+	return retry.OnError(retry.DefaultRetry, concurrent, func() error {
+		cm, err := configMaps.Get(ctx, TargetClustersConfigMapName, metav1.GetOptions{})
+		exists := !apierrors.IsNotFound(err)
+		if !exists {
+			if entry == nil {
+				return nil
+			}
+			cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: TargetClustersConfigMapName, Namespace: system.Namespace()}}
+		} else if err != nil {
+			return err
+		}
+
+		data := map[string]string{}
+		for cluster, value := range cm.Data {
+			var components []TargetClusterComponent
+			if err := yaml.Unmarshal([]byte(value), &components); err != nil {
+				// Drop the invalid value, it is aggregated again by the next reconciliations.
+				continue
+			}
+			kept := components[:0]
+			for _, component := range components {
+				if component.Kind != instance.GroupVersionKind().Kind || component.Namespace != instance.GetNamespace() || component.Name != instance.GetName() {
+					kept = append(kept, component)
+				}
+			}
+			if cluster == targetClusterKey(instance) && entry != nil {
+				kept = append(kept, *entry)
+			}
+			if err := setTargetClusterComponents(data, cluster, kept); err != nil {
+				return err
+			}
+		}
+		if _, ok := cm.Data[targetClusterKey(instance)]; !ok && entry != nil {
+			if err := setTargetClusterComponents(data, targetClusterKey(instance), []TargetClusterComponent{*entry}); err != nil {
+				return err
+			}
+		}
+
+		if !exists {
+			cm.Data = data
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			return err
+		}
+		if equality.Semantic.DeepEqual(cm.Data, data) || len(cm.Data)+len(data) == 0 {
+			return nil
+		}
+		cm = cm.DeepCopy()
+		cm.Data = data
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// setTargetClusterComponents sets the sorted components of the cluster in the data of the
+// ConfigMap, or removes the cluster without components.
+func setTargetClusterComponents(data map[string]string, cluster string, components []TargetClusterComponent) error {
+	if len(components) == 0 {
+		return nil
+	}
+	sort.Slice(components, func(i, j int) bool {
+		a, b := components[i], components[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	value, err := yaml.Marshal(components)
+	if err != nil {
+		return err
+	}
+	data[cluster] = string(value)
+	return nil
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	clientgotesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/system"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: spoke
+  cluster:
+    server: https://spoke.example.com
+contexts:
+- name: spoke
+  context:
+    cluster: spoke
+    user: spoke
+current-context: spoke
+users:
+- name: spoke
+  user:
+    token: secret
+`
+
+const testExecKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: spoke
+  cluster:
+    server: https://spoke.example.com
+contexts:
+- name: spoke
+  context:
+    cluster: spoke
+    user: spoke
+current-context: spoke
+users:
+- name: spoke
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: sh
+      args: ["-c", "touch /tmp/pwned"]
+`
+
+func TestTargetClusters(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "spoke", Namespace: "knative-serving", ResourceVersion: "1"},
+		Data: map[string][]byte{
+			DefaultKubeconfigKey: []byte(testKubeconfig),
+			"invalid":            []byte("{"),
+			"exec":               []byte(testExecKubeconfig),
+			"auth-provider":      []byte(strings.Replace(testKubeconfig, "token: secret", "auth-provider:\n      name: oidc", 1)),
+			"token-file":         []byte(strings.Replace(testKubeconfig, "token: secret", "tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token", 1)),
+		},
+	}
+	kubeClient := kubefake.NewSimpleClientset(secret)
+	clusters := NewTargetClusters(kubeClient)
+	var hosts []string
+	clusters.newClients = func(cfg *rest.Config) (*TargetClusterClients, error) {
+		hosts = append(hosts, cfg.Host)
+		return &TargetClusterClients{}, nil
+	}
+
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"}}
+	if clients, err := clusters.Get(context.Background(), ks); clients != nil || err != nil {
+		t.Fatalf("Get() without a target cluster = %v, %v, want nil, nil", clients, err)
+	}
+
+	ks.Spec.TargetCluster = &base.TargetCluster{SecretName: "spoke"}
+	for i := 0; i < 2; i++ {
+		if _, err := clusters.Get(context.Background(), ks); err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+	}
+	util.AssertDeepEqual(t, hosts, []string{"https://spoke.example.com"})
+
+	// The clients are built again, once the secret changed.
+	secret.ResourceVersion = "2"
+	if _, err := kubeClient.CoreV1().Secrets("knative-serving").Update(context.Background(), secret, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	if _, err := clusters.Get(context.Background(), ks); err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	util.AssertEqual(t, len(hosts), 2)
+
+	for _, target := range []base.TargetCluster{
+		{SecretName: "missing"},
+		{SecretName: "spoke", Key: "missing"},
+		{SecretName: "spoke", Key: "invalid"},
+		{SecretName: "spoke", Key: "exec"},
+		{SecretName: "spoke", Key: "auth-provider"},
+		{SecretName: "spoke", Key: "token-file"},
+	} {
+		ks.Spec.TargetCluster = &target
+		if _, err := clusters.Get(context.Background(), ks); err == nil {
+			t.Errorf("Get(%+v) = nil, want an error", target)
+		}
+	}
+}
+
+func TestSameTargetCluster(t *testing.T) {
+	component := func(ns string, target *base.TargetCluster) base.KComponent {
+		return &v1beta1.KnativeServing{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns},
+			Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{TargetCluster: target}},
+		}
+	}
+	local := component("knative-serving", nil)
+	spoke := component("knative-serving", &base.TargetCluster{SecretName: "spoke"})
+
+	util.AssertEqual(t, SameTargetCluster(local, component("other", nil)), true)
+	util.AssertEqual(t, SameTargetCluster(local, spoke), false)
+	util.AssertEqual(t, SameTargetCluster(spoke, component("knative-serving", &base.TargetCluster{SecretName: "spoke", Key: DefaultKubeconfigKey})), true)
+	util.AssertEqual(t, SameTargetCluster(spoke, component("other", &base.TargetCluster{SecretName: "spoke"})), false)
+	util.AssertEqual(t, SameTargetCluster(spoke, component("knative-serving", &base.TargetCluster{SecretName: "other"})), false)
+}
+
+func TestTargetClusterStatus(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "spoke", Namespace: "knative-serving", ResourceVersion: "1"},
+		Data:       map[string][]byte{DefaultKubeconfigKey: []byte(testKubeconfig)},
+	}
+	kubeClient := kubefake.NewSimpleClientset(secret)
+	clusters := NewTargetClusters(kubeClient)
+	clusters.newClients = func(*rest.Config) (*TargetClusterClients, error) {
+		return &TargetClusterClients{}, nil
+	}
+	ctx := context.Background()
+	data := func() map[string]string {
+		t.Helper()
+		cm, err := kubeClient.CoreV1().ConfigMaps("knative-operator").Get(ctx, TargetClustersConfigMapName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+		return cm.Data
+	}
+
+	local := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"}}
+	clusters.PublishStatus(ctx, local)
+	util.AssertDeepEqual(t, data(), map[string]string(nil))
+
+	target := &base.TargetCluster{SecretName: "spoke"}
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "spoke", Namespace: "knative-serving"},
+		Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{TargetCluster: target}},
+	}
+	ks.Status.InitializeConditions()
+	ks.Status.MarkInstallFailed("boom")
+	ks.Status.SetVersion("1.21.0")
+	ke := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Name: "spoke", Namespace: "knative-serving"},
+		Spec:       v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{TargetCluster: target}},
+	}
+	ke.Status.InitializeConditions()
+	ke.Status.MarkInstallSucceeded()
+	ke.Status.MarkDeploymentsAvailable()
+	ke.Status.MarkDependenciesInstalled()
+	ke.Status.MarkVersionMigrationEligible()
+	ke.Status.SetVersion("1.21.0")
+	if _, err := clusters.Get(ctx, ks); err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	clusters.PublishStatus(ctx, ks)
+	clusters.PublishStatus(ctx, ke)
+	util.AssertDeepEqual(t, data(), map[string]string{
+		"knative-serving.spoke.kubeconfig": `- kind: KnativeEventing
+  name: spoke
+  namespace: knative-serving
+  ready: true
+  server: https://spoke.example.com
+  version: 1.21.0
+- kind: KnativeServing
+  message: 'Install failed with message: boom'
+  name: spoke
+  namespace: knative-serving
+  ready: false
+  reason: Error
+  server: https://spoke.example.com
+  version: 1.21.0
+`,
+	})
+
+	// The component moves to another target cluster.
+	ks.Spec.TargetCluster = &base.TargetCluster{SecretName: "other"}
+	clusters.PublishStatus(ctx, ks)
+	clusters.ForgetStatus(ctx, ke)
+	util.AssertDeepEqual(t, data(), map[string]string{
+		"knative-serving.other.kubeconfig": `- kind: KnativeServing
+  message: 'Install failed with message: boom'
+  name: spoke
+  namespace: knative-serving
+  ready: false
+  reason: Error
+  version: 1.21.0
+`,
+	})
+}
+
+func TestTargetClusterStatusWrites(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	kubeClient := kubefake.NewSimpleClientset()
+	clusters := NewTargetClusters(kubeClient)
+	ctx := context.Background()
+	conflicts := 0
+	kubeClient.PrependReactor("update", "configmaps", func(clientgotesting.Action) (bool, runtime.Object, error) {
+		if conflicts == 0 {
+			return false, nil, nil
+		}
+		conflicts--
+		return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, TargetClustersConfigMapName, nil)
+	})
+	writes := func() int {
+		n := len(kubeClient.Actions())
+		kubeClient.ClearActions()
+		return n
+	}
+
+	local := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"}}
+	clusters.PublishStatus(ctx, local)
+	util.AssertEqual(t, writes(), 1)
+	// The status of a component without target cluster is not published again.
+	clusters.PublishStatus(ctx, local)
+	util.AssertEqual(t, writes(), 0)
+
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "spoke", Namespace: "knative-serving"},
+		Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{TargetCluster: &base.TargetCluster{SecretName: "spoke"}}},
+	}
+	ks.Status.InitializeConditions()
+	clusters.PublishStatus(ctx, ks)
+	util.AssertEqual(t, writes(), 2)
+	// The unchanged status is not published again.
+	clusters.PublishStatus(ctx, ks)
+	util.AssertEqual(t, writes(), 0)
+
+	// A conflicting update is retried.
+	conflicts = 1
+	ks.Status.SetVersion("1.21.0")
+	clusters.PublishStatus(ctx, ks)
+	cm, err := kubeClient.CoreV1().ConfigMaps("knative-operator").Get(ctx, TargetClustersConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	if !strings.Contains(cm.Data["knative-serving.spoke.kubeconfig"], "version: 1.21.0") {
+		t.Errorf("The status was not published after the conflict: %v", cm.Data)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// MakeCronJob returns a CronJob running the pod spec every minute.
+func MakeCronJob(name string, podSpec corev1.PodSpec) *batchv1.CronJob {
+	return &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "CronJob",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "* * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: podSpec,
+					},
+				},
+			},
+		},
+	}
+}
+
+// MakeService returns a Service selecting the pods with the labels of the selector.
+func MakeService(name string, selector map[string]string, ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: selector,
+			Ports:    ports,
+		},
+	}
+}
+
+// MakeConfigMap returns a ConfigMap with the data.
+func MakeConfigMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Data: data,
+	}
+}
+
+// MakeHPA returns a HorizontalPodAutoscaler scaling the deployment of the same name.
+func MakeHPA(name string, minReplicas, maxReplicas int32) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "autoscaling/v2",
+			Kind:       "HorizontalPodAutoscaler",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: maxReplicas,
+		},
+	}
+}
+
+// MakePDB returns a PodDisruptionBudget of the pods with the labels of the selector.
+func MakePDB(name string, minAvailable intstr.IntOrString, selector map[string]string) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "policy/v1",
+			Kind:       "PodDisruptionBudget",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: selector},
+		},
+	}
+}
+
+// ManifestBuilder builds the manifest of a test from typed objects and YAML documents, e.g.
+//
+//	manifest := NewManifestBuilder(t).
+//		Add(MakeDeployment("activator", podSpec), MakeHPA("activator", 1, 20)).
+//		AddYAML(crd).
+//		InNamespace("knative-serving").
+//		Build()
+type ManifestBuilder struct {
+	t         *testing.T
+	resources []unstructured.Unstructured
+	namespace string
+}
+
+// NewManifestBuilder returns a builder of an empty manifest, which fails the test on invalid objects.
+func NewManifestBuilder(t *testing.T) *ManifestBuilder {
+	return &ManifestBuilder{t: t}
+}
+
+// Add appends the typed objects, e.g. those of the Make functions, to the manifest.
+func (b *ManifestBuilder) Add(objs ...interface{}) *ManifestBuilder {
+	b.t.Helper()
+	for _, obj := range objs {
+		b.resources = append(b.resources, MakeUnstructured(b.t, obj))
+	}
+	return b
+}
+
+// AddUnstructured appends the resources to the manifest as they are.
+func (b *ManifestBuilder) AddUnstructured(resources ...unstructured.Unstructured) *ManifestBuilder {
+	b.resources = append(b.resources, resources...)
+	return b
+}
+
+// AddYAML appends the resources of the multi-document YAML to the manifest.
+func (b *ManifestBuilder) AddYAML(yaml string) *ManifestBuilder {
+	b.t.Helper()
+	manifest, err := mf.ManifestFrom(mf.Reader(strings.NewReader(yaml)))
+	if err != nil {
+		b.t.Fatalf("Could not parse the manifest: %v", err)
+	}
+	b.resources = append(b.resources, manifest.Resources()...)
+	return b
+}
+
+// InNamespace moves the namespaced resources into the namespace, when the manifest is built, the
+// way mf.InjectNamespace does for the manifests of the releases.
+func (b *ManifestBuilder) InNamespace(namespace string) *ManifestBuilder {
+	b.namespace = namespace
+	return b
+}
+
+// Build returns the manifest of the resources in the order they were added.
+func (b *ManifestBuilder) Build() mf.Manifest {
+	b.t.Helper()
+	manifest, err := mf.ManifestFrom(mf.Slice(b.resources))
+	if err != nil {
+		b.t.Fatalf("Could not create the manifest: %v", err)
+	}
+	if b.namespace == "" {
+		return manifest
+	}
+	manifest, err = manifest.Transform(mf.InjectNamespace(b.namespace))
+	if err != nil {
+		b.t.Fatalf("Could not move the manifest into the namespace %s: %v", b.namespace, err)
+	}
+	return manifest
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const crd = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: images.caching.internal.knative.dev
+`
+
+func TestManifestBuilder(t *testing.T) {
+	labels := map[string]string{"app": "activator"}
+	manifest := NewManifestBuilder(t).
+		Add(MakeDeployment("activator", corev1.PodSpec{}),
+			MakeCronJob("cleanup", corev1.PodSpec{}),
+			MakeService("activator-service", labels, corev1.ServicePort{Name: "http", Port: 80}),
+			MakeConfigMap("config-autoscaler", map[string]string{"enable-scale-to-zero": "false"}),
+			MakeHPA("activator", 1, 20),
+			MakePDB("activator-pdb", intstr.FromString("80%"), labels)).
+		AddYAML(crd).
+		InNamespace("knative-serving").
+		Build()
+
+	resources := manifest.Resources()
+	AssertEqual(t, len(resources), 7)
+	for _, u := range resources[:6] {
+		AssertEqual(t, u.GetNamespace(), "knative-serving")
+	}
+	AssertEqual(t, resources[0].GetKind(), "Deployment")
+	AssertEqual(t, resources[5].GetAPIVersion(), "policy/v1")
+	AssertEqual(t, resources[6].GetKind(), "CustomResourceDefinition")
+	AssertEqual(t, resources[6].GetNamespace(), "")
+}
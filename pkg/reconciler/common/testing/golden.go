@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// UpdateGoldenEnvKey is the environment variable, which makes AssertGolden rewrite the golden files
+// with the actual output instead of comparing against them, e.g.
+// UPDATE_GOLDEN=true go test ./cmd/operator/
+const UpdateGoldenEnvKey = "UPDATE_GOLDEN"
+
+// AssertGolden compares the actual output against the content of the golden file. In the update mode,
+// the golden file is written instead, so that the change of the output shows up in the diff of the
+// golden file.
+func AssertGolden(t *testing.T, goldenPath string, actual []byte) {
+	t.Helper()
+	if update, _ := strconv.ParseBool(os.Getenv(UpdateGoldenEnvKey)); update {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), 0o755); err != nil {
+			t.Fatalf("Failed to create the directory of the golden file %s: %v", goldenPath, err)
+		}
+		if err := os.WriteFile(goldenPath, actual, 0o644); err != nil {
+			t.Fatalf("Failed to update the golden file %s: %v", goldenPath, err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("Failed to read the golden file %s, run the test with %s=true to create it: %v", goldenPath, UpdateGoldenEnvKey, err)
+	}
+	if diff := cmp.Diff(string(expected), string(actual)); diff != "" {
+		t.Fatalf("The output does not match the golden file %s, run the test with %s=true to update it (-golden, +actual): %s",
+			goldenPath, UpdateGoldenEnvKey, diff)
+	}
+}
@@ -14,6 +14,8 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
+// Package testing has the builders and assertions of the tests of the reconcilers. Extensions of the
+// operator use them to build the manifests of their own tests.
 package testing
 
 import (
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// TransformerPhase is the position of the transformers of the plugins in the pipeline.
+type TransformerPhase string
+
+const (
+	// TransformerPhaseBeforeOverrides runs the transformers after the namespace is injected and
+	// before the ones applying the spec, e.g. the registry, the config and the workload overrides,
+	// so that the spec of the component takes precedence over the plugins.
+	TransformerPhaseBeforeOverrides TransformerPhase = "BeforeOverrides"
+	// TransformerPhaseAfterOverrides runs the transformers after all the transformers of the
+	// operator, so that the plugins take precedence over the spec of the component.
+	TransformerPhaseAfterOverrides TransformerPhase = "AfterOverrides"
+)
+
+// TransformerPlugin contributes a transformer to the pipeline of all the components.
+type TransformerPlugin interface {
+	// Name identifies the plugin in spec.transformerOrder, it is unique among the plugins.
+	Name() string
+	// Phase is the position of the transformer in the pipeline.
+	Phase() TransformerPhase
+	// Transformer returns the transformer for the component, nil to leave it as it is.
+	Transformer(base.KComponent) mf.Transformer
+}
+
+type transformerRegistry struct {
+	mu      sync.RWMutex
+	plugins map[string]TransformerPlugin
+}
+
+var transformerPlugins = &transformerRegistry{plugins: map[string]TransformerPlugin{}}
+
+// RegisterTransformer registers the plugin for the transformers of all the components. It is meant
+// to be called before the controllers are started, e.g. in an init function.
+func RegisterTransformer(plugin TransformerPlugin) error {
+	return transformerPlugins.register(plugin)
+}
+
+func (r *transformerRegistry) register(plugin TransformerPlugin) error {
+	name := plugin.Name()
+	if name == "" {
+		return fmt.Errorf("the transformer plugin needs a name")
+	}
+	if phase := plugin.Phase(); phase != TransformerPhaseBeforeOverrides && phase != TransformerPhaseAfterOverrides {
+		return fmt.Errorf("the transformer plugin %s has the unknown phase %q", name, phase)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.plugins[name]; ok {
+		return fmt.Errorf("the transformer plugin %s is already registered", name)
+	}
+	r.plugins[name] = plugin
+	return nil
+}
+
+func (r *transformerRegistry) unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.plugins, name)
+}
+
+// ordered returns the plugins of the phase in the order of spec.transformerOrder, followed by the
+// others by name. The unordered plugins must not change the same fields.
+func (r *transformerRegistry) ordered(phase TransformerPhase, order []string) (ordered, unordered []TransformerPlugin, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	listed := make(map[string]bool, len(order))
+	for _, name := range order {
+		plugin, ok := r.plugins[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("spec.transformerOrder: the transformer plugin %s is not registered", name)
+		}
+		if listed[name] {
+			return nil, nil, fmt.Errorf("spec.transformerOrder: the transformer plugin %s is listed twice", name)
+		}
+		listed[name] = true
+		if plugin.Phase() == phase {
+			ordered = append(ordered, plugin)
+		}
+	}
+	for name, plugin := range r.plugins {
+		if !listed[name] && plugin.Phase() == phase {
+			unordered = append(unordered, plugin)
+		}
+	}
+	sort.Slice(unordered, func(i, j int) bool { return unordered[i].Name() < unordered[j].Name() })
+	return ordered, unordered, nil
+}
+
+// PluginTransform returns the transformer running the plugins of the phase. Since the outcome of
+// the plugins, which spec.transformerOrder does not order, would depend on their names, it fails
+// when two of them change the same field of a resource.
+func PluginTransform(instance base.KComponent, phase TransformerPhase) mf.Transformer {
+	ordered, unordered, err := transformerPlugins.ordered(phase, instance.GetSpec().GetTransformerOrder())
+	if err != nil {
+		return func(*unstructured.Unstructured) error { return err }
+	}
+	type named struct {
+		name        string
+		transformer mf.Transformer
+		checked     bool
+	}
+	var transformers []named
+	for _, plugin := range ordered {
+		if t := plugin.Transformer(instance); t != nil {
+			transformers = append(transformers, named{name: plugin.Name(), transformer: t})
+		}
+	}
+	checked := 0
+	for _, plugin := range unordered {
+		if t := plugin.Transformer(instance); t != nil {
+			transformers = append(transformers, named{name: plugin.Name(), transformer: t, checked: true})
+			checked++
+		}
+	}
+	if len(transformers) == 0 {
+		return nil
+	}
+	return func(u *unstructured.Unstructured) error {
+		// The fields changed by the unordered plugins are only tracked, if they could conflict.
+		owners := map[string]string{}
+		for _, t := range transformers {
+			if !t.checked || checked < 2 {
+				if err := t.transformer(u); err != nil {
+					return fmt.Errorf("transformer plugin %s: %w", t.name, err)
+				}
+				continue
+			}
+			before := leafFields(u.Object)
+			if err := t.transformer(u); err != nil {
+				return fmt.Errorf("transformer plugin %s: %w", t.name, err)
+			}
+			for _, field := range changedFields(before, leafFields(u.Object)) {
+				if owner, ok := owners[field]; ok {
+					return fmt.Errorf("the transformer plugins %s and %s both change %s of %s %s/%s, order them in spec.transformerOrder",
+						owner, t.name, field, u.GetKind(), u.GetNamespace(), u.GetName())
+				}
+				owners[field] = t.name
+			}
+		}
+		return nil
+	}
+}
+
+// leafFields flattens the object into its fields by their path, e.g. spec.replicas.
+func leafFields(obj map[string]interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	var walk func(prefix string, value interface{})
+	walk = func(prefix string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				fields[prefix] = v
+			}
+			for key, child := range v {
+				walk(strings.TrimPrefix(prefix+"."+key, "."), child)
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				fields[prefix] = v
+			}
+			for i, child := range v {
+				walk(fmt.Sprintf("%s[%d]", prefix, i), child)
+			}
+		default:
+			fields[prefix] = v
+		}
+	}
+	walk("", obj)
+	return fields
+}
+
+// changedFields returns the paths of the fields, which were added, removed or changed.
+func changedFields(before, after map[string]interface{}) []string {
+	var changed []string
+	for path, value := range after {
+		if old, ok := before[path]; !ok || !reflect.DeepEqual(old, value) {
+			changed = append(changed, path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+// labelPlugin sets the label app to its value.
+type labelPlugin struct {
+	name, value string
+	phase       TransformerPhase
+}
+
+func (p labelPlugin) Name() string { return p.name }
+
+func (p labelPlugin) Phase() TransformerPhase { return p.phase }
+
+func (p labelPlugin) Transformer(base.KComponent) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		labels := u.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels["app"] = p.value
+		u.SetLabels(labels)
+		return nil
+	}
+}
+
+func TestRegisterTransformer(t *testing.T) {
+	plugin := labelPlugin{name: "test", phase: TransformerPhaseAfterOverrides}
+	if err := RegisterTransformer(plugin); err != nil {
+		t.Fatalf("RegisterTransformer() = %v", err)
+	}
+	defer transformerPlugins.unregister(plugin.name)
+
+	if err := RegisterTransformer(plugin); err == nil {
+		t.Error("RegisterTransformer() = nil, want an error for the duplicate name")
+	}
+	if err := RegisterTransformer(labelPlugin{name: "other", phase: "Somewhere"}); err == nil {
+		t.Error("RegisterTransformer() = nil, want an error for the unknown phase")
+	}
+	if err := RegisterTransformer(labelPlugin{phase: TransformerPhaseAfterOverrides}); err == nil {
+		t.Error("RegisterTransformer() = nil, want an error for the missing name")
+	}
+}
+
+func TestPluginTransform(t *testing.T) {
+	plugins := []labelPlugin{
+		{name: "a", value: "a", phase: TransformerPhaseAfterOverrides},
+		{name: "b", value: "b", phase: TransformerPhaseAfterOverrides},
+		{name: "c", value: "c", phase: TransformerPhaseBeforeOverrides},
+	}
+	for _, plugin := range plugins {
+		if err := RegisterTransformer(plugin); err != nil {
+			t.Fatalf("RegisterTransformer() = %v", err)
+		}
+		defer transformerPlugins.unregister(plugin.name)
+	}
+
+	tests := []struct {
+		name      string
+		order     []string
+		wantLabel string
+		wantErr   string
+	}{{
+		name:    "conflict",
+		wantErr: "the transformer plugins a and b both change metadata.labels.app of Deployment knative-serving/controller",
+	}, {
+		name:      "ordered",
+		order:     []string{"b", "a"},
+		wantLabel: "a",
+	}, {
+		// The listed plugin runs before the unlisted one, which is the only one to check.
+		name:      "partially ordered",
+		order:     []string{"b"},
+		wantLabel: "a",
+	}, {
+		name:    "unknown plugin",
+		order:   []string{"d"},
+		wantErr: "spec.transformerOrder: the transformer plugin d is not registered",
+	}, {
+		name:    "duplicate plugin",
+		order:   []string{"a", "a"},
+		wantErr: "spec.transformerOrder: the transformer plugin a is listed twice",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+				Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{TransformerOrder: test.order}},
+			}
+			manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+				util.MakeUnstructured(t, &appsv1.Deployment{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+					ObjectMeta: metav1.ObjectMeta{Name: "controller"},
+				}),
+			}))
+			if err != nil {
+				t.Fatalf("ManifestFrom() = %v", err)
+			}
+			err = Transform(context.Background(), &manifest, ks)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("Transform() = %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transform() = %v", err)
+			}
+			util.AssertEqual(t, manifest.Resources()[0].GetLabels()["app"], test.wantLabel)
+		})
+	}
+}
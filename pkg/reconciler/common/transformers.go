@@ -30,22 +30,36 @@ func transformers(ctx context.Context, obj base.KComponent) []mf.Transformer {
 	return []mf.Transformer{
 		injectOwner(obj),
 		mf.InjectNamespace(obj.GetNamespace()),
+		OperandNamespaceTransform(obj),
 		NamespaceConfigurationTransform(obj.GetSpec().GetNamespaceConfiguration()),
+		PluginTransform(obj, TransformerPhaseBeforeOverrides),
 		HighAvailabilityTransform(obj),
+		ResilienceTransform(obj),
 		ImageTransform(obj.GetSpec().GetRegistry(), logger),
 		JobTransform(obj),
+		FeaturesTransform(obj.GetSpec().GetFeatures(), logger),
 		ConfigMapTransform(obj.GetSpec().GetConfig(), logger),
 		KubernetesMinVersionTransform(),
 		ResourceRequirementsTransform(obj, logger),
 		OverridesTransform(obj.GetSpec().GetWorkloadOverrides(), logger),
+		ArchitecturesTransform(obj),
+		LinuxNodesTransform(obj),
+		SpotTransform(obj),
 		ServicesTransform(obj, logger),
+		IPFamiliesTransform(obj),
+		ServiceAccountsTransform(obj),
 		PodDisruptionBudgetsTransform(obj, logger),
+		PodSecurityTransform(obj),
+		PluginTransform(obj, TransformerPhaseAfterOverrides),
 	}
 }
 
-func injectOwner(owner mf.Owner) mf.Transformer {
+func injectOwner(owner base.KComponent) mf.Transformer {
+	// Owner references across clusters are not valid, the operator deletes the resources in a
+	// target cluster itself, when the component is finalized.
+	remote := owner.GetSpec().GetTargetCluster() != nil
 	return func(u *unstructured.Unstructured) error {
-		if u.GetNamespace() != "" {
+		if u.GetNamespace() != "" && !remote {
 			u.SetOwnerReferences([]v1.OwnerReference{*v1.NewControllerRef(owner, owner.GroupVersionKind())})
 		}
 		return nil
@@ -24,6 +24,7 @@ import (
 	mf "github.com/manifestival/manifestival"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	"knative.dev/pkg/ptr"
 )
@@ -82,6 +83,31 @@ func TestCommonTransformers(t *testing.T) {
 	}
 }
 
+func TestCommonTransformersTargetCluster(t *testing.T) {
+	component := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      "test-name",
+		},
+		Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec: base.CommonSpec{TargetCluster: &base.TargetCluster{SecretName: "spoke"}},
+		},
+	}
+	in := []unstructured.Unstructured{*NamespacedResource("test/v1", "TestCR", "another-ns", "test-resource")}
+	manifest, err := mf.ManifestFrom(mf.Slice(in))
+	if err != nil {
+		t.Fatalf("Failed to generate manifest: %v", err)
+	}
+	if err := Transform(context.Background(), &manifest, component); err != nil {
+		t.Fatalf("Failed to transform manifest: %v", err)
+	}
+
+	// Owner references cannot refer to a resource in another cluster.
+	if got := manifest.Resources()[0].GetOwnerReferences(); len(got) != 0 {
+		t.Fatalf("GetOwnerReferences() = %v, want none", got)
+	}
+}
+
 func TestInjectNamespace(t *testing.T) {
 	component := &v1beta1.KnativeEventing{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// allUnmanagedKeys is the value of the annotation UnmanagedKeysAnnotation, which excludes all the keys
+// of a ConfigMap.
+const allUnmanagedKeys = "*"
+
+// UnmanagedConfigMaps keeps the live values of the keys of the ConfigMaps in the manifest, which are
+// listed in their annotation operator.knative.dev/unmanaged-keys, instead of reverting them to the
+// values of the manifest, e.g. for a hotfix. The exclusions are listed in the
+// UnmanagedConfigMapsWarning condition, so that they are not forgotten.
+func UnmanagedConfigMaps(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	status := instance.GetStatus()
+	live := map[types.NamespacedName]*unstructured.Unstructured{}
+	var exclusions []string
+	for _, u := range manifest.Filter(mf.ByKind("ConfigMap")).Resources() {
+		current, err := manifest.Client.Get(&u)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get the ConfigMap %s/%s: %w", u.GetNamespace(), u.GetName(), err)
+		}
+		keys := unmanagedKeys(current)
+		if len(keys) == 0 {
+			continue
+		}
+		live[types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}] = current
+		if keys[0] == allUnmanagedKeys {
+			exclusions = append(exclusions, fmt.Sprintf("%s/%s (all keys)", u.GetNamespace(), u.GetName()))
+		} else {
+			exclusions = append(exclusions, fmt.Sprintf("%s/%s (keys %s)", u.GetNamespace(), u.GetName(), strings.Join(keys, ", ")))
+		}
+	}
+	if len(exclusions) == 0 {
+		status.ClearUnmanagedConfigMapsWarning()
+		return nil
+	}
+
+	transformed, err := manifest.Transform(func(u *unstructured.Unstructured) error {
+		current, ok := live[types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}]
+		if !ok || u.GetKind() != "ConfigMap" {
+			return nil
+		}
+		return keepUnmanagedKeys(u, current)
+	})
+	if err != nil {
+		return err
+	}
+	*manifest = transformed
+	sort.Strings(exclusions)
+	status.MarkUnmanagedConfigMapsWarning(exclusions)
+	logging.FromContext(ctx).Warnw("ConfigMaps excluded from the reconciliation", "exclusions", exclusions)
+	return nil
+}
+
+// unmanagedKeys returns the sorted keys listed in the annotation of the ConfigMap, or only "*" if all
+// the keys are excluded.
+func unmanagedKeys(cm *unstructured.Unstructured) []string {
+	var keys []string
+	for _, key := range strings.Split(cm.GetAnnotations()[base.UnmanagedKeysAnnotation], ",") {
+		key = strings.TrimSpace(key)
+		if key == allUnmanagedKeys {
+			return []string{allUnmanagedKeys}
+		}
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// keepUnmanagedKeys copies the values of the unmanaged keys from the live ConfigMap to the one of the
+// manifest, as well as the annotation, so that applying the manifest changes neither.
+func keepUnmanagedKeys(u, current *unstructured.Unstructured) error {
+	keys := unmanagedKeys(current)
+	annotations := u.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[base.UnmanagedKeysAnnotation] = current.GetAnnotations()[base.UnmanagedKeysAnnotation]
+	u.SetAnnotations(annotations)
+
+	for _, field := range []string{"data", "binaryData"} {
+		desired, _, err := unstructured.NestedStringMap(u.Object, field)
+		if err != nil {
+			return err
+		}
+		actual, _, err := unstructured.NestedStringMap(current.Object, field)
+		if err != nil {
+			return err
+		}
+		if keys[0] == allUnmanagedKeys {
+			desired = actual
+		} else {
+			if desired == nil {
+				desired = map[string]string{}
+			}
+			for _, key := range keys {
+				if value, ok := actual[key]; ok {
+					desired[key] = value
+				} else {
+					delete(desired, key)
+				}
+			}
+		}
+		if len(desired) == 0 {
+			unstructured.RemoveNestedField(u.Object, field)
+			continue
+		}
+		if err := unstructured.SetNestedStringMap(u.Object, desired, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mf "github.com/manifestival/manifestival"
+	fake "github.com/manifestival/manifestival/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestUnmanagedConfigMaps(t *testing.T) {
+	liveConfigMap := func(annotation string, data map[string]string) *corev1.ConfigMap {
+		cm := util.MakeConfigMap("config-autoscaler", data)
+		cm.Namespace = "knative-serving"
+		if annotation != "" {
+			cm.Annotations = map[string]string{base.UnmanagedKeysAnnotation: annotation}
+		}
+		return cm
+	}
+
+	tests := []struct {
+		name    string
+		live    *corev1.ConfigMap
+		want    map[string]string
+		message string
+	}{{
+		name: "not installed",
+		want: map[string]string{"a": "1", "b": "2", "c": "3"},
+	}, {
+		name: "not annotated",
+		live: liveConfigMap("", map[string]string{"a": "hotfix"}),
+		want: map[string]string{"a": "1", "b": "2", "c": "3"},
+	}, {
+		name:    "some keys",
+		live:    liveConfigMap(" b, a ,", map[string]string{"a": "hotfix", "c": "edited", "d": "4"}),
+		want:    map[string]string{"a": "hotfix", "c": "3"},
+		message: "ConfigMaps excluded from the reconciliation: knative-serving/config-autoscaler (keys a, b)",
+	}, {
+		name:    "all keys",
+		live:    liveConfigMap("*", map[string]string{"a": "hotfix", "d": "4"}),
+		want:    map[string]string{"a": "hotfix", "d": "4"},
+		message: "ConfigMaps excluded from the reconciliation: knative-serving/config-autoscaler (all keys)",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var existing []runtime.Object
+			if test.live != nil {
+				existing = append(existing, test.live)
+			}
+			manifest := util.NewManifestBuilder(t).
+				Add(util.MakeConfigMap("config-autoscaler", map[string]string{"a": "1", "b": "2", "c": "3"})).
+				InNamespace("knative-serving").
+				Build()
+			manifest, err := mf.ManifestFrom(mf.Slice(manifest.Resources()), mf.UseClient(fake.New(existing...)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ks := &v1beta1.KnativeServing{}
+			ks.Status.MarkUnmanagedConfigMapsWarning([]string{"outdated"})
+
+			if err := UnmanagedConfigMaps(context.Background(), &manifest, ks); err != nil {
+				t.Fatalf("UnmanagedConfigMaps() = %v", err)
+			}
+			u := manifest.Resources()[0]
+			got, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Data (-want, +got): %s", diff)
+			}
+			c := ks.Status.GetCondition(base.UnmanagedConfigMapsWarning)
+			if test.message == "" {
+				if c != nil {
+					t.Errorf("Condition = %v, want none", c)
+				}
+				return
+			}
+			if c == nil || c.Status != corev1.ConditionTrue || c.Message != test.message {
+				t.Errorf("Condition = %v, want message %q", c, test.message)
+			}
+			if got, want := u.GetAnnotations()[base.UnmanagedKeysAnnotation], test.live.Annotations[base.UnmanagedKeysAnnotation]; got != want {
+				t.Errorf("Annotation = %q, want %q", got, want)
+			}
+		})
+	}
+}
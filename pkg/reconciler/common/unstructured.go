@@ -17,9 +17,21 @@ limitations under the License.
 package common
 
 import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// podSpecPaths are the paths of the pod specs of the workloads, by kind.
+var podSpecPaths = map[string][]string{
+	"Deployment":  {"spec", "template", "spec"},
+	"StatefulSet": {"spec", "template", "spec"},
+	"DaemonSet":   {"spec", "template", "spec"},
+	"Job":         {"spec", "template", "spec"},
+}
+
 // NamespacedResource is an unstructured resource with the given apiVersion, kind, ns and name.
 func NamespacedResource(apiVersion, kind, ns, name string) *unstructured.Unstructured {
 	resource := &unstructured.Unstructured{}
@@ -34,3 +46,78 @@ func NamespacedResource(apiVersion, kind, ns, name string) *unstructured.Unstruc
 func ClusterScopedResource(apiVersion, kind, name string) *unstructured.Unstructured {
 	return NamespacedResource(apiVersion, kind, "", name)
 }
+
+// envToUnstructured converts the env vars to their unstructured representation, so that they can
+// be merged into unstructured workloads without a round trip through the typed workloads.
+func envToUnstructured(env []corev1.EnvVar) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(env))
+	for i := range env {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&env[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the env var %s: %w", env[i].Name, err)
+		}
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+// mergePodSpecEnv merges the unstructured env vars into all the containers and init containers of
+// the workload, the same way as mergeEnv. Resources, which are not workloads, are not changed.
+func mergePodSpecEnv(u *unstructured.Unstructured, env []interface{}) error {
+	path, ok := podSpecPaths[u.GetKind()]
+	if !ok {
+		return nil
+	}
+	for _, field := range []string{"containers", "initContainers"} {
+		fields := append(append([]string{}, path...), field)
+		containers, found, err := unstructured.NestedSlice(u.Object, fields...)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		for i := range containers {
+			container, ok := containers[i].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s of %s %s is not an object", field, u.GetKind(), u.GetName())
+			}
+			current, _, err := unstructured.NestedSlice(container, "env")
+			if err != nil {
+				return err
+			}
+			container["env"] = mergeUnstructuredEnv(env, current)
+		}
+		if err := unstructured.SetNestedSlice(u.Object, containers, fields...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeUnstructuredEnv replaces the env vars in tgt with the ones of the same name in src, and
+// appends the other ones of src.
+func mergeUnstructuredEnv(src, tgt []interface{}) []interface{} {
+	if len(tgt) == 0 {
+		return runtime.DeepCopyJSONValue(src).([]interface{})
+	}
+	for _, srcV := range src {
+		exists := false
+		for i, tgtV := range tgt {
+			if envName(srcV) == envName(tgtV) {
+				tgt[i] = runtime.DeepCopyJSONValue(srcV)
+				exists = true
+			}
+		}
+		if !exists {
+			tgt = append(tgt, runtime.DeepCopyJSONValue(srcV))
+		}
+	}
+	return tgt
+}
+
+func envName(env interface{}) string {
+	m, _ := env.(map[string]interface{})
+	name, _ := m["name"].(string)
+	return name
+}
@@ -19,8 +19,17 @@ package common
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
 )
 
 func TestNamespacedResource(t *testing.T) {
@@ -47,3 +56,113 @@ func TestClusterScopedResource(t *testing.T) {
 		t.Errorf("Got = %v, want %v", got, want)
 	}
 }
+
+func TestMergePodSpecEnv(t *testing.T) {
+	env := []corev1.EnvVar{
+		{Name: "A", Value: "new"},
+		{Name: "B", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+	}
+	podSpec := corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Name: "duplicates",
+			Env:  []corev1.EnvVar{{Name: "A", Value: "old"}, {Name: "C", Value: "1"}, {Name: "A", Value: "older"}},
+		}, {
+			Name: "empty",
+		}, {
+			Name: "value-from",
+			Env:  []corev1.EnvVar{{Name: "B", Value: "plain"}},
+		}},
+		InitContainers: []corev1.Container{{
+			Name: "init",
+			Env:  []corev1.EnvVar{{Name: "D", Value: "1"}},
+		}},
+	}
+	noInitContainers := corev1.PodSpec{Containers: []corev1.Container{{Name: "only"}}}
+
+	tests := []struct {
+		name string
+		obj  runtime.Object
+	}{{
+		name: "Deployment",
+		obj:  util.MakeDeployment("test", podSpec),
+	}, {
+		name: "StatefulSet",
+		obj:  util.MakeStatefulSet("test", podSpec),
+	}, {
+		name: "DaemonSet",
+		obj:  util.MakeDaemonSet("test", podSpec),
+	}, {
+		name: "Job",
+		obj:  util.MakeJob("test", podSpec),
+	}, {
+		name: "no init containers",
+		obj:  util.MakeDeployment("test", noInitContainers),
+	}, {
+		name: "not a workload",
+		obj:  &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}, Data: map[string]string{"A": "1"}},
+	}}
+
+	uenv, err := envToUnstructured(env)
+	if err != nil {
+		t.Fatalf("envToUnstructured() = %v", err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u := util.MakeUnstructured(t, test.obj)
+			want := u.DeepCopy()
+			if err := typedMergePodSpecEnv(want, env); err != nil {
+				t.Fatalf("typedMergePodSpecEnv() = %v", err)
+			}
+			if err := mergePodSpecEnv(&u, uenv); err != nil {
+				t.Fatalf("mergePodSpecEnv() = %v", err)
+			}
+
+			// Both implementations must result in the same typed resource.
+			got, wantTyped := test.obj.DeepCopyObject(), test.obj.DeepCopyObject()
+			if err := scheme.Scheme.Convert(&u, got, nil); err != nil {
+				t.Fatalf("Failed to convert: %v", err)
+			}
+			if err := scheme.Scheme.Convert(want, wantTyped, nil); err != nil {
+				t.Fatalf("Failed to convert: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(got, wantTyped) {
+				t.Errorf("Unexpected result (-want, +got): %s", cmp.Diff(wantTyped, got))
+			}
+		})
+	}
+}
+
+// typedMergePodSpecEnv is the reference implementation of mergePodSpecEnv, converting the
+// workloads to their typed representation.
+func typedMergePodSpecEnv(u *unstructured.Unstructured, env []corev1.EnvVar) error {
+	var obj runtime.Object
+	var podSpec *corev1.PodSpec
+	switch u.GetKind() {
+	case "Deployment":
+		d := &appsv1.Deployment{}
+		obj, podSpec = d, &d.Spec.Template.Spec
+	case "StatefulSet":
+		ss := &appsv1.StatefulSet{}
+		obj, podSpec = ss, &ss.Spec.Template.Spec
+	case "DaemonSet":
+		ds := &appsv1.DaemonSet{}
+		obj, podSpec = ds, &ds.Spec.Template.Spec
+	case "Job":
+		job := &batchv1.Job{}
+		obj, podSpec = job, &job.Spec.Template.Spec
+	default:
+		return nil
+	}
+	if err := scheme.Scheme.Convert(u, obj, nil); err != nil {
+		return err
+	}
+	for i := range podSpec.Containers {
+		src := append([]corev1.EnvVar{}, env...)
+		mergeEnv(&src, &podSpec.Containers[i].Env)
+	}
+	for i := range podSpec.InitContainers {
+		src := append([]corev1.EnvVar{}, env...)
+		mergeEnv(&src, &podSpec.InitContainers[i].Env)
+	}
+	return scheme.Scheme.Convert(obj, u, nil)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"golang.org/x/mod/semver"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// VersionSkewPolicyEnvKey is the environment variable to specify, how a Knative Serving and a
+	// Knative Eventing out of their supported skew are handled: VersionSkewWarn, the default, or
+	// VersionSkewBlock.
+	VersionSkewPolicyEnvKey = "VERSION_SKEW_POLICY"
+	// VersionSkewWarn only surfaces the skew in the VersionSkewWarning condition and as a warning
+	// of the webhook.
+	VersionSkewWarn = "warn"
+	// VersionSkewBlock refuses to install a version out of the skew.
+	VersionSkewBlock = "block"
+)
+
+// ServingEventingSkew is the supported skew of Knative Eventing to Knative Serving in the same
+// cluster. Both are released together, and tested with the same minor version of each other.
+var ServingEventingSkew = VersionSkew{Behind: 1, Ahead: 1}
+
+// VersionSkew is the range of the minor versions of a component relative to the version of the
+// Knative release, which it is installed with.
+type VersionSkew struct {
+	// Behind is the number of minor versions, by which the component may lag behind the release.
+	Behind int
+	// Ahead is the number of minor versions, by which the component may be ahead of the release.
+	Ahead int
+}
+
+// Check returns an error, if the version of the component is out of the skew to the version of
+// the release. Both versions have to contain at least the major and the minor number.
+func (s VersionSkew) Check(version, release string) error {
+	major, minor, err := majorMinor(version)
+	if err != nil {
+		return err
+	}
+	releaseMajor, releaseMinor, err := majorMinor(release)
+	if err != nil {
+		return err
+	}
+	if major != releaseMajor {
+		return fmt.Errorf("version %s has another major version than %s", version, release)
+	}
+	switch diff := minor - releaseMinor; {
+	case diff < -s.Behind:
+		return fmt.Errorf("version %s is more than %d minor versions behind %s", version, s.Behind, release)
+	case diff > s.Ahead:
+		if s.Ahead == 0 {
+			return fmt.Errorf("version %s is ahead of %s", version, release)
+		}
+		return fmt.Errorf("version %s is more than %d minor versions ahead of %s", version, s.Ahead, release)
+	}
+	return nil
+}
+
+func majorMinor(version string) (string, int, error) {
+	v := SanitizeSemver(version)
+	// semver accepts a version without the minor number, e.g. v1 for v1.0.0.
+	if !semver.IsValid(v) || !strings.Contains(strings.SplitN(v, "-", 2)[0], ".") {
+		return "", 0, fmt.Errorf("version %s is not in the format major.minor[.patch]", version)
+	}
+	parts := strings.Split(semver.MajorMinor(v), ".")
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, err
+	}
+	return parts[0], minor, nil
+}
+
+// ComponentVersion returns the version of the named component of the Knative component:
+// spec.versionOverrides of the component, or the given version of the release otherwise.
+func ComponentVersion(instance base.KComponent, name, version string) string {
+	if override := instance.GetSpec().GetVersionOverrides()[name]; override != "" {
+		return override
+	}
+	return version
+}
+
+// BlockVersionSkew returns whether VERSION_SKEW_POLICY blocks versions out of the skew.
+func BlockVersionSkew() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv(VersionSkewPolicyEnvKey)), VersionSkewBlock)
+}
+
+// CheckServingEventingSkew returns an error, if the target versions of the KnativeServing and the
+// KnativeEventing are out of ServingEventingSkew. Nothing is checked against the latest version.
+func CheckServingEventingSkew(a, b base.KComponent) error {
+	serving, eventing := a, b
+	if a.GroupVersionKind().Kind == operator.KindKnativeEventing {
+		serving, eventing = b, a
+	}
+	servingVersion, eventingVersion := TargetVersion(serving), TargetVersion(eventing)
+	if strings.EqualFold(servingVersion, LATEST_VERSION) || strings.EqualFold(eventingVersion, LATEST_VERSION) {
+		return nil
+	}
+	if err := ServingEventingSkew.Check(eventingVersion, servingVersion); err != nil {
+		return fmt.Errorf("KnativeEventing %s/%s at version %s is out of the supported skew to KnativeServing %s/%s at version %s: %w",
+			eventing.GetNamespace(), eventing.GetName(), eventingVersion, serving.GetNamespace(), serving.GetName(), servingVersion, err)
+	}
+	return nil
+}
+
+// PeerComponent returns the component of another kind, which is installed into the same cluster as
+// the instance, or nil if there is none.
+func PeerComponent[T base.KComponent](instance base.KComponent, components []T) base.KComponent {
+	for _, component := range components {
+		if component.GetDeletionTimestamp().IsZero() && SameTargetCluster(instance, component) {
+			return component
+		}
+	}
+	return nil
+}
+
+// CheckVersionSkew returns a Stage, which checks the target version of a KnativeServing or a
+// KnativeEventing against the one of its peer in the same cluster. A skew is surfaced in the
+// VersionSkewWarning condition. With VERSION_SKEW_POLICY set to block, installing a new version out
+// of the skew fails, while the installed version is kept.
+func CheckVersionSkew(peer func(base.KComponent) (base.KComponent, error)) Stage {
+	return func(ctx context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		status := instance.GetStatus()
+		other, err := peer(instance)
+		if err != nil {
+			return err
+		}
+		if other == nil {
+			status.ClearVersionSkewWarning()
+			return nil
+		}
+		skewErr := CheckServingEventingSkew(instance, other)
+		if skewErr == nil {
+			status.ClearVersionSkewWarning()
+			return nil
+		}
+		status.MarkVersionSkewWarning(skewErr.Error())
+		if BlockVersionSkew() && status.GetVersion() != TargetVersion(instance) {
+			status.MarkInstallFailed(skewErr.Error())
+			return skewErr
+		}
+		logging.FromContext(ctx).Warnw("Versions out of the supported skew", "error", skewErr)
+		return nil
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestVersionSkewCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		skew    VersionSkew
+		version string
+		release string
+		wantErr string
+	}{{
+		name:    "same minor version",
+		skew:    VersionSkew{},
+		version: "1.21.2",
+		release: "1.21",
+	}, {
+		name:    "behind",
+		skew:    VersionSkew{Behind: 2},
+		version: "v1.19",
+		release: "1.21.0",
+	}, {
+		name:    "ahead",
+		skew:    VersionSkew{Ahead: 1},
+		version: "1.22",
+		release: "1.21.0",
+	}, {
+		name:    "too far behind",
+		skew:    VersionSkew{Behind: 2},
+		version: "1.18",
+		release: "1.21.0",
+		wantErr: "version 1.18 is more than 2 minor versions behind 1.21.0",
+	}, {
+		name:    "ahead without skew",
+		skew:    VersionSkew{Behind: 2},
+		version: "1.22",
+		release: "1.21.0",
+		wantErr: "version 1.22 is ahead of 1.21.0",
+	}, {
+		name:    "too far ahead",
+		skew:    VersionSkew{Ahead: 1},
+		version: "1.23",
+		release: "1.21.0",
+		wantErr: "version 1.23 is more than 1 minor versions ahead of 1.21.0",
+	}, {
+		name:    "other major version",
+		skew:    VersionSkew{Behind: 2},
+		version: "2.21",
+		release: "1.21.0",
+		wantErr: "version 2.21 has another major version than 1.21.0",
+	}, {
+		name:    "no minor version",
+		skew:    VersionSkew{Behind: 2},
+		version: "1",
+		release: "1.21.0",
+		wantErr: "version 1 is not in the format major.minor[.patch]",
+	}, {
+		name:    "invalid release",
+		version: "1.21",
+		release: "latest",
+		wantErr: "version latest is not in the format major.minor[.patch]",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.skew.Check(test.version, test.release)
+			if test.wantErr == "" {
+				util.AssertEqual(t, err, nil)
+				return
+			}
+			if err == nil {
+				t.Fatalf("Check() = nil, want %q", test.wantErr)
+			}
+			util.AssertEqual(t, err.Error(), test.wantErr)
+		})
+	}
+}
+
+func TestComponentVersion(t *testing.T) {
+	ks := &v1beta1.KnativeServing{
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{VersionOverrides: map[string]string{"istio": "1.20"}},
+		},
+	}
+	util.AssertEqual(t, ComponentVersion(ks, "istio", "1.21.0"), "1.20")
+	util.AssertEqual(t, ComponentVersion(ks, "kourier", "1.21.0"), "1.21.0")
+}
+
+func TestCheckServingEventingSkew(t *testing.T) {
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+		Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Version: "1.21.0"}},
+	}
+	ke := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+		Spec:       v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{Version: "1.22.0"}},
+	}
+	util.AssertEqual(t, CheckServingEventingSkew(ks, ke), nil)
+	util.AssertEqual(t, CheckServingEventingSkew(ke, ks), nil)
+
+	ke.Spec.Version = "1.23.0"
+	want := "KnativeEventing knative-eventing/knative-eventing at version 1.23.0 is out of the supported skew to " +
+		"KnativeServing knative-serving/knative-serving at version 1.21.0: version 1.23.0 is more than 1 minor versions ahead of 1.21.0"
+	for _, err := range []error{CheckServingEventingSkew(ks, ke), CheckServingEventingSkew(ke, ks)} {
+		if err == nil {
+			t.Fatalf("CheckServingEventingSkew() = nil, want %q", want)
+		}
+		util.AssertEqual(t, err.Error(), want)
+	}
+}
+
+func TestPeerComponent(t *testing.T) {
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	now := metav1.Now()
+	deleting := &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "deleting", Name: "knative-eventing", DeletionTimestamp: &now}}
+	remote := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "spoke"},
+		Spec:       v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{TargetCluster: &base.TargetCluster{SecretName: "spoke"}}},
+	}
+	local := &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"}}
+
+	if peer := PeerComponent(ks, []*v1beta1.KnativeEventing{deleting, remote}); peer != nil {
+		t.Errorf("PeerComponent() = %v, want nil", peer)
+	}
+	util.AssertEqual(t, PeerComponent(ks, []*v1beta1.KnativeEventing{deleting, remote, local}), base.KComponent(local))
+}
+
+func TestCheckVersionSkew(t *testing.T) {
+	ke := &v1beta1.KnativeEventing{Spec: v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{Version: "1.18.0"}}}
+	peer := func(base.KComponent) (base.KComponent, error) {
+		return ke, nil
+	}
+
+	tests := []struct {
+		name        string
+		policy      string
+		version     string
+		installed   string
+		peer        func(base.KComponent) (base.KComponent, error)
+		wantWarning bool
+		wantErr     bool
+	}{{
+		name:    "no peer",
+		version: "1.21.0",
+		peer:    func(base.KComponent) (base.KComponent, error) { return nil, nil },
+	}, {
+		name:    "in skew",
+		version: "1.19.0",
+		peer:    peer,
+	}, {
+		name:        "warning",
+		version:     "1.21.0",
+		peer:        peer,
+		wantWarning: true,
+	}, {
+		name:        "blocked",
+		policy:      VersionSkewBlock,
+		version:     "1.21.0",
+		installed:   "1.19.0",
+		peer:        peer,
+		wantWarning: true,
+		wantErr:     true,
+	}, {
+		name:        "installed version is not blocked",
+		policy:      VersionSkewBlock,
+		version:     "1.21.0",
+		installed:   "1.21.0",
+		peer:        peer,
+		wantWarning: true,
+	}, {
+		name:    "failed to list",
+		version: "1.21.0",
+		peer:    func(base.KComponent) (base.KComponent, error) { return nil, errors.New("failed to list") },
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(VersionSkewPolicyEnvKey, test.policy)
+			ks := &v1beta1.KnativeServing{
+				Spec:   v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Version: test.version}},
+				Status: v1beta1.KnativeServingStatus{Version: test.installed},
+			}
+			ks.Status.InitializeConditions()
+			err := CheckVersionSkew(test.peer)(context.Background(), nil, ks)
+			util.AssertEqual(t, err != nil, test.wantErr)
+			util.AssertEqual(t, ks.Status.GetCondition(base.VersionSkewWarning) != nil, test.wantWarning)
+		})
+	}
+}
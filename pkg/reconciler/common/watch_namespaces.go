@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+)
+
+// WatchNamespaces restricts the informers and the controllers to the WatchNamespaces of the
+// ControllerConfig in the context, so that the operator does not need to list and watch resources
+// cluster-wide. The returned context and controller constructors replace the passed ones in
+// sharedmain. The informers of the first namespace are set up by sharedmain, each further namespace
+// gets its own informers and controllers.
+func WatchNamespaces(ctx context.Context, ctors ...injection.ControllerConstructor) (context.Context, []injection.ControllerConstructor) {
+	namespaces := GetControllerConfig(ctx).WatchNamespaces
+	if len(namespaces) == 0 {
+		return ctx, ctors
+	}
+	scoped := append([]injection.ControllerConstructor{}, ctors...)
+	for _, ns := range namespaces[1:] {
+		scope := &namespaceScope{namespace: ns}
+		for i, ctor := range ctors {
+			scoped = append(scoped, scope.controller(ctor, i == len(ctors)-1))
+		}
+	}
+	return injection.WithNamespaceScope(ctx, namespaces[0]), scoped
+}
+
+// namespaceScope holds the informers of a further watched namespace, which are shared by all its
+// controllers.
+type namespaceScope struct {
+	namespace string
+
+	once      sync.Once
+	ctx       context.Context
+	informers []controller.Informer
+}
+
+// controller returns the constructor of the controller in the namespace. The constructor of the
+// last controller starts the informers, because sharedmain only starts its own ones.
+func (s *namespaceScope) controller(ctor injection.ControllerConstructor, last bool) injection.ControllerConstructor {
+	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+		s.once.Do(func() {
+			s.ctx, s.informers = injection.Default.SetupInformers(injection.WithNamespaceScope(ctx, s.namespace), injection.GetConfig(ctx))
+		})
+		impl := ctor(s.ctx, cmw)
+		if last {
+			if err := controller.StartInformers(ctx.Done(), s.informers...); err != nil {
+				logging.FromContext(ctx).Fatalw("Failed to start the informers", zap.String("namespace", s.namespace), zap.Error(err))
+			}
+		}
+		return impl
+	}
+}
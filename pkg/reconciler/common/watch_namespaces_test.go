@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestWatchNamespaces(t *testing.T) {
+	ctor := func(context.Context, configmap.Watcher) *controller.Impl { return nil }
+
+	ctx, ctors := WatchNamespaces(context.Background(), ctor, ctor)
+	util.AssertEqual(t, injection.HasNamespaceScope(ctx), false)
+	util.AssertEqual(t, len(ctors), 2)
+
+	cfg := ControllerConfig{WatchNamespaces: []string{"team-a", "team-b", "team-c"}}
+	ctx, ctors = WatchNamespaces(WithControllerConfig(context.Background(), cfg), ctor, ctor)
+	util.AssertEqual(t, injection.GetNamespaceScope(ctx), "team-a")
+	// Both controllers for each of the three namespaces.
+	util.AssertEqual(t, len(ctors), 6)
+}
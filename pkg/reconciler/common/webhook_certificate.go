@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	clientgocache "k8s.io/client-go/tools/cache"
+	kubefilteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+const (
+	// OperatorWebhookSecretName is the name of the secret of the serving certificate of the
+	// operator webhook, in the namespace of the operator.
+	OperatorWebhookSecretName = "operator-webhook-certs"
+
+	// WebhookCertificateWarningPeriod is the remaining validity of a webhook certificate, below
+	// which the WebhookCertificateWarning condition is marked. The webhooks rotate their
+	// certificates before, so the warning means that the rotation failed.
+	WebhookCertificateWarningPeriod = 12 * time.Hour
+
+	// webhookCertificateMetric is the gauge of the days until the webhook certificates expire.
+	webhookCertificateMetric = "kn.operator.webhook.certificate.expiry"
+)
+
+// CertificateNotAfter returns the expiry of the serving certificate in the secret of a webhook,
+// which has the keys of knative.dev/pkg/webhook/certificates/resources.
+func CertificateNotAfter(secret *corev1.Secret) (time.Time, error) {
+	for _, key := range []string{certresources.ServerKey, certresources.ServerCert, certresources.CACert} {
+		if len(secret.Data[key]) == 0 {
+			return time.Time{}, fmt.Errorf("missing the key %s", key)
+		}
+	}
+	pair, err := tls.X509KeyPair(secret.Data[certresources.ServerCert], secret.Data[certresources.ServerKey])
+	if err != nil {
+		return time.Time{}, err
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+// WebhookCertificateSecrets is a manifestival predicate for the certificate secrets of the webhooks
+// of the Knative components, which are named webhook-certs or *-webhook-certs by convention.
+func WebhookCertificateSecrets(u *unstructured.Unstructured) bool {
+	return u.GetKind() == "Secret" && isWebhookCertificateSecretName(u.GetName())
+}
+
+func isWebhookCertificateSecretName(name string) bool {
+	return name == "webhook-certs" || strings.HasSuffix(name, "-webhook-certs")
+}
+
+// CheckWebhookCertificates returns a Stage, which checks the certificate of the operator webhook
+// with the operatorClient, and the ones of the webhooks in the manifest with the kubeClient of the
+// target cluster. The certificates, which expire within WebhookCertificateWarningPeriod or are
+// invalid, are surfaced in the WebhookCertificateWarning condition, and the days until they expire
+// in the gauge kn.operator.webhook.certificate.expiry.
+//
+// The certificates of the webhooks in the manifest, which the webhooks have not generated yet, are
+// skipped. With the annotation RegenerateWebhookCertificatesAnnotation, the ones with a warning are
+// cleared, so that the webhooks generate them again. In dry-run mode, they are not cleared, but
+// reported as pending changes in the preview. A failed check is logged and does not fail the
+// reconciliation.
+func CheckWebhookCertificates(operatorClient, kubeClient kubernetes.Interface) Stage {
+	registerWebhookCertificateMetricsOnce()
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		logger := logging.FromContext(ctx)
+		now := time.Now()
+		regenerate := strings.EqualFold(instance.GetAnnotations()[base.RegenerateWebhookCertificatesAnnotation], "true")
+		expiries := map[types.NamespacedName]time.Time{}
+		var warnings []string
+		var pending []PreviewChange
+
+		key := types.NamespacedName{Namespace: system.Namespace(), Name: OperatorWebhookSecretName}
+		secret, err := operatorClient.CoreV1().Secrets(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			warnings = append(warnings, fmt.Sprintf("The certificate secret %s is missing", key))
+		case err != nil:
+			logger.Warnw("Failed to check the certificate of the operator webhook", zap.Error(err))
+		default:
+			// The operator webhook rotates its certificate itself, it is never regenerated here.
+			notAfter, warning := checkWebhookCertificate(secret, now)
+			if !notAfter.IsZero() {
+				expiries[key] = notAfter
+			}
+			if warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+
+		for _, u := range manifest.Filter(WebhookCertificateSecrets).Resources() {
+			key := types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}
+			secret, err := kubeClient.CoreV1().Secrets(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) || (err == nil && len(secret.Data) == 0) {
+				// The webhook generates the certificate once it is running.
+				continue
+			} else if err != nil {
+				logger.Warnw("Failed to check the certificate of the webhook", zap.String("secret", key.String()), zap.Error(err))
+				continue
+			}
+			notAfter, warning := checkWebhookCertificate(secret, now)
+			if !notAfter.IsZero() {
+				expiries[key] = notAfter
+			}
+			if warning == "" {
+				continue
+			}
+			if regenerate && IsDryRun(instance) {
+				pending = append(pending, PreviewChange{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Namespace:  key.Namespace,
+					Name:       key.Name,
+					Action:     PreviewActionUpdate,
+					Patch:      `{"data":null}`,
+				})
+				warning += ", it is regenerated once the dry-run mode is disabled"
+			} else if regenerate {
+				if err := clearWebhookCertificate(ctx, kubeClient, secret); err != nil {
+					logger.Warnw("Failed to regenerate the certificate of the webhook", zap.String("secret", key.String()), zap.Error(err))
+				} else {
+					logger.Infow("Regenerating the certificate of the webhook", zap.String("secret", key.String()))
+					warning += ", it is being regenerated"
+					delete(expiries, key)
+				}
+			}
+			warnings = append(warnings, warning)
+		}
+
+		webhookCertificateExpiries.set(instance, expiries)
+		pendingChanges.set(instance, pending)
+		status := instance.GetStatus()
+		if len(warnings) == 0 {
+			status.ClearWebhookCertificateWarning()
+		} else {
+			status.MarkWebhookCertificateWarning(strings.Join(warnings, "; "))
+		}
+		return nil
+	}
+}
+
+// ForgetWebhookCertificates stops reporting the certificates of the deleted Knative component.
+func ForgetWebhookCertificates(instance base.KComponent) {
+	webhookCertificateExpiries.set(instance, nil)
+	pendingChanges.set(instance, nil)
+}
+
+// WatchWebhookCertificates enqueues the Knative component of the kind gvk, which owns a webhook
+// certificate secret with the label selector, whenever the secret changes, so that a certificate
+// is checked right after it is generated, instead of on the next resync. The informer is started
+// here, as sharedmain only starts the injected ones.
+func WatchWebhookCertificates(ctx context.Context, impl *controller.Impl, selector string, gvk schema.GroupVersionKind) {
+	logger := logging.FromContext(ctx)
+	informer := kubefilteredfactory.Get(ctx, selector).Core().V1().Secrets().Informer()
+	// The informer only enqueues the owner, so it does not need to cache the certificates.
+	if err := informer.SetTransform(TrimForEnqueue); err != nil {
+		logger.Warnw("Failed to trim the objects cached by the informer", zap.Error(err))
+	}
+	if _, err := informer.AddEventHandler(clientgocache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			object, ok := obj.(metav1.Object)
+			return ok && isWebhookCertificateSecretName(object.GetName()) && controller.FilterControllerGVK(gvk)(obj)
+		},
+		Handler: controller.HandleAll(impl.EnqueueControllerOf),
+	}); err != nil {
+		logger.Warnw("Failed to watch the webhook certificates", zap.Error(err))
+		return
+	}
+	go informer.Run(ctx.Done())
+}
+
+// checkWebhookCertificate returns the expiry of the certificate in the secret of a webhook, zero
+// if it is invalid, and the warning about it, empty if it is valid beyond
+// WebhookCertificateWarningPeriod after now.
+func checkWebhookCertificate(secret *corev1.Secret, now time.Time) (time.Time, string) {
+	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+	notAfter, err := CertificateNotAfter(secret)
+	switch {
+	case err != nil:
+		return time.Time{}, fmt.Sprintf("The certificate in the secret %s is invalid: %v", key, err)
+	case notAfter.Before(now):
+		return notAfter, fmt.Sprintf("The certificate in the secret %s expired at %s", key, notAfter.UTC().Format(time.RFC3339))
+	case notAfter.Before(now.Add(WebhookCertificateWarningPeriod)):
+		return notAfter, fmt.Sprintf("The certificate in the secret %s expires at %s", key, notAfter.UTC().Format(time.RFC3339))
+	}
+	return notAfter, ""
+}
+
+// clearWebhookCertificate removes the certificate from the secret, which the webhook of the
+// Knative component generates again, like after the installation.
+func clearWebhookCertificate(ctx context.Context, kubeClient kubernetes.Interface, secret *corev1.Secret) error {
+	secret = secret.DeepCopy()
+	secret.Data = nil
+	_, err := kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// webhookCertificateExpiryTracker holds the expiries of the webhook certificates of each Knative
+// component, which are reported by the gauge.
+type webhookCertificateExpiryTracker struct {
+	mu       sync.Mutex
+	expiries map[string]map[types.NamespacedName]time.Time
+}
+
+var (
+	webhookCertificateExpiries = &webhookCertificateExpiryTracker{expiries: map[string]map[types.NamespacedName]time.Time{}}
+
+	registerWebhookCertificateMetricsOnce = sync.OnceFunc(func() {
+		if err := registerWebhookCertificateMetrics(webhookCertificateExpiries, otel.GetMeterProvider()); err != nil {
+			logging.FromContext(context.Background()).Warnw("Failed to register the webhook certificate metrics", zap.Error(err))
+		}
+	})
+)
+
+func (t *webhookCertificateExpiryTracker) set(instance base.KComponent, expiries map[types.NamespacedName]time.Time) {
+	key := fmt.Sprintf("%T/%s/%s", instance, instance.GetNamespace(), instance.GetName())
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(expiries) == 0 {
+		delete(t.expiries, key)
+	} else {
+		t.expiries[key] = expiries
+	}
+}
+
+// snapshot returns the expiries of the webhook certificates of all the Knative components, which
+// may share some, e.g. the one of the operator webhook.
+func (t *webhookCertificateExpiryTracker) snapshot() map[types.NamespacedName]time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	all := map[types.NamespacedName]time.Time{}
+	for _, expiries := range t.expiries {
+		for key, notAfter := range expiries {
+			all[key] = notAfter
+		}
+	}
+	return all
+}
+
+// registerWebhookCertificateMetrics reports the days until the webhook certificates of the tracker
+// expire as a gauge, which is negative once they expired, by the namespace and the name of the
+// secret.
+func registerWebhookCertificateMetrics(tracker *webhookCertificateExpiryTracker, provider metric.MeterProvider) error {
+	_, err := provider.Meter(meterName).Float64ObservableGauge(webhookCertificateMetric,
+		metric.WithDescription("The days until the certificate of the webhook expires"),
+		metric.WithUnit("d"),
+		metric.WithFloat64Callback(func(_ context.Context, observer metric.Float64Observer) error {
+			now := time.Now()
+			for key, notAfter := range tracker.snapshot() {
+				observer.Observe(notAfter.Sub(now).Hours()/24, metric.WithAttributes(
+					attribute.String("namespace", key.Namespace),
+					attribute.String("secret", key.Name)))
+			}
+			return nil
+		}))
+	return err
+}
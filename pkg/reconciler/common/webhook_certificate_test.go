@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/system"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func webhookCertificateSecret(t *testing.T, namespace, name string, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+	serverKey, serverCert, caCert, err := certresources.CreateCerts(context.Background(), "webhook", namespace, notAfter)
+	if err != nil {
+		t.Fatalf("CreateCerts() = %v", err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Data: map[string][]byte{
+			certresources.ServerKey:  serverKey,
+			certresources.ServerCert: serverCert,
+			certresources.CACert:     caCert,
+		},
+	}
+}
+
+func TestCheckWebhookCertificates(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	now := time.Now()
+	operatorSecret := webhookCertificateSecret(t, "knative-operator", OperatorWebhookSecretName, now.Add(5*24*time.Hour))
+	emptySecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "webhook-certs"}}
+	tests := []struct {
+		name           string
+		operatorSecret *corev1.Secret
+		secret         *corev1.Secret
+		regenerate     bool
+		dryRun         bool
+		wantMsg        string
+		wantCleared    bool
+		wantPending    []PreviewChange
+	}{{
+		name:           "valid",
+		operatorSecret: operatorSecret,
+		secret:         webhookCertificateSecret(t, "knative-serving", "webhook-certs", now.Add(5*24*time.Hour)),
+	}, {
+		name:           "not generated yet",
+		operatorSecret: operatorSecret,
+		secret:         emptySecret,
+	}, {
+		name:           "not installed yet",
+		operatorSecret: operatorSecret,
+	}, {
+		name:    "missing operator webhook secret",
+		wantMsg: "The certificate secret knative-operator/operator-webhook-certs is missing",
+	}, {
+		name:           "invalid operator webhook certificate",
+		operatorSecret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-operator", Name: OperatorWebhookSecretName}},
+		wantMsg:        "The certificate in the secret knative-operator/operator-webhook-certs is invalid: missing the key server-key.pem",
+	}, {
+		name:           "expiring",
+		operatorSecret: operatorSecret,
+		secret:         webhookCertificateSecret(t, "knative-serving", "webhook-certs", now.Add(time.Hour)),
+		wantMsg:        "The certificate in the secret knative-serving/webhook-certs expires at " + now.Add(time.Hour).UTC().Format(time.RFC3339),
+	}, {
+		name:           "expired",
+		operatorSecret: webhookCertificateSecret(t, "knative-operator", OperatorWebhookSecretName, now.Add(-time.Hour)),
+		secret:         webhookCertificateSecret(t, "knative-serving", "webhook-certs", now.Add(-time.Hour)),
+		wantMsg: "The certificate in the secret knative-operator/operator-webhook-certs expired at " + now.Add(-time.Hour).UTC().Format(time.RFC3339) +
+			"; The certificate in the secret knative-serving/webhook-certs expired at " + now.Add(-time.Hour).UTC().Format(time.RFC3339),
+	}, {
+		name:           "regenerate",
+		operatorSecret: operatorSecret,
+		secret:         webhookCertificateSecret(t, "knative-serving", "webhook-certs", now.Add(-time.Hour)),
+		regenerate:     true,
+		wantMsg:        "The certificate in the secret knative-serving/webhook-certs expired at " + now.Add(-time.Hour).UTC().Format(time.RFC3339) + ", it is being regenerated",
+		wantCleared:    true,
+	}, {
+		name:           "regenerate in dry-run mode",
+		operatorSecret: operatorSecret,
+		secret:         webhookCertificateSecret(t, "knative-serving", "webhook-certs", now.Add(-time.Hour)),
+		regenerate:     true,
+		dryRun:         true,
+		wantMsg:        "The certificate in the secret knative-serving/webhook-certs expired at " + now.Add(-time.Hour).UTC().Format(time.RFC3339) + ", it is regenerated once the dry-run mode is disabled",
+		wantPending: []PreviewChange{{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Namespace:  "knative-serving",
+			Name:       "webhook-certs",
+			Action:     PreviewActionUpdate,
+			Patch:      `{"data":null}`,
+		}},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var operatorObjs, objs []runtime.Object
+			if test.operatorSecret != nil {
+				operatorObjs = append(operatorObjs, test.operatorSecret)
+			}
+			if test.secret != nil {
+				objs = append(objs, test.secret)
+			}
+			kubeClient := kubefake.NewSimpleClientset(objs...)
+			manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+				util.MakeUnstructured(t, emptySecret),
+				util.MakeUnstructured(t, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "other"}}),
+			}))
+			if err != nil {
+				t.Fatalf("ManifestFrom() = %v", err)
+			}
+			ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+			ks.Annotations = map[string]string{}
+			if test.regenerate {
+				ks.Annotations[base.RegenerateWebhookCertificatesAnnotation] = "true"
+			}
+			if test.dryRun {
+				ks.Annotations[base.DryRunAnnotation] = "true"
+			}
+			// A previous warning is cleared, once the certificates are valid.
+			ks.Status.MarkWebhookCertificateWarning("previous")
+			defer ForgetWebhookCertificates(ks)
+
+			stage := CheckWebhookCertificates(kubefake.NewSimpleClientset(operatorObjs...), kubeClient)
+			if err := stage(context.Background(), &manifest, ks); err != nil {
+				t.Fatalf("CheckWebhookCertificates() = %v", err)
+			}
+			cond := ks.Status.GetCondition(base.WebhookCertificateWarning)
+			if test.wantMsg == "" {
+				if cond != nil {
+					t.Errorf("GetCondition(WebhookCertificateWarning) = %v, want nil", cond)
+				}
+			} else if cond == nil || cond.Message != test.wantMsg {
+				t.Errorf("GetCondition(WebhookCertificateWarning) = %v, want the message %q", cond, test.wantMsg)
+			}
+			if test.secret != nil {
+				got, err := kubeClient.CoreV1().Secrets(test.secret.Namespace).Get(context.Background(), test.secret.Name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("Get() = %v", err)
+				}
+				util.AssertEqual(t, len(got.Data) == 0, test.wantCleared || len(test.secret.Data) == 0)
+			}
+			util.AssertDeepEqual(t, pendingChanges.take(ks), test.wantPending)
+		})
+	}
+}
+
+func TestWebhookCertificateMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	tracker := &webhookCertificateExpiryTracker{expiries: map[string]map[types.NamespacedName]time.Time{}}
+	if err := registerWebhookCertificateMetrics(tracker, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))); err != nil {
+		t.Fatalf("registerWebhookCertificateMetrics() = %v", err)
+	}
+
+	collect := func() map[string]float64 {
+		rm := metricdata.ResourceMetrics{}
+		if err := reader.Collect(context.Background(), &rm); err != nil {
+			t.Fatalf("Collect() = %v", err)
+		}
+		got := map[string]float64{}
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				if m.Name != webhookCertificateMetric {
+					continue
+				}
+				for _, point := range m.Data.(metricdata.Gauge[float64]).DataPoints {
+					namespace, _ := point.Attributes.Value("namespace")
+					secret, _ := point.Attributes.Value("secret")
+					got[namespace.AsString()+"/"+secret.AsString()] = math.Round(point.Value)
+				}
+			}
+		}
+		return got
+	}
+
+	now := time.Now()
+	operator := types.NamespacedName{Namespace: "knative-operator", Name: OperatorWebhookSecretName}
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	ke := &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"}}
+	tracker.set(ks, map[types.NamespacedName]time.Time{
+		operator: now.Add(5 * 24 * time.Hour),
+		{Namespace: "knative-serving", Name: "webhook-certs"}: now.Add(3 * 24 * time.Hour),
+	})
+	tracker.set(ke, map[types.NamespacedName]time.Time{
+		operator: now.Add(5 * 24 * time.Hour),
+		{Namespace: "knative-eventing", Name: "eventing-webhook-certs"}: now.Add(-2 * 24 * time.Hour),
+	})
+	util.AssertDeepEqual(t, collect(), map[string]float64{
+		"knative-operator/operator-webhook-certs": 5,
+		"knative-serving/webhook-certs":           3,
+		"knative-eventing/eventing-webhook-certs": -2,
+	})
+
+	// The certificates of a deleted component are not reported anymore.
+	tracker.set(ke, nil)
+	util.AssertDeepEqual(t, collect(), map[string]float64{
+		"knative-operator/operator-webhook-certs": 5,
+		"knative-serving/webhook-certs":           3,
+	})
+}
@@ -171,10 +171,12 @@ func replaceResources(override *base.WorkloadOverride, ps *corev1.PodTemplateSpe
 
 func replaceEnv(override *base.WorkloadOverride, ps *corev1.PodTemplateSpec) {
 	if len(override.Env) > 0 {
-		containers := ps.Spec.Containers
-		for i := range containers {
-			if override := findEnvOverride(override.Env, containers[i].Name); override != nil {
-				mergeEnv(&override.EnvVars, &containers[i].Env)
+		// The names of the containers and the init containers are unique within the pod.
+		for _, containers := range [][]corev1.Container{ps.Spec.InitContainers, ps.Spec.Containers} {
+			for i := range containers {
+				if override := findEnvOverride(override.Env, containers[i].Name); override != nil {
+					overrideEnv(override, &containers[i].Env)
+				}
 			}
 		}
 	}
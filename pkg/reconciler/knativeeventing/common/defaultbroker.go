@@ -27,8 +27,11 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/scheme"
 	eventingconfig "knative.dev/eventing/pkg/apis/config"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/apis/eventing"
+	"knative.dev/operator/pkg/apis/operator/base"
 	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/ptr"
 	"sigs.k8s.io/yaml"
 )
 
@@ -64,12 +67,18 @@ func DefaultBrokerConfigMapTransform(instance *eventingv1beta1.KnativeEventing,
 				log.Error(err, "Error parsing default broker ConfigMap", "unstructured", u, "configMap", configMap)
 				return err
 			}
+			if defaults.ClusterDefaultConfig == nil {
+				defaults.ClusterDefaultConfig = &eventingconfig.DefaultConfig{}
+			}
 
 			defaultBrokerClass := instance.Spec.DefaultBrokerClass
 			if defaultBrokerClass == "" {
 				defaultBrokerClass = eventing.MTChannelBrokerClassValue
 			}
 			defaults.ClusterDefaultConfig.DefaultBrokerClass = defaultBrokerClass
+			if !defaultBrokerConfigDefined(config) {
+				applyDefaultBrokerConfig(defaults.ClusterDefaultConfig, brokerConfig(instance))
+			}
 
 			err = writeDefaultsToConfigMap(defaults, configMap, log)
 			if err != nil {
@@ -113,3 +122,66 @@ func findDefaultBrokerClassDefined(data map[string]string) bool {
 	}
 	return false
 }
+
+// defaultBrokerConfigDefined returns whether spec.config sets the default-br-config of config-br-defaults,
+// which then takes precedence over spec.defaultBrokerConfig.
+func defaultBrokerConfigDefined(config base.ConfigMapData) bool {
+	// The "config-" prefix is optional
+	for _, name := range []string{"br-defaults", eventingconfig.DefaultsConfigName} {
+		if _, ok := config[name][eventingconfig.BrokerDefaultsKey]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// brokerConfig returns spec.defaultBrokerConfig, referring to the RabbitmqBrokerConfig of
+// spec.rabbitmq, if it leaves the configuration of a default RabbitMQBroker unset.
+func brokerConfig(instance *eventingv1beta1.KnativeEventing) *base.BrokerConfiguration {
+	config := instance.Spec.DefaultBrokerConfig
+	ref := defaultRabbitMQBrokerConfig(instance)
+	if ref == nil || (config != nil && config.Config != nil) {
+		return config
+	}
+	if config == nil {
+		return &base.BrokerConfiguration{Config: ref}
+	}
+	config = config.DeepCopy()
+	config.Config = ref
+	return config
+}
+
+// applyDefaultBrokerConfig sets the reference to the configuration and the delivery of the brokers in
+// the cluster default, keeping the settings of the shipped ConfigMap, which spec.defaultBrokerConfig
+// leaves unset.
+func applyDefaultBrokerConfig(defaults *eventingconfig.DefaultConfig, brokerConfig *base.BrokerConfiguration) {
+	if brokerConfig == nil {
+		return
+	}
+	if defaults.BrokerConfig == nil {
+		defaults.BrokerConfig = &eventingconfig.BrokerConfig{}
+	}
+	if brokerConfig.Config != nil {
+		defaults.BrokerConfig.KReference = brokerConfig.Config.DeepCopy()
+	}
+	delivery := brokerConfig.Delivery
+	if delivery == nil {
+		return
+	}
+	if defaults.BrokerConfig.Delivery == nil {
+		defaults.BrokerConfig.Delivery = &eventingduckv1.DeliverySpec{}
+	}
+	if delivery.Retry != nil {
+		defaults.BrokerConfig.Delivery.Retry = ptr.Int32(*delivery.Retry)
+	}
+	if delivery.BackoffPolicy != "" {
+		policy := eventingduckv1.BackoffPolicyType(delivery.BackoffPolicy)
+		defaults.BrokerConfig.Delivery.BackoffPolicy = &policy
+	}
+	if delivery.BackoffDelay != "" {
+		defaults.BrokerConfig.Delivery.BackoffDelay = ptr.String(delivery.BackoffDelay)
+	}
+	if delivery.DeadLetterSink != nil {
+		defaults.BrokerConfig.Delivery.DeadLetterSink = delivery.DeadLetterSink.DeepCopy()
+	}
+}
@@ -22,8 +22,13 @@ import (
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingconfig "knative.dev/eventing/pkg/apis/config"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
 
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/yaml"
@@ -205,6 +210,83 @@ func TestDefaultBrokerTransform(t *testing.T) {
 	}
 }
 
+func TestDefaultBrokerConfig(t *testing.T) {
+	shipped := map[string]string{"default-br-config": `
+clusterDefault:
+  brokerClass: MTChannelBasedBroker
+  apiVersion: v1
+  kind: ConfigMap
+  name: config-br-default-channel
+  namespace: knative-eventing
+  delivery:
+    retry: 10
+    backoffPolicy: exponential
+    backoffDelay: PT0.2S
+`}
+	sink := &duckv1.Destination{URI: apis.HTTP("dead-letter.default.svc.cluster.local")}
+	exponential := eventingduckv1.BackoffPolicyExponential
+
+	tests := []struct {
+		name     string
+		config   base.ConfigMapData
+		expected *eventingconfig.BrokerConfig
+	}{{
+		name: "typed config",
+		expected: &eventingconfig.BrokerConfig{
+			KReference: &duckv1.KReference{APIVersion: "v1", Kind: "ConfigMap", Name: "kafka-channel", Namespace: "knative-eventing"},
+			Delivery: &eventingduckv1.DeliverySpec{
+				Retry:          ptr.Int32(3),
+				BackoffPolicy:  &exponential,
+				BackoffDelay:   ptr.String("PT1S"),
+				DeadLetterSink: sink,
+			},
+		},
+	}, {
+		name:   "spec.config takes precedence",
+		config: base.ConfigMapData{"br-defaults": {"default-br-config": shipped["default-br-config"]}},
+		expected: &eventingconfig.BrokerConfig{
+			KReference: &duckv1.KReference{APIVersion: "v1", Kind: "ConfigMap", Name: "config-br-default-channel", Namespace: "knative-eventing"},
+			Delivery: &eventingduckv1.DeliverySpec{
+				Retry:         ptr.Int32(10),
+				BackoffPolicy: &exponential,
+				BackoffDelay:  ptr.String("PT0.2S"),
+			},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					DefaultBrokerConfig: &base.BrokerConfiguration{
+						Config:   &duckv1.KReference{APIVersion: "v1", Kind: "ConfigMap", Name: "kafka-channel", Namespace: "knative-eventing"},
+						Delivery: &base.BrokerDeliveryConfiguration{Retry: ptr.Int32(3), BackoffDelay: "PT1S", DeadLetterSink: sink},
+					},
+				},
+			}
+			cm := corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "config-br-defaults"},
+				Data:       shipped,
+			}
+			u := util.MakeUnstructured(t, &cm)
+			if err := DefaultBrokerConfigMapTransform(instance, log)(&u); err != nil {
+				t.Fatalf("DefaultBrokerConfigMapTransform() = %v", err)
+			}
+			configMap := &corev1.ConfigMap{}
+			if err := scheme.Scheme.Convert(&u, configMap, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			defaults, err := eventingconfig.NewDefaultsConfigFromConfigMap(configMap)
+			if err != nil {
+				t.Fatalf("NewDefaultsConfigFromConfigMap() = %v", err)
+			}
+			util.AssertDeepEqual(t, defaults.ClusterDefaultConfig.BrokerConfig, tt.expected)
+		})
+	}
+}
+
 func makeConfigMap(t *testing.T, name string, data base.ConfigMapData) corev1.ConfigMap {
 	out, err := yaml.Marshal(&data)
 	if err != nil {
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+const (
+	// IstioFeature is the flag of config-features, which enables the integration with Istio.
+	IstioFeature = "istio"
+
+	istioNetworkingGroupVersion = "networking.istio.io/v1beta1"
+	istioInjectionLabel         = "istio-injection"
+	istioRevisionLabel          = "istio.io/rev"
+)
+
+// IstioTransform enables the istio feature of config-features for spec.istio, unless spec.config or
+// spec.features set it.
+func IstioTransform(instance *eventingv1beta1.KnativeEventing, log *zap.SugaredLogger) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if !istioEnabled(instance) || u.GetKind() != "ConfigMap" || u.GetName() != common.FeaturesConfigMapName {
+			return nil
+		}
+		if _, ok := configuredFeature(instance, IstioFeature); ok {
+			return nil
+		}
+		return common.UpdateConfigMap(u, map[string]string{IstioFeature: "enabled"}, log)
+	}
+}
+
+// CheckIstio returns a Stage, which validates that the cluster runs Istio for spec.istio, and injects
+// its sidecars into the namespace of Knative Eventing, either by the labels of spec.namespace or by
+// the ones of the namespace in the cluster. eventing-istio relies on the sidecars of the components
+// to send their traffic through the mesh.
+func CheckIstio(kubeClient kubernetes.Interface) common.Stage {
+	return func(ctx context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+		if !ok || !istioEnabled(ke) {
+			return nil
+		}
+		missing, err := missingKinds(kubeClient, istioNetworkingGroupVersion, sets.New("DestinationRule", "ServiceEntry"))
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			msg := fmt.Sprintf("Istio is not installed, the API %s is not served for %s",
+				istioNetworkingGroupVersion, strings.Join(missing, ", "))
+			instance.GetStatus().MarkInstallFailed(msg)
+			return fmt.Errorf("%s", msg)
+		}
+
+		var labels map[string]string
+		if ns := ke.Spec.GetNamespaceConfiguration(); ns != nil {
+			labels = ns.Labels
+		}
+		if !sidecarInjection(labels) {
+			namespace, err := kubeClient.CoreV1().Namespaces().Get(ctx, ke.GetNamespace(), metav1.GetOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get the namespace %s: %w", ke.GetNamespace(), err)
+			}
+			if err != nil || !sidecarInjection(namespace.GetLabels()) {
+				msg := fmt.Sprintf("Istio does not inject its sidecars into the namespace %s, label it with %s: enabled or %s in spec.namespace.labels",
+					ke.GetNamespace(), istioInjectionLabel, istioRevisionLabel)
+				instance.GetStatus().MarkInstallFailed(msg)
+				return fmt.Errorf("%s", msg)
+			}
+		}
+		return nil
+	}
+}
+
+// sidecarInjection returns whether the labels of a namespace make Istio inject its sidecars.
+func sidecarInjection(labels map[string]string) bool {
+	if labels[istioInjectionLabel] == "enabled" {
+		return true
+	}
+	_, ok := labels[istioRevisionLabel]
+	return ok && labels[istioInjectionLabel] != "disabled"
+}
+
+func istioEnabled(ke *eventingv1beta1.KnativeEventing) bool {
+	return ke.Spec.Istio != nil && ke.Spec.Istio.Enabled
+}
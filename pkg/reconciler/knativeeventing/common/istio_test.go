@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func istioInstance(istio *base.EventingComponentConfiguration, namespaceLabels map[string]string, features map[string]string) *v1beta1.KnativeEventing {
+	ke := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+		Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec: base.CommonSpec{Features: features},
+			Istio:      istio,
+		},
+	}
+	if namespaceLabels != nil {
+		ke.Spec.NamespaceConfiguration = &base.NamespaceConfiguration{Labels: namespaceLabels}
+	}
+	return ke
+}
+
+func TestIstioTransform(t *testing.T) {
+	enabled := &base.EventingComponentConfiguration{Enabled: true}
+	tests := []struct {
+		name     string
+		istio    *base.EventingComponentConfiguration
+		features map[string]string
+		expected map[string]string
+	}{{
+		name:     "no istio",
+		expected: map[string]string{"transport-encryption": "disabled"},
+	}, {
+		name:     "disabled",
+		istio:    &base.EventingComponentConfiguration{},
+		expected: map[string]string{"transport-encryption": "disabled"},
+	}, {
+		name:     "enabled",
+		istio:    enabled,
+		expected: map[string]string{"transport-encryption": "disabled", IstioFeature: "enabled"},
+	}, {
+		name:     "feature of spec.features",
+		istio:    enabled,
+		features: map[string]string{IstioFeature: "disabled"},
+		expected: map[string]string{"transport-encryption": "disabled"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			u.SetName("config-features")
+			_ = unstructured.SetNestedStringMap(u.Object, map[string]string{"transport-encryption": "disabled"}, "data")
+			if err := IstioTransform(istioInstance(tt.istio, nil, tt.features), zap.NewNop().Sugar())(u); err != nil {
+				t.Fatalf("IstioTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
+
+func TestCheckIstio(t *testing.T) {
+	enabled := &base.EventingComponentConfiguration{Enabled: true}
+	served := []string{"DestinationRule", "ServiceEntry"}
+	tests := []struct {
+		name            string
+		istio           *base.EventingComponentConfiguration
+		namespaceLabels map[string]string
+		namespace       *corev1.Namespace
+		served          []string
+		expectedErr     string
+	}{{
+		name: "no istio",
+	}, {
+		name:        "istio not installed",
+		istio:       enabled,
+		expectedErr: "Istio is not installed, the API networking.istio.io/v1beta1 is not served for DestinationRule, ServiceEntry",
+	}, {
+		name:            "injection by spec.namespace",
+		istio:           enabled,
+		namespaceLabels: map[string]string{"istio-injection": "enabled"},
+		served:          served,
+	}, {
+		name:  "injection by the namespace in the cluster",
+		istio: enabled,
+		namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "knative-eventing",
+			Labels: map[string]string{"istio.io/rev": "stable"},
+		}},
+		served: served,
+	}, {
+		name:            "injection disabled",
+		istio:           enabled,
+		namespaceLabels: map[string]string{"istio-injection": "disabled", "istio.io/rev": "stable"},
+		served:          served,
+		expectedErr:     "Istio does not inject its sidecars into the namespace knative-eventing",
+	}, {
+		name:        "no namespace",
+		istio:       enabled,
+		served:      served,
+		expectedErr: "Istio does not inject its sidecars into the namespace knative-eventing",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []runtime.Object
+			if tt.namespace != nil {
+				objects = append(objects, tt.namespace)
+			}
+			kubeClient := kubefake.NewSimpleClientset(objects...)
+			if len(tt.served) > 0 {
+				resources := &metav1.APIResourceList{GroupVersion: "networking.istio.io/v1beta1"}
+				for _, kind := range tt.served {
+					resources.APIResources = append(resources.APIResources, metav1.APIResource{Kind: kind})
+				}
+				kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{resources}
+			}
+			instance := istioInstance(tt.istio, tt.namespaceLabels, nil)
+			instance.Status.InitializeConditions()
+			manifest, _ := mf.ManifestFrom(mf.Slice{})
+
+			err := CheckIstio(kubeClient)(context.Background(), &manifest, instance)
+			if tt.expectedErr == "" {
+				util.AssertEqual(t, err, nil)
+				return
+			}
+			if err == nil {
+				t.Fatalf("CheckIstio() = nil, want %q", tt.expectedErr)
+			}
+			if got := err.Error(); !strings.HasPrefix(got, tt.expectedErr) {
+				t.Fatalf("CheckIstio() = %q, want it to start with %q", got, tt.expectedErr)
+			}
+			util.AssertEqual(t, instance.Status.IsReady(), false)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// JobSinkName is the deployment of the receiver of the JobSinks, and of its service and its
+// ServiceAccount.
+const JobSinkName = "job-sink"
+
+// JobSinkResources matches the receiver of the JobSinks, its RBAC and its certificate, which are
+// left out, if spec.jobSink disables it. The CRD and the controller of JobSinks are part of the
+// eventing-controller, and stay installed.
+func JobSinkResources(ke *eventingv1beta1.KnativeEventing) mf.Predicate {
+	return func(u *unstructured.Unstructured) bool {
+		if ke.Spec.JobSink == nil || ke.Spec.JobSink.Enabled == nil || *ke.Spec.JobSink.Enabled {
+			return false
+		}
+		switch u.GetKind() {
+		case "Deployment", "Service", "ServiceAccount":
+			return u.GetName() == JobSinkName
+		case "ClusterRole", "ClusterRoleBinding":
+			return u.GetName() == "knative-eventing-"+JobSinkName
+		case "Certificate":
+			return u.GetName() == JobSinkName+"-server-tls"
+		}
+		return false
+	}
+}
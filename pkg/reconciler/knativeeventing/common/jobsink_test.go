@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestJobSinkResources(t *testing.T) {
+	var resources []*unstructured.Unstructured
+	for _, r := range [][2]string{
+		{"Deployment", "job-sink"},
+		{"Service", "job-sink"},
+		{"ServiceAccount", "job-sink"},
+		{"ClusterRole", "knative-eventing-job-sink"},
+		{"ClusterRoleBinding", "knative-eventing-job-sink"},
+		{"Certificate", "job-sink-server-tls"},
+		{"ClusterRole", "jobsinks-addressable-resolver"},
+		{"CustomResourceDefinition", "jobsinks.sinks.knative.dev"},
+	} {
+		u := &unstructured.Unstructured{}
+		u.SetKind(r[0])
+		u.SetName(r[1])
+		resources = append(resources, u)
+	}
+	tests := []struct {
+		name     string
+		jobSink  *base.JobSinkConfiguration
+		expected int
+	}{{
+		name: "no job sink",
+	}, {
+		name:    "enabled by default",
+		jobSink: &base.JobSinkConfiguration{},
+	}, {
+		name:    "enabled",
+		jobSink: &base.JobSinkConfiguration{Enabled: ptr.Bool(true)},
+	}, {
+		name:     "disabled",
+		jobSink:  &base.JobSinkConfiguration{Enabled: ptr.Bool(false)},
+		expected: 6,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ke := &eventingv1beta1.KnativeEventing{Spec: eventingv1beta1.KnativeEventingSpec{JobSink: tt.jobSink}}
+			pred := JobSinkResources(ke)
+			matched := 0
+			for _, u := range resources {
+				if pred(u) {
+					matched++
+				}
+			}
+			util.AssertEqual(t, matched, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const (
+	// KafkaBrokerConfigMapName is the ConfigMap of the Kafka cluster of the brokers of the Kafka class.
+	KafkaBrokerConfigMapName = "kafka-broker-config"
+	// KafkaChannelConfigMapName is the ConfigMap of the Kafka cluster of the KafkaChannels.
+	KafkaChannelConfigMapName = "kafka-channel-config"
+
+	bootstrapServersKey    = "bootstrap.servers"
+	authSecretNameKey      = "auth.secret.ref.name"
+	authSecretNamespaceKey = "auth.secret.ref.namespace"
+	replicationFactorKey   = "default.topic.replication.factor"
+	partitionsKey          = "default.topic.partitions"
+)
+
+// KafkaTransform writes the Kafka cluster of spec.kafka to the ConfigMaps of the Kafka broker and
+// channel. The keys set in spec.config are kept.
+func KafkaTransform(instance *eventingv1beta1.KnativeEventing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		kafka := instance.Spec.Kafka
+		if kafka == nil || u.GetKind() != "ConfigMap" {
+			return nil
+		}
+		data := map[string]string{}
+		switch u.GetName() {
+		case KafkaBrokerConfigMapName:
+			if kafka.ReplicationFactor != nil {
+				data[replicationFactorKey] = strconv.Itoa(int(*kafka.ReplicationFactor))
+			}
+			if kafka.Partitions != nil {
+				data[partitionsKey] = strconv.Itoa(int(*kafka.Partitions))
+			}
+			if kafka.AuthSecretRef != nil {
+				data[authSecretNameKey] = kafka.AuthSecretRef.Name
+			}
+		case KafkaChannelConfigMapName:
+			if kafka.AuthSecretRef != nil {
+				data[authSecretNameKey] = kafka.AuthSecretRef.Name
+				data[authSecretNamespaceKey] = instance.GetNamespace()
+			}
+		default:
+			return nil
+		}
+		if len(kafka.BootstrapServers) > 0 {
+			data[bootstrapServersKey] = strings.Join(kafka.BootstrapServers, ",")
+		}
+		config := instance.Spec.GetConfig()[u.GetName()]
+		for key, value := range data {
+			if _, ok := config[key]; ok {
+				continue
+			}
+			if err := unstructured.SetNestedField(u.Object, value, "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func makeKafkaConfigMap(name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("ConfigMap")
+	u.SetName(name)
+	_ = unstructured.SetNestedStringMap(u.Object, map[string]string{
+		bootstrapServersKey: "my-cluster-kafka-bootstrap.kafka:9092",
+	}, "data")
+	return u
+}
+
+func TestKafkaTransform(t *testing.T) {
+	replicas, partitions := int32(1), int32(4)
+	tests := []struct {
+		name            string
+		kafka           *base.KafkaConfiguration
+		config          base.ConfigMapData
+		expectedBroker  map[string]string
+		expectedChannel map[string]string
+	}{{
+		name:            "no kafka",
+		expectedBroker:  map[string]string{bootstrapServersKey: "my-cluster-kafka-bootstrap.kafka:9092"},
+		expectedChannel: map[string]string{bootstrapServersKey: "my-cluster-kafka-bootstrap.kafka:9092"},
+	}, {
+		name: "cluster",
+		kafka: &base.KafkaConfiguration{
+			BootstrapServers:  []string{"kafka-0.kafka:9093", "kafka-1.kafka:9093"},
+			AuthSecretRef:     &corev1.LocalObjectReference{Name: "kafka-auth"},
+			ReplicationFactor: &replicas,
+			Partitions:        &partitions,
+		},
+		expectedBroker: map[string]string{
+			bootstrapServersKey:  "kafka-0.kafka:9093,kafka-1.kafka:9093",
+			authSecretNameKey:    "kafka-auth",
+			replicationFactorKey: "1",
+			partitionsKey:        "4",
+		},
+		expectedChannel: map[string]string{
+			bootstrapServersKey:    "kafka-0.kafka:9093,kafka-1.kafka:9093",
+			authSecretNameKey:      "kafka-auth",
+			authSecretNamespaceKey: "knative-eventing",
+		},
+	}, {
+		name:   "spec.config takes precedence",
+		kafka:  &base.KafkaConfiguration{BootstrapServers: []string{"kafka-0.kafka:9093"}, Partitions: &partitions},
+		config: base.ConfigMapData{KafkaBrokerConfigMapName: {bootstrapServersKey: "other.kafka:9092"}},
+		expectedBroker: map[string]string{
+			bootstrapServersKey: "my-cluster-kafka-bootstrap.kafka:9092",
+			partitionsKey:       "4",
+		},
+		expectedChannel: map[string]string{bootstrapServersKey: "kafka-0.kafka:9093"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &eventingv1beta1.KnativeEventing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+				Spec: eventingv1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Kafka:      tt.kafka,
+				},
+			}
+			broker := makeKafkaConfigMap(KafkaBrokerConfigMapName)
+			channel := makeKafkaConfigMap(KafkaChannelConfigMapName)
+			for _, u := range []*unstructured.Unstructured{broker, channel} {
+				if err := KafkaTransform(instance)(u); err != nil {
+					t.Fatalf("KafkaTransform() = %v", err)
+				}
+			}
+			data, _, _ := unstructured.NestedStringMap(broker.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedBroker)
+			data, _, _ = unstructured.NestedStringMap(channel.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedChannel)
+		})
+	}
+}
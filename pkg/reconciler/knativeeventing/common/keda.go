@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+const (
+	// KafkaFeaturesConfigMapName is the ConfigMap of the feature flags of eventing-kafka-broker.
+	KafkaFeaturesConfigMapName = "config-kafka-features"
+	// KafkaAutoscalerConfigMapName is the ConfigMap of the autoscaling of the Kafka consumers.
+	KafkaAutoscalerConfigMapName = "config-kafka-autoscaler"
+	// KafkaAutoscalerKEDAFeature is the flag of config-kafka-features, which makes the controller of
+	// eventing-kafka-broker create ScaledObjects for the Kafka consumers.
+	KafkaAutoscalerKEDAFeature = "controller-autoscaler-keda"
+
+	kedaGroupVersion = "keda.sh/v1alpha1"
+	scaledObjectKind = "ScaledObject"
+
+	// The bounds and the utilization of the HorizontalPodAutoscalers of the brokers, for the
+	// deployments shipping none.
+	defaultKEDAMinReplicas    = 1
+	defaultKEDAMaxReplicas    = 10
+	defaultKEDACPUUtilization = 70
+)
+
+// KEDATransform enables the autoscaling of the Kafka consumers of spec.keda.kafka in
+// config-kafka-features and writes its settings to config-kafka-autoscaler, keeping the keys set in
+// spec.config. The deployments scaled by the ScaledObjects of spec.keda.workloads lose their
+// replicas, which KEDA sets instead.
+func KEDATransform(instance *eventingv1beta1.KnativeEventing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		keda := instance.Spec.KEDA
+		if keda == nil {
+			return nil
+		}
+		if u.GetKind() == "Deployment" {
+			if kedaWorkload(keda, u.GetName()) != nil {
+				unstructured.RemoveNestedField(u.Object, "spec", "replicas")
+			}
+			return nil
+		}
+		if u.GetKind() != "ConfigMap" || keda.Kafka == nil {
+			return nil
+		}
+		var data map[string]string
+		switch u.GetName() {
+		case KafkaFeaturesConfigMapName:
+			data = map[string]string{KafkaAutoscalerKEDAFeature: "enabled"}
+		case KafkaAutoscalerConfigMapName:
+			data = kafkaAutoscalerConfig(keda.Kafka)
+		default:
+			return nil
+		}
+		// The "config-" prefix is optional
+		config := instance.Spec.GetConfig()
+		for key, value := range data {
+			if _, ok := config[u.GetName()][key]; ok {
+				continue
+			}
+			if _, ok := config[strings.TrimPrefix(u.GetName(), "config-")][key]; ok {
+				continue
+			}
+			if err := unstructured.SetNestedField(u.Object, value, "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func kafkaAutoscalerConfig(kafka *base.KEDAKafkaConfiguration) map[string]string {
+	data := map[string]string{}
+	for key, value := range map[string]*int32{
+		"min-scale":        kafka.MinReplicas,
+		"max-scale":        kafka.MaxReplicas,
+		"polling-interval": kafka.PollingInterval,
+		"cooldown-period":  kafka.CooldownPeriod,
+	} {
+		if value != nil {
+			data[key] = strconv.Itoa(int(*value))
+		}
+	}
+	if kafka.LagThreshold != nil {
+		data["lag-threshold"] = strconv.FormatInt(*kafka.LagThreshold, 10)
+	}
+	return data
+}
+
+func kedaWorkload(keda *base.KEDAConfiguration, name string) *base.KEDAWorkloadConfiguration {
+	for i := range keda.Workloads {
+		if keda.Workloads[i].Name == name {
+			return &keda.Workloads[i]
+		}
+	}
+	return nil
+}
+
+// hpaTarget returns the name of the deployment, which the HorizontalPodAutoscaler scales.
+func hpaTarget(u *unstructured.Unstructured) string {
+	kind, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "kind")
+	if kind != "Deployment" {
+		return ""
+	}
+	name, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "name")
+	return name
+}
+
+// KEDAScaledHPAs matches the HorizontalPodAutoscalers of the deployments of spec.keda.workloads,
+// which are left out, as KEDA does not scale a deployment with another autoscaler.
+func KEDAScaledHPAs(ke *eventingv1beta1.KnativeEventing) mf.Predicate {
+	return func(u *unstructured.Unstructured) bool {
+		if ke.Spec.KEDA == nil || u.GetKind() != "HorizontalPodAutoscaler" {
+			return false
+		}
+		return kedaWorkload(ke.Spec.KEDA, hpaTarget(u)) != nil
+	}
+}
+
+// AppendKEDAScaledObjects appends a ScaledObject for each deployment of spec.keda.workloads to the
+// manifest. The settings left unset are taken from the HorizontalPodAutoscaler of the deployment in
+// the manifest, if any.
+func AppendKEDAScaledObjects(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+	if !ok || ke.Spec.KEDA == nil || len(ke.Spec.KEDA.Workloads) == 0 {
+		return nil
+	}
+	hpas := map[string]*unstructured.Unstructured{}
+	for _, u := range manifest.Filter(mf.ByKind("HorizontalPodAutoscaler")).Resources() {
+		hpas[hpaTarget(&u)] = &u
+	}
+	objects := make([]unstructured.Unstructured, 0, len(ke.Spec.KEDA.Workloads))
+	for i := range ke.Spec.KEDA.Workloads {
+		objects = append(objects, *scaledObject(&ke.Spec.KEDA.Workloads[i], hpas[ke.Spec.KEDA.Workloads[i].Name]))
+	}
+	m, err := mf.ManifestFrom(mf.Slice(objects))
+	if err != nil {
+		return err
+	}
+	*manifest = manifest.Append(m)
+	return nil
+}
+
+func scaledObject(workload *base.KEDAWorkloadConfiguration, hpa *unstructured.Unstructured) *unstructured.Unstructured {
+	minReplicas, maxReplicas, utilization := int64(defaultKEDAMinReplicas), int64(defaultKEDAMaxReplicas), int64(defaultKEDACPUUtilization)
+	if hpa != nil {
+		if value, ok, _ := unstructured.NestedInt64(hpa.Object, "spec", "minReplicas"); ok {
+			minReplicas = value
+		}
+		if value, ok, _ := unstructured.NestedInt64(hpa.Object, "spec", "maxReplicas"); ok {
+			maxReplicas = value
+		}
+		metrics, _, _ := unstructured.NestedSlice(hpa.Object, "spec", "metrics")
+		for _, metric := range metrics {
+			metric, ok := metric.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, _, _ := unstructured.NestedString(metric, "resource", "name"); name != "cpu" {
+				continue
+			}
+			if value, ok, _ := unstructured.NestedInt64(metric, "resource", "target", "averageUtilization"); ok {
+				utilization = value
+			}
+		}
+	}
+	if workload.MinReplicas != nil {
+		minReplicas = int64(*workload.MinReplicas)
+	}
+	if workload.MaxReplicas != nil {
+		maxReplicas = int64(*workload.MaxReplicas)
+	}
+	if workload.CPUUtilization != nil {
+		utilization = int64(*workload.CPUUtilization)
+	}
+
+	spec := map[string]interface{}{
+		"scaleTargetRef": map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       workload.Name,
+		},
+		"minReplicaCount": minReplicas,
+		"maxReplicaCount": maxReplicas,
+		"triggers": []interface{}{map[string]interface{}{
+			"type":       "cpu",
+			"metricType": "Utilization",
+			"metadata":   map[string]interface{}{"value": strconv.FormatInt(utilization, 10)},
+		}},
+	}
+	if workload.PollingInterval != nil {
+		spec["pollingInterval"] = int64(*workload.PollingInterval)
+	}
+	if workload.CooldownPeriod != nil {
+		spec["cooldownPeriod"] = int64(*workload.CooldownPeriod)
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetAPIVersion(kedaGroupVersion)
+	u.SetKind(scaledObjectKind)
+	u.SetName(workload.Name)
+	return u
+}
+
+// CheckKEDA returns a Stage, which validates that KEDA is installed in the cluster, before the
+// autoscaling of spec.keda is handed over to it.
+func CheckKEDA(kubeClient kubernetes.Interface) common.Stage {
+	return func(_ context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+		if !ok || ke.Spec.KEDA == nil {
+			return nil
+		}
+		required := sets.New[string]()
+		if len(ke.Spec.KEDA.Workloads) > 0 {
+			required.Insert(scaledObjectKind)
+		}
+		if ke.Spec.KEDA.Kafka != nil {
+			// The Kafka controller authenticates the scalers of the consumers with TriggerAuthentications.
+			required.Insert(scaledObjectKind, "TriggerAuthentication")
+		}
+		if required.Len() == 0 {
+			return nil
+		}
+		missing, err := missingKinds(kubeClient, kedaGroupVersion, required)
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			msg := fmt.Sprintf("KEDA is not installed, the API %s is not served for %s",
+				kedaGroupVersion, strings.Join(missing, ", "))
+			instance.GetStatus().MarkInstallFailed(msg)
+			return fmt.Errorf("%s", msg)
+		}
+		return nil
+	}
+}
+
+// DeleteKEDAScaledHPAs returns a Stage, which deletes the HorizontalPodAutoscalers of the
+// deployments of spec.keda.workloads from the cluster, before their ScaledObjects are installed.
+// KEDA rejects a ScaledObject for a deployment, which another autoscaler already scales.
+func DeleteKEDAScaledHPAs(kubeClient kubernetes.Interface) common.Stage {
+	return func(ctx context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+		if !ok || ke.Spec.KEDA == nil || len(ke.Spec.KEDA.Workloads) == 0 {
+			return nil
+		}
+		client := kubeClient.AutoscalingV2().HorizontalPodAutoscalers(ke.GetNamespace())
+		hpas, err := client.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list the HorizontalPodAutoscalers: %w", err)
+		}
+		for _, hpa := range hpas.Items {
+			ref := hpa.Spec.ScaleTargetRef
+			if ref.Kind != "Deployment" || kedaWorkload(ke.Spec.KEDA, ref.Name) == nil || scaledByKEDA(hpa.GetOwnerReferences()) {
+				continue
+			}
+			if err := client.Delete(ctx, hpa.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete the HorizontalPodAutoscaler %s: %w", hpa.Name, err)
+			}
+		}
+		return nil
+	}
+}
+
+// scaledByKEDA returns whether KEDA owns a HorizontalPodAutoscaler, which it creates for each
+// ScaledObject.
+func scaledByKEDA(owners []metav1.OwnerReference) bool {
+	for _, owner := range owners {
+		if owner.APIVersion == kedaGroupVersion && owner.Kind == scaledObjectKind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func kedaInstance(keda *base.KEDAConfiguration, config base.ConfigMapData) *v1beta1.KnativeEventing {
+	return &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+		Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec: base.CommonSpec{Config: config},
+			KEDA:       keda,
+		},
+	}
+}
+
+func TestKEDATransform(t *testing.T) {
+	kafka := &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{
+		KEDAScalingConfiguration: base.KEDAScalingConfiguration{MinReplicas: ptr.Int32(1), MaxReplicas: ptr.Int32(20)},
+		LagThreshold:             ptr.Int64(50),
+	}}
+	tests := []struct {
+		name      string
+		keda      *base.KEDAConfiguration
+		config    base.ConfigMapData
+		configMap string
+		expected  map[string]string
+	}{{
+		name:      "no keda",
+		configMap: KafkaAutoscalerConfigMapName,
+		expected:  map[string]string{"min-scale": "0", "max-scale": "50"},
+	}, {
+		name:      "autoscaler",
+		keda:      kafka,
+		configMap: KafkaAutoscalerConfigMapName,
+		expected:  map[string]string{"min-scale": "1", "max-scale": "20", "lag-threshold": "50"},
+	}, {
+		name:      "keys of spec.config",
+		keda:      kafka,
+		config:    base.ConfigMapData{"kafka-autoscaler": {"max-scale": "30"}},
+		configMap: KafkaAutoscalerConfigMapName,
+		expected:  map[string]string{"min-scale": "1", "max-scale": "50", "lag-threshold": "50"},
+	}, {
+		name:      "features",
+		keda:      kafka,
+		configMap: KafkaFeaturesConfigMapName,
+		expected:  map[string]string{"min-scale": "0", "max-scale": "50", KafkaAutoscalerKEDAFeature: "enabled"},
+	}, {
+		name:      "only workloads",
+		keda:      &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress"}}},
+		configMap: KafkaFeaturesConfigMapName,
+		expected:  map[string]string{"min-scale": "0", "max-scale": "50"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			u.SetName(tt.configMap)
+			_ = unstructured.SetNestedStringMap(u.Object, map[string]string{"min-scale": "0", "max-scale": "50"}, "data")
+			if err := KEDATransform(kedaInstance(tt.keda, tt.config))(u); err != nil {
+				t.Fatalf("KEDATransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
+
+func TestKEDATransformDeployment(t *testing.T) {
+	keda := &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress"}}}
+	for name, expected := range map[string]bool{"mt-broker-ingress": false, "mt-broker-filter": true} {
+		u := &unstructured.Unstructured{}
+		u.SetKind("Deployment")
+		u.SetName(name)
+		_ = unstructured.SetNestedField(u.Object, int64(1), "spec", "replicas")
+		if err := KEDATransform(kedaInstance(keda, nil))(u); err != nil {
+			t.Fatalf("KEDATransform() = %v", err)
+		}
+		_, found, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		util.AssertEqual(t, found, expected)
+	}
+}
+
+func hpa(name, target string) unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": target},
+			"minReplicas":    int64(2),
+			"maxReplicas":    int64(5),
+			"metrics": []interface{}{map[string]interface{}{
+				"type": "Resource",
+				"resource": map[string]interface{}{
+					"name":   "cpu",
+					"target": map[string]interface{}{"type": "Utilization", "averageUtilization": int64(60)},
+				},
+			}},
+		},
+	}}
+	u.SetAPIVersion("autoscaling/v2")
+	u.SetKind("HorizontalPodAutoscaler")
+	u.SetName(name)
+	return u
+}
+
+func TestAppendKEDAScaledObjects(t *testing.T) {
+	keda := &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{
+		Name:                     "mt-broker-ingress",
+		KEDAScalingConfiguration: base.KEDAScalingConfiguration{MaxReplicas: ptr.Int32(20), CooldownPeriod: ptr.Int32(60)},
+	}, {
+		Name:           "imc-dispatcher",
+		CPUUtilization: ptr.Int32(80),
+	}}}
+	manifest, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{hpa("broker-ingress-hpa", "mt-broker-ingress")}))
+	instance := kedaInstance(keda, nil)
+	if err := AppendKEDAScaledObjects(context.Background(), &manifest, instance); err != nil {
+		t.Fatalf("AppendKEDAScaledObjects() = %v", err)
+	}
+
+	objects := manifest.Filter(mf.ByKind(scaledObjectKind)).Resources()
+	util.AssertEqual(t, len(objects), 2)
+	expected := map[string]map[string]interface{}{
+		"mt-broker-ingress": {"min": int64(2), "max": int64(20), "cpu": "60", "cooldown": int64(60)},
+		"imc-dispatcher":    {"min": int64(1), "max": int64(10), "cpu": "80"},
+	}
+	for _, u := range objects {
+		want := expected[u.GetName()]
+		target, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "name")
+		util.AssertEqual(t, target, u.GetName())
+		minReplicas, _, _ := unstructured.NestedInt64(u.Object, "spec", "minReplicaCount")
+		util.AssertEqual(t, minReplicas, want["min"])
+		maxReplicas, _, _ := unstructured.NestedInt64(u.Object, "spec", "maxReplicaCount")
+		util.AssertEqual(t, maxReplicas, want["max"])
+		cooldown, found, _ := unstructured.NestedInt64(u.Object, "spec", "cooldownPeriod")
+		if want["cooldown"] != nil || found {
+			util.AssertEqual(t, cooldown, want["cooldown"])
+		}
+		triggers, _, _ := unstructured.NestedSlice(u.Object, "spec", "triggers")
+		value, _, _ := unstructured.NestedString(triggers[0].(map[string]interface{}), "metadata", "value")
+		util.AssertEqual(t, value, want["cpu"])
+	}
+
+	filtered := manifest.Filter(mf.Not(KEDAScaledHPAs(instance)))
+	util.AssertEqual(t, len(filtered.Filter(mf.ByKind("HorizontalPodAutoscaler")).Resources()), 0)
+}
+
+func TestCheckKEDA(t *testing.T) {
+	tests := []struct {
+		name        string
+		keda        *base.KEDAConfiguration
+		served      []string
+		expectedErr string
+	}{{
+		name: "no keda",
+	}, {
+		name:        "keda not installed",
+		keda:        &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress"}}},
+		expectedErr: "KEDA is not installed, the API keda.sh/v1alpha1 is not served for ScaledObject",
+	}, {
+		name:        "no trigger authentications for kafka",
+		keda:        &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{}},
+		served:      []string{"ScaledObject"},
+		expectedErr: "KEDA is not installed, the API keda.sh/v1alpha1 is not served for TriggerAuthentication",
+	}, {
+		name:   "keda installed",
+		keda:   &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{}},
+		served: []string{"ScaledObject", "TriggerAuthentication"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			if len(tt.served) > 0 {
+				resources := &metav1.APIResourceList{GroupVersion: "keda.sh/v1alpha1"}
+				for _, kind := range tt.served {
+					resources.APIResources = append(resources.APIResources, metav1.APIResource{Kind: kind})
+				}
+				kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{resources}
+			}
+			instance := kedaInstance(tt.keda, nil)
+			instance.Status.InitializeConditions()
+			manifest, _ := mf.ManifestFrom(mf.Slice{})
+
+			err := CheckKEDA(kubeClient)(context.Background(), &manifest, instance)
+			if tt.expectedErr == "" {
+				util.AssertEqual(t, err, nil)
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.expectedErr) {
+				t.Fatalf("CheckKEDA() = %v, want it to start with %q", err, tt.expectedErr)
+			}
+			util.AssertEqual(t, instance.Status.IsReady(), false)
+		})
+	}
+}
+
+func TestDeleteKEDAScaledHPAs(t *testing.T) {
+	hpa := func(name, target string, owners ...metav1.OwnerReference) *autoscalingv2.HorizontalPodAutoscaler {
+		return &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: name, OwnerReferences: owners},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: target},
+			},
+		}
+	}
+	kubeClient := kubefake.NewSimpleClientset(
+		hpa("broker-ingress-hpa", "mt-broker-ingress"),
+		hpa("broker-filter-hpa", "mt-broker-filter"),
+		hpa("keda-hpa-mt-broker-ingress", "mt-broker-ingress", metav1.OwnerReference{APIVersion: "keda.sh/v1alpha1", Kind: "ScaledObject", Name: "mt-broker-ingress"}),
+	)
+	instance := kedaInstance(&base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress"}}}, nil)
+	manifest, _ := mf.ManifestFrom(mf.Slice{})
+	if err := DeleteKEDAScaledHPAs(kubeClient)(context.Background(), &manifest, instance); err != nil {
+		t.Fatalf("DeleteKEDAScaledHPAs() = %v", err)
+	}
+
+	hpas, _ := kubeClient.AutoscalingV2().HorizontalPodAutoscalers("knative-eventing").List(context.Background(), metav1.ListOptions{})
+	var names []string
+	for _, hpa := range hpas.Items {
+		names = append(names, hpa.Name)
+	}
+	util.AssertDeepEqual(t, names, []string{"broker-filter-hpa", "keda-hpa-mt-broker-ingress"})
+}
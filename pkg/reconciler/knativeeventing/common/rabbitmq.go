@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	// RabbitMQBrokerClass is the broker class of eventing-rabbitmq.
+	RabbitMQBrokerClass = "RabbitMQBroker"
+	// DefaultRabbitMQBrokerConfigName is the RabbitmqBrokerConfig, which the operator creates for
+	// the RabbitmqCluster of spec.rabbitmq.clusterRef.
+	DefaultRabbitMQBrokerConfigName = "default-rabbitmq-broker-config"
+
+	rabbitMQBrokerConfigAPIVersion = "eventing.knative.dev/v1alpha1"
+	rabbitMQBrokerConfigKind       = "RabbitmqBrokerConfig"
+	// rabbitMQGroupVersion is served by the RabbitMQ cluster operator and the messaging topology operator.
+	rabbitMQGroupVersion = "rabbitmq.com/v1beta1"
+)
+
+// AppendRabbitMQBrokerConfig appends the RabbitmqBrokerConfig of spec.rabbitmq.clusterRef to the
+// manifest, after the manifests defining its CRD.
+func AppendRabbitMQBrokerConfig(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+	if !ok || ke.Spec.RabbitMQ == nil || ke.Spec.RabbitMQ.ClusterRef == nil {
+		return nil
+	}
+	m, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*rabbitMQBrokerConfig(ke)}))
+	if err != nil {
+		return err
+	}
+	*manifest = manifest.Append(m)
+	return nil
+}
+
+func rabbitMQBrokerConfig(ke *eventingv1beta1.KnativeEventing) *unstructured.Unstructured {
+	ref := ke.Spec.RabbitMQ.ClusterRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = ke.GetNamespace()
+	}
+	spec := map[string]interface{}{
+		"rabbitmqClusterReference": map[string]interface{}{
+			"name":      ref.Name,
+			"namespace": namespace,
+		},
+	}
+	if ke.Spec.RabbitMQ.QueueType != "" {
+		spec["queueType"] = ke.Spec.RabbitMQ.QueueType
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetAPIVersion(rabbitMQBrokerConfigAPIVersion)
+	u.SetKind(rabbitMQBrokerConfigKind)
+	u.SetName(DefaultRabbitMQBrokerConfigName)
+	u.SetNamespace(ke.GetNamespace())
+	return u
+}
+
+// defaultRabbitMQBrokerConfig returns the reference to the RabbitmqBrokerConfig of
+// spec.rabbitmq.clusterRef, if the RabbitMQBroker is the default broker class, nil otherwise.
+func defaultRabbitMQBrokerConfig(ke *eventingv1beta1.KnativeEventing) *duckv1.KReference {
+	if ke.Spec.DefaultBrokerClass != RabbitMQBrokerClass || ke.Spec.RabbitMQ == nil || ke.Spec.RabbitMQ.ClusterRef == nil {
+		return nil
+	}
+	return &duckv1.KReference{
+		APIVersion: rabbitMQBrokerConfigAPIVersion,
+		Kind:       rabbitMQBrokerConfigKind,
+		Name:       DefaultRabbitMQBrokerConfigName,
+		Namespace:  ke.GetNamespace(),
+	}
+}
+
+// CheckRabbitMQ returns a Stage, which validates that the RabbitMQ operators serve the APIs needed
+// by the components of spec.rabbitmq: the RabbitmqCluster for spec.rabbitmq.clusterRef, and the
+// topology of the queues for the broker.
+func CheckRabbitMQ(kubeClient kubernetes.Interface) common.Stage {
+	return func(_ context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+		if !ok || ke.Spec.RabbitMQ == nil {
+			return nil
+		}
+		required := sets.New[string]()
+		if ke.Spec.RabbitMQ.ClusterRef != nil {
+			required.Insert("RabbitmqCluster")
+		}
+		if ke.Spec.RabbitMQ.Broker != nil && ke.Spec.RabbitMQ.Broker.Enabled {
+			required.Insert("Binding", "Exchange", "Queue")
+		}
+		if required.Len() == 0 {
+			return nil
+		}
+
+		missing, err := missingKinds(kubeClient, rabbitMQGroupVersion, required)
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			msg := fmt.Sprintf("the RabbitMQ operators are not installed, the API %s is not served for %s",
+				rabbitMQGroupVersion, strings.Join(missing, ", "))
+			instance.GetStatus().MarkInstallFailed(msg)
+			return fmt.Errorf("%s", msg)
+		}
+		return nil
+	}
+}
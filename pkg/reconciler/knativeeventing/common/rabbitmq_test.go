@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func rabbitMQInstance(class string, rabbitmq *base.RabbitMQConfiguration, brokerConfig *base.BrokerConfiguration) *v1beta1.KnativeEventing {
+	return &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+		Spec: v1beta1.KnativeEventingSpec{
+			DefaultBrokerClass:  class,
+			DefaultBrokerConfig: brokerConfig,
+			RabbitMQ:            rabbitmq,
+		},
+	}
+}
+
+func TestAppendRabbitMQBrokerConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		rabbitmq *base.RabbitMQConfiguration
+		expected []map[string]interface{}
+	}{{
+		name:     "no cluster",
+		rabbitmq: &base.RabbitMQConfiguration{Broker: &base.EventingComponentConfiguration{Enabled: true}},
+	}, {
+		name:     "cluster in the namespace of Knative Eventing",
+		rabbitmq: &base.RabbitMQConfiguration{ClusterRef: &base.RabbitMQClusterReference{Name: "rabbitmq"}},
+		expected: []map[string]interface{}{{
+			"rabbitmqClusterReference": map[string]interface{}{"name": "rabbitmq", "namespace": "knative-eventing"},
+		}},
+	}, {
+		name: "cluster in another namespace",
+		rabbitmq: &base.RabbitMQConfiguration{
+			ClusterRef: &base.RabbitMQClusterReference{Name: "rabbitmq", Namespace: "rabbitmq-system"},
+			QueueType:  "quorum",
+		},
+		expected: []map[string]interface{}{{
+			"rabbitmqClusterReference": map[string]interface{}{"name": "rabbitmq", "namespace": "rabbitmq-system"},
+			"queueType":                "quorum",
+		}},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, _ := mf.ManifestFrom(mf.Slice{})
+			if err := AppendRabbitMQBrokerConfig(context.Background(), &manifest, rabbitMQInstance("", tt.rabbitmq, nil)); err != nil {
+				t.Fatalf("AppendRabbitMQBrokerConfig() = %v", err)
+			}
+			var specs []map[string]interface{}
+			for _, u := range manifest.Resources() {
+				util.AssertEqual(t, u.GetKind(), "RabbitmqBrokerConfig")
+				util.AssertEqual(t, u.GetName(), DefaultRabbitMQBrokerConfigName)
+				specs = append(specs, u.Object["spec"].(map[string]interface{}))
+			}
+			util.AssertDeepEqual(t, specs, tt.expected)
+		})
+	}
+}
+
+func TestRabbitMQBrokerConfig(t *testing.T) {
+	cluster := &base.RabbitMQConfiguration{ClusterRef: &base.RabbitMQClusterReference{Name: "rabbitmq"}}
+	ref := &duckv1.KReference{APIVersion: "eventing.knative.dev/v1alpha1", Kind: "RabbitmqBrokerConfig", Name: DefaultRabbitMQBrokerConfigName, Namespace: "knative-eventing"}
+	own := &duckv1.KReference{APIVersion: "eventing.knative.dev/v1alpha1", Kind: "RabbitmqBrokerConfig", Name: "own", Namespace: "default"}
+	delivery := &base.BrokerDeliveryConfiguration{Retry: ptr.Int32(3)}
+
+	tests := []struct {
+		name         string
+		class        string
+		brokerConfig *base.BrokerConfiguration
+		expected     *base.BrokerConfiguration
+	}{{
+		name:  "other broker class",
+		class: "MTChannelBasedBroker",
+	}, {
+		name:     "default RabbitMQBroker",
+		class:    RabbitMQBrokerClass,
+		expected: &base.BrokerConfiguration{Config: ref},
+	}, {
+		name:         "with a delivery",
+		class:        RabbitMQBrokerClass,
+		brokerConfig: &base.BrokerConfiguration{Delivery: delivery},
+		expected:     &base.BrokerConfiguration{Config: ref, Delivery: delivery},
+	}, {
+		name:         "configuration of its own",
+		class:        RabbitMQBrokerClass,
+		brokerConfig: &base.BrokerConfiguration{Config: own},
+		expected:     &base.BrokerConfiguration{Config: own},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			util.AssertDeepEqual(t, brokerConfig(rabbitMQInstance(tt.class, cluster, tt.brokerConfig)), tt.expected)
+		})
+	}
+}
+
+func TestCheckRabbitMQ(t *testing.T) {
+	enabled := &base.EventingComponentConfiguration{Enabled: true}
+	tests := []struct {
+		name        string
+		rabbitmq    *base.RabbitMQConfiguration
+		served      []string
+		expectedErr string
+	}{{
+		name: "no rabbitmq",
+	}, {
+		name:     "source only",
+		rabbitmq: &base.RabbitMQConfiguration{Source: enabled},
+	}, {
+		name:     "installed",
+		rabbitmq: &base.RabbitMQConfiguration{ClusterRef: &base.RabbitMQClusterReference{Name: "rabbitmq"}, Broker: enabled},
+		served:   []string{"Binding", "Exchange", "Queue", "RabbitmqCluster"},
+	}, {
+		name:        "cluster operator not installed",
+		rabbitmq:    &base.RabbitMQConfiguration{ClusterRef: &base.RabbitMQClusterReference{Name: "rabbitmq"}, Broker: enabled},
+		served:      []string{"Binding", "Exchange", "Queue"},
+		expectedErr: "the RabbitMQ operators are not installed, the API rabbitmq.com/v1beta1 is not served for RabbitmqCluster",
+	}, {
+		name:        "nothing installed",
+		rabbitmq:    &base.RabbitMQConfiguration{Broker: enabled},
+		expectedErr: "the RabbitMQ operators are not installed, the API rabbitmq.com/v1beta1 is not served for Binding, Exchange, Queue",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			if len(tt.served) > 0 {
+				resources := &metav1.APIResourceList{GroupVersion: "rabbitmq.com/v1beta1"}
+				for _, kind := range tt.served {
+					resources.APIResources = append(resources.APIResources, metav1.APIResource{Kind: kind})
+				}
+				kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{resources}
+			}
+			instance := rabbitMQInstance("", tt.rabbitmq, nil)
+			instance.Status.InitializeConditions()
+			manifest, _ := mf.ManifestFrom(mf.Slice{})
+
+			err := CheckRabbitMQ(kubeClient)(context.Background(), &manifest, instance)
+			if tt.expectedErr == "" {
+				util.AssertEqual(t, err, nil)
+				return
+			}
+			if err == nil {
+				t.Fatalf("CheckRabbitMQ() = nil, want %q", tt.expectedErr)
+			}
+			util.AssertEqual(t, err.Error(), tt.expectedErr)
+			util.AssertEqual(t, instance.Status.IsReady(), false)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const (
+	// SugarConfigMapName is the ConfigMap of the sugar reconciler.
+	SugarConfigMapName = "config-sugar"
+	// NamespaceSelectorKey is the key of the namespace selector in config-sugar.
+	NamespaceSelectorKey = "namespace-selector"
+	// TriggerSelectorKey is the key of the trigger selector in config-sugar.
+	TriggerSelectorKey = "trigger-selector"
+)
+
+// SugarTransform writes the selectors of spec.sugar to config-sugar. The keys set in spec.config
+// are kept, with or without the "config-" prefix of its name.
+func SugarTransform(instance *eventingv1beta1.KnativeEventing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		sugar := instance.Spec.Sugar
+		if sugar == nil || u.GetKind() != "ConfigMap" || u.GetName() != SugarConfigMapName {
+			return nil
+		}
+		config := instance.Spec.GetConfig()
+		for key, selector := range map[string]*metav1.LabelSelector{
+			NamespaceSelectorKey: sugar.NamespaceSelector,
+			TriggerSelectorKey:   sugar.TriggerSelector,
+		} {
+			if selector == nil {
+				continue
+			}
+			if _, ok := config["sugar"][key]; ok {
+				continue
+			}
+			if _, ok := config[SugarConfigMapName][key]; ok {
+				continue
+			}
+			value, err := json.Marshal(selector)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the %s: %w", key, err)
+			}
+			if err := unstructured.SetNestedField(u.Object, string(value), "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestSugarTransform(t *testing.T) {
+	tests := []struct {
+		name     string
+		sugar    *base.SugarConfiguration
+		config   base.ConfigMapData
+		expected map[string]string
+	}{{
+		name:     "no sugar",
+		expected: map[string]string{"_example": ""},
+	}, {
+		name: "all namespaces and labeled triggers",
+		sugar: &base.SugarConfiguration{
+			NamespaceSelector: &metav1.LabelSelector{},
+			TriggerSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"eventing.knative.dev/autocreate": "true"}},
+		},
+		expected: map[string]string{
+			"_example":           "",
+			NamespaceSelectorKey: "{}",
+			TriggerSelectorKey:   `{"matchLabels":{"eventing.knative.dev/autocreate":"true"}}`,
+		},
+	}, {
+		name:   "selector of spec.config",
+		sugar:  &base.SugarConfiguration{NamespaceSelector: &metav1.LabelSelector{}, TriggerSelector: &metav1.LabelSelector{}},
+		config: base.ConfigMapData{"sugar": {NamespaceSelectorKey: `{"matchLabels":{"team":"a"}}`}},
+		expected: map[string]string{
+			"_example":         "",
+			TriggerSelectorKey: "{}",
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &eventingv1beta1.KnativeEventing{
+				Spec: eventingv1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Sugar:      tt.sugar,
+				},
+			}
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			u.SetName(SugarConfigMapName)
+			_ = unstructured.SetNestedStringMap(u.Object, map[string]string{"_example": ""}, "data")
+			if err := SugarTransform(instance)(u); err != nil {
+				t.Fatalf("SugarTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/eventing/pkg/apis/feature"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+const (
+	// CAIssuerName is the ClusterIssuer, which the certificates of Knative Eventing refer to.
+	CAIssuerName = "knative-eventing-ca-issuer"
+	// SelfSignedIssuerName is the ClusterIssuer, which issues the self-signed CA of the operator.
+	SelfSignedIssuerName = "knative-eventing-selfsigned-issuer"
+	// CACertificateName is the Certificate of the self-signed CA, and the secret it is stored in.
+	CACertificateName = "knative-eventing-ca"
+	// TrustBundleName is the trust-manager Bundle of the CA, and the ConfigMaps it creates.
+	TrustBundleName = "knative-eventing-trust-bundle"
+
+	certManagerGroup        = "cert-manager.io"
+	certManagerGroupVersion = "cert-manager.io/v1"
+	trustManagerAPIVersion  = "trust.cert-manager.io/v1alpha1"
+	clusterIssuerKind       = "ClusterIssuer"
+	certificateKind         = "Certificate"
+	trustBundleKind         = "Bundle"
+	caKey                   = "ca.crt"
+	// trustBundleLabel marks the ConfigMaps with the CAs, which the Knative components trust.
+	trustBundleLabel = "networking.knative.dev/trust-bundle"
+
+	defaultCANamespace   = "cert-manager"
+	defaultCADuration    = 8760 * time.Hour
+	defaultCARenewBefore = 720 * time.Hour
+)
+
+var certificateGVK = schema.GroupVersionKind{Group: certManagerGroup, Version: "v1", Kind: certificateKind}
+
+// TransportEncryptionEnabled returns whether the transport-encryption of config-features is
+// permissive or strict. spec.config takes precedence over spec.features, which takes precedence
+// over spec.transportEncryption.
+func TransportEncryptionEnabled(ke *eventingv1beta1.KnativeEventing) bool {
+	mode, ok := configuredFeature(ke, feature.TransportEncryption)
+	if !ok && ke.Spec.TransportEncryption != nil {
+		mode = ke.Spec.TransportEncryption.Mode
+	}
+	flags, err := feature.NewFlagsConfigFromConfigMap(&corev1.ConfigMap{Data: map[string]string{feature.TransportEncryption: mode}})
+	if err != nil {
+		return false
+	}
+	return flags.IsPermissiveTransportEncryption() || flags.IsStrictTransportEncryption()
+}
+
+// configuredFeature returns the value of the feature flag in spec.config, with or without the
+// "config-" prefix of config-features, or else in spec.features.
+func configuredFeature(ke *eventingv1beta1.KnativeEventing, key string) (string, bool) {
+	config := ke.Spec.GetConfig()
+	for _, name := range []string{"features", common.FeaturesConfigMapName} {
+		if value, ok := config[name][key]; ok {
+			return value, true
+		}
+	}
+	value, ok := ke.Spec.GetFeatures()[key]
+	return value, ok
+}
+
+// AppendTransportEncryption appends the self-signed CA of spec.transportEncryption, unless it refers
+// to an issuer of its own, and the trust-manager Bundle of spec.transportEncryption.trustBundle to
+// the manifest. They are removed with the certificates of Knative Eventing, when the transport
+// encryption is disabled.
+func AppendTransportEncryption(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+	if !ok || ke.Spec.TransportEncryption == nil {
+		return nil
+	}
+	var resources []unstructured.Unstructured
+	if ke.Spec.TransportEncryption.IssuerRef == nil {
+		resources = append(resources, selfSignedCA(ke)...)
+	}
+	if ke.Spec.TransportEncryption.TrustBundle != nil {
+		bundle, err := trustBundle(ke)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, *bundle)
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	m, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return err
+	}
+	*manifest = manifest.Append(m)
+	return nil
+}
+
+// selfSignedCA returns the ClusterIssuer knative-eventing-ca-issuer, issuing from a CA signed by
+// itself. The CA keeps its private key, when cert-manager renews it, so that the certificates issued
+// before stay trusted until they are renewed too.
+func selfSignedCA(ke *eventingv1beta1.KnativeEventing) []unstructured.Unstructured {
+	namespace, duration, renewBefore := defaultCANamespace, defaultCADuration, defaultCARenewBefore
+	if ca := ke.Spec.TransportEncryption.CA; ca != nil {
+		if ca.Namespace != "" {
+			namespace = ca.Namespace
+		}
+		if ca.Duration != nil {
+			duration = ca.Duration.Duration
+		}
+		if ca.RenewBefore != nil {
+			renewBefore = ca.RenewBefore.Duration
+		}
+	}
+	selfSigned := certManagerResource(clusterIssuerKind, SelfSignedIssuerName, "", map[string]interface{}{
+		"selfSigned": map[string]interface{}{},
+	})
+	certificate := certManagerResource(certificateKind, CACertificateName, namespace, map[string]interface{}{
+		"isCA":        true,
+		"commonName":  CACertificateName,
+		"secretName":  CACertificateName,
+		"duration":    duration.String(),
+		"renewBefore": renewBefore.String(),
+		"privateKey": map[string]interface{}{
+			"algorithm":      "ECDSA",
+			"size":           int64(256),
+			"rotationPolicy": "Never",
+		},
+		"issuerRef": map[string]interface{}{
+			"name":  SelfSignedIssuerName,
+			"kind":  clusterIssuerKind,
+			"group": certManagerGroup,
+		},
+	})
+	issuer := certManagerResource(clusterIssuerKind, CAIssuerName, "", map[string]interface{}{
+		"ca": map[string]interface{}{"secretName": CACertificateName},
+	})
+	return []unstructured.Unstructured{*selfSigned, *certificate, *issuer}
+}
+
+func certManagerResource(kind, name, namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetAPIVersion(certManagerGroupVersion)
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	return u
+}
+
+// trustBundle returns the trust-manager Bundle, which copies the CA into a ConfigMap labeled as a
+// trust bundle, in each of the selected namespaces.
+func trustBundle(ke *eventingv1beta1.KnativeEventing) (*unstructured.Unstructured, error) {
+	config := ke.Spec.TransportEncryption.TrustBundle
+	secret := map[string]interface{}{"name": CACertificateName, "key": caKey}
+	if config.Source != nil {
+		secret["name"] = config.Source.SecretName
+		if config.Source.Key != "" {
+			secret["key"] = config.Source.Key
+		}
+	}
+	selector := map[string]interface{}{
+		"matchLabels": map[string]interface{}{corev1.LabelMetadataName: ke.GetNamespace()},
+	}
+	if config.NamespaceSelector != nil {
+		converted, err := runtime.DefaultUnstructuredConverter.ToUnstructured(config.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the namespaceSelector of the trust bundle: %w", err)
+		}
+		selector = converted
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"sources": []interface{}{map[string]interface{}{"secret": secret}},
+			"target": map[string]interface{}{
+				"configMap": map[string]interface{}{
+					"key": caKey,
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{trustBundleLabel: "true"},
+					},
+				},
+				"namespaceSelector": selector,
+			},
+		},
+	}}
+	u.SetAPIVersion(trustManagerAPIVersion)
+	u.SetKind(trustBundleKind)
+	u.SetName(TrustBundleName)
+	return u, nil
+}
+
+// TransportEncryptionTransform sets the transport-encryption of spec.transportEncryption in
+// config-features, unless spec.config or spec.features set it, and makes the certificates of
+// Knative Eventing refer to spec.transportEncryption.issuerRef. It also restores the namespaces of
+// the resources of AppendTransportEncryption, which the common transformers inject.
+func TransportEncryptionTransform(instance *eventingv1beta1.KnativeEventing, log *zap.SugaredLogger) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		encryption := instance.Spec.TransportEncryption
+		if encryption == nil {
+			return nil
+		}
+		group := u.GroupVersionKind().Group
+		switch {
+		case u.GetKind() == "ConfigMap" && u.GetName() == common.FeaturesConfigMapName:
+			if _, ok := configuredFeature(instance, feature.TransportEncryption); ok || encryption.Mode == "" {
+				return nil
+			}
+			return common.UpdateConfigMap(u, map[string]string{feature.TransportEncryption: encryption.Mode}, log)
+		case group == certManagerGroup && u.GetKind() == clusterIssuerKind,
+			u.GetAPIVersion() == trustManagerAPIVersion && u.GetKind() == trustBundleKind:
+			// Both are cluster-scoped.
+			u.SetNamespace("")
+			u.SetOwnerReferences(nil)
+		case group == certManagerGroup && u.GetKind() == certificateKind && u.GetName() == CACertificateName:
+			// The CA is stored in the cluster resource namespace of cert-manager, with no owner
+			// across the namespaces.
+			namespace := defaultCANamespace
+			if encryption.CA != nil && encryption.CA.Namespace != "" {
+				namespace = encryption.CA.Namespace
+			}
+			u.SetNamespace(namespace)
+			u.SetOwnerReferences(nil)
+		case group == certManagerGroup && u.GetKind() == certificateKind && encryption.IssuerRef != nil:
+			name, _, _ := unstructured.NestedString(u.Object, "spec", "issuerRef", "name")
+			if name != CAIssuerName {
+				return nil
+			}
+			kind := encryption.IssuerRef.Kind
+			if kind == "" {
+				kind = clusterIssuerKind
+			}
+			return unstructured.SetNestedStringMap(u.Object, map[string]string{
+				"name":  encryption.IssuerRef.Name,
+				"kind":  kind,
+				"group": certManagerGroup,
+			}, "spec", "issuerRef")
+		}
+		return nil
+	}
+}
+
+// CheckTransportEncryption returns a Stage, which validates that cert-manager is installed in the
+// cluster, and trust-manager for spec.transportEncryption.trustBundle, before the certificates of
+// Knative Eventing are requested from them.
+func CheckTransportEncryption(kubeClient kubernetes.Interface) common.Stage {
+	return func(_ context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+		if !ok || ke.Spec.TransportEncryption == nil || !TransportEncryptionEnabled(ke) {
+			return nil
+		}
+		required := map[string]sets.Set[string]{certManagerGroupVersion: sets.New(certificateKind, clusterIssuerKind)}
+		if ref := ke.Spec.TransportEncryption.IssuerRef; ref != nil && ref.Kind != "" {
+			required[certManagerGroupVersion].Insert(ref.Kind)
+		}
+		if ke.Spec.TransportEncryption.TrustBundle != nil {
+			required[trustManagerAPIVersion] = sets.New(trustBundleKind)
+		}
+		for _, groupVersion := range []string{certManagerGroupVersion, trustManagerAPIVersion} {
+			if required[groupVersion] == nil {
+				continue
+			}
+			missing, err := missingKinds(kubeClient, groupVersion, required[groupVersion])
+			if err != nil {
+				return err
+			}
+			if len(missing) > 0 {
+				msg := fmt.Sprintf("the transport encryption requires cert-manager and trust-manager, the API %s is not served for %s",
+					groupVersion, strings.Join(missing, ", "))
+				instance.GetStatus().MarkInstallFailed(msg)
+				return fmt.Errorf("%s", msg)
+			}
+		}
+		return nil
+	}
+}
+
+// missingKinds returns the sorted kinds of the required ones, which the cluster does not serve in the
+// group version.
+func missingKinds(kubeClient kubernetes.Interface, groupVersion string, required sets.Set[string]) ([]string, error) {
+	served := sets.New[string]()
+	resources, err := kubeClient.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to discover the resources of %s: %w", groupVersion, err)
+	}
+	if resources != nil {
+		for _, r := range resources.APIResources {
+			served.Insert(r.Kind)
+		}
+	}
+	return sets.List(required.Difference(served)), nil
+}
+
+// UpdateCertificateStatus is a Stage, which reports the readiness and the expiry of the installed
+// cert-manager Certificates in the status of the KnativeEventing.
+func UpdateCertificateStatus(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	ke, ok := instance.(*eventingv1beta1.KnativeEventing)
+	if !ok {
+		return nil
+	}
+	statuses, err := certificateStatuses(manifest.Client, manifest)
+	if err != nil {
+		return err
+	}
+	ke.Status.Certificates = statuses
+	return nil
+}
+
+func certificateStatuses(client unstructuredGetter, manifest *mf.Manifest) ([]base.CertificateStatus, error) {
+	var statuses []base.CertificateStatus
+	for _, u := range manifest.Filter(mf.ByGVK(certificateGVK)).Resources() {
+		status := base.CertificateStatus{Name: u.GetName(), Namespace: u.GetNamespace()}
+		current, err := client.Get(&u)
+		if apierrors.IsNotFound(err) {
+			statuses = append(statuses, status)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the Certificate %s/%s: %w", u.GetNamespace(), u.GetName(), err)
+		}
+		status.NotAfter = parseTime(current, "status", "notAfter")
+		status.RenewalTime = parseTime(current, "status", "renewalTime")
+		conditions, _, _ := unstructured.NestedSlice(current.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition, _ := c.(map[string]interface{})
+			if condition["type"] == "Ready" {
+				status.Ready = condition["status"] == string(metav1.ConditionTrue)
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func parseTime(u *unstructured.Unstructured, fields ...string) *metav1.Time {
+	value, _, _ := unstructured.NestedString(u.Object, fields...)
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
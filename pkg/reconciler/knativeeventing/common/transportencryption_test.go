@@ -0,0 +1,371 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func transportEncryptionInstance(encryption *base.TransportEncryptionConfiguration, features map[string]string, config base.ConfigMapData) *v1beta1.KnativeEventing {
+	return &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+		Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec:          base.CommonSpec{Config: config, Features: features},
+			TransportEncryption: encryption,
+		},
+	}
+}
+
+func TestTransportEncryptionEnabled(t *testing.T) {
+	strict := &base.TransportEncryptionConfiguration{Mode: "strict"}
+	tests := []struct {
+		name       string
+		encryption *base.TransportEncryptionConfiguration
+		features   map[string]string
+		config     base.ConfigMapData
+		expected   bool
+	}{{
+		name: "nothing set",
+	}, {
+		name:       "typed mode",
+		encryption: strict,
+		expected:   true,
+	}, {
+		name:       "disabled",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "disabled"},
+	}, {
+		name:     "spec.features",
+		features: map[string]string{"transport-encryption": "permissive"},
+		expected: true,
+	}, {
+		name:       "spec.features takes precedence",
+		encryption: strict,
+		features:   map[string]string{"transport-encryption": "disabled"},
+	}, {
+		name:       "spec.config takes precedence",
+		encryption: strict,
+		features:   map[string]string{"transport-encryption": "strict"},
+		config:     base.ConfigMapData{"config-features": {"transport-encryption": "disabled"}},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			util.AssertEqual(t, TransportEncryptionEnabled(transportEncryptionInstance(tt.encryption, tt.features, tt.config)), tt.expected)
+		})
+	}
+}
+
+func TestAppendTransportEncryption(t *testing.T) {
+	tests := []struct {
+		name       string
+		encryption *base.TransportEncryptionConfiguration
+		expected   []string
+	}{{
+		name: "no transport encryption",
+	}, {
+		name:       "self-signed CA",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict"},
+		expected:   []string{"ClusterIssuer/" + SelfSignedIssuerName, "Certificate/" + CACertificateName, "ClusterIssuer/" + CAIssuerName},
+	}, {
+		name: "own issuer",
+		encryption: &base.TransportEncryptionConfiguration{
+			Mode:      "strict",
+			IssuerRef: &base.CertManagerIssuerRef{Name: "corporate"},
+		},
+	}, {
+		name: "trust bundle",
+		encryption: &base.TransportEncryptionConfiguration{
+			Mode:        "strict",
+			IssuerRef:   &base.CertManagerIssuerRef{Name: "corporate"},
+			TrustBundle: &base.EventingTrustBundleConfiguration{Source: &base.TrustBundleConfiguration{SecretName: "corporate-ca"}},
+		},
+		expected: []string{"Bundle/" + TrustBundleName},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, _ := mf.ManifestFrom(mf.Slice{})
+			if err := AppendTransportEncryption(context.Background(), &manifest, transportEncryptionInstance(tt.encryption, nil, nil)); err != nil {
+				t.Fatalf("AppendTransportEncryption() = %v", err)
+			}
+			var got []string
+			for _, u := range manifest.Resources() {
+				got = append(got, u.GetKind()+"/"+u.GetName())
+			}
+			util.AssertDeepEqual(t, got, tt.expected)
+		})
+	}
+}
+
+func TestSelfSignedCA(t *testing.T) {
+	instance := transportEncryptionInstance(&base.TransportEncryptionConfiguration{
+		Mode: "strict",
+		CA: &base.TransportEncryptionCAConfiguration{
+			Namespace: "certs",
+			Duration:  &metav1.Duration{Duration: 48 * time.Hour},
+		},
+	}, nil, nil)
+	resources := selfSignedCA(instance)
+	ca := resources[1]
+	util.AssertEqual(t, ca.GetNamespace(), "certs")
+	duration, _, _ := unstructured.NestedString(ca.Object, "spec", "duration")
+	util.AssertEqual(t, duration, "48h0m0s")
+	renewBefore, _, _ := unstructured.NestedString(ca.Object, "spec", "renewBefore")
+	util.AssertEqual(t, renewBefore, "720h0m0s")
+	secret, _, _ := unstructured.NestedString(resources[2].Object, "spec", "ca", "secretName")
+	util.AssertEqual(t, secret, CACertificateName)
+}
+
+func TestTrustBundle(t *testing.T) {
+	tests := []struct {
+		name             string
+		trustBundle      *base.EventingTrustBundleConfiguration
+		expectedSource   map[string]interface{}
+		expectedSelector map[string]interface{}
+	}{{
+		name:             "self-signed CA",
+		trustBundle:      &base.EventingTrustBundleConfiguration{},
+		expectedSource:   map[string]interface{}{"name": CACertificateName, "key": "ca.crt"},
+		expectedSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"kubernetes.io/metadata.name": "knative-eventing"}},
+	}, {
+		name: "own CA and namespaces",
+		trustBundle: &base.EventingTrustBundleConfiguration{
+			Source:            &base.TrustBundleConfiguration{SecretName: "corporate-ca", Key: "tls.crt"},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"eventing": "enabled"}},
+		},
+		expectedSource:   map[string]interface{}{"name": "corporate-ca", "key": "tls.crt"},
+		expectedSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"eventing": "enabled"}},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := transportEncryptionInstance(&base.TransportEncryptionConfiguration{Mode: "strict", TrustBundle: tt.trustBundle}, nil, nil)
+			bundle, err := trustBundle(instance)
+			if err != nil {
+				t.Fatalf("trustBundle() = %v", err)
+			}
+			sources, _, _ := unstructured.NestedSlice(bundle.Object, "spec", "sources")
+			util.AssertDeepEqual(t, sources[0].(map[string]interface{})["secret"], tt.expectedSource)
+			selector, _, _ := unstructured.NestedMap(bundle.Object, "spec", "target", "namespaceSelector")
+			util.AssertDeepEqual(t, selector, tt.expectedSelector)
+		})
+	}
+}
+
+func TestTransportEncryptionTransform(t *testing.T) {
+	features := func() *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{"data": map[string]interface{}{"transport-encryption": "disabled"}}}
+		u.SetAPIVersion("v1")
+		u.SetKind("ConfigMap")
+		u.SetName("config-features")
+		u.SetNamespace("knative-eventing")
+		return u
+	}
+	certificate := func(name, issuer string) *unstructured.Unstructured {
+		u := certManagerResource(certificateKind, name, "knative-eventing", map[string]interface{}{
+			"issuerRef": map[string]interface{}{"name": issuer, "kind": clusterIssuerKind, "group": certManagerGroup},
+		})
+		u.SetOwnerReferences([]metav1.OwnerReference{{Name: "knative-eventing"}})
+		return u
+	}
+	issuerRef := &base.CertManagerIssuerRef{Kind: "Issuer", Name: "corporate"}
+
+	tests := []struct {
+		name       string
+		encryption *base.TransportEncryptionConfiguration
+		config     base.ConfigMapData
+		input      *unstructured.Unstructured
+		expected   *unstructured.Unstructured
+	}{{
+		name:     "no transport encryption",
+		input:    features(),
+		expected: features(),
+	}, {
+		name:       "mode",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict"},
+		input:      features(),
+		expected: func() *unstructured.Unstructured {
+			u := features()
+			u.Object["data"] = map[string]interface{}{"transport-encryption": "strict"}
+			return u
+		}(),
+	}, {
+		name:       "mode of spec.config",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict"},
+		config:     base.ConfigMapData{"features": {"transport-encryption": "permissive"}},
+		input:      features(),
+		expected:   features(),
+	}, {
+		name:       "own issuer",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict", IssuerRef: issuerRef},
+		input:      certificate("job-sink-server-tls", CAIssuerName),
+		expected: func() *unstructured.Unstructured {
+			u := certificate("job-sink-server-tls", CAIssuerName)
+			u.Object["spec"] = map[string]interface{}{
+				"issuerRef": map[string]interface{}{"name": "corporate", "kind": "Issuer", "group": certManagerGroup},
+			}
+			return u
+		}(),
+	}, {
+		name:       "certificate of another issuer",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict", IssuerRef: issuerRef},
+		input:      certificate("other", "other-issuer"),
+		expected:   certificate("other", "other-issuer"),
+	}, {
+		name:       "self-signed CA",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict"},
+		input:      certificate(CACertificateName, SelfSignedIssuerName),
+		expected: func() *unstructured.Unstructured {
+			u := certificate(CACertificateName, SelfSignedIssuerName)
+			u.SetNamespace("cert-manager")
+			u.SetOwnerReferences(nil)
+			return u
+		}(),
+	}, {
+		name:       "cluster issuer",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict"},
+		input: func() *unstructured.Unstructured {
+			u := certManagerResource(clusterIssuerKind, CAIssuerName, "knative-eventing", map[string]interface{}{})
+			u.SetOwnerReferences([]metav1.OwnerReference{{Name: "knative-eventing"}})
+			return u
+		}(),
+		expected: certManagerResource(clusterIssuerKind, CAIssuerName, "", map[string]interface{}{}),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := transportEncryptionInstance(tt.encryption, nil, tt.config)
+			if err := TransportEncryptionTransform(instance, zap.NewNop().Sugar())(tt.input); err != nil {
+				t.Fatalf("TransportEncryptionTransform() = %v", err)
+			}
+			util.AssertDeepEqual(t, tt.input, tt.expected)
+		})
+	}
+}
+
+func TestCheckTransportEncryption(t *testing.T) {
+	tests := []struct {
+		name        string
+		encryption  *base.TransportEncryptionConfiguration
+		served      map[string][]string
+		expectedErr string
+	}{{
+		name: "no transport encryption",
+	}, {
+		name:       "disabled",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "disabled"},
+	}, {
+		name:       "installed",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict", TrustBundle: &base.EventingTrustBundleConfiguration{}},
+		served: map[string][]string{
+			"cert-manager.io/v1":             {"Certificate", "ClusterIssuer"},
+			"trust.cert-manager.io/v1alpha1": {"Bundle"},
+		},
+	}, {
+		name:        "cert-manager not installed",
+		encryption:  &base.TransportEncryptionConfiguration{Mode: "strict", IssuerRef: &base.CertManagerIssuerRef{Kind: "Issuer", Name: "corporate"}},
+		expectedErr: "the transport encryption requires cert-manager and trust-manager, the API cert-manager.io/v1 is not served for Certificate, ClusterIssuer, Issuer",
+	}, {
+		name:        "trust-manager not installed",
+		encryption:  &base.TransportEncryptionConfiguration{Mode: "strict", TrustBundle: &base.EventingTrustBundleConfiguration{}},
+		served:      map[string][]string{"cert-manager.io/v1": {"Certificate", "ClusterIssuer"}},
+		expectedErr: "the transport encryption requires cert-manager and trust-manager, the API trust.cert-manager.io/v1alpha1 is not served for Bundle",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			for groupVersion, kinds := range tt.served {
+				resources := &metav1.APIResourceList{GroupVersion: groupVersion}
+				for _, kind := range kinds {
+					resources.APIResources = append(resources.APIResources, metav1.APIResource{Kind: kind})
+				}
+				fake := kubeClient.Discovery().(*fakediscovery.FakeDiscovery)
+				fake.Resources = append(fake.Resources, resources)
+			}
+			instance := transportEncryptionInstance(tt.encryption, nil, nil)
+			instance.Status.InitializeConditions()
+			manifest, _ := mf.ManifestFrom(mf.Slice{})
+
+			err := CheckTransportEncryption(kubeClient)(context.Background(), &manifest, instance)
+			if tt.expectedErr == "" {
+				util.AssertEqual(t, err, nil)
+				return
+			}
+			if err == nil {
+				t.Fatalf("CheckTransportEncryption() = nil, want %q", tt.expectedErr)
+			}
+			util.AssertEqual(t, err.Error(), tt.expectedErr)
+			util.AssertEqual(t, instance.Status.IsReady(), false)
+		})
+	}
+}
+
+type certificateGetter map[string]*unstructured.Unstructured
+
+func (g certificateGetter) Get(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if u, ok := g[obj.GetName()]; ok {
+		return u, nil
+	}
+	return nil, errors.NewNotFound(schema.GroupResource{}, obj.GetName())
+}
+
+func TestCertificateStatuses(t *testing.T) {
+	issued := certManagerResource(certificateKind, "job-sink-server-tls", "knative-eventing", map[string]interface{}{})
+	issued.Object["status"] = map[string]interface{}{
+		"notAfter":    "2026-01-30T12:00:00Z",
+		"renewalTime": "2026-01-15T12:00:00Z",
+		"conditions":  []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+	}
+	manifest, _ := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		*certManagerResource(certificateKind, "job-sink-server-tls", "knative-eventing", map[string]interface{}{}),
+		*certManagerResource(certificateKind, "imc-dispatcher-server-tls", "knative-eventing", map[string]interface{}{}),
+		*certManagerResource(clusterIssuerKind, CAIssuerName, "", map[string]interface{}{}),
+	}))
+
+	statuses, err := certificateStatuses(certificateGetter{"job-sink-server-tls": issued}, &manifest)
+	if err != nil {
+		t.Fatalf("certificateStatuses() = %v", err)
+	}
+	notAfter := metav1.NewTime(time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC))
+	renewalTime := metav1.NewTime(time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC))
+	util.AssertDeepEqual(t, statuses, []base.CertificateStatus{{
+		Name:        "job-sink-server-tls",
+		Namespace:   "knative-eventing",
+		Ready:       true,
+		NotAfter:    &notAfter,
+		RenewalTime: &renewalTime,
+	}, {
+		Name:      "imc-dispatcher-server-tls",
+		Namespace: "knative-eventing",
+	}})
+}
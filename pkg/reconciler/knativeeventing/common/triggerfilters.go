@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// NewTriggerFiltersFeature is the flag of config-features, which enables the filters field of
+// triggers, in the versions of Knative Eventing having it.
+const NewTriggerFiltersFeature = "new-trigger-filters"
+
+// TriggerFiltersTransform sets the new-trigger-filters flag of config-features for
+// spec.triggerFilters.new, unless spec.config or spec.features set it.
+func TriggerFiltersTransform(instance *eventingv1beta1.KnativeEventing, log *zap.SugaredLogger) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		filters := instance.Spec.TriggerFilters
+		if filters == nil || filters.New == nil || u.GetKind() != "ConfigMap" || u.GetName() != common.FeaturesConfigMapName {
+			return nil
+		}
+		if _, ok := configuredFeature(instance, NewTriggerFiltersFeature); ok {
+			return nil
+		}
+		value := "disabled"
+		if *filters.New {
+			value = "enabled"
+		}
+		return common.UpdateConfigMap(u, map[string]string{NewTriggerFiltersFeature: value}, log)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestTriggerFiltersTransform(t *testing.T) {
+	tests := []struct {
+		name     string
+		filters  *base.TriggerFiltersConfiguration
+		config   base.ConfigMapData
+		expected map[string]string
+	}{{
+		name:     "no trigger filters",
+		expected: map[string]string{NewTriggerFiltersFeature: "disabled"},
+	}, {
+		name:     "unset",
+		filters:  &base.TriggerFiltersConfiguration{},
+		expected: map[string]string{NewTriggerFiltersFeature: "disabled"},
+	}, {
+		name:     "enabled",
+		filters:  &base.TriggerFiltersConfiguration{New: ptr.Bool(true)},
+		expected: map[string]string{NewTriggerFiltersFeature: "enabled"},
+	}, {
+		name:     "flag of spec.config",
+		filters:  &base.TriggerFiltersConfiguration{New: ptr.Bool(true)},
+		config:   base.ConfigMapData{"features": {NewTriggerFiltersFeature: "disabled"}},
+		expected: map[string]string{NewTriggerFiltersFeature: "disabled"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &eventingv1beta1.KnativeEventing{
+				Spec: eventingv1beta1.KnativeEventingSpec{
+					CommonSpec:     base.CommonSpec{Config: tt.config},
+					TriggerFilters: tt.filters,
+				},
+			}
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			u.SetName("config-features")
+			_ = unstructured.SetNestedStringMap(u.Object, map[string]string{NewTriggerFiltersFeature: "disabled"}, "data")
+			if err := TriggerFiltersTransform(instance, zap.NewNop().Sugar())(u); err != nil {
+				t.Fatalf("TriggerFiltersTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
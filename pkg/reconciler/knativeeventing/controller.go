@@ -17,14 +17,15 @@ import (
 	"context"
 
 	"github.com/go-logr/zapr"
-	mfc "github.com/manifestival/client-go-client"
 	mf "github.com/manifestival/manifestival"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	operatorclient "knative.dev/operator/pkg/client/injection/client"
 	knativeEventinginformer "knative.dev/operator/pkg/client/injection/informers/operator/v1beta1/knativeeventing"
+	knativeServinginformer "knative.dev/operator/pkg/client/injection/informers/operator/v1beta1/knativeserving"
 	knereconciler "knative.dev/operator/pkg/client/injection/reconciler/operator/v1beta1/knativeeventing"
 	"knative.dev/operator/pkg/reconciler/common"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
@@ -33,6 +34,7 @@ import (
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
+	"knative.dev/pkg/injection/clients/dynamicclient"
 	"knative.dev/pkg/logging"
 )
 
@@ -55,12 +57,13 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 func NewExtendedController(generator common.ExtensionGenerator) injection.ControllerConstructor {
 	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 		knativeEventingInformer := knativeEventinginformer.Get(ctx)
+		knativeServingInformer := knativeServinginformer.Get(ctx)
 		deploymentInformer := deploymentinformer.Get(ctx, Selector)
 		configMapInformer := configmapinformer.Get(ctx, Selector)
 		kubeClient := kubeclient.Get(ctx)
 		logger := logging.FromContext(ctx)
 
-		mfclient, err := mfc.NewClient(injection.GetConfig(ctx))
+		mfclient, err := common.NewManifestClient(injection.GetConfig(ctx))
 		if err != nil {
 			logger.Fatalw("Error creating client from injected config", zap.Error(err))
 		}
@@ -70,22 +73,47 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		c := &Reconciler{
 			kubeClientSet:     kubeClient,
 			operatorClientSet: operatorclient.Get(ctx),
+			servingLister:     knativeServingInformer.Lister(),
 			manifest:          manifest,
+			renderCache:       common.NewRenderCache(),
+			targetClusters:    common.NewTargetClusters(kubeClient),
 		}
-		impl := knereconciler.NewImpl(ctx, c)
+		impl := common.ConfigureController(ctx, "KnativeEventing", knereconciler.NewImpl(ctx, c, common.PatchStatusOptions))
 		c.extension = generator(ctx, impl)
+		c.recommender = common.NewResourceRecommender(kubeClient, dynamicclient.Get(ctx), impl)
+		c.eco = common.NewEcoMode(ctx, dynamicclient.Get(ctx), impl, func() ([]*v1beta1.KnativeEventing, error) {
+			return knativeEventingInformer.Lister().List(labels.Everything())
+		})
 
 		logger.Info("Setting up event handlers")
 
 		knativeEventingInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+		common.ResyncPeriodically(ctx, impl, knativeEventingInformer.Informer())
+		common.WatchDrift(ctx, impl, Selector, v1beta1.SchemeGroupVersion.WithKind("KnativeEventing"))
+		common.WatchWebhookCertificates(ctx, impl, Selector, v1beta1.SchemeGroupVersion.WithKind("KnativeEventing"))
+		common.WatchConfigSecrets(ctx, impl, Selector, func(namespace string) ([]*v1beta1.KnativeEventing, error) {
+			return knativeEventingInformer.Lister().KnativeEventings(namespace).List(labels.Everything())
+		})
 
+		// The version of a KnativeServing in any namespace is checked against the one of the KnativeEventing.
+		knativeServingInformer.Informer().AddEventHandler(controller.HandleAll(common.EnqueueNamespace(impl,
+			func(string) ([]*v1beta1.KnativeEventing, error) {
+				return knativeEventingInformer.Lister().List(labels.Everything())
+			})))
+
+		// The informers only enqueue the KnativeEventing, so they do not need to cache the full resources.
+		for _, informer := range []cache.SharedIndexInformer{deploymentInformer.Informer(), configMapInformer.Informer()} {
+			if err := informer.SetTransform(common.TrimForEnqueue); err != nil {
+				logger.Warnw("Failed to trim the objects cached by the informer", zap.Error(err))
+			}
+		}
 		deploymentInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterControllerGVK(v1beta1.SchemeGroupVersion.WithKind("KnativeEventing")),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.EnqueueDrifted(impl),
 		})
 		configMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterControllerGVK(v1beta1.SchemeGroupVersion.WithKind("KnativeEventing")),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.EnqueueDrifted(impl),
 		})
 
 		return impl
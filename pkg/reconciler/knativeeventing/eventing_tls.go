@@ -21,13 +21,13 @@ import (
 	"fmt"
 
 	mf "github.com/manifestival/manifestival"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"knative.dev/eventing/pkg/apis/feature"
 
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	kec "knative.dev/operator/pkg/reconciler/knativeeventing/common"
 )
 
 var (
@@ -37,14 +37,16 @@ var (
 func (r *Reconciler) handleTLSResources(ctx context.Context, manifests *mf.Manifest, comp base.KComponent) error {
 	instance := comp.(*v1beta1.KnativeEventing)
 
-	if isTLSEnabled(instance) {
+	if kec.TransportEncryptionEnabled(instance) {
 		return nil
 	}
 
-	// Delete TLS resources (if present)
-	toBeDeleted := manifests.Filter(tlsResourcesPred)
-	if err := toBeDeleted.Delete(mf.IgnoreNotFound(true)); err != nil && !meta.IsNoMatchError(err) {
-		return fmt.Errorf("failed to delete TLS resources: %v", err)
+	// Delete TLS resources (if present), unless nothing may be changed in dry-run mode
+	if !common.IsDryRun(instance) {
+		toBeDeleted := manifests.Filter(tlsResourcesPred)
+		if err := toBeDeleted.Delete(mf.IgnoreNotFound(true)); err != nil && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete TLS resources: %v", err)
+		}
 	}
 
 	// Filter out TLS resources from the final list of manifests
@@ -58,25 +60,3 @@ func byGroup(group string) mf.Predicate {
 		return u.GroupVersionKind().Group == group
 	}
 }
-
-func isTLSEnabled(instance *v1beta1.KnativeEventing) bool {
-	cmData, ok := getFeaturesConfig(instance)
-	if !ok {
-		return false
-	}
-
-	f, err := feature.NewFlagsConfigFromConfigMap(&corev1.ConfigMap{Data: cmData})
-	if err != nil {
-		return false
-	}
-
-	return f.IsPermissiveTransportEncryption() || f.IsStrictTransportEncryption()
-}
-
-func getFeaturesConfig(instance *v1beta1.KnativeEventing) (map[string]string, bool) {
-	features, ok := instance.Spec.GetConfig()["features"]
-	if !ok {
-		features, ok = instance.Spec.GetConfig()["config-features"]
-	}
-	return features, ok
-}
@@ -23,8 +23,10 @@ import (
 	mf "github.com/manifestival/manifestival"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 
@@ -32,6 +34,7 @@ import (
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	clientset "knative.dev/operator/pkg/client/clientset/versioned"
 	knereconciler "knative.dev/operator/pkg/client/injection/reconciler/operator/v1beta1/knativeeventing"
+	listers "knative.dev/operator/pkg/client/listers/operator/v1beta1"
 	"knative.dev/operator/pkg/reconciler/common"
 	kec "knative.dev/operator/pkg/reconciler/knativeeventing/common"
 	"knative.dev/operator/pkg/reconciler/knativeeventing/source"
@@ -44,6 +47,8 @@ type Reconciler struct {
 	kubeClientSet kubernetes.Interface
 	// operatorClientSet allows us to talk to the k8s for operator APIs
 	operatorClientSet clientset.Interface
+	// servingLister lists the KnativeServings, whose versions have to be in skew with the KnativeEventing
+	servingLister listers.KnativeServingLister
 	// manifest is empty, but with a valid client and logger. all
 	// manifests are immutable, and any created during reconcile are
 	// expected to be appended to this one, obviating the passing of
@@ -51,6 +56,14 @@ type Reconciler struct {
 	manifest mf.Manifest
 	// Platform-specific behavior to affect the transform
 	extension common.Extension
+	// renderCache avoids rendering the manifest again, if its inputs did not change
+	renderCache *common.RenderCache
+	// targetClusters holds the clients of the clusters, into which the components are installed
+	targetClusters *common.TargetClusters
+	// recommender recommends the resources of the containers for spec.resourceRecommendations
+	recommender *common.ResourceRecommender
+	// eco scales the idle deployments down for spec.eco
+	eco *common.EcoMode
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -63,15 +76,24 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1beta1.Knative
 
 	// Clean up the cache, if the Serving CR is deleted.
 	common.ClearCache()
+	r.renderCache.Delete(original)
+	common.ForgetWebhookCertificates(original)
+	common.ForgetAppliedHashes(original)
+	common.ForgetUpgrade(ctx, original)
+	r.targetClusters.ForgetStatus(ctx, original)
 
 	// List all KnativeEventings to determine if cluster-scoped resources should be deleted.
-	kes, err := r.operatorClientSet.OperatorV1beta1().KnativeEventings("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("failed to list all KnativeEventings: %w", err)
+	var kes []v1beta1.KnativeEventing
+	for _, ns := range common.GetControllerConfig(ctx).ListNamespaces() {
+		list, err := r.operatorClientSet.OperatorV1beta1().KnativeEventings(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list all KnativeEventings: %w", err)
+		}
+		kes = append(kes, list.Items...)
 	}
 
-	for _, ke := range kes.Items {
-		if ke.GetDeletionTimestamp().IsZero() {
+	for _, ke := range kes {
+		if ke.GetDeletionTimestamp().IsZero() && common.SameTargetCluster(&ke, original) {
 			// Not deleting all KnativeEventings. Nothing to do here.
 			return nil
 		}
@@ -117,15 +139,28 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1beta1.Knative
 
 // ReconcileKind compares the actual state with the desired, and attempts to
 // converge the two.
-func (r *Reconciler) ReconcileKind(ctx context.Context, ke *v1beta1.KnativeEventing) pkgreconciler.Event {
+func (r *Reconciler) ReconcileKind(ctx context.Context, ke *v1beta1.KnativeEventing) (event pkgreconciler.Event) {
 	logger := logging.FromContext(ctx)
+	original := ke.DeepCopy()
+	defer func() {
+		if err := common.PatchStatus(ctx, r.operatorClientSet, original, ke); err != nil {
+			event = err
+		}
+	}()
 	ke.Status.InitializeConditions()
-	ke.Status.ObservedGeneration = ke.Generation
+	defer common.ReportUpgrade(ctx, ke)
+	defer r.targetClusters.PublishStatus(ctx, ke)
 
 	logger.Infow("Reconciling KnativeEventing", "status", ke.Status)
 
+	if common.CheckPaused(ke) {
+		logger.Infow("Reconciliation is paused", "annotation", base.PausedAnnotation)
+		return nil
+	}
+
 	if err := common.IsVersionValidMigrationEligible(ke); err != nil {
 		ke.Status.MarkVersionMigrationNotEligible(err.Error())
+		common.ObserveGeneration(ke, &ke.Status.Status, nil)
 		return nil
 	}
 	ke.Status.MarkVersionMigrationEligible()
@@ -133,21 +168,97 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ke *v1beta1.KnativeEvent
 	if err := r.extension.Reconcile(ctx, ke); err != nil {
 		return err
 	}
-	stages := common.Stages{
-		common.AppendTarget,
-		source.AppendTargetSources,
-		common.AppendAdditionalManifests,
-		r.appendExtensionManifests,
-		r.transform,
-		r.handleTLSResources,
+	kubeClient, manifest, err := r.targetCluster(ctx, ke)
+	if err != nil {
+		ke.Status.MarkInstallFailed(err.Error())
+		common.ObserveGeneration(ke, &ke.Status.Status, err)
+		return err
+	}
+	stages := r.renderStages(kubeClient)
+	stages = append(stages,
+		kec.CheckRabbitMQ(kubeClient),
+		kec.CheckTransportEncryption(kubeClient),
+		kec.CheckIstio(kubeClient),
+		kec.CheckKEDA(kubeClient),
+		common.ExternalTransform,
+		common.ConfigFromSecrets(r.kubeClientSet), // After the external transformer, which must not see the values of the Secrets
+		common.CheckConfigNames,
+		common.UnmanagedConfigMaps,
+		r.eco.ScaleDownIdle,
+		r.recommender.Recommend,
+		common.ResolveDigests(r.kubeClientSet),
+		common.Preflight(kubeClient),
+		common.CheckVersionSkew(r.serving),
+		common.CheckWebhookCertificates(r.kubeClientSet, kubeClient),
+		common.CustomStages(common.StagePositionAfterRender),
+		common.Preview(r.kubeClientSet), // In dry-run mode, the stages stop after publishing the preview
+		kec.DeleteKEDAScaledHPAs(kubeClient),
+		common.PruneConfigKeys,
 		manifests.Install,
 		manifests.SetManifestPaths, // setting path right after applying manifests to populate paths
+		common.SetPermissions,
+		common.CustomStages(common.StagePositionAfterInstall),
+		common.SetAppliedManifest,
+		common.PublishInventory(r.kubeClientSet),
+		kec.UpdateCertificateStatus,
+		common.DeleteObsoleteNetworkPolicies(kubeClient),
+		common.DeleteObsoleteSpotPodDisruptionBudgets(kubeClient),
+		common.DeleteObsoleteResiliencePodDisruptionBudgets(kubeClient),
 		common.CheckDeployments,
 		common.MarkStatusSuccess,
+		common.CustomStages(common.StagePositionAfterReady),
+		common.Conformance(kubeClient, common.ConformanceBrokerTrigger),
 		common.DeleteObsoleteResources(ctx, ke, r.installed),
+	)
+	err = stages.Execute(ctx, &manifest, ke)
+	common.ObserveGeneration(ke, &ke.Status.Status, err)
+	if err != nil {
+		// Render the manifest again on the next attempt, in case it depends on a changed cluster.
+		r.renderCache.Delete(ke)
+		return err
+	}
+	if ke.Spec.TargetCluster != nil && !ke.Status.IsReady() {
+		// The resources in a target cluster are not watched, poll until they are ready.
+		return controller.NewRequeueAfter(common.TargetClusterPollInterval)
+	}
+	if common.ConformanceRunning(&ke.Status) {
+		// The conformance Jobs are not watched, poll until they are done.
+		return controller.NewRequeueAfter(common.ConformancePollInterval)
+	}
+	return nil
+}
+
+// serving returns the KnativeServing, which installs Knative Serving into the same cluster.
+func (r *Reconciler) serving(instance base.KComponent) (base.KComponent, error) {
+	kss, err := r.servingLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return common.PeerComponent(instance, kss), nil
+}
+
+// renderStages returns the stages, which compute the manifest to be applied for the component
+func (r *Reconciler) renderStages(kubeClient kubernetes.Interface) common.Stages {
+	return common.Stages{
+		r.renderCache.Stage(common.Stages{
+			common.AppendTarget,
+			source.AppendTargetSources,
+			common.AppendAdditionalManifests,
+			kec.AppendRabbitMQBrokerConfig,
+			kec.AppendTransportEncryption,
+			kec.AppendKEDAScaledObjects,
+			r.appendExtensionManifests,
+			common.AppendNetworkPolicies,
+			common.AppendSpotPodDisruptionBudgets,
+			common.ResiliencePodDisruptionBudgets,
+			r.transform,
+		}),
+		common.UpgradeRemovedAPIs(kubeClient), // Not cached, the version of Kubernetes changes with upgrades of the cluster
+		r.transformFromCluster,
+		common.AggregateClusterRoles(kubeClient),
+		r.handleTLSResources,
+		common.ApplyPlatform(kubeClient),
 	}
-	manifest := r.manifest.Append()
-	return stages.Execute(ctx, &manifest, ke)
 }
 
 // transform mutates the passed manifest to one with common, component
@@ -158,19 +269,49 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 	extra := []mf.Transformer{
 		kec.DefaultBrokerConfigMapTransform(instance, logger),
 		kec.SinkBindingSelectionModeTransform(instance, logger),
-		kec.ReplicasEnvVarsTransform(manifest.Client),
+		kec.KafkaTransform(instance),
+		kec.TransportEncryptionTransform(instance, logger),
+		kec.SugarTransform(instance),
+		kec.IstioTransform(instance, logger),
+		kec.TriggerFiltersTransform(instance, logger),
+		kec.KEDATransform(instance),
 		// Ensure all resources have the selector applied so that the controller re-queues applied resources when they change.
 		common.InjectLabel(SelectorKey, SelectorValue),
 	}
 	extra = append(extra, r.extension.Transformers(instance)...)
+	*manifest = manifest.Filter(mf.Not(mf.Any(source.PingSourceResources(instance), kec.JobSinkResources(instance), kec.KEDAScaledHPAs(instance))))
 	return common.Transform(ctx, manifest, instance, extra...)
 }
 
+// transformFromCluster mutates the passed manifest with the transformations, which depend on the
+// current state of the cluster. They are not cached with the rest of the manifest.
+func (r *Reconciler) transformFromCluster(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
+	m, err := manifest.Transform(kec.ReplicasEnvVarsTransform(manifest.Client))
+	if err != nil {
+		comp.GetStatus().MarkInstallFailed(err.Error())
+		return err
+	}
+	*manifest = m
+	return nil
+}
+
 // injectNamespace mutates the namespace of all installed resources
 func (r *Reconciler) injectNamespace(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
 	return common.InjectNamespace(manifest, comp)
 }
 
+// targetCluster returns the clients of the cluster, into which the component is installed: the
+// cluster of the operator, unless the component specifies a target cluster.
+func (r *Reconciler) targetCluster(ctx context.Context, instance base.KComponent) (kubernetes.Interface, mf.Manifest, error) {
+	clients, err := r.targetClusters.Get(ctx, instance)
+	if err != nil || clients == nil {
+		return r.kubeClientSet, r.manifest.Append(), err
+	}
+	manifest := r.manifest.Append()
+	manifest.Client = clients.Manifest
+	return clients.Kube, manifest, nil
+}
+
 func (r *Reconciler) installed(ctx context.Context, instance base.KComponent) (*mf.Manifest, error) {
 	paths := instance.GetStatus().GetManifests()
 	if len(paths) == 0 {
@@ -181,7 +322,11 @@ func (r *Reconciler) installed(ctx context.Context, instance base.KComponent) (*
 	if err != nil {
 		return &installed, err
 	}
-	installed = r.manifest.Append(installed)
+	_, target, err := r.targetCluster(ctx, instance)
+	if err != nil {
+		return &installed, err
+	}
+	installed = target.Append(installed)
 
 	// Per the manifests, that have been installed in the cluster, we only need to inject the correct namespace
 	// in the stages.
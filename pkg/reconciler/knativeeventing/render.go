@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativeeventing
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// Render returns the manifest, which the operator would apply for the given KnativeEventing, without
+// accessing the cluster. Transformers, which merge in the live state, see no resources at all.
+func Render(ctx context.Context, ke *v1beta1.KnativeEventing) (*mf.Manifest, error) {
+	r := &Reconciler{
+		manifest:  mf.Manifest{Client: common.OfflineClient()},
+		extension: common.NoExtension(ctx, nil),
+	}
+	ke.Status.InitializeConditions()
+	manifest := r.manifest.Append()
+	if err := r.renderStages(nil).Execute(ctx, &manifest, ke); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
@@ -21,15 +21,32 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	mf "github.com/manifestival/manifestival"
 	"golang.org/x/mod/semver"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	"knative.dev/operator/pkg/reconciler/common"
 )
 
+// The source bundles of the Kafka components of eventing-kafka-broker, of the RabbitMQ components
+// of eventing-rabbitmq, and of eventing-istio.
+const (
+	KafkaBundle          = "kafka"
+	KafkaBrokerBundle    = "kafka-broker"
+	KafkaChannelBundle   = "kafka-channel"
+	KafkaSinkBundle      = "kafka-sink"
+	RabbitMQBundle       = "rabbitmq"
+	RabbitMQBrokerBundle = "rabbitmq-broker"
+	IstioBundle          = "istio"
+)
+
+// pingSourceAdapter is the deployment, which sends the events of all PingSources.
+const pingSourceAdapter = "pingsource-mt-adapter"
+
 func getSource(path string) (mf.Manifest, error) {
 	if path == "" {
 		return mf.Manifest{}, nil
@@ -37,79 +54,189 @@ func getSource(path string) (mf.Manifest, error) {
 	return common.FetchManifest(path)
 }
 
-func getAllSourcePath(version string) string {
+// catalogPath returns the directory of the source bundles of the minor of the version.
+func catalogPath(version string) string {
 	koDataDir := os.Getenv(common.KoEnvKey)
 	sourceVersion := common.LATEST_VERSION
 	if !strings.EqualFold(version, common.LATEST_VERSION) {
-		sourceVersion = semver.MajorMinor(common.SanitizeSemver(version))[1:]
+		sourceVersion = version
+		// This line can make sure a valid available source version is returned.
+		if majorMinor := semver.MajorMinor(common.SanitizeSemver(version)); majorMinor != "" {
+			sourceVersion = majorMinor[1:]
+		}
 	}
+	return filepath.Join(koDataDir, "eventing-source", sourceVersion)
+}
+
+// Path returns the path of the manifests of the named bundle in the version.
+func Path(version, name string) string {
+	return filepath.Join(catalogPath(version), name)
+}
 
-	sourcePath := filepath.Join(koDataDir, "eventing-source", sourceVersion)
-	// List all the directories under the sourcePath, because we will append all the paths for eventing sources
-	fileList, err := os.ReadDir(sourcePath)
+// VersionSkew is the skew of the bundles to Knative Eventing, which spec.versionOverrides may pin
+// them to. The sources and the integrations are released after Knative Eventing, and may lag one
+// minor version behind it.
+var VersionSkew = common.VersionSkew{Behind: 1}
+
+// Bundles returns the names of the source bundles, which the operator ships for the version.
+func Bundles(version string) []string {
+	// Every directory in the catalog of the version is a bundle
+	fileList, err := os.ReadDir(catalogPath(version))
 	if err != nil {
-		return ""
+		return nil
 	}
-
-	var urls []string
+	var names []string
 	for _, file := range fileList {
-		name := path.Join(sourcePath, file.Name())
-		pathDirOrFile, err := os.Stat(name)
-		if err != nil {
-			continue
-		}
-		if pathDirOrFile.IsDir() {
-			urls = append(urls, name)
+		if file.IsDir() {
+			names = append(names, file.Name())
 		}
 	}
+	return names
+}
+
+func getAllSourcePath(version string) string {
+	sourcePath := catalogPath(version)
+	var urls []string
+	for _, name := range Bundles(version) {
+		urls = append(urls, path.Join(sourcePath, name))
+	}
 	return strings.Join(urls, common.COMMA)
 }
 
 // GetSourcePath returns the path of Eventing Source manifests, selected by the
 // Eventing CR.
 func GetSourcePath(version string, ke *v1beta1.KnativeEventing) string {
-	if ke.Spec.Source == nil {
+	if ke.Spec.Source == nil && ke.Spec.Kafka == nil && ke.Spec.RabbitMQ == nil && ke.Spec.Istio == nil {
 		// If no eventing source is defined, return an empty string.
 		return ""
 	}
-
-	koDataDir := os.Getenv(common.KoEnvKey)
-	sourceVersion := common.LATEST_VERSION
-	if !strings.EqualFold(version, common.LATEST_VERSION) {
-		sourceVersion = semver.MajorMinor(common.SanitizeSemver(version))[1:]
+	source := ke.Spec.Source
+	if source == nil {
+		source = &v1beta1.SourceConfigs{}
 	}
 
-	// This line can make sure a valid available source version is returned.
-	sourcePath := filepath.Join(koDataDir, "eventing-source", sourceVersion)
+	// The bundles pinned by spec.versionOverrides are taken from the catalog of their version.
+	bundlePath := func(name string) string {
+		return Path(common.ComponentVersion(ke, name, version), name)
+	}
 	var urls []string
 
-	if ke.Spec.Source.Ceph.Enabled {
-		url := filepath.Join(sourcePath, "ceph")
+	if source.Ceph.Enabled {
+		url := bundlePath("ceph")
 		urls = append(urls, url)
 	}
-	if ke.Spec.Source.Github.Enabled {
-		url := filepath.Join(sourcePath, "github")
+	if source.Github.Enabled {
+		url := bundlePath("github")
 		urls = append(urls, url)
 	}
-	if ke.Spec.Source.Gitlab.Enabled {
-		url := filepath.Join(sourcePath, "gitlab")
+	if source.Gitlab.Enabled {
+		url := bundlePath("gitlab")
 		urls = append(urls, url)
 	}
-	if ke.Spec.Source.Kafka.Enabled {
-		url := filepath.Join(sourcePath, "kafka")
+	if source.Kafka.Enabled {
+		url := bundlePath("kafka")
 		urls = append(urls, url)
 	}
-	if ke.Spec.Source.Rabbitmq.Enabled {
-		url := filepath.Join(sourcePath, "rabbitmq")
+	if source.Rabbitmq.Enabled {
+		url := bundlePath("rabbitmq")
 		urls = append(urls, url)
 	}
-	if ke.Spec.Source.Redis.Enabled {
-		url := filepath.Join(sourcePath, "redis")
+	if source.Redis.Enabled {
+		url := bundlePath("redis")
+		urls = append(urls, url)
+	}
+	for _, name := range source.Bundles {
+		url := bundlePath(name)
+		if !slices.Contains(urls, url) {
+			urls = append(urls, url)
+		}
+	}
+	integrations := append(KafkaBundles(ke.Spec.Kafka), RabbitMQBundles(ke.Spec.RabbitMQ)...)
+	for _, name := range append(integrations, IstioBundles(ke.Spec.Istio)...) {
+		url := bundlePath(name)
+		if slices.Contains(urls, url) {
+			continue
+		}
+		if _, err := os.Stat(url); err != nil && OptionalBundle(name) {
+			continue
+		}
 		urls = append(urls, url)
 	}
 	return strings.Join(urls, common.COMMA)
 }
 
+// KafkaBundles returns the names of the source bundles, which install the Kafka components of
+// spec.kafka: the controller with the KafkaSource, and the enabled data planes.
+func KafkaBundles(kafka *base.KafkaConfiguration) []string {
+	if kafka == nil {
+		return nil
+	}
+	names := []string{KafkaBundle}
+	if kafka.Broker != nil && kafka.Broker.Enabled {
+		names = append(names, KafkaBrokerBundle)
+	}
+	if kafka.Channel != nil && kafka.Channel.Enabled {
+		names = append(names, KafkaChannelBundle)
+	}
+	if kafka.Sink != nil && kafka.Sink.Enabled {
+		names = append(names, KafkaSinkBundle)
+	}
+	return names
+}
+
+// RabbitMQBundles returns the names of the source bundles, which install the enabled RabbitMQ
+// components of spec.rabbitmq.
+func RabbitMQBundles(rabbitmq *base.RabbitMQConfiguration) []string {
+	if rabbitmq == nil {
+		return nil
+	}
+	var names []string
+	if rabbitmq.Source != nil && rabbitmq.Source.Enabled {
+		names = append(names, RabbitMQBundle)
+	}
+	if rabbitmq.Broker != nil && rabbitmq.Broker.Enabled {
+		names = append(names, RabbitMQBrokerBundle)
+	}
+	return names
+}
+
+// IstioBundles returns the names of the source bundles, which install eventing-istio, if
+// spec.istio enables it.
+func IstioBundles(istio *base.EventingComponentConfiguration) []string {
+	if istio == nil || !istio.Enabled {
+		return nil
+	}
+	return []string{IstioBundle}
+}
+
+// OptionalBundle returns whether the bundle is not shipped for every version. Its manifests may be
+// in spec.additionalManifests instead.
+func OptionalBundle(name string) bool {
+	switch name {
+	case KafkaBrokerBundle, KafkaChannelBundle, KafkaSinkBundle, RabbitMQBrokerBundle, IstioBundle:
+		return true
+	}
+	return false
+}
+
+// PingSourceResources matches the adapter of the built-in PingSource and its RBAC, which are left
+// out, if spec.source.ping disables it. The CRD and the controller of PingSources are part of the
+// eventing-controller, and stay installed.
+func PingSourceResources(ke *v1beta1.KnativeEventing) mf.Predicate {
+	return func(u *unstructured.Unstructured) bool {
+		if ke.Spec.Source == nil || ke.Spec.Source.Ping == nil || ke.Spec.Source.Ping.Enabled == nil || *ke.Spec.Source.Ping.Enabled {
+			return false
+		}
+		switch u.GetKind() {
+		case "Deployment", "ServiceAccount":
+			return u.GetName() == pingSourceAdapter
+		case "ClusterRole", "ClusterRoleBinding":
+			return u.GetName() == "knative-eventing-"+pingSourceAdapter
+		}
+		return false
+	}
+}
+
 // AppendTargetSources appends the manifests of the eventing sources to be installed
 func AppendTargetSources(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
 	version := common.TargetVersion(instance)
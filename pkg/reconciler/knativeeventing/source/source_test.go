@@ -20,9 +20,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/ptr"
+
 	"knative.dev/operator/pkg/apis/operator/base"
 	eventingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
 	"knative.dev/operator/pkg/reconciler/common"
@@ -325,6 +329,45 @@ func TestGetSourcePath(t *testing.T) {
 		expectedSourcePath: os.Getenv(common.KoEnvKey) + "/eventing-source/0.22/gitlab" + common.COMMA +
 			os.Getenv(common.KoEnvKey) + "/eventing-source/0.22/kafka" + common.COMMA +
 			os.Getenv(common.KoEnvKey) + "/eventing-source/0.22/rabbitmq",
+	}, {
+		name:    "Bundles of the catalog",
+		version: "0.22.1",
+		instance: eventingv1beta1.KnativeEventing{
+			Spec: eventingv1beta1.KnativeEventingSpec{
+				CommonSpec: base.CommonSpec{
+					Version: "0.22",
+				},
+				Source: &eventingv1beta1.SourceConfigs{
+					Kafka: base.KafkaSourceConfiguration{
+						Enabled: true,
+					},
+					Bundles: []string{"kafka", "redis"},
+				},
+			},
+		},
+		expectedSourcePath: os.Getenv(common.KoEnvKey) + "/eventing-source/0.22/kafka" + common.COMMA +
+			os.Getenv(common.KoEnvKey) + "/eventing-source/0.22/redis",
+	}, {
+		name:    "Bundle pinned by spec.versionOverrides",
+		version: "0.22.1",
+		instance: eventingv1beta1.KnativeEventing{
+			Spec: eventingv1beta1.KnativeEventingSpec{
+				CommonSpec: base.CommonSpec{
+					Version:          "0.22",
+					VersionOverrides: map[string]string{"redis": "0.21"},
+				},
+				Source: &eventingv1beta1.SourceConfigs{
+					Kafka: base.KafkaSourceConfiguration{
+						Enabled: true,
+					},
+					Redis: base.RedisSourceConfiguration{
+						Enabled: true,
+					},
+				},
+			},
+		},
+		expectedSourcePath: os.Getenv(common.KoEnvKey) + "/eventing-source/0.22/kafka" + common.COMMA +
+			os.Getenv(common.KoEnvKey) + "/eventing-source/0.21/redis",
 	}, {
 		name:    "No source is enabled",
 		version: "0.23.0",
@@ -345,3 +388,139 @@ func TestGetSourcePath(t *testing.T) {
 		})
 	}
 }
+
+func TestBundles(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+
+	util.AssertDeepEqual(t, Bundles("0.22.1"), []string{"ceph", "github", "gitlab", "kafka", "rabbitmq", "redis"})
+	util.AssertDeepEqual(t, Bundles("0.99.0"), []string(nil))
+}
+
+func TestPingSourceResources(t *testing.T) {
+	resources := []*unstructured.Unstructured{
+		makeResource("Deployment", "pingsource-mt-adapter"),
+		makeResource("ServiceAccount", "pingsource-mt-adapter"),
+		makeResource("ClusterRole", "knative-eventing-pingsource-mt-adapter"),
+		makeResource("ClusterRoleBinding", "knative-eventing-pingsource-mt-adapter"),
+		makeResource("ConfigMap", "config-ping-defaults"),
+		makeResource("Deployment", "eventing-controller"),
+	}
+	tests := []struct {
+		name     string
+		source   *eventingv1beta1.SourceConfigs
+		expected int
+	}{{
+		name:     "no source",
+		expected: 0,
+	}, {
+		name:     "enabled by default",
+		source:   &eventingv1beta1.SourceConfigs{Ping: &base.PingSourceConfiguration{}},
+		expected: 0,
+	}, {
+		name:     "enabled",
+		source:   &eventingv1beta1.SourceConfigs{Ping: &base.PingSourceConfiguration{Enabled: ptr.Bool(true)}},
+		expected: 0,
+	}, {
+		name:     "disabled",
+		source:   &eventingv1beta1.SourceConfigs{Ping: &base.PingSourceConfiguration{Enabled: ptr.Bool(false)}},
+		expected: 4,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ke := &eventingv1beta1.KnativeEventing{Spec: eventingv1beta1.KnativeEventingSpec{Source: tt.source}}
+			pred := PingSourceResources(ke)
+			matched := 0
+			for _, u := range resources {
+				if pred(u) {
+					matched++
+				}
+			}
+			util.AssertEqual(t, matched, tt.expected)
+		})
+	}
+}
+
+func makeResource(kind, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetKind(kind)
+	u.SetName(name)
+	return u
+}
+
+func TestGetSourcePathKafka(t *testing.T) {
+	koData := t.TempDir()
+	t.Setenv(common.KoEnvKey, koData)
+	for _, name := range []string{KafkaBundle, KafkaBrokerBundle} {
+		if err := os.MkdirAll(filepath.Join(koData, "eventing-source", "1.21", name), 0o755); err != nil {
+			t.Fatalf("MkdirAll() = %v", err)
+		}
+	}
+	sourcePath := filepath.Join(koData, "eventing-source", "1.21")
+
+	tests := []struct {
+		name               string
+		source             *eventingv1beta1.SourceConfigs
+		kafka              *base.KafkaConfiguration
+		expectedSourcePath string
+	}{{
+		name:               "controller only",
+		kafka:              &base.KafkaConfiguration{},
+		expectedSourcePath: filepath.Join(sourcePath, KafkaBundle),
+	}, {
+		name: "shipped data planes",
+		kafka: &base.KafkaConfiguration{
+			Broker:  &base.EventingComponentConfiguration{Enabled: true},
+			Channel: &base.EventingComponentConfiguration{Enabled: true},
+			Sink:    &base.EventingComponentConfiguration{Enabled: false},
+		},
+		expectedSourcePath: filepath.Join(sourcePath, KafkaBundle) + common.COMMA + filepath.Join(sourcePath, KafkaBrokerBundle),
+	}, {
+		name:               "also a source",
+		source:             &eventingv1beta1.SourceConfigs{Kafka: base.KafkaSourceConfiguration{Enabled: true}},
+		kafka:              &base.KafkaConfiguration{},
+		expectedSourcePath: filepath.Join(sourcePath, KafkaBundle),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ke := &eventingv1beta1.KnativeEventing{
+				Spec: eventingv1beta1.KnativeEventingSpec{Source: tt.source, Kafka: tt.kafka},
+			}
+			util.AssertEqual(t, GetSourcePath("1.21.0", ke), tt.expectedSourcePath)
+		})
+	}
+}
+
+func TestRabbitMQBundles(t *testing.T) {
+	enabled := &base.EventingComponentConfiguration{Enabled: true}
+	util.AssertDeepEqual(t, RabbitMQBundles(nil), []string(nil))
+	util.AssertDeepEqual(t, RabbitMQBundles(&base.RabbitMQConfiguration{}), []string(nil))
+	util.AssertDeepEqual(t, RabbitMQBundles(&base.RabbitMQConfiguration{Source: enabled}), []string{RabbitMQBundle})
+	util.AssertDeepEqual(t, RabbitMQBundles(&base.RabbitMQConfiguration{Source: enabled, Broker: enabled}), []string{RabbitMQBundle, RabbitMQBrokerBundle})
+}
+
+func TestIstioBundles(t *testing.T) {
+	util.AssertDeepEqual(t, IstioBundles(nil), []string(nil))
+	util.AssertDeepEqual(t, IstioBundles(&base.EventingComponentConfiguration{}), []string(nil))
+	util.AssertDeepEqual(t, IstioBundles(&base.EventingComponentConfiguration{Enabled: true}), []string{IstioBundle})
+}
+
+func TestGetSourcePathIstio(t *testing.T) {
+	koData := t.TempDir()
+	t.Setenv(common.KoEnvKey, koData)
+	sourcePath := filepath.Join(koData, "eventing-source", "1.21")
+	if err := os.MkdirAll(filepath.Join(sourcePath, KafkaBundle), 0o755); err != nil {
+		t.Fatalf("MkdirAll() = %v", err)
+	}
+	ke := &eventingv1beta1.KnativeEventing{
+		Spec: eventingv1beta1.KnativeEventingSpec{Istio: &base.EventingComponentConfiguration{Enabled: true}},
+	}
+	// Until the operator ships eventing-istio, its manifests are to be added to spec.additionalManifests.
+	util.AssertEqual(t, GetSourcePath("1.21.0", ke), "")
+
+	if err := os.MkdirAll(filepath.Join(sourcePath, IstioBundle), 0o755); err != nil {
+		t.Fatalf("MkdirAll() = %v", err)
+	}
+	util.AssertEqual(t, GetSourcePath("1.21.0", ke), filepath.Join(sourcePath, IstioBundle))
+}
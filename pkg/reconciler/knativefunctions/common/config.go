@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const (
+	// BuildersConfigMapName is the ConfigMap of the builder images of the on-cluster builds.
+	BuildersConfigMapName = "config-func-builders"
+	// PVCConfigMapName is the ConfigMap of the PersistentVolumeClaims of the on-cluster builds.
+	PVCConfigMapName = "config-func-pvc"
+
+	// PackKey is the key of the Cloud Native Buildpacks builder image in config-func-builders.
+	PackKey = "pack"
+	// S2IKey is the key of the Source-to-Image builder image in config-func-builders.
+	S2IKey = "s2i"
+
+	// SizeKey is the key of the requested storage in config-func-pvc.
+	SizeKey = "size"
+	// StorageClassNameKey is the key of the storage class in config-func-pvc.
+	StorageClassNameKey = "storage-class-name"
+	// AccessModeKey is the key of the access mode in config-func-pvc.
+	AccessModeKey = "access-mode"
+)
+
+// BuildersTransform writes the images of spec.builders to config-func-builders.
+func BuildersTransform(instance *v1beta1.KnativeFunctions) mf.Transformer {
+	return configMapTransform(instance, BuildersConfigMapName, builders(instance.Spec.Builders))
+}
+
+// PVCTransform writes the settings of spec.pvc to config-func-pvc.
+func PVCTransform(instance *v1beta1.KnativeFunctions) mf.Transformer {
+	data := map[string]string{}
+	if pvc := instance.Spec.PVC; pvc != nil {
+		if pvc.Size != nil {
+			data[SizeKey] = pvc.Size.String()
+		}
+		if pvc.StorageClassName != "" {
+			data[StorageClassNameKey] = pvc.StorageClassName
+		}
+		if pvc.AccessMode != "" {
+			data[AccessModeKey] = string(pvc.AccessMode)
+		}
+	}
+	return configMapTransform(instance, PVCConfigMapName, data)
+}
+
+// configMapTransform writes the data to the ConfigMap of the name. The keys set in spec.config are
+// kept, with or without the "config-" prefix of its name.
+func configMapTransform(instance *v1beta1.KnativeFunctions, name string, data map[string]string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if len(data) == 0 || u.GetKind() != "ConfigMap" || u.GetName() != name {
+			return nil
+		}
+		config := instance.Spec.GetConfig()
+		for key, value := range data {
+			if configured(config, name, key) {
+				continue
+			}
+			if err := unstructured.SetNestedField(u.Object, value, "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// configured returns whether spec.config sets the key of the ConfigMap of the name, with or without
+// the "config-" prefix of its name.
+func configured(config base.ConfigMapData, name, key string) bool {
+	for _, n := range []string{name, strings.TrimPrefix(name, "config-")} {
+		if _, ok := config[n][key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// builders returns the images of spec.builders by their keys in config-func-builders.
+func builders(spec *base.FunctionsBuildersConfiguration) map[string]string {
+	images := map[string]string{}
+	if spec == nil {
+		return images
+	}
+	if spec.Pack != "" {
+		images[PackKey] = spec.Pack
+	}
+	if spec.S2I != "" {
+		images[S2IKey] = spec.S2I
+	}
+	return images
+}
+
+// builderImage returns the builder image of the key, which config-func-builders ends up with: the one
+// of spec.config, else the one of spec.builders. It is empty, if both leave the image unset.
+func builderImage(instance *v1beta1.KnativeFunctions, key string) string {
+	config := instance.Spec.GetConfig()
+	for _, name := range []string{BuildersConfigMapName, strings.TrimPrefix(BuildersConfigMapName, "config-")} {
+		if image, ok := config[name][key]; ok {
+			return image
+		}
+	}
+	return builders(instance.Spec.Builders)[key]
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func configMap(name string, data map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("ConfigMap")
+	u.SetName(name)
+	_ = unstructured.SetNestedStringMap(u.Object, data, "data")
+	return u
+}
+
+func TestBuildersTransform(t *testing.T) {
+	shipped := map[string]string{PackKey: "ghcr.io/knative/builder-jammy-base:latest", S2IKey: "registry.access.redhat.com/ubi8/nodejs-20-minimal"}
+	tests := []struct {
+		name     string
+		builders *base.FunctionsBuildersConfiguration
+		config   base.ConfigMapData
+		expected map[string]string
+	}{{
+		name:     "no builders",
+		expected: shipped,
+	}, {
+		name:     "pack builder",
+		builders: &base.FunctionsBuildersConfiguration{Pack: "example.com/builder:latest"},
+		expected: map[string]string{PackKey: "example.com/builder:latest", S2IKey: shipped[S2IKey]},
+	}, {
+		name:     "builder of spec.config",
+		builders: &base.FunctionsBuildersConfiguration{Pack: "example.com/builder:latest", S2I: "example.com/s2i:latest"},
+		config:   base.ConfigMapData{"func-builders": {PackKey: shipped[PackKey]}},
+		expected: map[string]string{PackKey: shipped[PackKey], S2IKey: "example.com/s2i:latest"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeFunctions{
+				Spec: v1beta1.KnativeFunctionsSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Builders:   tt.builders,
+				},
+			}
+			u := configMap(BuildersConfigMapName, shipped)
+			if err := BuildersTransform(instance)(u); err != nil {
+				t.Fatalf("BuildersTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
+
+func TestPVCTransform(t *testing.T) {
+	size := resource.MustParse("1Gi")
+	shipped := map[string]string{SizeKey: "256Mi", AccessModeKey: "ReadWriteOnce"}
+	tests := []struct {
+		name     string
+		pvc      *base.FunctionsPVCConfiguration
+		config   base.ConfigMapData
+		expected map[string]string
+	}{{
+		name:     "no pvc",
+		expected: shipped,
+	}, {
+		name: "all settings",
+		pvc:  &base.FunctionsPVCConfiguration{Size: &size, StorageClassName: "fast", AccessMode: corev1.ReadWriteMany},
+		expected: map[string]string{
+			SizeKey:             "1Gi",
+			StorageClassNameKey: "fast",
+			AccessModeKey:       "ReadWriteMany",
+		},
+	}, {
+		name:     "size of spec.config",
+		pvc:      &base.FunctionsPVCConfiguration{Size: &size},
+		config:   base.ConfigMapData{PVCConfigMapName: {SizeKey: "256Mi"}},
+		expected: shipped,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeFunctions{
+				Spec: v1beta1.KnativeFunctionsSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					PVC:        tt.pvc,
+				},
+			}
+			u := configMap(PVCConfigMapName, shipped)
+			if err := PVCTransform(instance)(u); err != nil {
+				t.Fatalf("PVCTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
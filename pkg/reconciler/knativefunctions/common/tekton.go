@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+const (
+	// BuildpacksTaskName is the Task of the on-cluster builds with Cloud Native Buildpacks.
+	BuildpacksTaskName = "func-buildpacks"
+	// S2ITaskName is the Task of the on-cluster builds with Source-to-Image.
+	S2ITaskName = "func-s2i"
+	// BuilderImageParam is the parameter of the builder image of the build Tasks.
+	BuilderImageParam = "BUILDER_IMAGE"
+
+	tektonGroupVersion = "tekton.dev/v1"
+)
+
+// taskBuilders maps the build Tasks to the keys of their builder images in config-func-builders.
+var taskBuilders = map[string]string{
+	BuildpacksTaskName: PackKey,
+	S2ITaskName:        S2IKey,
+}
+
+// BuilderImageTransform defaults the builder image parameter of the build Tasks to the image of
+// config-func-builders, so that the pipelines of func, which pass no image, build with it.
+func BuilderImageTransform(instance *v1beta1.KnativeFunctions) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetAPIVersion() != tektonGroupVersion || u.GetKind() != "Task" {
+			return nil
+		}
+		key, ok := taskBuilders[u.GetName()]
+		if !ok {
+			return nil
+		}
+		image := builderImage(instance, key)
+		if image == "" {
+			return nil
+		}
+		params, _, err := unstructured.NestedSlice(u.Object, "spec", "params")
+		if err != nil {
+			return err
+		}
+		for i := range params {
+			param, ok := params[i].(map[string]interface{})
+			if !ok || param["name"] != BuilderImageParam {
+				continue
+			}
+			param["default"] = image
+		}
+		return unstructured.SetNestedSlice(u.Object, params, "spec", "params")
+	}
+}
+
+// CheckTekton is a Stage, which validates that the cluster serves the Tekton APIs of the manifest.
+// The Tasks can't be applied, and func can't run its on-cluster builds without Tekton Pipelines.
+func CheckTekton(kubeClient kubernetes.Interface) common.Stage {
+	return func(_ context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		required := sets.New[string]()
+		for _, u := range manifest.Resources() {
+			if u.GetAPIVersion() == tektonGroupVersion {
+				required.Insert(u.GetKind())
+			}
+		}
+		if required.Len() == 0 {
+			return nil
+		}
+		resources, err := kubeClient.Discovery().ServerResourcesForGroupVersion(tektonGroupVersion)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to discover the resources of %s: %w", tektonGroupVersion, err)
+		}
+		if resources != nil {
+			for _, r := range resources.APIResources {
+				required.Delete(r.Kind)
+			}
+		}
+		if required.Len() > 0 {
+			msg := fmt.Sprintf("Tekton Pipelines is not installed, the API %s is not served for %s",
+				tektonGroupVersion, strings.Join(sets.List(required), ", "))
+			instance.GetStatus().MarkInstallFailed(msg)
+			return fmt.Errorf("%s", msg)
+		}
+		return nil
+	}
+}
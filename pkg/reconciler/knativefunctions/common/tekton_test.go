@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func task(name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(tektonGroupVersion)
+	u.SetKind("Task")
+	u.SetName(name)
+	_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+		map[string]interface{}{"name": "APP_IMAGE"},
+		map[string]interface{}{"name": BuilderImageParam, "default": "shipped"},
+	}, "spec", "params")
+	return u
+}
+
+func TestBuilderImageTransform(t *testing.T) {
+	tests := []struct {
+		name     string
+		task     string
+		builders *base.FunctionsBuildersConfiguration
+		config   base.ConfigMapData
+		expected string
+	}{{
+		name:     "no builders",
+		task:     BuildpacksTaskName,
+		expected: "shipped",
+	}, {
+		name:     "builder of spec.builders",
+		task:     S2ITaskName,
+		builders: &base.FunctionsBuildersConfiguration{S2I: "example.com/s2i:latest"},
+		expected: "example.com/s2i:latest",
+	}, {
+		name:     "builder of spec.config",
+		task:     BuildpacksTaskName,
+		config:   base.ConfigMapData{BuildersConfigMapName: {PackKey: "example.com/builder:latest"}},
+		expected: "example.com/builder:latest",
+	}, {
+		name:     "other task",
+		task:     "func-deploy",
+		builders: &base.FunctionsBuildersConfiguration{Pack: "example.com/builder:latest"},
+		expected: "shipped",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeFunctions{
+				Spec: v1beta1.KnativeFunctionsSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Builders:   tt.builders,
+				},
+			}
+			u := task(tt.task)
+			if err := BuilderImageTransform(instance)(u); err != nil {
+				t.Fatalf("BuilderImageTransform() = %v", err)
+			}
+			params, _, _ := unstructured.NestedSlice(u.Object, "spec", "params")
+			util.AssertEqual(t, params[1].(map[string]interface{})["default"], tt.expected)
+			util.AssertEqual(t, len(params[0].(map[string]interface{})), 1)
+		})
+	}
+}
+
+func TestCheckTekton(t *testing.T) {
+	tests := []struct {
+		name        string
+		resources   []unstructured.Unstructured
+		served      []string
+		expectedErr string
+	}{{
+		name: "no tekton resources",
+	}, {
+		name:        "tekton not installed",
+		resources:   []unstructured.Unstructured{*task(BuildpacksTaskName)},
+		expectedErr: "Tekton Pipelines is not installed, the API tekton.dev/v1 is not served for Task",
+	}, {
+		name:      "tekton installed",
+		resources: []unstructured.Unstructured{*task(BuildpacksTaskName)},
+		served:    []string{"Pipeline", "Task"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			if len(tt.served) > 0 {
+				resources := &metav1.APIResourceList{GroupVersion: tektonGroupVersion}
+				for _, kind := range tt.served {
+					resources.APIResources = append(resources.APIResources, metav1.APIResource{Kind: kind})
+				}
+				kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{resources}
+			}
+			instance := &v1beta1.KnativeFunctions{}
+			instance.Status.InitializeConditions()
+			manifest, _ := mf.ManifestFrom(mf.Slice(tt.resources))
+
+			err := CheckTekton(kubeClient)(context.Background(), &manifest, instance)
+			if tt.expectedErr == "" {
+				util.AssertEqual(t, err, nil)
+				return
+			}
+			if err == nil || err.Error() != tt.expectedErr {
+				t.Fatalf("CheckTekton() = %v, want %q", err, tt.expectedErr)
+			}
+			util.AssertEqual(t, instance.Status.IsReady(), false)
+		})
+	}
+}
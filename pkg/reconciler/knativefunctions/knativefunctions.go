@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativefunctions
+
+import (
+	"context"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	clientset "knative.dev/operator/pkg/client/clientset/versioned"
+	kfreconciler "knative.dev/operator/pkg/client/injection/reconciler/operator/v1beta1/knativefunctions"
+	"knative.dev/operator/pkg/reconciler/common"
+	kfc "knative.dev/operator/pkg/reconciler/knativefunctions/common"
+	"knative.dev/operator/pkg/reconciler/manifests"
+)
+
+// Reconciler implements controller.Reconciler for KnativeFunctions resources.
+type Reconciler struct {
+	// kubeClientSet allows us to talk to the k8s for core APIs
+	kubeClientSet kubernetes.Interface
+	// operatorClientSet allows us to talk to the k8s for operator APIs
+	operatorClientSet clientset.Interface
+	// manifest is empty, but with a valid client and logger. all
+	// manifests are immutable, and any created during reconcile are
+	// expected to be appended to this one, obviating the passing of
+	// client & logger
+	manifest mf.Manifest
+	// renderCache avoids rendering the manifest again, if its inputs did not change
+	renderCache *common.RenderCache
+	// targetClusters holds the clients of the clusters, into which the components are installed
+	targetClusters *common.TargetClusters
+	// recommender recommends the resources of the containers for spec.resourceRecommendations
+	recommender *common.ResourceRecommender
+}
+
+// Check that our Reconciler implements controller.Reconciler
+var _ kfreconciler.Interface = (*Reconciler)(nil)
+var _ kfreconciler.Finalizer = (*Reconciler)(nil)
+
+// FinalizeKind removes all resources after deletion of a KnativeFunctions.
+func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1beta1.KnativeFunctions) pkgreconciler.Event {
+	logger := logging.FromContext(ctx)
+
+	// Clean up the cache, if the Functions CR is deleted.
+	common.ClearCache()
+	r.renderCache.Delete(original)
+	common.ForgetWebhookCertificates(original)
+	common.ForgetAppliedHashes(original)
+	common.ForgetUpgrade(ctx, original)
+	r.targetClusters.ForgetStatus(ctx, original)
+
+	// List all KnativeFunctions to determine if cluster-scoped resources should be deleted.
+	var kfs []v1beta1.KnativeFunctions
+	for _, ns := range common.GetControllerConfig(ctx).ListNamespaces() {
+		list, err := r.operatorClientSet.OperatorV1beta1().KnativeFunctions(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list all KnativeFunctions: %w", err)
+		}
+		kfs = append(kfs, list.Items...)
+	}
+
+	for _, kf := range kfs {
+		if kf.GetDeletionTimestamp().IsZero() && common.SameTargetCluster(&kf, original) {
+			// Not deleting all KnativeFunctions. Nothing to do here.
+			return nil
+		}
+	}
+
+	logger.Info("Deleting cluster-scoped resources")
+	manifest, err := r.installed(ctx, original)
+	if err != nil {
+		logger.Error("Unable to fetch installed manifest; no cluster-scoped resources will be finalized", err)
+		return nil
+	}
+	if manifest == nil {
+		return nil
+	}
+	if err := common.Uninstall(manifest); err != nil {
+		logger.Error("Failed to finalize platform resources", err)
+	}
+	return nil
+}
+
+// ReconcileKind compares the actual state with the desired, and attempts to
+// converge the two.
+func (r *Reconciler) ReconcileKind(ctx context.Context, kf *v1beta1.KnativeFunctions) (event pkgreconciler.Event) {
+	logger := logging.FromContext(ctx)
+	original := kf.DeepCopy()
+	defer func() {
+		if err := common.PatchStatus(ctx, r.operatorClientSet, original, kf); err != nil {
+			event = err
+		}
+	}()
+	kf.Status.InitializeConditions()
+	defer common.ReportUpgrade(ctx, kf)
+	defer r.targetClusters.PublishStatus(ctx, kf)
+
+	logger.Infow("Reconciling KnativeFunctions", "status", kf.Status)
+
+	if common.CheckPaused(kf) {
+		logger.Infow("Reconciliation is paused", "annotation", base.PausedAnnotation)
+		return nil
+	}
+
+	if err := common.IsVersionValidMigrationEligible(kf); err != nil {
+		kf.Status.MarkVersionMigrationNotEligible(err.Error())
+		common.ObserveGeneration(kf, &kf.Status.Status, nil)
+		return nil
+	}
+	kf.Status.MarkVersionMigrationEligible()
+
+	kubeClient, manifest, err := r.targetCluster(ctx, kf)
+	if err != nil {
+		kf.Status.MarkInstallFailed(err.Error())
+		common.ObserveGeneration(kf, &kf.Status.Status, err)
+		return err
+	}
+	stages := r.renderStages(kubeClient)
+	stages = append(stages,
+		kfc.CheckTekton(kubeClient),
+		common.ExternalTransform,
+		common.ConfigFromSecrets(r.kubeClientSet), // After the external transformer, which must not see the values of the Secrets
+		common.CheckConfigNames,
+		common.UnmanagedConfigMaps,
+		r.recommender.Recommend,
+		common.ResolveDigests(r.kubeClientSet),
+		common.Preflight(kubeClient),
+		common.CheckWebhookCertificates(r.kubeClientSet, kubeClient),
+		common.CustomStages(common.StagePositionAfterRender),
+		common.Preview(r.kubeClientSet), // In dry-run mode, the stages stop after publishing the preview
+		common.PruneConfigKeys,
+		manifests.Install,
+		manifests.SetManifestPaths, // setting path right after applying manifests to populate paths
+		common.SetPermissions,
+		common.CustomStages(common.StagePositionAfterInstall),
+		common.SetAppliedManifest,
+		common.PublishInventory(r.kubeClientSet),
+		common.DeleteObsoleteNetworkPolicies(kubeClient),
+		common.DeleteObsoleteSpotPodDisruptionBudgets(kubeClient),
+		common.DeleteObsoleteResiliencePodDisruptionBudgets(kubeClient),
+		common.CheckDeployments,
+		common.MarkStatusSuccess,
+		common.CustomStages(common.StagePositionAfterReady),
+		common.DeleteObsoleteResources(ctx, kf, r.installed),
+	)
+	err = stages.Execute(ctx, &manifest, kf)
+	common.ObserveGeneration(kf, &kf.Status.Status, err)
+	if err != nil {
+		// Render the manifest again on the next attempt, in case it depends on a changed cluster.
+		r.renderCache.Delete(kf)
+		return err
+	}
+	if kf.Spec.TargetCluster != nil && !kf.Status.IsReady() {
+		// The resources in a target cluster are not watched, poll until they are ready.
+		return controller.NewRequeueAfter(common.TargetClusterPollInterval)
+	}
+	return nil
+}
+
+// renderStages returns the stages, which compute the manifest to be applied for the component
+func (r *Reconciler) renderStages(kubeClient kubernetes.Interface) common.Stages {
+	return common.Stages{
+		r.renderCache.Stage(common.Stages{
+			common.AppendTarget,
+			common.AppendAdditionalManifests,
+			common.AppendNetworkPolicies,
+			common.AppendSpotPodDisruptionBudgets,
+			common.ResiliencePodDisruptionBudgets,
+			r.transform,
+		}),
+		common.UpgradeRemovedAPIs(kubeClient), // Not cached, the version of Kubernetes changes with upgrades of the cluster
+		common.AggregateClusterRoles(kubeClient),
+		common.ApplyPlatform(kubeClient),
+	}
+}
+
+// transform mutates the passed manifest to one with common and component transformations applied
+func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
+	instance := comp.(*v1beta1.KnativeFunctions)
+	extra := []mf.Transformer{
+		kfc.BuildersTransform(instance),
+		kfc.PVCTransform(instance),
+		kfc.BuilderImageTransform(instance),
+		// Ensure all resources have the selector applied so that the controller re-queues applied resources when they change.
+		common.InjectLabel(SelectorKey, SelectorValue),
+	}
+	return common.Transform(ctx, manifest, instance, extra...)
+}
+
+// injectNamespace mutates the namespace of all installed resources
+func (r *Reconciler) injectNamespace(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
+	return common.InjectNamespace(manifest, comp)
+}
+
+// targetCluster returns the clients of the cluster, into which the component is installed: the
+// cluster of the operator, unless the component specifies a target cluster.
+func (r *Reconciler) targetCluster(ctx context.Context, instance base.KComponent) (kubernetes.Interface, mf.Manifest, error) {
+	clients, err := r.targetClusters.Get(ctx, instance)
+	if err != nil || clients == nil {
+		return r.kubeClientSet, r.manifest.Append(), err
+	}
+	manifest := r.manifest.Append()
+	manifest.Client = clients.Manifest
+	return clients.Kube, manifest, nil
+}
+
+func (r *Reconciler) installed(ctx context.Context, instance base.KComponent) (*mf.Manifest, error) {
+	paths := instance.GetStatus().GetManifests()
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	installed, err := common.FetchManifestFromArray(paths)
+	if err != nil {
+		return &installed, err
+	}
+	_, target, err := r.targetCluster(ctx, instance)
+	if err != nil {
+		return &installed, err
+	}
+	installed = target.Append(installed)
+
+	// Per the manifests, that have been installed in the cluster, we only need to inject the correct namespace
+	// in the stages.
+	stages := common.Stages{r.injectNamespace}
+	err = stages.Execute(ctx, &installed, instance)
+	return &installed, err
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativefunctions
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/system"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	operatorfake "knative.dev/operator/pkg/client/clientset/versioned/fake"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestTargetClusterStatus(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	t.Setenv(common.KoEnvKey, "../../../cmd/operator/kodata")
+	ctx := context.Background()
+	kf := &v1beta1.KnativeFunctions{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-functions", Name: "spoke"},
+		Spec: v1beta1.KnativeFunctionsSpec{CommonSpec: base.CommonSpec{
+			TargetCluster: &base.TargetCluster{SecretName: "spoke"},
+		}},
+	}
+	kubeClient := kubefake.NewSimpleClientset()
+	r := &Reconciler{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorfake.NewSimpleClientset(kf),
+		manifest:          mf.Manifest{Client: common.OfflineClient()},
+		targetClusters:    common.NewTargetClusters(kubeClient),
+	}
+	entry := func() string {
+		t.Helper()
+		cm, err := kubeClient.CoreV1().ConfigMaps("knative-operator").Get(ctx, common.TargetClustersConfigMapName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return ""
+		}
+		if err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+		return cm.Data["knative-functions.spoke.kubeconfig"]
+	}
+
+	// The kubeconfig of the target cluster is missing, which the status reports.
+	if err := r.ReconcileKind(ctx, kf); err == nil {
+		t.Fatal("ReconcileKind() = nil, want an error for the missing kubeconfig")
+	}
+	if entry() == "" {
+		t.Fatal("The KnativeFunctions is missing in the ConfigMap of the target clusters")
+	}
+
+	if err := r.FinalizeKind(ctx, kf); err != nil {
+		t.Fatalf("FinalizeKind() = %v", err)
+	}
+	if got := entry(); got != "" {
+		t.Fatalf("The deleted KnativeFunctions is still in the ConfigMap of the target clusters: %s", got)
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativefunctions
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// Render returns the manifest, which the operator would apply for the given KnativeFunctions, without
+// accessing the cluster.
+func Render(ctx context.Context, kf *v1beta1.KnativeFunctions) (*mf.Manifest, error) {
+	r := &Reconciler{
+		manifest: mf.Manifest{Client: common.OfflineClient()},
+	}
+	kf.Status.InitializeConditions()
+	manifest := r.manifest.Append()
+	if err := r.renderStages(nil).Execute(ctx, &manifest, kf); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	listers "knative.dev/operator/pkg/client/listers/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// Serving returns the KnativeServing, whose ingresses the KnativeNetworking installs: the one in the same
+// namespace, which installs Knative Serving into the same cluster. It is nil, if there is none.
+func Serving(lister listers.KnativeServingLister, kn *v1beta1.KnativeNetworking) (*v1beta1.KnativeServing, error) {
+	kss, err := lister.KnativeServings(kn.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, ks := range kss {
+		if ks.GetDeletionTimestamp().IsZero() && common.SameTargetCluster(ks, kn) {
+			return ks, nil
+		}
+	}
+	return nil, nil
+}
+
+// Networking returns the KnativeNetworking, which installs the ingresses of the KnativeServing instead
+// of it. It is nil, if there is none, so that the KnativeServing installs its ingresses itself.
+func Networking(lister listers.KnativeNetworkingLister, ks *v1beta1.KnativeServing) (*v1beta1.KnativeNetworking, error) {
+	if lister == nil {
+		return nil, nil
+	}
+	kns, err := lister.KnativeNetworkings(ks.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, kn := range kns {
+		if kn.GetDeletionTimestamp().IsZero() && common.SameTargetCluster(kn, ks) {
+			return kn, nil
+		}
+	}
+	return nil, nil
+}
+
+// AdoptServing sets the fields of the KnativeNetworking, which it leaves unset, to the ones of the
+// KnativeServing, so that the ingresses are installed with the same configuration as by the
+// KnativeServing itself. Without a version, the ingresses follow the version of Knative Serving.
+func AdoptServing(kn *v1beta1.KnativeNetworking, ks *v1beta1.KnativeServing) {
+	spec, from := &kn.Spec, ks.Spec.DeepCopy()
+	if spec.Ingress == nil {
+		spec.Ingress = from.Ingress
+	}
+	if spec.Version == "" && len(spec.Manifests) == 0 {
+		spec.Version = common.TargetVersion(ks)
+	}
+	if spec.Config == nil {
+		spec.Config = from.Config
+	}
+	if equality.Semantic.DeepEqual(spec.Registry, base.Registry{}) {
+		spec.Registry = from.Registry
+	}
+	if spec.Workloads == nil {
+		spec.Workloads = from.Workloads
+	}
+	if spec.ServiceOverride == nil {
+		spec.ServiceOverride = from.ServiceOverride
+	}
+	if spec.ServiceAccountOverride == nil {
+		spec.ServiceAccountOverride = from.ServiceAccountOverride
+	}
+	if spec.HighAvailability == nil {
+		spec.HighAvailability = from.HighAvailability
+	}
+	if spec.PodDisruptionBudgetOverride == nil {
+		spec.PodDisruptionBudgetOverride = from.PodDisruptionBudgetOverride
+	}
+	if spec.NamespaceConfiguration == nil {
+		spec.NamespaceConfiguration = from.NamespaceConfiguration
+	}
+	if spec.Platform == "" {
+		spec.Platform = from.Platform
+	}
+	if spec.Architectures == nil {
+		spec.Architectures = from.Architectures
+	}
+	if spec.LinuxNodesOnly == nil {
+		spec.LinuxNodesOnly = from.LinuxNodesOnly
+	}
+	if len(spec.IPFamilies) == 0 && spec.IPFamilyPolicy == nil {
+		spec.IPFamilyConfiguration = from.IPFamilyConfiguration
+	}
+	if spec.Spot == nil {
+		spec.Spot = from.Spot
+	}
+	if spec.Security == nil && from.Security != nil && from.Security.PodSecurityStandard != "" {
+		spec.Security = &from.Security.PodSecurityConfiguration
+	}
+}
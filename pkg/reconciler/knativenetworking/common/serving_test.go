@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	listers "knative.dev/operator/pkg/client/listers/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func indexer(t *testing.T, objs ...interface{}) cache.Indexer {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range objs {
+		if err := indexer.Add(obj); err != nil {
+			t.Fatalf("Add() = %v", err)
+		}
+	}
+	return indexer
+}
+
+func TestServing(t *testing.T) {
+	now := metav1.Now()
+	spoke := &base.TargetCluster{SecretName: "spoke"}
+	tests := []struct {
+		name     string
+		existing []interface{}
+		want     string
+	}{{
+		name: "no KnativeServing",
+	}, {
+		name: "same namespace",
+		existing: []interface{}{
+			&v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "other"}},
+			&v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}},
+		},
+		want: "knative-serving",
+	}, {
+		name: "being deleted",
+		existing: []interface{}{
+			&v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving", DeletionTimestamp: &now}},
+		},
+	}, {
+		name: "other target cluster",
+		existing: []interface{}{
+			&v1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "spoke"},
+				Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{TargetCluster: spoke}},
+			},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kn := &v1beta1.KnativeNetworking{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-networking"}}
+			ks, err := Serving(listers.NewKnativeServingLister(indexer(t, test.existing...)), kn)
+			if err != nil {
+				t.Fatalf("Serving() = %v", err)
+			}
+			got := ""
+			if ks != nil {
+				got = ks.Name
+			}
+			util.AssertEqual(t, got, test.want)
+		})
+	}
+}
+
+func TestNetworking(t *testing.T) {
+	ks := &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"}}
+	kn, err := Networking(nil, ks)
+	if err != nil || kn != nil {
+		t.Fatalf("Networking() without a lister = %v, %v, want nil", kn, err)
+	}
+
+	now := metav1.Now()
+	lister := listers.NewKnativeNetworkingLister(indexer(t,
+		&v1beta1.KnativeNetworking{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "other"}},
+		&v1beta1.KnativeNetworking{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "deleted", DeletionTimestamp: &now}},
+		&v1beta1.KnativeNetworking{ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-networking"}},
+	))
+	kn, err = Networking(lister, ks)
+	if err != nil {
+		t.Fatalf("Networking() = %v", err)
+	}
+	if kn == nil || kn.Name != "knative-networking" {
+		t.Fatalf("Networking() = %v, want knative-serving/knative-networking", kn)
+	}
+}
+
+func TestAdoptServing(t *testing.T) {
+	ks := &v1beta1.KnativeServing{
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{
+				Version:          "1.21.0",
+				Config:           base.ConfigMapData{"istio": {"gateway.knative-serving.knative-ingress-gateway": "istio-ingressgateway.istio-system.svc.cluster.local"}},
+				Registry:         base.Registry{Default: "example-registry.io/${NAME}:1.21.0"},
+				Workloads:        []base.WorkloadOverride{{Name: "net-istio-controller", Replicas: ptr.Int32(2)}},
+				HighAvailability: &base.HighAvailability{Replicas: ptr.Int32(3)},
+				Architectures:    []string{"amd64", "arm64"},
+				LinuxNodesOnly:   ptr.Bool(false),
+				IPFamilyConfiguration: base.IPFamilyConfiguration{
+					IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+				},
+				Spot: &base.SpotConfiguration{Enabled: true, NodeSelector: map[string]string{"spot": "true"}},
+			},
+			Ingress: &v1beta1.IngressConfigs{Istio: base.IstioIngressConfiguration{Enabled: true}},
+		},
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		kn := &v1beta1.KnativeNetworking{}
+		AdoptServing(kn, ks)
+		util.AssertDeepEqual(t, kn.Spec, v1beta1.KnativeNetworkingSpec{CommonSpec: ks.Spec.CommonSpec, Ingress: ks.Spec.Ingress})
+		kn.Spec.Config["istio"]["other"] = "value"
+		if _, ok := ks.Spec.Config["istio"]["other"]; ok {
+			t.Error("AdoptServing() shares spec.config with the KnativeServing")
+		}
+	})
+
+	t.Run("set fields", func(t *testing.T) {
+		ingress := &v1beta1.IngressConfigs{Kourier: base.KourierIngressConfiguration{Enabled: true}}
+		kn := &v1beta1.KnativeNetworking{
+			Spec: v1beta1.KnativeNetworkingSpec{
+				CommonSpec: base.CommonSpec{Version: "1.20", Registry: base.Registry{Override: map[string]string{"kourier": "example-registry.io/kourier"}}},
+				Ingress:    ingress,
+			},
+		}
+		AdoptServing(kn, ks)
+		util.AssertEqual(t, kn.Spec.Version, "1.20")
+		util.AssertDeepEqual(t, kn.Spec.Ingress, ingress)
+		util.AssertEqual(t, kn.Spec.Registry.Default, "")
+		util.AssertDeepEqual(t, kn.Spec.Config, ks.Spec.Config)
+		util.AssertDeepEqual(t, kn.Spec.HighAvailability, ks.Spec.HighAvailability)
+	})
+
+	t.Run("pod security standard", func(t *testing.T) {
+		ks := ks.DeepCopy()
+		ks.Spec.Security = &v1beta1.SecurityConfigs{
+			PodSecurityConfiguration: base.PodSecurityConfiguration{PodSecurityStandard: base.PodSecurityStandardRestricted},
+		}
+		kn := &v1beta1.KnativeNetworking{}
+		AdoptServing(kn, ks)
+		util.AssertEqual(t, kn.Spec.GetPodSecurityStandard(), base.PodSecurityStandardRestricted)
+	})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativenetworking
+
+import (
+	"context"
+
+	"github.com/go-logr/zapr"
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	operatorclient "knative.dev/operator/pkg/client/injection/client"
+	knativeNetworkinginformer "knative.dev/operator/pkg/client/injection/informers/operator/v1beta1/knativenetworking"
+	knativeServinginformer "knative.dev/operator/pkg/client/injection/informers/operator/v1beta1/knativeserving"
+	knreconciler "knative.dev/operator/pkg/client/injection/reconciler/operator/v1beta1/knativenetworking"
+	"knative.dev/operator/pkg/reconciler/common"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment/filtered"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// SelectorKey is the key of the selector for the KnativeNetworking resources.
+	SelectorKey = "app.kubernetes.io/name"
+	// SelectorValue is the value of the selector for the KnativeNetworking resources.
+	SelectorValue = "knative-networking"
+	// Selector is the selector for the KnativeNetworking resources.
+	Selector = SelectorKey + "=" + SelectorValue
+)
+
+// NewController initializes the controller and is called by the generated code
+// Registers eventhandlers to enqueue events
+func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	knativeNetworkingInformer := knativeNetworkinginformer.Get(ctx)
+	knativeServingInformer := knativeServinginformer.Get(ctx)
+	deploymentInformer := deploymentinformer.Get(ctx, Selector)
+	configMapInformer := configmapinformer.Get(ctx, Selector)
+	kubeClient := kubeclient.Get(ctx)
+	logger := logging.FromContext(ctx)
+
+	mfclient, err := common.NewManifestClient(injection.GetConfig(ctx))
+	if err != nil {
+		logger.Fatalw("Error creating client from injected config", zap.Error(err))
+	}
+	mflogger := zapr.NewLogger(logger.Named("manifestival").Desugar())
+	manifest, _ := mf.ManifestFrom(mf.Slice{}, mf.UseClient(mfclient), mf.UseLogger(mflogger))
+
+	c := &Reconciler{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorclient.Get(ctx),
+		servingLister:     knativeServingInformer.Lister(),
+		manifest:          manifest,
+		renderCache:       common.NewRenderCache(),
+		targetClusters:    common.NewTargetClusters(kubeClient),
+	}
+	impl := common.ConfigureController(ctx, "KnativeNetworking", knreconciler.NewImpl(ctx, c, common.PatchStatusOptions))
+	c.recommender = common.NewResourceRecommender(kubeClient, dynamicclient.Get(ctx), impl)
+
+	logger.Info("Setting up event handlers")
+
+	knativeNetworkingInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	common.ResyncPeriodically(ctx, impl, knativeNetworkingInformer.Informer())
+	common.WatchDrift(ctx, impl, Selector, v1beta1.SchemeGroupVersion.WithKind("KnativeNetworking"))
+	common.WatchWebhookCertificates(ctx, impl, Selector, v1beta1.SchemeGroupVersion.WithKind("KnativeNetworking"))
+	common.WatchConfigSecrets(ctx, impl, Selector, func(namespace string) ([]*v1beta1.KnativeNetworking, error) {
+		return knativeNetworkingInformer.Lister().KnativeNetworkings(namespace).List(labels.Everything())
+	})
+
+	// The KnativeNetworking adopts the configuration of the ingresses of the KnativeServing in its namespace.
+	knativeServingInformer.Informer().AddEventHandler(controller.HandleAll(common.EnqueueNamespace(impl,
+		func(namespace string) ([]*v1beta1.KnativeNetworking, error) {
+			return knativeNetworkingInformer.Lister().KnativeNetworkings(namespace).List(labels.Everything())
+		})))
+
+	// The informers only enqueue the KnativeNetworking, so they do not need to cache the full resources.
+	for _, informer := range []cache.SharedIndexInformer{deploymentInformer.Informer(), configMapInformer.Informer()} {
+		if err := informer.SetTransform(common.TrimForEnqueue); err != nil {
+			logger.Warnw("Failed to trim the objects cached by the informer", zap.Error(err))
+		}
+	}
+	deploymentInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGVK(v1beta1.SchemeGroupVersion.WithKind("KnativeNetworking")),
+		Handler:    common.EnqueueDrifted(impl),
+	})
+	configMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGVK(v1beta1.SchemeGroupVersion.WithKind("KnativeNetworking")),
+		Handler:    common.EnqueueDrifted(impl),
+	})
+
+	return impl
+}
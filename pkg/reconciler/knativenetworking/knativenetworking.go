@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativenetworking
+
+import (
+	"context"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	clientset "knative.dev/operator/pkg/client/clientset/versioned"
+	knreconciler "knative.dev/operator/pkg/client/injection/reconciler/operator/v1beta1/knativenetworking"
+	listers "knative.dev/operator/pkg/client/listers/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	knc "knative.dev/operator/pkg/reconciler/knativenetworking/common"
+	ksc "knative.dev/operator/pkg/reconciler/knativeserving/common"
+	"knative.dev/operator/pkg/reconciler/knativeserving/ingress"
+	"knative.dev/operator/pkg/reconciler/manifests"
+)
+
+// Reconciler implements controller.Reconciler for KnativeNetworking resources.
+type Reconciler struct {
+	// kubeClientSet allows us to talk to the k8s for core APIs
+	kubeClientSet kubernetes.Interface
+	// operatorClientSet allows us to talk to the k8s for operator APIs
+	operatorClientSet clientset.Interface
+	// servingLister lists the KnativeServings, whose ingresses are installed
+	servingLister listers.KnativeServingLister
+	// manifest is empty, but with a valid client and logger. all
+	// manifests are immutable, and any created during reconcile are
+	// expected to be appended to this one, obviating the passing of
+	// client & logger
+	manifest mf.Manifest
+	// renderCache avoids rendering the manifest again, if its inputs did not change
+	renderCache *common.RenderCache
+	// targetClusters holds the clients of the clusters, into which the components are installed
+	targetClusters *common.TargetClusters
+	// recommender recommends the resources of the containers for spec.resourceRecommendations
+	recommender *common.ResourceRecommender
+}
+
+// Check that our Reconciler implements controller.Reconciler
+var _ knreconciler.Interface = (*Reconciler)(nil)
+var _ knreconciler.Finalizer = (*Reconciler)(nil)
+
+// FinalizeKind removes all resources after deletion of a KnativeNetworking. The KnativeServing
+// installs its ingresses itself again afterwards.
+func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1beta1.KnativeNetworking) pkgreconciler.Event {
+	logger := logging.FromContext(ctx)
+
+	// Clean up the cache, if the Networking CR is deleted.
+	common.ClearCache()
+	r.renderCache.Delete(original)
+	common.ForgetWebhookCertificates(original)
+	common.ForgetAppliedHashes(original)
+	common.ForgetUpgrade(ctx, original)
+	r.targetClusters.ForgetStatus(ctx, original)
+
+	// List all KnativeNetworkings to determine if cluster-scoped resources should be deleted.
+	var kns []v1beta1.KnativeNetworking
+	for _, ns := range common.GetControllerConfig(ctx).ListNamespaces() {
+		list, err := r.operatorClientSet.OperatorV1beta1().KnativeNetworkings(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list all KnativeNetworkings: %w", err)
+		}
+		kns = append(kns, list.Items...)
+	}
+
+	for _, kn := range kns {
+		if kn.GetDeletionTimestamp().IsZero() && common.SameTargetCluster(&kn, original) {
+			// Not deleting all KnativeNetworkings. Nothing to do here.
+			return nil
+		}
+	}
+
+	logger.Info("Deleting cluster-scoped resources")
+	manifest, err := r.installed(ctx, original)
+	if err != nil {
+		logger.Error("Unable to fetch installed manifest; no cluster-scoped resources will be finalized", err)
+		return nil
+	}
+	if manifest == nil {
+		return nil
+	}
+	if err := common.Uninstall(manifest); err != nil {
+		logger.Error("Failed to finalize platform resources", err)
+	}
+	return nil
+}
+
+// ReconcileKind compares the actual state with the desired, and attempts to
+// converge the two.
+func (r *Reconciler) ReconcileKind(ctx context.Context, kn *v1beta1.KnativeNetworking) (event pkgreconciler.Event) {
+	logger := logging.FromContext(ctx)
+	original := kn.DeepCopy()
+	defer func() {
+		if err := common.PatchStatus(ctx, r.operatorClientSet, original, kn); err != nil {
+			event = err
+		}
+	}()
+	kn.Status.InitializeConditions()
+	defer common.ReportUpgrade(ctx, kn)
+	defer r.targetClusters.PublishStatus(ctx, kn)
+
+	logger.Infow("Reconciling KnativeNetworking", "status", kn.Status)
+
+	if common.CheckPaused(kn) {
+		logger.Infow("Reconciliation is paused", "annotation", base.PausedAnnotation)
+		return nil
+	}
+
+	ks, err := knc.Serving(r.servingLister, kn)
+	if err != nil {
+		return err
+	}
+	if ks == nil {
+		// The ingresses depend on the CRDs and the configuration of Knative Serving.
+		kn.Status.MarkDependencyMissing(fmt.Sprintf("no KnativeServing in the namespace %s installs Knative Serving into the same cluster", kn.Namespace))
+		common.ObserveGeneration(kn, &kn.Status.Status, nil)
+		return nil
+	}
+	kn.Status.MarkDependenciesInstalled()
+	// The adopted fields only change the manifest, the spec of the KnativeNetworking is not updated.
+	knc.AdoptServing(kn, ks)
+
+	if err := common.IsVersionValidMigrationEligible(kn); err != nil {
+		kn.Status.MarkVersionMigrationNotEligible(err.Error())
+		common.ObserveGeneration(kn, &kn.Status.Status, nil)
+		return nil
+	}
+	kn.Status.MarkVersionMigrationEligible()
+
+	kubeClient, manifest, err := r.targetCluster(ctx, kn)
+	if err != nil {
+		kn.Status.MarkInstallFailed(err.Error())
+		common.ObserveGeneration(kn, &kn.Status.Status, err)
+		return err
+	}
+	stages := r.renderStages(kubeClient)
+	stages = append(stages,
+		common.ExternalTransform,
+		common.ConfigFromSecrets(r.kubeClientSet), // After the external transformer, which must not see the values of the Secrets
+		common.CheckConfigNames,
+		common.UnmanagedConfigMaps,
+		r.recommender.Recommend,
+		common.ResolveDigests(r.kubeClientSet),
+		common.Preflight(kubeClient),
+		common.CheckWebhookCertificates(r.kubeClientSet, kubeClient),
+		common.CustomStages(common.StagePositionAfterRender),
+		common.Preview(r.kubeClientSet), // In dry-run mode, the stages stop after publishing the preview
+		common.PruneConfigKeys,
+		manifests.Install,
+		manifests.SetManifestPaths, // setting path right after applying manifests to populate paths
+		common.SetPermissions,
+		common.CustomStages(common.StagePositionAfterInstall),
+		common.SetAppliedManifest,
+		common.PublishInventory(r.kubeClientSet),
+		common.DeleteObsoleteNetworkPolicies(kubeClient),
+		common.DeleteObsoleteSpotPodDisruptionBudgets(kubeClient),
+		common.DeleteObsoleteResiliencePodDisruptionBudgets(kubeClient),
+		common.CheckDeployments,
+		common.MarkStatusSuccess,
+		common.CustomStages(common.StagePositionAfterReady),
+		ingress.MarkStatusIngress,
+		common.DeleteObsoleteResources(ctx, kn, r.installed),
+	)
+	err = stages.Execute(ctx, &manifest, kn)
+	common.ObserveGeneration(kn, &kn.Status.Status, err)
+	if err != nil {
+		// Render the manifest again on the next attempt, in case it depends on a changed cluster.
+		r.renderCache.Delete(kn)
+		return err
+	}
+	if kn.Spec.TargetCluster != nil && !kn.Status.IsReady() {
+		// The resources in a target cluster are not watched, poll until they are ready.
+		return controller.NewRequeueAfter(common.TargetClusterPollInterval)
+	}
+	return nil
+}
+
+// renderStages returns the stages, which compute the manifest to be applied for the component
+func (r *Reconciler) renderStages(kubeClient kubernetes.Interface) common.Stages {
+	return common.Stages{
+		r.renderCache.Stage(common.Stages{
+			appendTarget,
+			ingress.AppendTargetIngress,
+			common.AppendAdditionalManifests,
+			common.AppendNetworkPolicies,
+			common.AppendSpotPodDisruptionBudgets,
+			common.ResiliencePodDisruptionBudgets,
+			r.transform,
+		}),
+		common.UpgradeRemovedAPIs(kubeClient), // Not cached, the version of Kubernetes changes with upgrades of the cluster
+		common.AggregateClusterRoles(kubeClient),
+		common.ApplyPlatform(kubeClient),
+	}
+}
+
+// appendTarget appends the manifests of spec.manifests, the ingresses have no release manifests
+// of their own otherwise.
+func appendTarget(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	if len(instance.GetSpec().GetManifests()) == 0 {
+		return nil
+	}
+	return common.AppendTarget(ctx, manifest, instance)
+}
+
+// transform mutates the passed manifest to one with common and component transformations applied
+func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
+	instance := comp.(*v1beta1.KnativeNetworking)
+	ks := ksc.ConvertToKS(instance)
+	extra := []mf.Transformer{
+		// Ensure all resources have the selector applied so that the controller re-queues applied resources when they change.
+		common.InjectLabel(SelectorKey, SelectorValue),
+	}
+	extra = append(extra, ingress.Transformers(ctx, ks)...)
+	extra = append(extra, ingress.IngressServiceTransform(ks))
+	return common.Transform(ctx, manifest, instance, extra...)
+}
+
+// injectNamespace mutates the namespace of all installed resources
+func (r *Reconciler) injectNamespace(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
+	extra := []mf.Transformer{ingress.IngressServiceTransform(ksc.ConvertToKS(comp))}
+	return common.InjectNamespace(manifest, comp, extra...)
+}
+
+// targetCluster returns the clients of the cluster, into which the component is installed: the
+// cluster of the operator, unless the component specifies a target cluster.
+func (r *Reconciler) targetCluster(ctx context.Context, instance base.KComponent) (kubernetes.Interface, mf.Manifest, error) {
+	clients, err := r.targetClusters.Get(ctx, instance)
+	if err != nil || clients == nil {
+		return r.kubeClientSet, r.manifest.Append(), err
+	}
+	manifest := r.manifest.Append()
+	manifest.Client = clients.Manifest
+	return clients.Kube, manifest, nil
+}
+
+func (r *Reconciler) installed(ctx context.Context, instance base.KComponent) (*mf.Manifest, error) {
+	paths := instance.GetStatus().GetManifests()
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	installed, err := common.FetchManifestFromArray(paths)
+	if err != nil {
+		return &installed, err
+	}
+	_, target, err := r.targetCluster(ctx, instance)
+	if err != nil {
+		return &installed, err
+	}
+	installed = target.Append(installed)
+
+	// Per the manifests, that have been installed in the cluster, we only need to inject the correct namespace
+	// in the stages.
+	stages := common.Stages{r.injectNamespace}
+	err = stages.Execute(ctx, &installed, instance)
+	return &installed, err
+}
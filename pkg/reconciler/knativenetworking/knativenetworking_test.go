@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativenetworking
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/system"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	operatorfake "knative.dev/operator/pkg/client/clientset/versioned/fake"
+	listers "knative.dev/operator/pkg/client/listers/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestTargetClusterStatus(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "knative-operator")
+	ctx := context.Background()
+	kn := &v1beta1.KnativeNetworking{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "spoke"},
+		Spec: v1beta1.KnativeNetworkingSpec{CommonSpec: base.CommonSpec{
+			TargetCluster: &base.TargetCluster{SecretName: "spoke"},
+		}},
+	}
+	kubeClient := kubefake.NewSimpleClientset()
+	r := &Reconciler{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorfake.NewSimpleClientset(kn),
+		servingLister:     listers.NewKnativeServingLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		manifest:          mf.Manifest{Client: common.OfflineClient()},
+		targetClusters:    common.NewTargetClusters(kubeClient),
+	}
+	entry := func() string {
+		t.Helper()
+		cm, err := kubeClient.CoreV1().ConfigMaps("knative-operator").Get(ctx, common.TargetClustersConfigMapName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return ""
+		}
+		if err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+		return cm.Data["knative-serving.spoke.kubeconfig"]
+	}
+
+	// No KnativeServing installs Knative Serving into the target cluster, which the status reports.
+	if err := r.ReconcileKind(ctx, kn); err != nil {
+		t.Fatalf("ReconcileKind() = %v", err)
+	}
+	if entry() == "" {
+		t.Fatal("The KnativeNetworking is missing in the ConfigMap of the target clusters")
+	}
+
+	if err := r.FinalizeKind(ctx, kn); err != nil {
+		t.Fatalf("FinalizeKind() = %v", err)
+	}
+	if got := entry(); got != "" {
+		t.Fatalf("The deleted KnativeNetworking is still in the ConfigMap of the target clusters: %s", got)
+	}
+}
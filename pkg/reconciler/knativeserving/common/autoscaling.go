@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const autoscalerConfigMapName = "config-autoscaler"
+
+// AutoscalingTransform renders spec.autoscaling into config-autoscaler. The keys set in spec.config
+// take precedence.
+func AutoscalingTransform(instance *servingv1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		autoscaling := instance.Spec.Autoscaling
+		if autoscaling == nil || u.GetKind() != "ConfigMap" || u.GetName() != autoscalerConfigMapName {
+			return nil
+		}
+		values := map[string]string{}
+		if v := autoscaling.TargetConcurrency; v != nil {
+			values["container-concurrency-target-default"] = strconv.FormatInt(*v, 10)
+		}
+		if v := autoscaling.TargetUtilizationPercentage; v != nil {
+			values["container-concurrency-target-percentage"] = strconv.FormatInt(*v, 10)
+		}
+		if v := autoscaling.ScaleToZeroGracePeriod; v != nil {
+			values["scale-to-zero-grace-period"] = v.Duration.String()
+		}
+		if v := autoscaling.InitialScale; v != nil {
+			values["initial-scale"] = strconv.Itoa(int(*v))
+		}
+		if v := autoscaling.AllowZeroInitialScale; v != nil {
+			values["allow-zero-initial-scale"] = strconv.FormatBool(*v)
+		}
+		if v := autoscaling.PanicWindowPercentage; v != nil {
+			values["panic-window-percentage"] = strconv.FormatInt(*v, 10)
+		}
+		if v := autoscaling.MinScale; v != nil {
+			values["min-scale"] = strconv.Itoa(int(*v))
+		}
+		if v := autoscaling.MaxScale; v != nil {
+			values["max-scale"] = strconv.Itoa(int(*v))
+		}
+		for key, value := range values {
+			if configured(instance, autoscalerConfigMapName, key) {
+				continue
+			}
+			if err := unstructured.SetNestedField(u.Object, value, "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// configured returns whether spec.config sets the key of the ConfigMap, with or without the
+// "config-" prefix of its name.
+func configured(instance *servingv1beta1.KnativeServing, cmName, key string) bool {
+	config := instance.Spec.GetConfig()
+	if _, ok := config[cmName][key]; ok {
+		return true
+	}
+	_, ok := config[strings.TrimPrefix(cmName, "config-")][key]
+	return ok
+}
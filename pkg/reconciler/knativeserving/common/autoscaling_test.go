@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestAutoscalingTransform(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling *base.AutoscalingConfiguration
+		config      base.ConfigMapData
+		expected    map[string]string
+	}{{
+		name:     "no autoscaling",
+		expected: map[string]string{"_example": "example"},
+	}, {
+		name: "all fields",
+		autoscaling: &base.AutoscalingConfiguration{
+			TargetConcurrency:           ptr.Int64(50),
+			TargetUtilizationPercentage: ptr.Int64(80),
+			ScaleToZeroGracePeriod:      &metav1.Duration{Duration: time.Minute},
+			InitialScale:                ptr.Int32(0),
+			AllowZeroInitialScale:       ptr.Bool(true),
+			PanicWindowPercentage:       ptr.Int64(20),
+			MinScale:                    ptr.Int32(1),
+			MaxScale:                    ptr.Int32(10),
+		},
+		expected: map[string]string{
+			"_example":                                "example",
+			"container-concurrency-target-default":    "50",
+			"container-concurrency-target-percentage": "80",
+			"scale-to-zero-grace-period":              "1m0s",
+			"initial-scale":                           "0",
+			"allow-zero-initial-scale":                "true",
+			"panic-window-percentage":                 "20",
+			"min-scale":                               "1",
+			"max-scale":                               "10",
+		},
+	}, {
+		name:        "spec.config takes precedence",
+		autoscaling: &base.AutoscalingConfiguration{MinScale: ptr.Int32(1), MaxScale: ptr.Int32(10)},
+		config:      base.ConfigMapData{"autoscaler": {"max-scale": "5"}},
+		expected:    map[string]string{"_example": "example", "min-scale": "1"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec:  base.CommonSpec{Config: tt.config},
+					Autoscaling: tt.autoscaling,
+				},
+			}
+			u := makeUnstructured("ConfigMap", "config-autoscaler")
+			if err := AutoscalingTransform(instance)(u); err != nil {
+				t.Fatalf("AutoscalingTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
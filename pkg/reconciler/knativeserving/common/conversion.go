@@ -26,6 +26,20 @@ func ConvertToKS(instance base.KComponent) *v1beta1.KnativeServing {
 	switch instance := instance.(type) {
 	case *v1beta1.KnativeServing:
 		ks = instance
+	case *v1beta1.KnativeNetworking:
+		// The ingresses of a KnativeNetworking are configured like the ones of a KnativeServing.
+		ks = &v1beta1.KnativeServing{
+			ObjectMeta: instance.ObjectMeta,
+			Spec: v1beta1.KnativeServingSpec{
+				CommonSpec: instance.Spec.CommonSpec,
+				Ingress:    instance.Spec.Ingress,
+			},
+			Status: v1beta1.KnativeServingStatus{
+				Version:   instance.Status.Version,
+				Manifests: instance.Status.Manifests,
+				Ingress:   instance.Status.Ingress,
+			},
+		}
 	}
 	return ks
 }
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const (
+	domainConfigMapName  = "config-domain"
+	networkConfigMapName = "config-network"
+	domainTemplateKey    = "domain-template"
+	exampleKey           = "_example"
+)
+
+// DomainTransform renders the domains and the domain template of spec.domain into config-domain
+// and config-network. The domains set in spec.config, and its domain-template, take precedence.
+func DomainTransform(instance *servingv1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		domain := instance.Spec.Domain
+		if domain == nil || u.GetKind() != "ConfigMap" {
+			return nil
+		}
+		switch u.GetName() {
+		case domainConfigMapName:
+			if !hasDomains(domain) || configuresDomains(instance) {
+				return nil
+			}
+			if domain.Default != "" {
+				if err := unstructured.SetNestedField(u.Object, "", "data", domain.Default); err != nil {
+					return err
+				}
+			}
+			for _, selector := range domain.Selectors {
+				value, err := yaml.Marshal(map[string]map[string]string{"selector": selector.Selector})
+				if err != nil {
+					return fmt.Errorf("failed to marshal the selector of the domain %s: %w", selector.Domain, err)
+				}
+				if err := unstructured.SetNestedField(u.Object, string(value), "data", selector.Domain); err != nil {
+					return err
+				}
+			}
+		case networkConfigMapName:
+			if domain.Template == "" {
+				return nil
+			}
+			// The "config-" prefix is optional
+			config := instance.Spec.GetConfig()
+			if _, ok := config["network"][domainTemplateKey]; ok {
+				return nil
+			}
+			if _, ok := config[networkConfigMapName][domainTemplateKey]; ok {
+				return nil
+			}
+			return unstructured.SetNestedField(u.Object, domain.Template, "data", domainTemplateKey)
+		}
+		return nil
+	}
+}
+
+// DefaultDomainResources matches the Job, which sets a magic DNS domain like sslip.io, and its
+// Service. They are not part of the shipped manifests, but may be added with the manifests of the
+// spec. They are left out, if spec.domain configures a domain, which the Job would override.
+func DefaultDomainResources(instance *servingv1beta1.KnativeServing) mf.Predicate {
+	return func(u *unstructured.Unstructured) bool {
+		if instance.Spec.Domain == nil || !hasDomains(instance.Spec.Domain) {
+			return false
+		}
+		return (u.GetKind() == "Job" && u.GetName() == "default-domain") ||
+			(u.GetKind() == "Service" && u.GetName() == "default-domain-service")
+	}
+}
+
+func hasDomains(domain *base.DomainConfiguration) bool {
+	return domain.Default != "" || len(domain.Selectors) > 0
+}
+
+// configuresDomains returns whether spec.config sets any domain in config-domain.
+func configuresDomains(instance *servingv1beta1.KnativeServing) bool {
+	config := instance.Spec.GetConfig()
+	// The "config-" prefix is optional
+	for _, name := range []string{"domain", domainConfigMapName} {
+		for key := range config[name] {
+			if key != exampleKey {
+				return true
+			}
+		}
+	}
+	return false
+}
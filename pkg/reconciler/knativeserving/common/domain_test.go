@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func makeUnstructured(kind, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name},
+		"data":       map[string]interface{}{"_example": "example"},
+	}}
+}
+
+func TestDomainTransform(t *testing.T) {
+	tests := []struct {
+		name            string
+		domain          *base.DomainConfiguration
+		config          base.ConfigMapData
+		expectedDomain  map[string]string
+		expectedNetwork map[string]string
+	}{{
+		name:            "no domain",
+		expectedDomain:  map[string]string{"_example": "example"},
+		expectedNetwork: map[string]string{"_example": "example"},
+	}, {
+		name: "domains and template",
+		domain: &base.DomainConfiguration{
+			Default:   "example.com",
+			Selectors: []base.DomainSelector{{Domain: "internal.example.com", Selector: map[string]string{"app": "internal"}}},
+			Template:  "{{.Name}}-{{.Namespace}}.{{.Domain}}",
+		},
+		expectedDomain: map[string]string{
+			"_example":             "example",
+			"example.com":          "",
+			"internal.example.com": "selector:\n  app: internal\n",
+		},
+		expectedNetwork: map[string]string{
+			"_example":        "example",
+			"domain-template": "{{.Name}}-{{.Namespace}}.{{.Domain}}",
+		},
+	}, {
+		name:   "spec.config takes precedence",
+		domain: &base.DomainConfiguration{Default: "example.com", Template: "{{.Name}}.{{.Domain}}"},
+		config: base.ConfigMapData{
+			"domain":         {"other.com": ""},
+			"config-network": {"domain-template": "{{.Name}}.{{.Namespace}}.{{.Domain}}"},
+		},
+		expectedDomain:  map[string]string{"_example": "example"},
+		expectedNetwork: map[string]string{"_example": "example"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Domain:     tt.domain,
+				},
+			}
+			domain := makeUnstructured("ConfigMap", "config-domain")
+			network := makeUnstructured("ConfigMap", "config-network")
+			for _, u := range []*unstructured.Unstructured{domain, network} {
+				if err := DomainTransform(instance)(u); err != nil {
+					t.Fatalf("DomainTransform() = %v", err)
+				}
+			}
+			data, _, _ := unstructured.NestedStringMap(domain.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedDomain)
+			data, _, _ = unstructured.NestedStringMap(network.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedNetwork)
+		})
+	}
+}
+
+func TestDefaultDomainResources(t *testing.T) {
+	job := makeUnstructured("Job", "default-domain")
+	service := makeUnstructured("Service", "default-domain-service")
+	other := makeUnstructured("Job", "storage-version-migration-serving")
+
+	withDomain := DefaultDomainResources(&servingv1beta1.KnativeServing{
+		Spec: servingv1beta1.KnativeServingSpec{Domain: &base.DomainConfiguration{Default: "example.com"}},
+	})
+	util.AssertEqual(t, withDomain(job), true)
+	util.AssertEqual(t, withDomain(service), true)
+	util.AssertEqual(t, withDomain(other), false)
+
+	templateOnly := DefaultDomainResources(&servingv1beta1.KnativeServing{
+		Spec: servingv1beta1.KnativeServingSpec{Domain: &base.DomainConfiguration{Template: "{{.Name}}.{{.Domain}}"}},
+	})
+	util.AssertEqual(t, templateOnly(job), false)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const autocreateClaimsKey = "autocreate-cluster-domain-claims"
+
+// DomainMappingTransform writes the autocreation of ClusterDomainClaims of spec.domain.mapping to
+// config-network, unless spec.config sets it.
+func DomainMappingTransform(instance *servingv1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if instance.Spec.Domain == nil || instance.Spec.Domain.Mapping == nil {
+			return nil
+		}
+		mapping := instance.Spec.Domain.Mapping
+		if u.GetKind() != "ConfigMap" || u.GetName() != networkConfigMapName || mapping.AutocreateClusterDomainClaims == nil {
+			return nil
+		}
+		if configured(instance, networkConfigMapName, autocreateClaimsKey) {
+			return nil
+		}
+		value := strconv.FormatBool(*mapping.AutocreateClusterDomainClaims)
+		return unstructured.SetNestedField(u.Object, value, "data", autocreateClaimsKey)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestDomainMappingTransform(t *testing.T) {
+	tests := []struct {
+		name            string
+		mapping         *base.DomainMappingConfiguration
+		config          base.ConfigMapData
+		expectedNetwork map[string]string
+	}{{
+		name:            "no mapping",
+		expectedNetwork: map[string]string{"_example": "example"},
+	}, {
+		name:            "autocreated claims",
+		mapping:         &base.DomainMappingConfiguration{AutocreateClusterDomainClaims: ptr.Bool(true)},
+		expectedNetwork: map[string]string{"_example": "example", "autocreate-cluster-domain-claims": "true"},
+	}, {
+		name:            "spec.config takes precedence",
+		mapping:         &base.DomainMappingConfiguration{AutocreateClusterDomainClaims: ptr.Bool(true)},
+		config:          base.ConfigMapData{"network": {"autocreate-cluster-domain-claims": "false"}},
+		expectedNetwork: map[string]string{"_example": "example"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Domain:     &base.DomainConfiguration{Mapping: tt.mapping},
+				},
+			}
+			network := makeUnstructured("ConfigMap", "config-network")
+			if err := DomainMappingTransform(instance)(network); err != nil {
+				t.Fatalf("DomainMappingTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(network.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedNetwork)
+		})
+	}
+}
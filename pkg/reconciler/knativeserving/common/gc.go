@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const gcConfigMapName = "config-gc"
+
+// GarbageCollectionTransform renders spec.garbageCollection into config-gc. The keys set in
+// spec.config take precedence.
+func GarbageCollectionTransform(instance *servingv1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		gc := instance.Spec.GarbageCollection
+		if gc == nil || u.GetKind() != "ConfigMap" || u.GetName() != gcConfigMapName {
+			return nil
+		}
+		values := map[string]string{}
+		if gc.RetainSinceCreateTime != "" {
+			values["retain-since-create-time"] = gc.RetainSinceCreateTime
+		}
+		if gc.RetainSinceLastActiveTime != "" {
+			values["retain-since-last-active-time"] = gc.RetainSinceLastActiveTime
+		}
+		if gc.MinNonActiveRevisions != nil {
+			values["min-non-active-revisions"] = strconv.FormatInt(*gc.MinNonActiveRevisions, 10)
+		}
+		if gc.MaxNonActiveRevisions != nil {
+			values["max-non-active-revisions"] = gc.MaxNonActiveRevisions.String()
+		}
+		for key, value := range values {
+			if configured(instance, gcConfigMapName, key) {
+				continue
+			}
+			if err := unstructured.SetNestedField(u.Object, value, "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestGarbageCollectionTransform(t *testing.T) {
+	disabled := intstr.FromString("disabled")
+	ten := intstr.FromInt32(10)
+
+	tests := []struct {
+		name     string
+		gc       *base.GarbageCollectionConfiguration
+		config   base.ConfigMapData
+		expected map[string]string
+	}{{
+		name:     "no garbage collection",
+		expected: map[string]string{"_example": "example"},
+	}, {
+		name: "all fields",
+		gc: &base.GarbageCollectionConfiguration{
+			RetainSinceCreateTime:     "48h",
+			RetainSinceLastActiveTime: "disabled",
+			MinNonActiveRevisions:     ptr.Int64(2),
+			MaxNonActiveRevisions:     &ten,
+		},
+		expected: map[string]string{
+			"_example":                      "example",
+			"retain-since-create-time":      "48h",
+			"retain-since-last-active-time": "disabled",
+			"min-non-active-revisions":      "2",
+			"max-non-active-revisions":      "10",
+		},
+	}, {
+		name:     "spec.config takes precedence",
+		gc:       &base.GarbageCollectionConfiguration{RetainSinceCreateTime: "24h", MaxNonActiveRevisions: &disabled},
+		config:   base.ConfigMapData{"gc": {"retain-since-create-time": "1h"}},
+		expected: map[string]string{"_example": "example", "max-non-active-revisions": "disabled"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec:        base.CommonSpec{Config: tt.config},
+					GarbageCollection: tt.gc,
+				},
+			}
+			u := makeUnstructured("ConfigMap", "config-gc")
+			if err := GarbageCollectionTransform(instance)(u); err != nil {
+				t.Fatalf("GarbageCollectionTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const deploymentConfigMapName = "config-deployment"
+
+// queueProxyResourceKeys are the keys of config-deployment, without the -request or -limit suffix,
+// of the resources of the queue-proxy.
+var queueProxyResourceKeys = map[corev1.ResourceName]string{
+	corev1.ResourceCPU:              "queue-sidecar-cpu",
+	corev1.ResourceMemory:           "queue-sidecar-memory",
+	corev1.ResourceEphemeralStorage: "queue-sidecar-ephemeral-storage",
+}
+
+// RevisionDeploymentTransform renders spec.revisionDeployments into config-deployment. The keys set
+// in spec.config take precedence.
+func RevisionDeploymentTransform(instance *servingv1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		deployments := instance.Spec.RevisionDeployments
+		if deployments == nil || u.GetKind() != "ConfigMap" || u.GetName() != deploymentConfigMapName {
+			return nil
+		}
+		values := map[string]string{}
+		if qp := deployments.QueueProxy; qp != nil {
+			if qp.Image != "" {
+				values["queue-sidecar-image"] = qp.Image
+			}
+			if qp.Resources != nil {
+				for suffix, resources := range map[string]corev1.ResourceList{"-request": qp.Resources.Requests, "-limit": qp.Resources.Limits} {
+					for name, quantity := range resources {
+						// Other resources are rejected by the webhook.
+						if key, ok := queueProxyResourceKeys[name]; ok {
+							values[key+suffix] = quantity.String()
+						}
+					}
+				}
+			}
+		}
+		if deployments.ProgressDeadline != nil {
+			values["progress-deadline"] = deployments.ProgressDeadline.Duration.String()
+		}
+		if len(deployments.RegistriesSkippingTagResolving) > 0 {
+			values["registries-skipping-tag-resolving"] = strings.Join(deployments.RegistriesSkippingTagResolving, ",")
+		}
+		for key, value := range values {
+			if configured(instance, deploymentConfigMapName, key) {
+				continue
+			}
+			if err := unstructured.SetNestedField(u.Object, value, "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
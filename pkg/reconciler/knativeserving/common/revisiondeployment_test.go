@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestRevisionDeploymentTransform(t *testing.T) {
+	tests := []struct {
+		name        string
+		deployments *base.RevisionDeploymentConfiguration
+		config      base.ConfigMapData
+		expected    map[string]string
+	}{{
+		name:     "no revision deployments",
+		expected: map[string]string{"_example": "example"},
+	}, {
+		name: "all fields",
+		deployments: &base.RevisionDeploymentConfiguration{
+			QueueProxy: &base.QueueProxyConfiguration{
+				Image: "registry.example.com/knative/queue:v1.21.0",
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			},
+			ProgressDeadline:               &metav1.Duration{Duration: 10 * time.Minute},
+			RegistriesSkippingTagResolving: []string{"kind.local", "registry.example.com"},
+		},
+		expected: map[string]string{
+			"_example":                          "example",
+			"queue-sidecar-image":               "registry.example.com/knative/queue:v1.21.0",
+			"queue-sidecar-cpu-request":         "50m",
+			"queue-sidecar-memory-limit":        "1Gi",
+			"progress-deadline":                 "10m0s",
+			"registries-skipping-tag-resolving": "kind.local,registry.example.com",
+		},
+	}, {
+		name: "spec.config takes precedence",
+		deployments: &base.RevisionDeploymentConfiguration{
+			QueueProxy: &base.QueueProxyConfiguration{Image: "registry.example.com/knative/queue:v1.21.0"},
+		},
+		config:   base.ConfigMapData{"config-deployment": {"queue-sidecar-image": "other.example.com/queue"}},
+		expected: map[string]string{"_example": "example"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec:          base.CommonSpec{Config: tt.config},
+					RevisionDeployments: tt.deployments,
+				},
+			}
+			u := makeUnstructured("ConfigMap", "config-deployment")
+			if err := RevisionDeploymentTransform(instance)(u); err != nil {
+				t.Fatalf("RevisionDeploymentTransform() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
@@ -17,13 +17,15 @@ import (
 	"context"
 
 	"github.com/go-logr/zapr"
-	mfc "github.com/manifestival/client-go-client"
 	mf "github.com/manifestival/manifestival"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	operatorclient "knative.dev/operator/pkg/client/injection/client"
+	knativeEventinginformer "knative.dev/operator/pkg/client/injection/informers/operator/v1beta1/knativeeventing"
+	knativeNetworkinginformer "knative.dev/operator/pkg/client/injection/informers/operator/v1beta1/knativenetworking"
 	knativeServinginformer "knative.dev/operator/pkg/client/injection/informers/operator/v1beta1/knativeserving"
 	knsreconciler "knative.dev/operator/pkg/client/injection/reconciler/operator/v1beta1/knativeserving"
 	"knative.dev/operator/pkg/reconciler/common"
@@ -33,6 +35,7 @@ import (
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
+	"knative.dev/pkg/injection/clients/dynamicclient"
 	"knative.dev/pkg/logging"
 )
 
@@ -55,12 +58,14 @@ const (
 func NewExtendedController(generator common.ExtensionGenerator) injection.ControllerConstructor {
 	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 		knativeServingInformer := knativeServinginformer.Get(ctx)
+		knativeNetworkingInformer := knativeNetworkinginformer.Get(ctx)
+		knativeEventingInformer := knativeEventinginformer.Get(ctx)
 		deploymentInformer := deploymentinformer.Get(ctx, Selector)
 		configMapInformer := configmapinformer.Get(ctx, Selector)
 		kubeClient := kubeclient.Get(ctx)
 		logger := logging.FromContext(ctx)
 
-		mfclient, err := mfc.NewClient(injection.GetConfig(ctx))
+		mfclient, err := common.NewManifestClient(injection.GetConfig(ctx))
 		if err != nil {
 			logger.Fatalw("Error creating client from injected config", zap.Error(err))
 		}
@@ -70,22 +75,54 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 		c := &Reconciler{
 			kubeClientSet:     kubeClient,
 			operatorClientSet: operatorclient.Get(ctx),
+			networkingLister:  knativeNetworkingInformer.Lister(),
+			eventingLister:    knativeEventingInformer.Lister(),
 			manifest:          manifest,
+			renderCache:       common.NewRenderCache(),
+			targetClusters:    common.NewTargetClusters(kubeClient),
 		}
-		impl := knsreconciler.NewImpl(ctx, c)
+		impl := common.ConfigureController(ctx, "KnativeServing", knsreconciler.NewImpl(ctx, c, common.PatchStatusOptions))
 		c.extension = generator(ctx, impl)
+		c.recommender = common.NewResourceRecommender(kubeClient, dynamicclient.Get(ctx), impl)
+		c.eco = common.NewEcoMode(ctx, dynamicclient.Get(ctx), impl, func() ([]*v1beta1.KnativeServing, error) {
+			return knativeServingInformer.Lister().List(labels.Everything())
+		})
 
 		logger.Info("Setting up event handlers")
 
 		knativeServingInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+		common.ResyncPeriodically(ctx, impl, knativeServingInformer.Informer())
+		common.WatchDrift(ctx, impl, Selector, v1beta1.SchemeGroupVersion.WithKind("KnativeServing"))
+		common.WatchWebhookCertificates(ctx, impl, Selector, v1beta1.SchemeGroupVersion.WithKind("KnativeServing"))
+		common.WatchConfigSecrets(ctx, impl, Selector, func(namespace string) ([]*v1beta1.KnativeServing, error) {
+			return knativeServingInformer.Lister().KnativeServings(namespace).List(labels.Everything())
+		})
+
+		// A KnativeNetworking takes over the ingresses of the KnativeServing in its namespace.
+		knativeNetworkingInformer.Informer().AddEventHandler(controller.HandleAll(common.EnqueueNamespace(impl,
+			func(namespace string) ([]*v1beta1.KnativeServing, error) {
+				return knativeServingInformer.Lister().KnativeServings(namespace).List(labels.Everything())
+			})))
 
+		// The version of a KnativeEventing in any namespace is checked against the one of the KnativeServing.
+		knativeEventingInformer.Informer().AddEventHandler(controller.HandleAll(common.EnqueueNamespace(impl,
+			func(string) ([]*v1beta1.KnativeServing, error) {
+				return knativeServingInformer.Lister().List(labels.Everything())
+			})))
+
+		// The informers only enqueue the KnativeServing, so they do not need to cache the full resources.
+		for _, informer := range []cache.SharedIndexInformer{deploymentInformer.Informer(), configMapInformer.Informer()} {
+			if err := informer.SetTransform(common.TrimForEnqueue); err != nil {
+				logger.Warnw("Failed to trim the objects cached by the informer", zap.Error(err))
+			}
+		}
 		deploymentInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterControllerGVK(v1beta1.SchemeGroupVersion.WithKind("KnativeServing")),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.EnqueueDrifted(impl),
 		})
 		configMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterControllerGVK(v1beta1.SchemeGroupVersion.WithKind("KnativeServing")),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.EnqueueDrifted(impl),
 		})
 
 		return impl
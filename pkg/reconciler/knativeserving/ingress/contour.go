@@ -18,11 +18,79 @@ package ingress
 
 import (
 	"context"
+	"fmt"
 
 	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 )
 
-func contourTransformers(_ context.Context, _ *v1beta1.KnativeServing) []mf.Transformer {
-	return nil
+const (
+	contourConfigMapName = "config-contour"
+	contourVisibilityKey = "visibility"
+	contourEnvoyService  = "envoy"
+)
+
+// contourVisibility is an entry of the visibility in config-contour.
+type contourVisibility struct {
+	Class   string `json:"class"`
+	Service string `json:"service"`
+}
+
+func contourTransformers(_ context.Context, instance *v1beta1.KnativeServing) []mf.Transformer {
+	return []mf.Transformer{
+		configureContourVisibility(instance),
+	}
+}
+
+// configureContourVisibility sets the visibility in config-contour from spec.ingress.contour. The
+// visibility set in spec.config takes precedence.
+func configureContourVisibility(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" || u.GetName() != contourConfigMapName {
+			return nil
+		}
+		contour := instance.Spec.Ingress.Contour
+		if contour.External == nil && contour.Internal == nil {
+			return nil
+		}
+		// The "config-" prefix is optional
+		config := instance.Spec.GetConfig()
+		if _, ok := config["contour"][contourVisibilityKey]; ok {
+			return nil
+		}
+		if _, ok := config[contourConfigMapName][contourVisibilityKey]; ok {
+			return nil
+		}
+		visibility := map[string]contourVisibility{
+			"ExternalIP":   contourVisibilityOf(contour.External, "contour-external"),
+			"ClusterLocal": contourVisibilityOf(contour.Internal, "contour-internal"),
+		}
+		value, err := yaml.Marshal(visibility)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the visibility of contour: %w", err)
+		}
+		return unstructured.SetNestedField(u.Object, string(value), "data", contourVisibilityKey)
+	}
+}
+
+// contourVisibilityOf returns the visibility entry of the configuration, with the defaults of
+// net-contour for the empty fields. The default class and namespace are the same.
+func contourVisibilityOf(config *base.ContourVisibilityConfiguration, defaultClass string) contourVisibility {
+	class, namespace, service := defaultClass, defaultClass, contourEnvoyService
+	if config != nil {
+		if config.Class != "" {
+			class = config.Class
+		}
+		if config.Namespace != "" {
+			namespace = config.Namespace
+		}
+		if config.Service != "" {
+			service = config.Service
+		}
+	}
+	return contourVisibility{Class: class, Service: namespace + "/" + service}
 }
@@ -20,6 +20,8 @@ import (
 	"context"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/operator/pkg/apis/operator/base"
 	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
 	util "knative.dev/operator/pkg/reconciler/common/testing"
 )
@@ -27,5 +29,70 @@ import (
 func TestContourTransformers(t *testing.T) {
 	instance := &servingv1beta1.KnativeServing{}
 	transformer := contourTransformers(context.TODO(), instance)
-	util.AssertEqual(t, len(transformer), 0)
+	util.AssertEqual(t, len(transformer), 1)
+}
+
+func TestConfigureContourVisibility(t *testing.T) {
+	tests := []struct {
+		name     string
+		contour  base.ContourIngressConfiguration
+		config   base.ConfigMapData
+		expected map[string]string
+	}{{
+		name:     "no visibility",
+		contour:  base.ContourIngressConfiguration{Enabled: true},
+		expected: map[string]string{"other": "value"},
+	}, {
+		name: "custom external contour",
+		contour: base.ContourIngressConfiguration{
+			Enabled:  true,
+			External: &base.ContourVisibilityConfiguration{Class: "public", Namespace: "projectcontour"},
+		},
+		expected: map[string]string{
+			"other":      "value",
+			"visibility": "ClusterLocal:\n  class: contour-internal\n  service: contour-internal/envoy\nExternalIP:\n  class: public\n  service: projectcontour/envoy\n",
+		},
+	}, {
+		name: "custom envoy services",
+		contour: base.ContourIngressConfiguration{
+			Enabled:  true,
+			External: &base.ContourVisibilityConfiguration{Service: "envoy-external"},
+			Internal: &base.ContourVisibilityConfiguration{Namespace: "projectcontour", Service: "envoy-internal"},
+		},
+		expected: map[string]string{
+			"other":      "value",
+			"visibility": "ClusterLocal:\n  class: contour-internal\n  service: projectcontour/envoy-internal\nExternalIP:\n  class: contour-external\n  service: contour-external/envoy-external\n",
+		},
+	}, {
+		name: "spec.config takes precedence",
+		contour: base.ContourIngressConfiguration{
+			Enabled:  true,
+			External: &base.ContourVisibilityConfiguration{Class: "public"},
+		},
+		config:   base.ConfigMapData{"config-contour": {"visibility": "custom"}},
+		expected: map[string]string{"other": "value"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Ingress:    &servingv1beta1.IngressConfigs{Contour: tt.contour},
+				},
+			}
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			u.SetName(contourConfigMapName)
+			if err := unstructured.SetNestedStringMap(u.Object, map[string]string{"other": "value"}, "data"); err != nil {
+				t.Fatalf("SetNestedStringMap() = %v", err)
+			}
+			if err := configureContourVisibility(ks)(u); err != nil {
+				t.Fatalf("configureContourVisibility() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
 }
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const (
+	externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+	externalDNSTTLAnnotation      = "external-dns.alpha.kubernetes.io/ttl"
+)
+
+// externalDNSTransform annotates the external gateway of the enabled ingresses with the hostname and
+// the TTL of spec.ingress.externalDNS: the knative-ingress-gateway of Istio, which external-dns reads
+// with its istio-gateway source, and the kourier Service. The gateways of the other ingresses are
+// not part of the manifests.
+func externalDNSTransform(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if !isExternalGateway(instance, u) {
+			return nil
+		}
+		externalDNS := instance.Spec.Ingress.ExternalDNS
+		hostname := externalDNS.Hostname
+		if hostname == "" {
+			if instance.Spec.Domain == nil || instance.Spec.Domain.Default == "" {
+				return errors.New("spec.ingress.externalDNS needs a hostname or spec.domain.default")
+			}
+			hostname = "*." + instance.Spec.Domain.Default
+		}
+
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[externalDNSHostnameAnnotation] = hostname
+		if externalDNS.TTL != nil {
+			annotations[externalDNSTTLAnnotation] = strconv.FormatInt(*externalDNS.TTL, 10)
+		}
+		u.SetAnnotations(annotations)
+		return nil
+	}
+}
+
+// isExternalGateway returns whether the resource is the gateway of an enabled ingress, which serves
+// the traffic from outside of the cluster.
+func isExternalGateway(instance *v1beta1.KnativeServing, u *unstructured.Unstructured) bool {
+	ingress := instance.Spec.Ingress
+	if ingress.Istio.Enabled && u.GetKind() == "Gateway" && strings.HasPrefix(u.GetAPIVersion(), "networking.istio.io/") {
+		name := defaultIngressGatewayName
+		if override := ingressGateway(instance); override != nil && override.Name != "" {
+			name = override.Name
+		}
+		return u.GetName() == name
+	}
+	return ingress.Kourier.Enabled && u.GetKind() == "Service" && u.GetName() == kourierGatewayServiceName
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestExternalDNSTransform(t *testing.T) {
+	ttl := int64(300)
+	kourierService := func() *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		u.SetKind("Service")
+		u.SetName("kourier")
+		return u
+	}
+
+	tests := []struct {
+		name        string
+		ingress     v1beta1.IngressConfigs
+		domain      *base.DomainConfiguration
+		resource    *unstructured.Unstructured
+		expected    map[string]string
+		expectedErr string
+	}{{
+		name: "kourier service with the default domain",
+		ingress: v1beta1.IngressConfigs{
+			Kourier:     base.KourierIngressConfiguration{Enabled: true},
+			ExternalDNS: &base.ExternalDNSConfiguration{Enabled: true, TTL: &ttl},
+		},
+		domain:   &base.DomainConfiguration{Default: "example.com"},
+		resource: kourierService(),
+		expected: map[string]string{
+			"external-dns.alpha.kubernetes.io/hostname": "*.example.com",
+			"external-dns.alpha.kubernetes.io/ttl":      "300",
+		},
+	}, {
+		name: "renamed istio gateway with a hostname",
+		ingress: v1beta1.IngressConfigs{
+			Istio: base.IstioIngressConfiguration{
+				Enabled:               true,
+				KnativeIngressGateway: &base.IstioGatewayOverride{Name: "public"},
+			},
+			ExternalDNS: &base.ExternalDNSConfiguration{Enabled: true, Hostname: "*.apps.example.com"},
+		},
+		resource: makeUnstructuredGateway("public", nil, nil),
+		expected: map[string]string{
+			"external-dns.alpha.kubernetes.io/hostname": "*.apps.example.com",
+		},
+	}, {
+		name: "local istio gateway",
+		ingress: v1beta1.IngressConfigs{
+			Istio:       base.IstioIngressConfiguration{Enabled: true},
+			ExternalDNS: &base.ExternalDNSConfiguration{Enabled: true, Hostname: "*.example.com"},
+		},
+		resource: makeUnstructuredGateway("knative-local-gateway", nil, nil),
+	}, {
+		name: "kourier service of a disabled ingress",
+		ingress: v1beta1.IngressConfigs{
+			Istio:       base.IstioIngressConfiguration{Enabled: true},
+			ExternalDNS: &base.ExternalDNSConfiguration{Enabled: true, Hostname: "*.example.com"},
+		},
+		resource: kourierService(),
+	}, {
+		name: "no hostname",
+		ingress: v1beta1.IngressConfigs{
+			Kourier:     base.KourierIngressConfiguration{Enabled: true},
+			ExternalDNS: &base.ExternalDNSConfiguration{Enabled: true},
+		},
+		resource:    kourierService(),
+		expectedErr: "spec.ingress.externalDNS needs a hostname or spec.domain.default",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{Ingress: &tt.ingress, Domain: tt.domain},
+			}
+			err := externalDNSTransform(instance)(tt.resource)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Fatalf("externalDNSTransform() = %v, want %q", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("externalDNSTransform() = %v", err)
+			}
+			util.AssertDeepEqual(t, tt.resource.GetAnnotations(), tt.expected)
+		})
+	}
+}
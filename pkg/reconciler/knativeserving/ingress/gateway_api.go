@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const (
+	gatewayConfigMapName = "config-gateway"
+	externalGatewaysKey  = "external-gateways"
+	localGatewaysKey     = "local-gateways"
+)
+
+func gatewayAPITransformers(_ context.Context, instance *v1beta1.KnativeServing) []mf.Transformer {
+	return []mf.Transformer{
+		configureGateways(instance),
+	}
+}
+
+// configureGateways sets the Gateways of spec.ingress.gatewayAPI in config-gateway. The keys set
+// in spec.config take precedence.
+func configureGateways(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" || u.GetName() != gatewayConfigMapName {
+			return nil
+		}
+		gatewayAPI := instance.Spec.Ingress.GatewayAPI
+		config := instance.Spec.GetConfig()
+		for key, gateways := range map[string][]base.GatewayAPIGateway{
+			externalGatewaysKey: gatewayAPI.ExternalGateways,
+			localGatewaysKey:    gatewayAPI.LocalGateways,
+		} {
+			if len(gateways) == 0 {
+				continue
+			}
+			// The "config-" prefix is optional
+			if _, ok := config["gateway"][key]; ok {
+				continue
+			}
+			if _, ok := config[gatewayConfigMapName][key]; ok {
+				continue
+			}
+			value, err := yaml.Marshal(gateways)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the %s: %w", key, err)
+			}
+			if err := unstructured.SetNestedField(u.Object, string(value), "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
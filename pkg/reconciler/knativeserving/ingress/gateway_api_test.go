@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestConfigureGateways(t *testing.T) {
+	external := []base.GatewayAPIGateway{{
+		Class:             "istio",
+		Gateway:           "istio-system/knative-gateway",
+		Service:           "istio-system/istio-ingressgateway",
+		SupportedFeatures: []string{"HTTPRouteRequestTimeout"},
+	}}
+	local := []base.GatewayAPIGateway{{
+		Class:   "istio",
+		Gateway: "istio-system/knative-local-gateway",
+	}}
+
+	tests := []struct {
+		name   string
+		config base.ConfigMapData
+		want   map[string]string
+	}{{
+		name: "gateways",
+		want: map[string]string{
+			externalGatewaysKey: "- class: istio\n  gateway: istio-system/knative-gateway\n  service: istio-system/istio-ingressgateway\n  supported-features:\n  - HTTPRouteRequestTimeout\n",
+			localGatewaysKey:    "- class: istio\n  gateway: istio-system/knative-local-gateway\n",
+		},
+	}, {
+		name:   "spec.config takes precedence",
+		config: base.ConfigMapData{"gateway": {localGatewaysKey: "custom"}},
+		want: map[string]string{
+			externalGatewaysKey: "- class: istio\n  gateway: istio-system/knative-gateway\n  service: istio-system/istio-ingressgateway\n  supported-features:\n  - HTTPRouteRequestTimeout\n",
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Ingress: &servingv1beta1.IngressConfigs{
+						GatewayAPI: base.GatewayAPIIngressConfiguration{
+							Enabled:          true,
+							ExternalGateways: external,
+							LocalGateways:    local,
+						},
+					},
+				},
+			}
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			u.SetName(gatewayConfigMapName)
+			if err := configureGateways(ks)(u); err != nil {
+				t.Fatalf("configureGateways() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.want)
+		})
+	}
+}
@@ -23,11 +23,13 @@ import (
 	"strings"
 
 	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
 	"golang.org/x/mod/semver"
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	"knative.dev/operator/pkg/reconciler/common"
 	servingcommon "knative.dev/operator/pkg/reconciler/knativeserving/common"
+	"knative.dev/pkg/logging"
 )
 
 // Transformers returns a list of transformers based on the enabled ingresses
@@ -45,6 +47,12 @@ func Transformers(ctx context.Context, ks *v1beta1.KnativeServing) []mf.Transfor
 	if ks.Spec.Ingress.Contour.Enabled {
 		transformers = append(transformers, contourTransformers(ctx, ks)...)
 	}
+	if ks.Spec.Ingress.GatewayAPI.Enabled {
+		transformers = append(transformers, gatewayAPITransformers(ctx, ks)...)
+	}
+	if ks.Spec.Ingress.ExternalDNS != nil && ks.Spec.Ingress.ExternalDNS.Enabled {
+		transformers = append(transformers, externalDNSTransform(ks))
+	}
 	return transformers
 }
 
@@ -59,43 +67,99 @@ func getIngress(path string) (mf.Manifest, error) {
 // by the Serving CR.
 func GetIngressPath(version string, ks *v1beta1.KnativeServing) string {
 	var urls []string
+	for _, name := range Names(ks) {
+		urls = append(urls, Path(common.ComponentVersion(ks, name, version), name))
+	}
+
+	return strings.Join(urls, common.COMMA)
+}
+
+// Path returns the path of the manifests of the named ingress in the version.
+func Path(version, name string) string {
 	koDataDir := os.Getenv(common.KoEnvKey)
 	sourceVersion := common.LATEST_VERSION
 	if !strings.EqualFold(version, common.LATEST_VERSION) {
-		sourceVersion = semver.MajorMinor(common.SanitizeSemver(version))[1:]
+		sourceVersion = version
+		// This line can make sure a valid available source version is returned.
+		if majorMinor := semver.MajorMinor(common.SanitizeSemver(version)); majorMinor != "" {
+			sourceVersion = majorMinor[1:]
+		}
 	}
+	return filepath.Join(koDataDir, "ingress", sourceVersion, name)
+}
+
+// VersionSkews is the skew matrix of the ingresses, which spec.versionOverrides may pin. The
+// releases of the networking layer frequently lag behind the ones of Knative Serving.
+var VersionSkews = map[string]common.VersionSkew{
+	"istio":       {Behind: 2},
+	"kourier":     {Behind: 2},
+	"contour":     {Behind: 2},
+	"gateway-api": {Behind: 2},
+}
 
-	// This line can make sure a valid available source version is returned.
-	ingressPath := filepath.Join(koDataDir, "ingress", sourceVersion)
+// Names returns the names of the ingresses enabled by the Serving CR. Istio is
+// the default, if no ingress is configured.
+func Names(ks *v1beta1.KnativeServing) []string {
 	if ks.Spec.Ingress == nil {
-		url := filepath.Join(ingressPath, "istio")
-		urls = append(urls, url)
-		return strings.Join(urls, common.COMMA)
+		return []string{"istio"}
 	}
-
+	var names []string
 	if ks.Spec.Ingress.Istio.Enabled {
-		url := filepath.Join(ingressPath, "istio")
-		urls = append(urls, url)
+		names = append(names, "istio")
 	}
 	if ks.Spec.Ingress.Contour.Enabled {
-		url := filepath.Join(ingressPath, "contour")
-		urls = append(urls, url)
+		names = append(names, "contour")
 	}
 	if ks.Spec.Ingress.Kourier.Enabled {
-		url := filepath.Join(ingressPath, "kourier")
-		urls = append(urls, url)
+		names = append(names, "kourier")
+	}
+	if ks.Spec.Ingress.GatewayAPI.Enabled {
+		names = append(names, "gateway-api")
 	}
+	return names
+}
 
-	return strings.Join(urls, common.COMMA)
+// MarkStatusIngress records the installed ingresses in the status of the Serving or Networking CR.
+func MarkStatusIngress(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+	names := strings.Join(Names(servingcommon.ConvertToKS(instance)), common.COMMA)
+	switch instance := instance.(type) {
+	case *v1beta1.KnativeServing:
+		instance.Status.Ingress = names
+	case *v1beta1.KnativeNetworking:
+		instance.Status.Ingress = names
+	}
+	return nil
+}
+
+// Resources matches the resources of the ingresses, which the Serving CR installs in the namespaces
+// they are transformed into. It matches nothing, if the manifests of the ingresses are not available.
+func Resources(ctx context.Context, ks *v1beta1.KnativeServing) mf.Predicate {
+	m, err := getIngress(GetIngressPath(common.TargetVersion(ks), ks))
+	if err == nil {
+		transformers := append([]mf.Transformer{mf.InjectNamespace(ks.GetNamespace())}, Transformers(ctx, ks)...)
+		m, err = m.Transform(append(transformers, IngressServiceTransform(ks))...)
+	}
+	if err != nil {
+		logging.FromContext(ctx).Warnw("Failed to determine the resources of the ingresses", zap.Error(err))
+		return mf.Nothing
+	}
+	return mf.In(m)
+}
+
+// IsIngressPath returns whether the manifest path in the status of a Serving CR refers to the
+// manifests of the ingresses.
+func IsIngressPath(path string) bool {
+	return strings.HasPrefix(path, filepath.Join(os.Getenv(common.KoEnvKey), "ingress")+string(filepath.Separator))
 }
 
 // AppendTargetIngress appends the manifests of the ingress to be installed
 func AppendTargetIngress(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
 	version := common.TargetVersion(instance)
-	ingressPath := GetIngressPath(version, servingcommon.ConvertToKS(instance))
+	ks := servingcommon.ConvertToKS(instance)
+	ingressPath := GetIngressPath(version, ks)
 	m, err := getIngress(ingressPath)
 	if err == nil {
-		*manifest = manifest.Append(m)
+		*manifest = manifest.Append(m.Filter(mf.Not(existingGateways(ks))))
 	}
 	if len(instance.GetSpec().GetManifests()) != 0 {
 		// If spec.manifests is not empty, it is possible that the eventing source is not available with the
@@ -30,7 +30,8 @@ import (
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 )
 
-// localGateway defines the structure for the entries in the 'local-gateways' array.
+// localGatewayConfig defines the structure for the entries in the 'local-gateways' and
+// 'external-gateways' arrays.
 type localGatewayConfig struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace"`
@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"knative.dev/operator/pkg/apis/operator/base"
 	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
 	"knative.dev/operator/pkg/reconciler/common"
@@ -46,7 +48,7 @@ func TestTransformers(t *testing.T) {
 				},
 			},
 		},
-		expected: 1,
+		expected: 3,
 	}, {
 		name: "Available kourier ingress",
 		instance: servingv1beta1.KnativeServing{
@@ -58,7 +60,7 @@ func TestTransformers(t *testing.T) {
 				},
 			},
 		},
-		expected: 3,
+		expected: 4,
 	}, {
 		name: "Available contour ingress",
 		instance: servingv1beta1.KnativeServing{
@@ -70,13 +72,13 @@ func TestTransformers(t *testing.T) {
 				},
 			},
 		},
-		expected: 0,
+		expected: 1,
 	}, {
 		name: "Empty ingress for default istio",
 		instance: servingv1beta1.KnativeServing{
 			Spec: servingv1beta1.KnativeServingSpec{},
 		},
-		expected: 1,
+		expected: 3,
 	}, {
 		name: "All ingresses enabled",
 		instance: servingv1beta1.KnativeServing{
@@ -94,7 +96,7 @@ func TestTransformers(t *testing.T) {
 				},
 			},
 		},
-		expected: 4,
+		expected: 8,
 	}}
 
 	for _, tt := range tests {
@@ -215,6 +217,31 @@ func TestGetIngressPath(t *testing.T) {
 			},
 		},
 		expectedPath: os.Getenv(common.KoEnvKey) + "/ingress/latest/contour",
+	}, {
+		name:    "Available ingress path for gateway-api next to istio",
+		version: "1.9",
+		ks: &servingv1beta1.KnativeServing{
+			Spec: servingv1beta1.KnativeServingSpec{
+				Ingress: &servingv1beta1.IngressConfigs{
+					Istio:      base.IstioIngressConfiguration{Enabled: true},
+					GatewayAPI: base.GatewayAPIIngressConfiguration{Enabled: true},
+				},
+			},
+		},
+		expectedPath: os.Getenv(common.KoEnvKey) + "/ingress/1.9/istio," + os.Getenv(common.KoEnvKey) + "/ingress/1.9/gateway-api",
+	}, {
+		name:    "Ingress path for kourier pinned by spec.versionOverrides",
+		version: "1.9",
+		ks: &servingv1beta1.KnativeServing{
+			Spec: servingv1beta1.KnativeServingSpec{
+				CommonSpec: base.CommonSpec{VersionOverrides: map[string]string{"kourier": "1.8.2"}},
+				Ingress: &servingv1beta1.IngressConfigs{
+					Istio:   base.IstioIngressConfiguration{Enabled: true},
+					Kourier: base.KourierIngressConfiguration{Enabled: true},
+				},
+			},
+		},
+		expectedPath: os.Getenv(common.KoEnvKey) + "/ingress/1.9/istio," + os.Getenv(common.KoEnvKey) + "/ingress/1.8/kourier",
 	}}
 
 	for _, tt := range tests {
@@ -225,6 +252,112 @@ func TestGetIngressPath(t *testing.T) {
 	}
 }
 
+func TestMarkStatusIngress(t *testing.T) {
+	tests := []struct {
+		name    string
+		ingress *servingv1beta1.IngressConfigs
+		want    string
+	}{{
+		name: "default ingress",
+		want: "istio",
+	}, {
+		name: "multiple ingresses",
+		ingress: &servingv1beta1.IngressConfigs{
+			Istio:   base.IstioIngressConfiguration{Enabled: true},
+			Kourier: base.KourierIngressConfiguration{Enabled: true},
+		},
+		want: "istio,kourier",
+	}, {
+		name:    "no ingress",
+		ingress: &servingv1beta1.IngressConfigs{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks := &servingv1beta1.KnativeServing{
+				Spec:   servingv1beta1.KnativeServingSpec{Ingress: tt.ingress},
+				Status: servingv1beta1.KnativeServingStatus{Ingress: "contour"},
+			}
+			if err := MarkStatusIngress(context.Background(), &mf.Manifest{}, ks); err != nil {
+				t.Fatalf("MarkStatusIngress() = %v", err)
+			}
+			util.AssertEqual(t, ks.Status.Ingress, tt.want)
+		})
+	}
+}
+
+func TestMarkStatusIngressNetworking(t *testing.T) {
+	kn := &servingv1beta1.KnativeNetworking{
+		Spec: servingv1beta1.KnativeNetworkingSpec{
+			Ingress: &servingv1beta1.IngressConfigs{Kourier: base.KourierIngressConfiguration{Enabled: true}},
+		},
+	}
+	if err := MarkStatusIngress(context.Background(), &mf.Manifest{}, kn); err != nil {
+		t.Fatalf("MarkStatusIngress() = %v", err)
+	}
+	util.AssertEqual(t, kn.Status.Ingress, "kourier")
+}
+
+func TestResources(t *testing.T) {
+	os.Setenv(common.KoEnvKey, "testdata/kodata")
+	defer os.Unsetenv(common.KoEnvKey)
+
+	resource := func(kind, ns, name string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		if kind == "Deployment" {
+			u.SetAPIVersion("apps/v1")
+		}
+		u.SetKind(kind)
+		u.SetNamespace(ns)
+		u.SetName(name)
+		return u
+	}
+	tests := []struct {
+		name     string
+		version  string
+		resource *unstructured.Unstructured
+		want     bool
+	}{{
+		name:     "ingress deployment",
+		version:  "1.9",
+		resource: resource("Deployment", "knative-serving", "net-istio-controller"),
+		want:     true,
+	}, {
+		name:     "transformed namespace",
+		version:  "1.9",
+		resource: resource("Service", "istio-system", "knative-local-gateway"),
+		want:     true,
+	}, {
+		name:     "serving deployment",
+		version:  "1.9",
+		resource: resource("Deployment", "knative-serving", "controller"),
+	}, {
+		name:     "unavailable ingresses",
+		version:  "0.12",
+		resource: resource("Deployment", "knative-serving", "net-istio-controller"),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks := &servingv1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+				Spec:       servingv1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Version: tt.version}},
+			}
+			util.AssertEqual(t, Resources(context.Background(), ks)(tt.resource), tt.want)
+		})
+	}
+}
+
+func TestIsIngressPath(t *testing.T) {
+	os.Setenv(common.KoEnvKey, "/var/run/ko")
+	defer os.Unsetenv(common.KoEnvKey)
+
+	util.AssertEqual(t, IsIngressPath("/var/run/ko/ingress/1.21/istio,/var/run/ko/ingress/1.21/kourier"), true)
+	util.AssertEqual(t, IsIngressPath("/var/run/ko/knative-serving/1.21.0"), false)
+	util.AssertEqual(t, IsIngressPath("https://example.com/ingress/net-istio.yaml"), false)
+}
+
 func TestAppendTargetIngress(t *testing.T) {
 	os.Setenv(common.KoEnvKey, "testdata/kodata")
 	defer os.Unsetenv(common.KoEnvKey)
@@ -18,6 +18,7 @@ package ingress
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	mf "github.com/manifestival/manifestival"
@@ -25,14 +26,30 @@ import (
 	istionetworkingv1beta "istio.io/client-go/pkg/apis/networking/v1beta1"
 	"istio.io/client-go/pkg/clientset/versioned/scheme"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
 	"knative.dev/operator/pkg/apis/operator/base"
 	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
 	"knative.dev/pkg/logging"
 )
 
+const (
+	istioConfigMapName           = "config-istio"
+	networkConfigMapName         = "config-network"
+	meshCompatibilityModeKey     = "mesh-compatibility-mode"
+	sidecarInjectLabel           = "sidecar.istio.io/inject"
+	defaultIngressGatewayName    = "knative-ingress-gateway"
+	defaultIngressGatewayService = "istio-ingressgateway.istio-system.svc.cluster.local"
+	defaultLocalGatewayService   = "knative-local-gateway.istio-system.svc.cluster.local"
+)
+
 func istioTransformers(ctx context.Context, instance *servingv1beta1.KnativeServing) []mf.Transformer {
 	logger := logging.FromContext(ctx)
-	return []mf.Transformer{gatewayTransform(instance, logger)}
+	return []mf.Transformer{
+		gatewayTransform(instance, logger),
+		configureIstioGateways(instance),
+		meshTransform(instance),
+	}
 }
 
 func gatewayTransform(instance *servingv1beta1.KnativeServing, log *zap.SugaredLogger) mf.Transformer {
@@ -54,15 +71,8 @@ func gatewayTransform(instance *servingv1beta1.KnativeServing, log *zap.SugaredL
 				return err
 			}
 
-			if u.GetName() == "knative-ingress-gateway" {
-				if err := updateIstioGateway(ingressGateway(instance), gateway, log); err != nil {
-					return err
-				}
-			}
-			// TODO: cluster-local-gateway was removed since v0.20 https://github.com/knative-extensions/net-istio/commit/058432d749435ef1fc61aa2b1fd048d0c75460ee
-			// Remove it once operator stops v0.20 support.
-			if u.GetName() == "cluster-local-gateway" || u.GetName() == "knative-local-gateway" {
-				if err := updateIstioGateway(localGateway(instance), gateway, log); err != nil {
+			if override := gatewayOverrideOf(instance, u.GetName()); override != nil {
+				if err := updateIstioGateway(override, gateway, log); err != nil {
 					return err
 				}
 			}
@@ -79,6 +89,124 @@ func gatewayTransform(instance *servingv1beta1.KnativeServing, log *zap.SugaredL
 	}
 }
 
+// gatewayOverrideOf returns the override of the Gateway with the name in the manifest, or nil.
+func gatewayOverrideOf(instance *servingv1beta1.KnativeServing, name string) *base.IstioGatewayOverride {
+	switch name {
+	case defaultIngressGatewayName:
+		return ingressGateway(instance)
+	// TODO: cluster-local-gateway was removed since v0.20 https://github.com/knative-extensions/net-istio/commit/058432d749435ef1fc61aa2b1fd048d0c75460ee
+	// Remove it once operator stops v0.20 support.
+	case "cluster-local-gateway", knativeLocalGateway:
+		return localGateway(instance)
+	}
+	return nil
+}
+
+// existingGateways matches the Gateways of the manifest, which the operator does not create,
+// because spec.ingress.istio refers to existing ones instead.
+func existingGateways(instance *servingv1beta1.KnativeServing) mf.Predicate {
+	return func(u *unstructured.Unstructured) bool {
+		if u.GetKind() != "Gateway" || !strings.HasPrefix(u.GetAPIVersion(), "networking.istio.io/") {
+			return false
+		}
+		override := gatewayOverrideOf(instance, u.GetName())
+		return override != nil && override.Create != nil && !*override.Create
+	}
+}
+
+// configureIstioGateways sets the Gateways of spec.ingress.istio in config-istio, if they are
+// renamed, existing ones or served by another service. The keys set in spec.config take
+// precedence, including the ones of the legacy format, which cannot be combined with the others.
+func configureIstioGateways(instance *servingv1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" || u.GetName() != istioConfigMapName {
+			return nil
+		}
+		// The "config-" prefix is optional
+		config := instance.Spec.GetConfig()
+		for _, data := range []map[string]string{config["istio"], config[istioConfigMapName]} {
+			for key := range data {
+				if key == externalGatewaysKey || key == localGatewaysKey ||
+					strings.HasPrefix(key, "gateway.") || strings.HasPrefix(key, "local-gateway.") {
+					return nil
+				}
+			}
+		}
+
+		for _, gateway := range []struct {
+			key      string
+			override *base.IstioGatewayOverride
+			name     string
+			service  string
+		}{
+			{externalGatewaysKey, ingressGateway(instance), defaultIngressGatewayName, defaultIngressGatewayService},
+			{localGatewaysKey, localGateway(instance), knativeLocalGateway, defaultLocalGatewayService},
+		} {
+			override := gateway.override
+			if override == nil || (override.Name == "" && override.Namespace == "" && override.Service == "") {
+				continue
+			}
+			entry := localGatewayConfig{
+				Name:      gateway.name,
+				Namespace: instance.GetNamespace(),
+				Service:   gateway.service,
+			}
+			if override.Name != "" {
+				entry.Name = override.Name
+			}
+			if override.Namespace != "" {
+				entry.Namespace = override.Namespace
+			}
+			if override.Service != "" {
+				entry.Service = override.Service
+			}
+			value, err := yaml.Marshal([]localGatewayConfig{entry})
+			if err != nil {
+				return fmt.Errorf("failed to marshal the %s: %w", gateway.key, err)
+			}
+			if err := unstructured.SetNestedField(u.Object, string(value), "data", gateway.key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// meshTransform injects the Istio sidecar into the pods of the deployments, and makes Knative
+// Serving reach the pods through their services under a STRICT PeerAuthentication, where the
+// direct requests to the pod IPs fail. Both follow spec.ingress.istio.mesh.
+func meshTransform(instance *servingv1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if instance.Spec.Ingress == nil || instance.Spec.Ingress.Istio.Mesh == nil {
+			return nil
+		}
+		mesh := instance.Spec.Ingress.Istio.Mesh
+		switch {
+		case u.GetKind() == "Deployment" && (mesh.SidecarInjection || mesh.StrictPeerAuthentication):
+			labels, _, err := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "labels")
+			if err != nil {
+				return err
+			}
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[sidecarInjectLabel] = "true"
+			return unstructured.SetNestedStringMap(u.Object, labels, "spec", "template", "metadata", "labels")
+		case u.GetKind() == "ConfigMap" && u.GetName() == networkConfigMapName && mesh.StrictPeerAuthentication:
+			// The "config-" prefix is optional
+			config := instance.Spec.GetConfig()
+			if _, ok := config["network"][meshCompatibilityModeKey]; ok {
+				return nil
+			}
+			if _, ok := config[networkConfigMapName][meshCompatibilityModeKey]; ok {
+				return nil
+			}
+			return unstructured.SetNestedField(u.Object, "enabled", "data", meshCompatibilityModeKey)
+		}
+		return nil
+	}
+}
+
 func ingressGateway(instance *servingv1beta1.KnativeServing) *base.IstioGatewayOverride {
 	if instance.Spec.Ingress != nil && instance.Spec.Ingress.Istio.KnativeIngressGateway != nil {
 		return instance.Spec.Ingress.Istio.KnativeIngressGateway
@@ -105,5 +233,9 @@ func updateIstioGateway(override *base.IstioGatewayOverride, gateway *istionetwo
 		gateway.Spec.Servers = override.Servers
 		log.Debugw("Finished Servers Overrides", "name", gateway.GetName())
 	}
+
+	if override != nil && override.Name != "" {
+		gateway.SetName(override.Name)
+	}
 	return nil
 }
@@ -25,10 +25,12 @@ import (
 	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	"istio.io/client-go/pkg/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"knative.dev/operator/pkg/apis/operator/base"
 	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
 	util "knative.dev/operator/pkg/reconciler/common/testing"
+	"knative.dev/pkg/ptr"
 )
 
 var log = zap.NewNop().Sugar()
@@ -313,3 +315,173 @@ func makeUnstructuredGatewayAlpha(name string, selector map[string]string, serve
 
 	return result
 }
+
+func TestGatewayTransformName(t *testing.T) {
+	instance := &servingv1beta1.KnativeServing{
+		Spec: servingv1beta1.KnativeServingSpec{
+			Ingress: &servingv1beta1.IngressConfigs{
+				Istio: base.IstioIngressConfiguration{
+					Enabled:               true,
+					KnativeIngressGateway: &base.IstioGatewayOverride{Name: "my-ingress-gateway"},
+				},
+			},
+		},
+	}
+	ingress := makeUnstructuredGateway("knative-ingress-gateway", nil, nil)
+	local := makeUnstructuredGateway("knative-local-gateway", nil, nil)
+	for _, u := range []*unstructured.Unstructured{ingress, local} {
+		if err := gatewayTransform(instance, log)(u); err != nil {
+			t.Fatalf("gatewayTransform() = %v", err)
+		}
+	}
+	util.AssertEqual(t, ingress.GetName(), "my-ingress-gateway")
+	util.AssertEqual(t, local.GetName(), "knative-local-gateway")
+}
+
+func TestExistingGateways(t *testing.T) {
+	instance := &servingv1beta1.KnativeServing{
+		Spec: servingv1beta1.KnativeServingSpec{
+			Ingress: &servingv1beta1.IngressConfigs{
+				Istio: base.IstioIngressConfiguration{
+					Enabled:               true,
+					KnativeIngressGateway: &base.IstioGatewayOverride{Create: ptr.Bool(false)},
+					KnativeLocalGateway:   &base.IstioGatewayOverride{Create: ptr.Bool(true)},
+				},
+			},
+		},
+	}
+	pred := existingGateways(instance)
+	util.AssertEqual(t, pred(makeUnstructuredGateway("knative-ingress-gateway", nil, nil)), true)
+	util.AssertEqual(t, pred(makeUnstructuredGateway("knative-local-gateway", nil, nil)), false)
+	util.AssertEqual(t, pred(makeUnstructuredGateway("other-gateway", nil, nil)), false)
+}
+
+func TestConfigureIstioGateways(t *testing.T) {
+	tests := []struct {
+		name     string
+		ingress  *base.IstioGatewayOverride
+		local    *base.IstioGatewayOverride
+		config   base.ConfigMapData
+		expected map[string]string
+	}{{
+		name:     "no overrides",
+		ingress:  &base.IstioGatewayOverride{Selector: map[string]string{"istio": "custom"}},
+		expected: map[string]string{},
+	}, {
+		name:    "existing ingress gateway",
+		ingress: &base.IstioGatewayOverride{Name: "public", Namespace: "istio-system", Create: ptr.Bool(false)},
+		expected: map[string]string{
+			"external-gateways": "- name: public\n  namespace: istio-system\n  service: istio-ingressgateway.istio-system.svc.cluster.local\n",
+		},
+	}, {
+		name:  "local gateway service",
+		local: &base.IstioGatewayOverride{Service: "private.istio-gateways.svc.cluster.local"},
+		expected: map[string]string{
+			"local-gateways": "- name: knative-local-gateway\n  namespace: knative-serving\n  service: private.istio-gateways.svc.cluster.local\n",
+		},
+	}, {
+		name:     "spec.config takes precedence",
+		ingress:  &base.IstioGatewayOverride{Name: "public"},
+		config:   base.ConfigMapData{"istio": {"gateway.knative-serving.knative-ingress-gateway": "istio-ingressgateway.istio-system.svc.cluster.local"}},
+		expected: map[string]string{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &servingv1beta1.KnativeServing{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving"},
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Ingress: &servingv1beta1.IngressConfigs{
+						Istio: base.IstioIngressConfiguration{
+							Enabled:               true,
+							KnativeIngressGateway: tt.ingress,
+							KnativeLocalGateway:   tt.local,
+						},
+					},
+				},
+			}
+			u := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "config-istio"},
+				"data":       map[string]interface{}{},
+			}}
+			if err := configureIstioGateways(instance)(u); err != nil {
+				t.Fatalf("configureIstioGateways() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
+
+func TestMeshTransform(t *testing.T) {
+	tests := []struct {
+		name            string
+		mesh            *base.IstioMeshConfiguration
+		config          base.ConfigMapData
+		expectedLabels  map[string]string
+		expectedNetwork map[string]string
+	}{{
+		name:            "no mesh",
+		expectedLabels:  map[string]string{"app": "activator"},
+		expectedNetwork: map[string]string{},
+	}, {
+		name:            "sidecar injection",
+		mesh:            &base.IstioMeshConfiguration{SidecarInjection: true},
+		expectedLabels:  map[string]string{"app": "activator", "sidecar.istio.io/inject": "true"},
+		expectedNetwork: map[string]string{},
+	}, {
+		name:            "strict peer authentication",
+		mesh:            &base.IstioMeshConfiguration{StrictPeerAuthentication: true},
+		expectedLabels:  map[string]string{"app": "activator", "sidecar.istio.io/inject": "true"},
+		expectedNetwork: map[string]string{"mesh-compatibility-mode": "enabled"},
+	}, {
+		name:            "strict peer authentication with mesh-compatibility-mode in spec.config",
+		mesh:            &base.IstioMeshConfiguration{StrictPeerAuthentication: true},
+		config:          base.ConfigMapData{"config-network": {"mesh-compatibility-mode": "auto"}},
+		expectedLabels:  map[string]string{"app": "activator", "sidecar.istio.io/inject": "true"},
+		expectedNetwork: map[string]string{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &servingv1beta1.KnativeServing{
+				Spec: servingv1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Config: tt.config},
+					Ingress: &servingv1beta1.IngressConfigs{
+						Istio: base.IstioIngressConfiguration{Enabled: true, Mesh: tt.mesh},
+					},
+				},
+			}
+			deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "activator"},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"labels": map[string]interface{}{"app": "activator"},
+						},
+					},
+				},
+			}}
+			network := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "config-network"},
+				"data":       map[string]interface{}{},
+			}}
+			for _, u := range []*unstructured.Unstructured{deployment, network} {
+				if err := meshTransform(instance)(u); err != nil {
+					t.Fatalf("meshTransform() = %v", err)
+				}
+			}
+			labels, _, _ := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "labels")
+			util.AssertDeepEqual(t, labels, tt.expectedLabels)
+			data, _, _ := unstructured.NestedStringMap(network.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedNetwork)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -43,6 +44,7 @@ func kourierTransformers(_ context.Context, instance *v1beta1.KnativeServing) []
 		replaceGatewayNamespace(),
 		configureGatewayService(instance),
 		configureBootstrapConfigMap(instance),
+		configureGatewayAutoscaling(instance),
 	}
 }
 
@@ -108,6 +110,16 @@ func configureGatewayService(instance *v1beta1.KnativeServing) mf.Transformer {
 			svc.Spec.LoadBalancerIP = instance.Spec.Ingress.Kourier.ServiceLoadBalancerIP
 		}
 
+		// Add the annotations, e.g. of the load balancer, if set.
+		if len(instance.Spec.Ingress.Kourier.ServiceAnnotations) > 0 {
+			if svc.Annotations == nil {
+				svc.Annotations = map[string]string{}
+			}
+			for k, v := range instance.Spec.Ingress.Kourier.ServiceAnnotations {
+				svc.Annotations[k] = v
+			}
+		}
+
 		// Configure HTTPPort/HTTPSPort if set.
 		if instance.Spec.Ingress.Kourier.HTTPPort > 0 || instance.Spec.Ingress.Kourier.HTTPSPort > 0 {
 			if svc.Spec.Type != v1.ServiceTypeNodePort {
@@ -138,7 +150,7 @@ func configureBootstrapConfigMap(instance *v1beta1.KnativeServing) mf.Transforme
 
 			for i := range deployment.Spec.Template.Spec.Volumes {
 				v := &deployment.Spec.Template.Spec.Volumes[i]
-				if v.ConfigMap.Name == kourierDefaultVolumeName {
+				if v.ConfigMap != nil && v.ConfigMap.Name == kourierDefaultVolumeName {
 					v.ConfigMap = &v1.ConfigMapVolumeSource{
 						LocalObjectReference: v1.LocalObjectReference{
 							Name: bootstrapName,
@@ -155,6 +167,44 @@ func configureBootstrapConfigMap(instance *v1beta1.KnativeServing) mf.Transforme
 	}
 }
 
+// configureGatewayAutoscaling sets the replicas and the CPU target of the HPA of the Kourier gateway.
+func configureGatewayAutoscaling(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		autoscaling := instance.Spec.Ingress.Kourier.GatewayAutoscaling
+		if autoscaling == nil || u.GetKind() != "HorizontalPodAutoscaler" || u.GetName() != kourierGatewayDeploymentNames {
+			return nil
+		}
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err := scheme.Scheme.Convert(u, hpa, nil); err != nil {
+			return err
+		}
+
+		if autoscaling.MinReplicas != nil {
+			hpa.Spec.MinReplicas = autoscaling.MinReplicas
+		}
+		if autoscaling.MaxReplicas != nil {
+			hpa.Spec.MaxReplicas = *autoscaling.MaxReplicas
+		}
+		if hpa.Spec.MinReplicas != nil && *hpa.Spec.MinReplicas > hpa.Spec.MaxReplicas {
+			return fmt.Errorf("the min-replicas %d of the kourier gateway exceed its max-replicas %d",
+				*hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+		}
+		if autoscaling.TargetCPUUtilization != nil {
+			for i := range hpa.Spec.Metrics {
+				metric := &hpa.Spec.Metrics[i]
+				if metric.Type == autoscalingv2.ResourceMetricSourceType && metric.Resource != nil && metric.Resource.Name == v1.ResourceCPU {
+					metric.Resource.Target = autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: autoscaling.TargetCPUUtilization,
+					}
+				}
+			}
+		}
+
+		return scheme.Scheme.Convert(hpa, u, nil)
+	}
+}
+
 func configureGatewayServiceTypeNodePort(instance *v1beta1.KnativeServing, svc *v1.Service) {
 	for i, v := range svc.Spec.Ports {
 		if v.Name != "https" && instance.Spec.Ingress.Kourier.HTTPPort > 0 {
@@ -23,6 +23,7 @@ import (
 	mf "github.com/manifestival/manifestival"
 	fake "github.com/manifestival/manifestival/fake"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -259,3 +260,112 @@ func verifyGatewayServiceLoadBalancerIP(t *testing.T, u *unstructured.Unstructur
 		util.AssertDeepEqual(t, svcLoadBalancerIP, expServiceLoadBalancerIP)
 	}
 }
+
+func TestKourierGatewayServiceAnnotations(t *testing.T) {
+	instance := servingInstance(servingNamespace, "LoadBalancer", "", "")
+	instance.Spec.Ingress.Kourier.ServiceAnnotations = map[string]string{
+		"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+	}
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        kourierGatewayServiceName,
+			Annotations: map[string]string{"existing": "annotation"},
+		},
+		Spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer},
+	}
+	u := &unstructured.Unstructured{}
+	if err := scheme.Scheme.Convert(svc, u, nil); err != nil {
+		t.Fatalf("Convert() = %v", err)
+	}
+	u.SetKind("Service")
+
+	if err := configureGatewayService(instance)(u); err != nil {
+		t.Fatalf("configureGatewayService() = %v", err)
+	}
+	util.AssertDeepEqual(t, u.GetAnnotations(), map[string]string{
+		"existing": "annotation",
+		"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+	})
+}
+
+func TestConfigureGatewayAutoscaling(t *testing.T) {
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	tests := []struct {
+		name          string
+		autoscaling   *base.KourierGatewayAutoscaling
+		expMinReplica int32
+		expMaxReplica int32
+		expTarget     int32
+		expError      string
+	}{{
+		name:          "no autoscaling",
+		expMinReplica: 1,
+		expMaxReplica: 10,
+		expTarget:     100,
+	}, {
+		name: "all fields",
+		autoscaling: &base.KourierGatewayAutoscaling{
+			MinReplicas:          int32Ptr(3),
+			MaxReplicas:          int32Ptr(20),
+			TargetCPUUtilization: int32Ptr(70),
+		},
+		expMinReplica: 3,
+		expMaxReplica: 20,
+		expTarget:     70,
+	}, {
+		name:          "min replicas only",
+		autoscaling:   &base.KourierGatewayAutoscaling{MinReplicas: int32Ptr(2)},
+		expMinReplica: 2,
+		expMaxReplica: 10,
+		expTarget:     100,
+	}, {
+		name:        "min replicas above max replicas",
+		autoscaling: &base.KourierGatewayAutoscaling{MinReplicas: int32Ptr(11)},
+		expError:    "the min-replicas 11 of the kourier gateway exceed its max-replicas 10",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := servingInstance(servingNamespace, "", "", "")
+			instance.Spec.Ingress.Kourier.GatewayAutoscaling = tt.autoscaling
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: kourierGatewayDeploymentNames},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					MinReplicas: int32Ptr(1),
+					MaxReplicas: 10,
+					Metrics: []autoscalingv2.MetricSpec{{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name:   v1.ResourceCPU,
+							Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: int32Ptr(100)},
+						},
+					}},
+				},
+			}
+			u := &unstructured.Unstructured{}
+			if err := scheme.Scheme.Convert(hpa, u, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			u.SetKind("HorizontalPodAutoscaler")
+
+			err := configureGatewayAutoscaling(instance)(u)
+			if tt.expError != "" {
+				if err == nil || err.Error() != tt.expError {
+					t.Fatalf("configureGatewayAutoscaling() = %v, want %q", err, tt.expError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("configureGatewayAutoscaling() = %v", err)
+			}
+			got := &autoscalingv2.HorizontalPodAutoscaler{}
+			if err := scheme.Scheme.Convert(u, got, nil); err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			util.AssertEqual(t, *got.Spec.MinReplicas, tt.expMinReplica)
+			util.AssertEqual(t, got.Spec.MaxReplicas, tt.expMaxReplica)
+			util.AssertEqual(t, *got.Spec.Metrics[0].Resource.Target.AverageUtilization, tt.expTarget)
+		})
+	}
+}
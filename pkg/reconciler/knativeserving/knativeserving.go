@@ -22,8 +22,10 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 
@@ -31,7 +33,9 @@ import (
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	clientset "knative.dev/operator/pkg/client/clientset/versioned"
 	knsreconciler "knative.dev/operator/pkg/client/injection/reconciler/operator/v1beta1/knativeserving"
+	listers "knative.dev/operator/pkg/client/listers/operator/v1beta1"
 	"knative.dev/operator/pkg/reconciler/common"
+	knc "knative.dev/operator/pkg/reconciler/knativenetworking/common"
 	ksc "knative.dev/operator/pkg/reconciler/knativeserving/common"
 	"knative.dev/operator/pkg/reconciler/knativeserving/ingress"
 	"knative.dev/operator/pkg/reconciler/knativeserving/security"
@@ -44,6 +48,10 @@ type Reconciler struct {
 	kubeClientSet kubernetes.Interface
 	// operatorClientSet allows us to configure operator objects
 	operatorClientSet clientset.Interface
+	// networkingLister lists the KnativeNetworkings, which install the ingresses instead of the KnativeServing
+	networkingLister listers.KnativeNetworkingLister
+	// eventingLister lists the KnativeEventings, whose versions have to be in skew with the KnativeServing
+	eventingLister listers.KnativeEventingLister
 	// manifest is empty, but with a valid client and logger. all
 	// manifests are immutable, and any created during reconcile are
 	// expected to be appended to this one, obviating the passing of
@@ -51,6 +59,14 @@ type Reconciler struct {
 	manifest mf.Manifest
 	// Platform-specific behavior to affect the transform
 	extension common.Extension
+	// renderCache avoids rendering the manifest again, if its inputs did not change
+	renderCache *common.RenderCache
+	// targetClusters holds the clients of the clusters, into which the components are installed
+	targetClusters *common.TargetClusters
+	// recommender recommends the resources of the containers for spec.resourceRecommendations
+	recommender *common.ResourceRecommender
+	// eco scales the idle deployments down for spec.eco
+	eco *common.EcoMode
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -63,15 +79,24 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1beta1.Knative
 
 	// Clean up the cache, if the Serving CR is deleted.
 	common.ClearCache()
+	r.renderCache.Delete(original)
+	common.ForgetWebhookCertificates(original)
+	common.ForgetAppliedHashes(original)
+	common.ForgetUpgrade(ctx, original)
+	r.targetClusters.ForgetStatus(ctx, original)
 
 	// List all KnativeServings to determine if cluster-scoped resources should be deleted.
-	kss, err := r.operatorClientSet.OperatorV1beta1().KnativeServings("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("failed to list all KnativeServings: %w", err)
+	var kss []v1beta1.KnativeServing
+	for _, ns := range common.GetControllerConfig(ctx).ListNamespaces() {
+		list, err := r.operatorClientSet.OperatorV1beta1().KnativeServings(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list all KnativeServings: %w", err)
+		}
+		kss = append(kss, list.Items...)
 	}
 
-	for _, ks := range kss.Items {
-		if ks.GetDeletionTimestamp().IsZero() {
+	for _, ks := range kss {
+		if ks.GetDeletionTimestamp().IsZero() && common.SameTargetCluster(&ks, original) {
 			// Not deleting all KnativeServings. Nothing to do here.
 			return nil
 		}
@@ -100,15 +125,28 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1beta1.Knative
 
 // ReconcileKind compares the actual state with the desired, and attempts to
 // converge the two.
-func (r *Reconciler) ReconcileKind(ctx context.Context, ks *v1beta1.KnativeServing) pkgreconciler.Event {
+func (r *Reconciler) ReconcileKind(ctx context.Context, ks *v1beta1.KnativeServing) (event pkgreconciler.Event) {
 	logger := logging.FromContext(ctx)
+	original := ks.DeepCopy()
+	defer func() {
+		if err := common.PatchStatus(ctx, r.operatorClientSet, original, ks); err != nil {
+			event = err
+		}
+	}()
 	ks.Status.InitializeConditions()
-	ks.Status.ObservedGeneration = ks.Generation
+	defer common.ReportUpgrade(ctx, ks)
+	defer r.targetClusters.PublishStatus(ctx, ks)
 
 	logger.Infow("Reconciling KnativeServing", "status", ks.Status)
 
+	if common.CheckPaused(ks) {
+		logger.Infow("Reconciliation is paused", "annotation", base.PausedAnnotation)
+		return nil
+	}
+
 	if err := common.IsVersionValidMigrationEligible(ks); err != nil {
 		ks.Status.MarkVersionMigrationNotEligible(err.Error())
+		common.ObserveGeneration(ks, &ks.Status.Status, nil)
 		return nil
 	}
 	ks.Status.MarkVersionMigrationEligible()
@@ -116,23 +154,103 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ks *v1beta1.KnativeServi
 	if err := r.extension.Reconcile(ctx, ks); err != nil {
 		return err
 	}
-	stages := common.Stages{
-		common.AppendTarget,
-		ingress.AppendTargetIngress,
-		security.AppendTargetSecurity,
-		common.AppendAdditionalManifests,
-		r.appendExtensionManifests,
-		r.transform,
+	kn, err := knc.Networking(r.networkingLister, ks)
+	if err != nil {
+		return err
+	}
+	if kn != nil && kn.Spec.Ingress != nil {
+		// Knative Serving is configured for the ingresses of the KnativeNetworking, e.g. with the ingress class.
+		ks.Spec.Ingress = kn.Spec.Ingress.DeepCopy()
+	}
+	kubeClient, manifest, err := r.targetCluster(ctx, ks)
+	if err != nil {
+		ks.Status.MarkInstallFailed(err.Error())
+		common.ObserveGeneration(ks, &ks.Status.Status, err)
+		return err
+	}
+	stages := r.renderStages(kubeClient)
+	stages = append(stages,
+		excludeIngresses(kn),
+		security.CheckCertManager(kubeClient),
+		common.ExternalTransform,
+		common.ConfigFromSecrets(r.kubeClientSet), // After the external transformer, which must not see the values of the Secrets
+		common.CheckConfigNames,
+		common.UnmanagedConfigMaps,
+		r.eco.ScaleDownIdle,
+		r.recommender.Recommend,
+		common.ResolveDigests(r.kubeClientSet),
+		common.Preflight(kubeClient),
+		common.CheckVersionSkew(r.eventing),
+		common.CheckWebhookCertificates(r.kubeClientSet, kubeClient),
+		common.CustomStages(common.StagePositionAfterRender),
+		common.Preview(r.kubeClientSet), // In dry-run mode, the stages stop after publishing the preview
+		common.PruneConfigKeys,
 		manifests.Install,
-		manifests.SetManifestPaths,    // setting path right after applying manifests to populate paths
+		manifests.SetManifestPaths, // setting path right after applying manifests to populate paths
+		common.SetPermissions,
+		dropIngressPaths(kn),
 		common.CheckWebhookDeployment, // Wait for webhook to be ready before creating Certificate resources
 		common.InstallWebhookDependentResources,
+		common.CustomStages(common.StagePositionAfterInstall),
+		common.SetAppliedManifest,
+		common.PublishInventory(r.kubeClientSet),
+		common.DeleteObsoleteNetworkPolicies(kubeClient),
+		common.DeleteObsoleteSpotPodDisruptionBudgets(kubeClient),
+		common.DeleteObsoleteResiliencePodDisruptionBudgets(kubeClient),
 		common.CheckDeployments,
 		common.MarkStatusSuccess,
+		common.CustomStages(common.StagePositionAfterReady),
+		common.Conformance(kubeClient, common.ConformanceService, common.ConformanceScaleToZero),
+		checkNetworking(kn),
+		ingress.MarkStatusIngress,
 		common.DeleteObsoleteResources(ctx, ks, r.installed),
+	)
+	err = stages.Execute(ctx, &manifest, ks)
+	common.ObserveGeneration(ks, &ks.Status.Status, err)
+	if err != nil {
+		// Render the manifest again on the next attempt, in case it depends on a changed cluster.
+		r.renderCache.Delete(ks)
+		return err
+	}
+	if ks.Spec.TargetCluster != nil && !ks.Status.IsReady() {
+		// The resources in a target cluster are not watched, poll until they are ready.
+		return controller.NewRequeueAfter(common.TargetClusterPollInterval)
+	}
+	if common.ConformanceRunning(&ks.Status) {
+		// The conformance Jobs are not watched, poll until they are done.
+		return controller.NewRequeueAfter(common.ConformancePollInterval)
+	}
+	return nil
+}
+
+// eventing returns the KnativeEventing, which installs Knative Eventing into the same cluster.
+func (r *Reconciler) eventing(instance base.KComponent) (base.KComponent, error) {
+	kes, err := r.eventingLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return common.PeerComponent(instance, kes), nil
+}
+
+// renderStages returns the stages, which compute the manifest to be applied for the component
+func (r *Reconciler) renderStages(kubeClient kubernetes.Interface) common.Stages {
+	return common.Stages{
+		r.renderCache.Stage(common.Stages{
+			common.AppendTarget,
+			ingress.AppendTargetIngress,
+			security.AppendTargetSecurity,
+			common.AppendAdditionalManifests,
+			r.appendExtensionManifests,
+			common.AppendNetworkPolicies,
+			common.AppendSpotPodDisruptionBudgets,
+			common.ResiliencePodDisruptionBudgets,
+			r.transform,
+		}),
+		common.UpgradeRemovedAPIs(kubeClient), // Not cached, the version of Kubernetes changes with upgrades of the cluster
+		r.transformFromCluster,
+		common.AggregateClusterRoles(kubeClient),
+		common.ApplyPlatform(kubeClient),
 	}
-	manifest := r.manifest.Append()
-	return stages.Execute(ctx, &manifest, ks)
 }
 
 // transform mutates the passed manifest to one with common, component
@@ -142,7 +260,11 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 	instance := comp.(*v1beta1.KnativeServing)
 	extra := []mf.Transformer{
 		ksc.CustomCertsTransform(instance, logger),
-		ksc.AggregationRuleTransform(manifest.Client),
+		ksc.DomainTransform(instance),
+		ksc.DomainMappingTransform(instance),
+		ksc.AutoscalingTransform(instance),
+		ksc.RevisionDeploymentTransform(instance),
+		ksc.GarbageCollectionTransform(instance),
 		// Ensure all resources have the selector applied so that the controller re-queues applied resources when they change.
 		common.InjectLabel(SelectorKey, SelectorValue),
 	}
@@ -150,9 +272,22 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 	extra = append(extra, ingress.Transformers(ctx, instance)...)
 	extra = append(extra, ingress.IngressServiceTransform(instance))
 	extra = append(extra, security.Transformers(ctx, instance)...)
+	*manifest = manifest.Filter(mf.Not(ksc.DefaultDomainResources(instance)))
 	return common.Transform(ctx, manifest, instance, extra...)
 }
 
+// transformFromCluster mutates the passed manifest with the transformations, which depend on the
+// current state of the cluster. They are not cached with the rest of the manifest.
+func (r *Reconciler) transformFromCluster(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
+	m, err := manifest.Transform(ksc.AggregationRuleTransform(manifest.Client))
+	if err != nil {
+		comp.GetStatus().MarkInstallFailed(err.Error())
+		return err
+	}
+	*manifest = m
+	return nil
+}
+
 // injectNamespace mutates the namespace of all installed resources
 func (r *Reconciler) injectNamespace(ctx context.Context, manifest *mf.Manifest, comp base.KComponent) error {
 	instance := comp.(*v1beta1.KnativeServing)
@@ -160,8 +295,28 @@ func (r *Reconciler) injectNamespace(ctx context.Context, manifest *mf.Manifest,
 	return common.InjectNamespace(manifest, instance, extra...)
 }
 
+// targetCluster returns the clients of the cluster, into which the component is installed: the
+// cluster of the operator, unless the component specifies a target cluster.
+func (r *Reconciler) targetCluster(ctx context.Context, instance base.KComponent) (kubernetes.Interface, mf.Manifest, error) {
+	clients, err := r.targetClusters.Get(ctx, instance)
+	if err != nil || clients == nil {
+		return r.kubeClientSet, r.manifest.Append(), err
+	}
+	manifest := r.manifest.Append()
+	manifest.Client = clients.Manifest
+	return clients.Kube, manifest, nil
+}
+
 func (r *Reconciler) installed(ctx context.Context, instance base.KComponent) (*mf.Manifest, error) {
 	paths := instance.GetStatus().GetManifests()
+	kn, err := knc.Networking(r.networkingLister, instance.(*v1beta1.KnativeServing))
+	if err != nil {
+		return nil, err
+	}
+	if kn != nil {
+		// The ingresses, which the KnativeServing installed itself before, now belong to the KnativeNetworking.
+		paths = withoutIngressPaths(paths)
+	}
 	if len(paths) == 0 {
 		return nil, nil
 	}
@@ -169,7 +324,11 @@ func (r *Reconciler) installed(ctx context.Context, instance base.KComponent) (*
 	if err != nil {
 		return &installed, err
 	}
-	installed = r.manifest.Append(installed)
+	_, target, err := r.targetCluster(ctx, instance)
+	if err != nil {
+		return &installed, err
+	}
+	installed = target.Append(installed)
 
 	// Per the manifests, that have been installed in the cluster, we only need to inject the correct namespace
 	// in the stages.
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativeserving
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestRenderStagesUpgradeRemovedAPIsAfterCache(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "../../../cmd/operator/kodata")
+	ctx := context.Background()
+	r := &Reconciler{
+		manifest:    mf.Manifest{Client: common.OfflineClient()},
+		extension:   common.NoExtension(ctx, nil),
+		renderCache: common.NewRenderCache(),
+	}
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{
+				Version:             "1.21.0",
+				AdditionalManifests: []base.Manifest{{Url: "testdata/pdb.yaml"}},
+			},
+		},
+	}
+	ks.Status.InitializeConditions()
+
+	kubeClient := kubefake.NewSimpleClientset()
+	render := func(gitVersion string) string {
+		t.Helper()
+		kubeClient.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: gitVersion}
+		manifest := r.manifest.Append()
+		if err := r.renderStages(kubeClient).Execute(ctx, &manifest, ks); err != nil {
+			t.Fatalf("Failed to render with Kubernetes %s: %v", gitVersion, err)
+		}
+		pdbs := manifest.Filter(mf.ByKind("PodDisruptionBudget"), mf.ByName("custom-pdb")).Resources()
+		if len(pdbs) != 1 {
+			t.Fatalf("Got %d PodDisruptionBudgets custom-pdb, want 1", len(pdbs))
+		}
+		return pdbs[0].GetAPIVersion()
+	}
+
+	util.AssertEqual(t, render("v1.24.3"), "policy/v1beta1")
+	// The cluster was upgraded, while the inputs of the render cache did not change.
+	util.AssertEqual(t, render("v1.25.0"), "policy/v1")
+}
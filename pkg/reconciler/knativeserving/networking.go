@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knativeserving
+
+import (
+	"context"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/pkg/reconciler/knativeserving/ingress"
+)
+
+// excludeIngresses removes the resources of the ingresses from the manifest, while the KnativeNetworking
+// installs them.
+func excludeIngresses(kn *v1beta1.KnativeNetworking) common.Stage {
+	if kn == nil {
+		return common.NoOp
+	}
+	return func(ctx context.Context, manifest *mf.Manifest, instance base.KComponent) error {
+		ks := instance.(*v1beta1.KnativeServing)
+		*manifest = manifest.Filter(mf.Not(ingress.Resources(ctx, ks)))
+		return nil
+	}
+}
+
+// dropIngressPaths removes the paths of the ingresses from status.manifests, while the KnativeNetworking
+// installs them, so that the KnativeServing does not delete them as obsolete resources.
+func dropIngressPaths(kn *v1beta1.KnativeNetworking) common.Stage {
+	if kn == nil {
+		return common.NoOp
+	}
+	return func(_ context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		status := instance.GetStatus()
+		status.SetManifests(withoutIngressPaths(status.GetManifests()))
+		return nil
+	}
+}
+
+// checkNetworking marks the dependencies of the KnativeServing as installing, until the KnativeNetworking
+// installing its ingresses is ready.
+func checkNetworking(kn *v1beta1.KnativeNetworking) common.Stage {
+	return func(_ context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		status := instance.GetStatus()
+		if kn != nil && !kn.Status.IsReady() {
+			status.MarkDependencyInstalling(fmt.Sprintf("KnativeNetworking %s/%s is not ready", kn.Namespace, kn.Name))
+			return nil
+		}
+		status.MarkDependenciesInstalled()
+		return nil
+	}
+}
+
+func withoutIngressPaths(paths []string) []string {
+	var kept []string
+	for _, path := range paths {
+		if !ingress.IsIngressPath(path) {
+			kept = append(kept, path)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	servingcommon "knative.dev/operator/pkg/reconciler/knativeserving/common"
+)
+
+const (
+	certManagerConfigMapName = "config-certmanager"
+	networkConfigMapName     = "config-network"
+	certManagerGroupVersion  = "cert-manager.io/v1"
+	clusterIssuerKind        = "ClusterIssuer"
+	enabledValue             = "Enabled"
+)
+
+func certManagerTransformers(_ context.Context, instance *v1beta1.KnativeServing) []mf.Transformer {
+	return []mf.Transformer{
+		configureIssuers(instance),
+		configureDomainTLS(instance),
+	}
+}
+
+// configureIssuers sets the issuers of spec.security.certManager in config-certmanager. The keys set
+// in spec.config take precedence.
+func configureIssuers(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" || u.GetName() != certManagerConfigMapName {
+			return nil
+		}
+		certManager := instance.Spec.Security.CertManager
+		for key, ref := range map[string]*base.CertManagerIssuerRef{
+			"issuerRef":             certManager.IssuerRef,
+			"clusterLocalIssuerRef": certManager.ClusterLocalIssuerRef,
+		} {
+			if ref == nil || configured(instance, "certmanager", key) {
+				continue
+			}
+			issuer := *ref
+			if issuer.Kind == "" {
+				issuer.Kind = clusterIssuerKind
+			}
+			value, err := yaml.Marshal(issuer)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the %s: %w", key, err)
+			}
+			if err := unstructured.SetNestedField(u.Object, string(value), "data", key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// configureDomainTLS enables the TLS of the external domains, and of the cluster-local domains if
+// requested, in config-network. The keys set in spec.config take precedence, including the
+// deprecated auto-tls.
+func configureDomainTLS(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" || u.GetName() != networkConfigMapName {
+			return nil
+		}
+		if !configured(instance, "network", "external-domain-tls") && !configured(instance, "network", "auto-tls") {
+			if err := unstructured.SetNestedField(u.Object, enabledValue, "data", "external-domain-tls"); err != nil {
+				return err
+			}
+		}
+		if instance.Spec.Security.CertManager.ClusterLocalDomainTLS && !configured(instance, "network", "cluster-local-domain-tls") {
+			return unstructured.SetNestedField(u.Object, enabledValue, "data", "cluster-local-domain-tls")
+		}
+		return nil
+	}
+}
+
+// configured returns whether spec.config sets the key of the ConfigMap, with or without the
+// "config-" prefix of its name.
+func configured(instance *v1beta1.KnativeServing, name, key string) bool {
+	config := instance.Spec.GetConfig()
+	if _, ok := config[name][key]; ok {
+		return true
+	}
+	_, ok := config["config-"+name][key]
+	return ok
+}
+
+// CheckCertManager returns a Stage, which validates that cert-manager is installed in the cluster,
+// before Knative Serving is configured to request certificates from it, for its domains or its
+// system-internal-tls.
+func CheckCertManager(kubeClient kubernetes.Interface) common.Stage {
+	return func(_ context.Context, _ *mf.Manifest, instance base.KComponent) error {
+		ks := servingcommon.ConvertToKS(instance)
+		if ks.Spec.Security == nil || (!ks.Spec.Security.CertManager.Enabled && !isSystemInternalTLSEnabled(ks)) {
+			return nil
+		}
+		required := sets.New("Certificate", clusterIssuerKind)
+		refs := []*base.CertManagerIssuerRef{ks.Spec.Security.CertManager.IssuerRef, ks.Spec.Security.CertManager.ClusterLocalIssuerRef}
+		if isSystemInternalTLSEnabled(ks) {
+			refs = append(refs, ks.Spec.Security.SystemInternalTLS.IssuerRef)
+		}
+		for _, ref := range refs {
+			if ref != nil && ref.Kind != "" {
+				required.Insert(ref.Kind)
+			}
+		}
+
+		served := sets.New[string]()
+		resources, err := kubeClient.Discovery().ServerResourcesForGroupVersion(certManagerGroupVersion)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to discover the resources of %s: %w", certManagerGroupVersion, err)
+		}
+		if resources != nil {
+			for _, r := range resources.APIResources {
+				served.Insert(r.Kind)
+			}
+		}
+		if missing := required.Difference(served); missing.Len() > 0 {
+			msg := fmt.Sprintf("cert-manager is not installed, the API %s is not served for %s",
+				certManagerGroupVersion, strings.Join(sets.List(missing), ", "))
+			instance.GetStatus().MarkInstallFailed(msg)
+			return fmt.Errorf("%s", msg)
+		}
+		return nil
+	}
+}
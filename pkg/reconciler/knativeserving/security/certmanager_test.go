@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func certManagerInstance(certManager base.CertManagerConfiguration, config base.ConfigMapData) *servingv1beta1.KnativeServing {
+	return &servingv1beta1.KnativeServing{
+		Spec: servingv1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{Config: config},
+			Security:   &servingv1beta1.SecurityConfigs{CertManager: certManager},
+		},
+	}
+}
+
+func configMap(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": name},
+		"data":       map[string]interface{}{},
+	}}
+}
+
+func TestConfigureIssuers(t *testing.T) {
+	tests := []struct {
+		name        string
+		certManager base.CertManagerConfiguration
+		config      base.ConfigMapData
+		expected    map[string]string
+	}{{
+		name:        "no issuers",
+		certManager: base.CertManagerConfiguration{Enabled: true},
+		expected:    map[string]string{},
+	}, {
+		name: "issuers",
+		certManager: base.CertManagerConfiguration{
+			Enabled:               true,
+			IssuerRef:             &base.CertManagerIssuerRef{Name: "letsencrypt"},
+			ClusterLocalIssuerRef: &base.CertManagerIssuerRef{Kind: "Issuer", Name: "internal"},
+		},
+		expected: map[string]string{
+			"issuerRef":             "kind: ClusterIssuer\nname: letsencrypt\n",
+			"clusterLocalIssuerRef": "kind: Issuer\nname: internal\n",
+		},
+	}, {
+		name: "spec.config takes precedence",
+		certManager: base.CertManagerConfiguration{
+			Enabled:   true,
+			IssuerRef: &base.CertManagerIssuerRef{Name: "letsencrypt"},
+		},
+		config:   base.ConfigMapData{"config-certmanager": {"issuerRef": "kind: ClusterIssuer\nname: other\n"}},
+		expected: map[string]string{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := configMap("config-certmanager")
+			if err := configureIssuers(certManagerInstance(tt.certManager, tt.config))(u); err != nil {
+				t.Fatalf("configureIssuers() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
+
+func TestConfigureDomainTLS(t *testing.T) {
+	tests := []struct {
+		name        string
+		certManager base.CertManagerConfiguration
+		config      base.ConfigMapData
+		expected    map[string]string
+	}{{
+		name:        "external domains",
+		certManager: base.CertManagerConfiguration{Enabled: true},
+		expected:    map[string]string{"external-domain-tls": "Enabled"},
+	}, {
+		name:        "cluster-local domains",
+		certManager: base.CertManagerConfiguration{Enabled: true, ClusterLocalDomainTLS: true},
+		expected:    map[string]string{"external-domain-tls": "Enabled", "cluster-local-domain-tls": "Enabled"},
+	}, {
+		name:        "deprecated auto-tls in spec.config",
+		certManager: base.CertManagerConfiguration{Enabled: true},
+		config:      base.ConfigMapData{"network": {"auto-tls": "Disabled"}},
+		expected:    map[string]string{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := configMap("config-network")
+			if err := configureDomainTLS(certManagerInstance(tt.certManager, tt.config))(u); err != nil {
+				t.Fatalf("configureDomainTLS() = %v", err)
+			}
+			data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expected)
+		})
+	}
+}
+
+func TestCheckCertManager(t *testing.T) {
+	tests := []struct {
+		name        string
+		certManager base.CertManagerConfiguration
+		served      []string
+		expectedErr string
+	}{{
+		name:        "disabled",
+		certManager: base.CertManagerConfiguration{},
+	}, {
+		name:        "installed",
+		certManager: base.CertManagerConfiguration{Enabled: true},
+		served:      []string{"Certificate", "ClusterIssuer", "Issuer"},
+	}, {
+		name:        "not installed",
+		certManager: base.CertManagerConfiguration{Enabled: true},
+		expectedErr: "cert-manager is not installed, the API cert-manager.io/v1 is not served for Certificate, ClusterIssuer",
+	}, {
+		name: "issuer kind not served",
+		certManager: base.CertManagerConfiguration{
+			Enabled:   true,
+			IssuerRef: &base.CertManagerIssuerRef{Kind: "Issuer", Name: "letsencrypt"},
+		},
+		served:      []string{"Certificate", "ClusterIssuer"},
+		expectedErr: "cert-manager is not installed, the API cert-manager.io/v1 is not served for Issuer",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			if len(tt.served) > 0 {
+				resources := &metav1.APIResourceList{GroupVersion: "cert-manager.io/v1"}
+				for _, kind := range tt.served {
+					resources.APIResources = append(resources.APIResources, metav1.APIResource{Kind: kind})
+				}
+				kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{resources}
+			}
+			instance := certManagerInstance(tt.certManager, nil)
+			instance.Status.InitializeConditions()
+			manifest, _ := mf.ManifestFrom(mf.Slice{})
+
+			err := CheckCertManager(kubeClient)(context.Background(), &manifest, instance)
+			if tt.expectedErr == "" {
+				util.AssertEqual(t, err, nil)
+				return
+			}
+			if err == nil {
+				t.Fatalf("CheckCertManager() = nil, want %q", tt.expectedErr)
+			}
+			util.AssertEqual(t, err.Error(), tt.expectedErr)
+			util.AssertEqual(t, instance.Status.IsReady(), false)
+		})
+	}
+}
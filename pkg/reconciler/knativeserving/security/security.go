@@ -25,6 +25,7 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"golang.org/x/mod/semver"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"knative.dev/operator/pkg/apis/operator/base"
 	"knative.dev/operator/pkg/apis/operator/v1beta1"
 	"knative.dev/operator/pkg/reconciler/common"
@@ -39,6 +40,13 @@ func AppendTargetSecurity(ctx context.Context, manifest *mf.Manifest, instance b
 	if err == nil {
 		*manifest = manifest.Append(m)
 	}
+	if ks := servingcommon.ConvertToKS(instance); hasTrustBundle(ks) {
+		bundle, bundleErr := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*trustBundle(ks)}))
+		if bundleErr != nil {
+			return bundleErr
+		}
+		*manifest = manifest.Append(bundle)
+	}
 
 	if len(instance.GetSpec().GetManifests()) != 0 {
 		// If spec.manifests is not empty, it is possible that the securityguard is not available with the specified version.
@@ -59,6 +67,14 @@ func Transformers(ctx context.Context, ks *v1beta1.KnativeServing) []mf.Transfor
 		transformers = append(transformers, securityGuardTransformers(ctx, ks)...)
 	}
 
+	if ks.Spec.Security.CertManager.Enabled {
+		transformers = append(transformers, certManagerTransformers(ctx, ks)...)
+	}
+
+	if isSystemInternalTLSEnabled(ks) {
+		transformers = append(transformers, systemInternalTLSTransformers(ctx, ks)...)
+	}
+
 	return transformers
 }
 
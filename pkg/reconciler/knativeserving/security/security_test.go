@@ -168,6 +168,18 @@ func TestTransformers(t *testing.T) {
 			},
 		},
 		expected: 0,
+	}, {
+		name: "Available cert-manager",
+		instance: servingv1beta1.KnativeServing{
+			Spec: servingv1beta1.KnativeServingSpec{
+				Security: &servingv1beta1.SecurityConfigs{
+					CertManager: base.CertManagerConfiguration{
+						Enabled: true,
+					},
+				},
+			},
+		},
+		expected: 2,
 	}}
 
 	for _, tt := range tests {
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+const (
+	systemInternalTLSKey    = "system-internal-tls"
+	systemInternalIssuerKey = "systemInternalIssuerRef"
+	trustBundleKind         = "Bundle"
+	trustBundleAPIVersion   = "trust.cert-manager.io/v1alpha1"
+	defaultTrustBundleKey   = "ca.crt"
+	// trustBundleLabel marks the ConfigMaps with the CAs, which the Knative components trust.
+	trustBundleLabel = "networking.knative.dev/trust-bundle"
+)
+
+func systemInternalTLSTransformers(_ context.Context, instance *v1beta1.KnativeServing) []mf.Transformer {
+	return []mf.Transformer{
+		configureSystemInternalTLS(instance),
+		clusterScopedTrustBundle(instance),
+	}
+}
+
+// configureSystemInternalTLS enables system-internal-tls in config-network, and sets the issuer of
+// its certificates in config-certmanager. The keys set in spec.config take precedence, including the
+// deprecated internal-encryption.
+func configureSystemInternalTLS(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ConfigMap" {
+			return nil
+		}
+		switch u.GetName() {
+		case networkConfigMapName:
+			if configured(instance, "network", systemInternalTLSKey) || configured(instance, "network", "internal-encryption") {
+				return nil
+			}
+			return unstructured.SetNestedField(u.Object, enabledValue, "data", systemInternalTLSKey)
+		case certManagerConfigMapName:
+			ref := instance.Spec.Security.SystemInternalTLS.IssuerRef
+			if ref == nil || configured(instance, "certmanager", systemInternalIssuerKey) {
+				return nil
+			}
+			issuer := *ref
+			if issuer.Kind == "" {
+				issuer.Kind = clusterIssuerKind
+			}
+			value, err := yaml.Marshal(issuer)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the %s: %w", systemInternalIssuerKey, err)
+			}
+			return unstructured.SetNestedField(u.Object, string(value), "data", systemInternalIssuerKey)
+		}
+		return nil
+	}
+}
+
+// clusterScopedTrustBundle removes the namespace and the owner of the trust Bundle, which the common
+// transformers inject into all the resources. A Bundle is cluster-scoped.
+func clusterScopedTrustBundle(instance *v1beta1.KnativeServing) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() == trustBundleKind && u.GetAPIVersion() == trustBundleAPIVersion && u.GetName() == trustBundleName(instance) {
+			u.SetNamespace("")
+			u.SetOwnerReferences(nil)
+		}
+		return nil
+	}
+}
+
+// trustBundle returns the trust-manager Bundle, which copies the CA of the secret into a ConfigMap in
+// the namespace of Knative Serving. The ConfigMap is labeled as a trust bundle, so that the Knative
+// components trust the certificates issued by the CA.
+func trustBundle(instance *v1beta1.KnativeServing) *unstructured.Unstructured {
+	source := instance.Spec.Security.SystemInternalTLS.TrustBundle
+	key := source.Key
+	if key == "" {
+		key = defaultTrustBundleKey
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": trustBundleAPIVersion,
+		"kind":       trustBundleKind,
+		"metadata": map[string]interface{}{
+			"name": trustBundleName(instance),
+		},
+		"spec": map[string]interface{}{
+			"sources": []interface{}{
+				map[string]interface{}{
+					"secret": map[string]interface{}{"name": source.SecretName, "key": key},
+				},
+			},
+			"target": map[string]interface{}{
+				"configMap": map[string]interface{}{
+					"key": defaultTrustBundleKey,
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{trustBundleLabel: "true"},
+					},
+				},
+				"namespaceSelector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{"kubernetes.io/metadata.name": instance.GetNamespace()},
+				},
+			},
+		},
+	}}
+}
+
+// trustBundleName returns the name of the Bundle, which is unique per namespace of Knative Serving,
+// and of the ConfigMap it creates in there.
+func trustBundleName(instance *v1beta1.KnativeServing) string {
+	return instance.GetNamespace() + "-trust-bundle"
+}
+
+func hasTrustBundle(instance *v1beta1.KnativeServing) bool {
+	return isSystemInternalTLSEnabled(instance) && instance.Spec.Security.SystemInternalTLS.TrustBundle != nil
+}
+
+func isSystemInternalTLSEnabled(instance *v1beta1.KnativeServing) bool {
+	return instance.Spec.Security != nil && instance.Spec.Security.SystemInternalTLS != nil &&
+		instance.Spec.Security.SystemInternalTLS.Enabled
+}
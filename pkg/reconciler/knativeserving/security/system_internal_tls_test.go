@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	servingv1beta1 "knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func systemInternalTLSInstance(tls *base.SystemInternalTLSConfiguration, config base.ConfigMapData) *servingv1beta1.KnativeServing {
+	return &servingv1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+		Spec: servingv1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{Version: "1.21.0", Config: config},
+			Security:   &servingv1beta1.SecurityConfigs{SystemInternalTLS: tls},
+		},
+	}
+}
+
+func TestConfigureSystemInternalTLS(t *testing.T) {
+	tests := []struct {
+		name                string
+		tls                 *base.SystemInternalTLSConfiguration
+		config              base.ConfigMapData
+		expectedNetwork     map[string]string
+		expectedCertManager map[string]string
+	}{{
+		name:                "default issuer",
+		tls:                 &base.SystemInternalTLSConfiguration{Enabled: true},
+		expectedNetwork:     map[string]string{"system-internal-tls": "Enabled"},
+		expectedCertManager: map[string]string{},
+	}, {
+		name: "issuer",
+		tls: &base.SystemInternalTLSConfiguration{
+			Enabled:   true,
+			IssuerRef: &base.CertManagerIssuerRef{Name: "internal-ca"},
+		},
+		expectedNetwork:     map[string]string{"system-internal-tls": "Enabled"},
+		expectedCertManager: map[string]string{"systemInternalIssuerRef": "kind: ClusterIssuer\nname: internal-ca\n"},
+	}, {
+		name:                "deprecated internal-encryption in spec.config",
+		tls:                 &base.SystemInternalTLSConfiguration{Enabled: true},
+		config:              base.ConfigMapData{"config-network": {"internal-encryption": "false"}},
+		expectedNetwork:     map[string]string{},
+		expectedCertManager: map[string]string{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := systemInternalTLSInstance(tt.tls, tt.config)
+			network := configMap("config-network")
+			certManager := configMap("config-certmanager")
+			for _, u := range []*unstructured.Unstructured{network, certManager} {
+				if err := configureSystemInternalTLS(instance)(u); err != nil {
+					t.Fatalf("configureSystemInternalTLS() = %v", err)
+				}
+			}
+			data, _, _ := unstructured.NestedStringMap(network.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedNetwork)
+			data, _, _ = unstructured.NestedStringMap(certManager.Object, "data")
+			util.AssertDeepEqual(t, data, tt.expectedCertManager)
+		})
+	}
+}
+
+func TestAppendTrustBundle(t *testing.T) {
+	instance := systemInternalTLSInstance(&base.SystemInternalTLSConfiguration{
+		Enabled:     true,
+		TrustBundle: &base.TrustBundleConfiguration{SecretName: "knative-internal-ca"},
+	}, nil)
+	manifest, _ := mf.ManifestFrom(mf.Slice{})
+	if err := AppendTargetSecurity(context.Background(), &manifest, instance); err != nil {
+		t.Fatalf("AppendTargetSecurity() = %v", err)
+	}
+	bundles := manifest.Filter(mf.ByKind("Bundle")).Resources()
+	util.AssertEqual(t, len(bundles), 1)
+	bundle := bundles[0]
+	util.AssertEqual(t, bundle.GetName(), "knative-serving-trust-bundle")
+	secret, _, _ := unstructured.NestedStringMap(bundle.Object["spec"].(map[string]interface{})["sources"].([]interface{})[0].(map[string]interface{}), "secret")
+	util.AssertDeepEqual(t, secret, map[string]string{"name": "knative-internal-ca", "key": "ca.crt"})
+	labels, _, _ := unstructured.NestedStringMap(bundle.Object, "spec", "target", "configMap", "metadata", "labels")
+	util.AssertDeepEqual(t, labels, map[string]string{"networking.knative.dev/trust-bundle": "true"})
+
+	// The common transformers inject the namespace and the owner of the Knative Serving.
+	bundle.SetNamespace("knative-serving")
+	bundle.SetOwnerReferences([]metav1.OwnerReference{{Name: "knative-serving"}})
+	if err := clusterScopedTrustBundle(instance)(&bundle); err != nil {
+		t.Fatalf("clusterScopedTrustBundle() = %v", err)
+	}
+	util.AssertEqual(t, bundle.GetNamespace(), "")
+	util.AssertEqual(t, len(bundle.GetOwnerReferences()), 0)
+}
@@ -42,10 +42,18 @@ func SetManifestPaths(ctx context.Context, manifest *mf.Manifest, instance base.
 	status := instance.GetStatus()
 	path := common.TargetManifestPathArray(instance)
 	version := common.TargetVersion(instance)
-	addedPath := ingress.GetIngressPath(version, ksc.ConvertToKS(instance))
+	var addedPath string
 	switch instance.(type) {
+	case *v1beta1.KnativeServing:
+		addedPath = ingress.GetIngressPath(version, ksc.ConvertToKS(instance))
 	case *v1beta1.KnativeEventing:
 		addedPath = source.GetSourcePath(version, source.ConvertToKE(instance))
+	case *v1beta1.KnativeNetworking:
+		if len(instance.GetSpec().GetManifests()) == 0 {
+			// The networking layer consists of the ingresses only, without a release of its own.
+			path = path[1:]
+		}
+		addedPath = ingress.GetIngressPath(version, ksc.ConvertToKS(instance))
 	}
 	if addedPath != "" {
 		path = append(path, addedPath)
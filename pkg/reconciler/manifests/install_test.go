@@ -100,6 +100,35 @@ func TestInstall(t *testing.T) {
 		},
 		expectedPath: []string{os.Getenv(common.KoEnvKey) + "/knative-serving/1.9.0",
 			os.Getenv(common.KoEnvKey) + "/ingress/1.9/istio"},
+	}, {
+		name:    "Knative Networking",
+		version: "1.9.0",
+		instance: &v1beta1.KnativeNetworking{
+			Spec: v1beta1.KnativeNetworkingSpec{
+				CommonSpec: base.CommonSpec{
+					Version: "1.9.0",
+				},
+				Ingress: &v1beta1.IngressConfigs{
+					Kourier: base.KourierIngressConfiguration{
+						Enabled: true,
+					},
+				},
+			},
+		},
+		expectedPath: []string{os.Getenv(common.KoEnvKey) + "/ingress/1.9/kourier"},
+	}, {
+		name:    "Knative Networking with manifests",
+		version: "1.9.0",
+		instance: &v1beta1.KnativeNetworking{
+			Spec: v1beta1.KnativeNetworkingSpec{
+				CommonSpec: base.CommonSpec{
+					Version:   "1.9.0",
+					Manifests: []base.Manifest{{Url: "https://example.com/net-istio.yaml"}},
+				},
+			},
+		},
+		expectedPath: []string{"https://example.com/net-istio.yaml",
+			os.Getenv(common.KoEnvKey) + "/ingress/1.9/istio"},
 	}}
 
 	for _, tt := range tests {
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package openshift extends the reconcilers of Knative Serving and Knative Eventing with the
+// resources, which the components need on OpenShift.
+package openshift
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	mfc "github.com/manifestival/client-go-client"
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/pkg/reconciler/knativeserving/ingress"
+)
+
+const (
+	// SecurityContextConstraints is the SCC, which the service accounts of the components may use.
+	// Unlike restricted-v2, it allows the non-root users set by the images and the manifests.
+	SecurityContextConstraints = "nonroot-v2"
+	// ServiceMeshControlPlaneName is the name of the ServiceMeshControlPlane, which the namespace of
+	// the KnativeServing joins with the Istio ingress.
+	ServiceMeshControlPlaneName = "basic"
+	// ServiceMeshControlPlaneNamespace is the namespace of the ServiceMeshControlPlane.
+	ServiceMeshControlPlaneNamespace = "istio-system"
+
+	sccResourceName     = "knative-openshift-scc"
+	routeName           = "kourier"
+	kourierServiceName  = "kourier"
+	kourierTargetPort   = "http2"
+	serviceMeshMember   = "default"
+	wildcardRoutePrefix = "wildcard."
+)
+
+var (
+	routeGVK             = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+	serviceMeshMemberGVK = schema.GroupVersionKind{Group: "maistra.io", Version: "v1", Kind: "ServiceMeshMember"}
+)
+
+// NewExtension creates the OpenShift Extension. It is a common.ExtensionGenerator.
+func NewExtension(ctx context.Context, _ *controller.Impl) common.Extension {
+	client, err := mfc.NewClient(injection.GetConfig(ctx))
+	if err != nil {
+		logging.FromContext(ctx).Fatalw("Error creating client from injected config", zap.Error(err))
+	}
+	return &extension{client: client}
+}
+
+type extension struct {
+	client mf.Client
+}
+
+// Manifests binds the service accounts of the component to the SecurityContextConstraints. For a
+// KnativeServing, it exposes the Kourier gateway with a wildcard Route of the default domain and
+// adds the namespace to the service mesh with the Istio ingress.
+func (e *extension) Manifests(comp base.KComponent) ([]mf.Manifest, error) {
+	resources := sccResources(comp.GetNamespace())
+	if ks, ok := comp.(*v1beta1.KnativeServing); ok {
+		if route := kourierRoute(ks); route != nil {
+			resources = append(resources, *route)
+		}
+		if hasIngress(ks, "istio") {
+			resources = append(resources, *serviceMeshMemberResource(ks.Namespace))
+		}
+	}
+	m, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return nil, err
+	}
+	return []mf.Manifest{m}, nil
+}
+
+// Transformers implements common.Extension.
+func (e *extension) Transformers(base.KComponent) []mf.Transformer {
+	return nil
+}
+
+// Reconcile deletes the Route and the ServiceMeshMember of a KnativeServing, once they are not
+// needed anymore. As they are not part of the installed manifests, the obsolete resources are not
+// deleted with those.
+func (e *extension) Reconcile(_ context.Context, comp base.KComponent) error {
+	ks, ok := comp.(*v1beta1.KnativeServing)
+	if !ok {
+		return nil
+	}
+	if kourierRoute(ks) == nil {
+		if err := e.deleteOwned(ks, common.NamespacedResource(routeGVK.GroupVersion().String(), routeGVK.Kind, ks.Namespace, routeName)); err != nil {
+			return err
+		}
+	}
+	if !hasIngress(ks, "istio") {
+		if err := e.deleteOwned(ks, common.NamespacedResource(serviceMeshMemberGVK.GroupVersion().String(), serviceMeshMemberGVK.Kind, ks.Namespace, serviceMeshMember)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Finalize implements common.Extension. The resources are deleted with the component, which owns them.
+func (e *extension) Finalize(context.Context, base.KComponent) error {
+	return nil
+}
+
+// deleteOwned deletes the resource, if it is controlled by the component. Resources of the same
+// name, which were created by others, and missing APIs, e.g. without OpenShift Service Mesh, are
+// ignored.
+func (e *extension) deleteOwned(comp base.KComponent, resource *unstructured.Unstructured) error {
+	current, err := e.client.Get(resource)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get %s %s: %w", resource.GetKind(), resource.GetName(), err)
+	}
+	if owner := metav1.GetControllerOf(current); owner == nil || owner.UID != comp.GetUID() {
+		return nil
+	}
+	if err := e.client.Delete(current, mf.IgnoreNotFound(true)); err != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", resource.GetKind(), resource.GetName(), err)
+	}
+	return nil
+}
+
+// sccResources are the Role and the RoleBinding, which allow all the service accounts of the
+// namespace to use the SecurityContextConstraints.
+func sccResources(namespace string) []unstructured.Unstructured {
+	role := common.NamespacedResource("rbac.authorization.k8s.io/v1", "Role", namespace, sccResourceName)
+	role.Object["rules"] = []interface{}{map[string]interface{}{
+		"apiGroups":     []interface{}{"security.openshift.io"},
+		"resources":     []interface{}{"securitycontextconstraints"},
+		"resourceNames": []interface{}{SecurityContextConstraints},
+		"verbs":         []interface{}{"use"},
+	}}
+	binding := common.NamespacedResource("rbac.authorization.k8s.io/v1", "RoleBinding", namespace, sccResourceName)
+	binding.Object["roleRef"] = map[string]interface{}{
+		"apiGroup": "rbac.authorization.k8s.io",
+		"kind":     "Role",
+		"name":     sccResourceName,
+	}
+	binding.Object["subjects"] = []interface{}{map[string]interface{}{
+		"apiGroup": "rbac.authorization.k8s.io",
+		"kind":     "Group",
+		"name":     "system:serviceaccounts:" + namespace,
+	}}
+	return []unstructured.Unstructured{*role, *binding}
+}
+
+// kourierRoute returns the Route, which exposes all the hosts of the default domain of the
+// KnativeServing at the Kourier gateway, nil without Kourier or a default domain.
+func kourierRoute(ks *v1beta1.KnativeServing) *unstructured.Unstructured {
+	if !hasIngress(ks, "kourier") || ks.Spec.Domain == nil || ks.Spec.Domain.Default == "" {
+		return nil
+	}
+	route := common.NamespacedResource(routeGVK.GroupVersion().String(), routeGVK.Kind, ks.Namespace, routeName)
+	route.Object["spec"] = map[string]interface{}{
+		"host":           wildcardRoutePrefix + ks.Spec.Domain.Default,
+		"wildcardPolicy": "Subdomain",
+		"to": map[string]interface{}{
+			"kind": "Service",
+			"name": kourierServiceName,
+		},
+		"port": map[string]interface{}{
+			"targetPort": kourierTargetPort,
+		},
+	}
+	return route
+}
+
+// serviceMeshMemberResource is the ServiceMeshMember, which adds the namespace to the member
+// roll of the ServiceMeshControlPlane.
+func serviceMeshMemberResource(namespace string) *unstructured.Unstructured {
+	member := common.NamespacedResource(serviceMeshMemberGVK.GroupVersion().String(), serviceMeshMemberGVK.Kind, namespace, serviceMeshMember)
+	member.Object["spec"] = map[string]interface{}{
+		"controlPlaneRef": map[string]interface{}{
+			"name":      ServiceMeshControlPlaneName,
+			"namespace": ServiceMeshControlPlaneNamespace,
+		},
+	}
+	return member
+}
+
+func hasIngress(ks *v1beta1.KnativeServing, name string) bool {
+	return slices.Contains(ingress.Names(ks), name)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openshift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manifestival/manifestival/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestManifests(t *testing.T) {
+	tests := []struct {
+		name      string
+		component base.KComponent
+		want      []string
+	}{{
+		name:      "eventing",
+		component: &v1beta1.KnativeEventing{ObjectMeta: metav1.ObjectMeta{Name: "knative-eventing", Namespace: "knative-eventing"}},
+		want:      []string{"Role/knative-openshift-scc", "RoleBinding/knative-openshift-scc"},
+	}, {
+		name:      "serving with istio by default",
+		component: &v1beta1.KnativeServing{ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"}},
+		want:      []string{"Role/knative-openshift-scc", "RoleBinding/knative-openshift-scc", "ServiceMeshMember/default"},
+	}, {
+		name: "serving with kourier",
+		component: &v1beta1.KnativeServing{
+			ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+			Spec: v1beta1.KnativeServingSpec{
+				Ingress: &v1beta1.IngressConfigs{Kourier: base.KourierIngressConfiguration{Enabled: true}},
+				Domain:  &base.DomainConfiguration{Default: "apps.example.com"},
+			},
+		},
+		want: []string{"Role/knative-openshift-scc", "RoleBinding/knative-openshift-scc", "Route/kourier"},
+	}, {
+		name: "serving with kourier without a domain",
+		component: &v1beta1.KnativeServing{
+			ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+			Spec: v1beta1.KnativeServingSpec{
+				Ingress: &v1beta1.IngressConfigs{Kourier: base.KourierIngressConfiguration{Enabled: true}},
+			},
+		},
+		want: []string{"Role/knative-openshift-scc", "RoleBinding/knative-openshift-scc"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifests, err := (&extension{}).Manifests(test.component)
+			if err != nil {
+				t.Fatalf("Manifests() = %v", err)
+			}
+			var got []string
+			for _, m := range manifests {
+				for _, u := range m.Resources() {
+					got = append(got, u.GetKind()+"/"+u.GetName())
+				}
+			}
+			util.AssertDeepEqual(t, got, test.want)
+		})
+	}
+}
+
+func TestManifestsResources(t *testing.T) {
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving"},
+		Spec: v1beta1.KnativeServingSpec{
+			Ingress: &v1beta1.IngressConfigs{Kourier: base.KourierIngressConfiguration{Enabled: true}},
+			Domain:  &base.DomainConfiguration{Default: "apps.example.com"},
+		},
+	}
+	manifests, err := (&extension{}).Manifests(ks)
+	if err != nil {
+		t.Fatalf("Manifests() = %v", err)
+	}
+	resources := manifests[0].Resources()
+
+	subject, _, _ := unstructured.NestedSlice(resources[1].Object, "subjects")
+	name, _, _ := unstructured.NestedString(subject[0].(map[string]interface{}), "name")
+	util.AssertEqual(t, name, "system:serviceaccounts:knative-serving")
+
+	host, _, _ := unstructured.NestedString(resources[2].Object, "spec", "host")
+	util.AssertEqual(t, host, "wildcard.apps.example.com")
+	service, _, _ := unstructured.NestedString(resources[2].Object, "spec", "to", "name")
+	util.AssertEqual(t, service, "kourier")
+}
+
+func TestReconcile(t *testing.T) {
+	ks := &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-serving", Namespace: "knative-serving", UID: "serving-uid"},
+		Spec: v1beta1.KnativeServingSpec{
+			Ingress: &v1beta1.IngressConfigs{Kourier: base.KourierIngressConfiguration{Enabled: true}},
+		},
+	}
+	owned := serviceMeshMemberResource(ks.Namespace)
+	owned.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(ks, v1beta1.SchemeGroupVersion.WithKind("KnativeServing"))})
+	route := kourierRoute(&v1beta1.KnativeServing{
+		ObjectMeta: ks.ObjectMeta,
+		Spec: v1beta1.KnativeServingSpec{
+			Ingress: ks.Spec.Ingress,
+			Domain:  &base.DomainConfiguration{Default: "apps.example.com"},
+		},
+	})
+
+	client := fake.New(owned, route)
+	if err := (&extension{client: client}).Reconcile(context.Background(), ks); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+
+	// The ServiceMeshMember of the KnativeServing is deleted without the Istio ingress.
+	if _, err := client.Get(serviceMeshMemberResource(ks.Namespace)); !apierrors.IsNotFound(err) {
+		t.Errorf("Get(ServiceMeshMember) = %v, want NotFound", err)
+	}
+	// The Route is kept, as it was not created by the KnativeServing.
+	if _, err := client.Get(route); err != nil {
+		t.Errorf("Get(Route) = %v", err)
+	}
+}
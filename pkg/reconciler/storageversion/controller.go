@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/pkg/apiextensions/storageversion"
+	apixclient "knative.dev/pkg/client/injection/apiextensions/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+// Resources are the resources of the operator, which are migrated to the storage version of
+// their CRD.
+var Resources = []schema.GroupResource{
+	operator.KnativeServingResource,
+	operator.KnativeEventingResource,
+}
+
+// NewController returns a controller, which migrates the stored KnativeServing and
+// KnativeEventing resources to the storage version of their CRD, once it becomes the leader.
+// The versions, which are no longer stored, are dropped from the status of the CRD afterwards.
+func NewController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+	r := &reconciler{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+				for _, gr := range Resources {
+					enq(bkt, types.NamespacedName{Name: gr.String()})
+				}
+				return nil
+			},
+		},
+		migrator: storageversion.NewMigrator(dynamicclient.Get(ctx), apixclient.Get(ctx)),
+	}
+
+	const queueName = "StorageVersionMigration"
+	return controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: queueName, Logger: logging.FromContext(ctx).Named(queueName)})
+}
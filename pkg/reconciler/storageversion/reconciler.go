@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+// migrator migrates the resources of a CRD to its storage version.
+type migrator interface {
+	Migrate(ctx context.Context, gr schema.GroupResource) error
+}
+
+type reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	migrator migrator
+}
+
+var (
+	_ controller.Reconciler     = (*reconciler)(nil)
+	_ pkgreconciler.LeaderAware = (*reconciler)(nil)
+)
+
+// Reconcile implements controller.Reconciler. The key is the group resource of the CRD. A failed
+// migration is retried with the backoff of the work queue.
+func (r *reconciler) Reconcile(ctx context.Context, key string) error {
+	logger := logging.FromContext(ctx).With(zap.String("resource", key))
+
+	if !r.IsLeaderFor(types.NamespacedName{Name: key}) {
+		return controller.NewSkipKey(key)
+	}
+
+	logger.Info("Migrating the stored resources to the storage version")
+	if err := r.migrator.Migrate(ctx, schema.ParseGroupResource(key)); err != nil {
+		if apierrs.IsNotFound(err) {
+			// The CRD is not installed, there is nothing to migrate.
+			logger.Infow("Skipping the storage version migration", zap.Error(err))
+			return nil
+		}
+		logger.Errorw("Failed to migrate the stored resources", zap.Error(err))
+		return err
+	}
+	logger.Info("Migrated the stored resources to the storage version")
+	return nil
+}
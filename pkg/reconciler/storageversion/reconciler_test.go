@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+type fakeMigrator struct {
+	err      error
+	migrated []schema.GroupResource
+}
+
+func (m *fakeMigrator) Migrate(_ context.Context, gr schema.GroupResource) error {
+	m.migrated = append(m.migrated, gr)
+	return m.err
+}
+
+func TestReconcile(t *testing.T) {
+	key := operator.KnativeServingResource.String()
+
+	tests := []struct {
+		name    string
+		leader  bool
+		err     error
+		wantErr bool
+		want    []schema.GroupResource
+	}{{
+		name:   "migrated",
+		leader: true,
+		want:   []schema.GroupResource{operator.KnativeServingResource},
+	}, {
+		name:    "failed",
+		leader:  true,
+		err:     errors.New("unable to patch resource"),
+		wantErr: true,
+		want:    []schema.GroupResource{operator.KnativeServingResource},
+	}, {
+		name:   "crd not found",
+		leader: true,
+		err:    apierrs.NewNotFound(schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}, key),
+		want:   []schema.GroupResource{operator.KnativeServingResource},
+	}, {
+		name:    "not the leader",
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &fakeMigrator{err: test.err}
+			r := &reconciler{migrator: m}
+			if test.leader {
+				if err := r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}); err != nil {
+					t.Fatalf("Promote() = %v", err)
+				}
+			}
+
+			err := r.Reconcile(context.Background(), key)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Reconcile() = %v, wantErr %v", err, test.wantErr)
+			}
+			if !test.leader {
+				if !controller.IsSkipKey(err) {
+					t.Errorf("Reconcile() = %v, want a skipped key", err)
+				}
+			}
+			if len(m.migrated) != len(test.want) || (len(test.want) > 0 && m.migrated[0] != test.want[0]) {
+				t.Errorf("Migrated = %v, want %v", m.migrated, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certificates generates and rotates the serving certificate of the operator webhook.
+package certificates
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// RotateBefore is the remaining validity of the certificate, below which it is rotated. The
+// certificates are valid for a week, so they are rotated every five days, long before the
+// WebhookCertificateWarning condition is marked.
+const RotateBefore = 2 * 24 * time.Hour
+
+type reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	client       kubernetes.Interface
+	secretLister corelisters.SecretLister
+	key          types.NamespacedName
+	serviceName  string
+	now          func() time.Time
+}
+
+var (
+	_ controller.Reconciler     = (*reconciler)(nil)
+	_ pkgreconciler.LeaderAware = (*reconciler)(nil)
+)
+
+// Reconcile implements controller.Reconciler
+func (r *reconciler) Reconcile(ctx context.Context, key string) error {
+	if !r.IsLeaderFor(r.key) {
+		return controller.NewSkipKey(key)
+	}
+	return r.reconcileCertificate(ctx)
+}
+
+// reconcileCertificate creates the secret, if it is missing, and generates its certificate again,
+// if it is invalid or expires within RotateBefore. Otherwise, it is reconciled again, when it is
+// due for rotation.
+func (r *reconciler) reconcileCertificate(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+
+	secret, err := r.secretLister.Secrets(r.key.Namespace).Get(r.key.Name)
+	if apierrors.IsNotFound(err) {
+		// The secret is not required to be installed with the webhook, it is created here.
+		newSecret, err := r.makeSecret(ctx)
+		if err != nil {
+			return err
+		}
+		logger.Infof("Creating the certificate secret %q", r.key.Name)
+		_, err = r.client.CoreV1().Secrets(r.key.Namespace).Create(ctx, newSecret, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	notAfter, err := common.CertificateNotAfter(secret)
+	if err != nil {
+		logger.Infow("Generating the certificate", zap.Error(err))
+	} else if rotateIn := notAfter.Sub(r.now()) - RotateBefore; rotateIn > 0 {
+		return controller.NewRequeueAfter(rotateIn)
+	} else {
+		logger.Infow("Rotating the certificate", zap.Time("notAfter", notAfter))
+	}
+
+	newSecret, err := r.makeSecret(ctx)
+	if err != nil {
+		return err
+	}
+	// Don't modify the informer copy.
+	secret = secret.DeepCopy()
+	secret.Data = newSecret.Data
+	_, err = r.client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *reconciler) makeSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret, err := certresources.MakeSecret(ctx, r.key.Name, r.key.Namespace, r.serviceName)
+	if err != nil {
+		return nil, err
+	}
+	secret.Labels = map[string]string{
+		"app.kubernetes.io/component": "operator-webhook",
+		"app.kubernetes.io/name":      "knative-operator",
+	}
+	return secret, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"knative.dev/operator/pkg/reconciler/common"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+const (
+	namespace   = "knative-operator"
+	serviceName = "operator-webhook"
+)
+
+func certificateSecret(t *testing.T, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+	serverKey, serverCert, caCert, err := certresources.CreateCerts(context.Background(), serviceName, namespace, notAfter)
+	if err != nil {
+		t.Fatalf("CreateCerts() = %v", err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: common.OperatorWebhookSecretName},
+		Data: map[string][]byte{
+			certresources.ServerKey:  serverKey,
+			certresources.ServerCert: serverCert,
+			certresources.CACert:     caCert,
+		},
+	}
+}
+
+func TestReconcileCertificate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		secret      *corev1.Secret
+		wantRequeue time.Duration
+		wantRotated bool
+	}{{
+		name:        "missing secret",
+		wantRotated: true,
+	}, {
+		name:        "empty secret",
+		secret:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: common.OperatorWebhookSecretName}},
+		wantRotated: true,
+	}, {
+		name:        "valid certificate",
+		secret:      certificateSecret(t, now.Add(5*24*time.Hour)),
+		wantRequeue: 3 * 24 * time.Hour,
+	}, {
+		name:        "certificate due for rotation",
+		secret:      certificateSecret(t, now.Add(RotateBefore-time.Hour)),
+		wantRotated: true,
+	}, {
+		name:        "expired certificate",
+		secret:      certificateSecret(t, now.Add(-time.Hour)),
+		wantRotated: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			var objs []runtime.Object
+			if test.secret != nil {
+				if err := indexer.Add(test.secret); err != nil {
+					t.Fatalf("Add() = %v", err)
+				}
+				objs = append(objs, test.secret)
+			}
+			kubeClient := kubefake.NewSimpleClientset(objs...)
+			key := types.NamespacedName{Namespace: namespace, Name: common.OperatorWebhookSecretName}
+			r := &reconciler{
+				client:       kubeClient,
+				secretLister: corelisters.NewSecretLister(indexer),
+				key:          key,
+				serviceName:  serviceName,
+				now:          func() time.Time { return now },
+			}
+			if err := r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}); err != nil {
+				t.Fatalf("Promote() = %v", err)
+			}
+
+			err := r.Reconcile(context.Background(), key.String())
+			if test.wantRequeue > 0 {
+				ok, after := controller.IsRequeueKey(err)
+				util.AssertEqual(t, ok, true)
+				util.AssertEqual(t, after.Round(time.Minute), test.wantRequeue)
+			} else if err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+
+			got, err := kubeClient.CoreV1().Secrets(namespace).Get(context.Background(), key.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Get() = %v", err)
+			}
+			notAfter, err := common.CertificateNotAfter(got)
+			if err != nil {
+				t.Fatalf("CertificateNotAfter() = %v", err)
+			}
+			// The certificates are generated for a week.
+			util.AssertEqual(t, notAfter.After(now.Add(6*24*time.Hour)), test.wantRotated)
+		})
+	}
+}
+
+func TestReconcileNotLeader(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	r := &reconciler{
+		client:       kubeClient,
+		secretLister: corelisters.NewSecretLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		key:          types.NamespacedName{Namespace: namespace, Name: common.OperatorWebhookSecretName},
+		now:          time.Now,
+	}
+	if !controller.IsSkipKey(r.Reconcile(context.Background(), "key")) {
+		t.Error("Reconcile() wanted to skip the key, when not the leader")
+	}
+	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() = %v", err)
+	}
+	util.AssertEqual(t, len(secrets.Items), 0)
+}
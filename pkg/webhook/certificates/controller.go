@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+)
+
+// NewController returns a controller, which generates the serving certificate of the webhook into
+// its secret and rotates it before it expires. Unlike the controller of knative.dev/pkg, it creates
+// the secret, if it is missing, so that the webhook bootstraps without any certificate installed
+// upfront. The validation and the conversion controllers patch the CA bundle of the webhook
+// configurations and of the CRDs, whenever the secret changes.
+func NewController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+	secretInformer := secretinformer.Get(ctx)
+	options := webhook.GetOptions(ctx)
+	key := types.NamespacedName{Namespace: system.Namespace(), Name: options.SecretName}
+
+	r := &reconciler{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			// Enqueue the key whenever we become leader.
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+				enq(bkt, key)
+				return nil
+			},
+		},
+		client:       kubeclient.Get(ctx),
+		secretLister: secretInformer.Lister(),
+		key:          key,
+		serviceName:  options.ServiceName,
+		now:          time.Now,
+	}
+
+	const queueName = "WebhookCertificates"
+	c := controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: queueName, Logger: logging.FromContext(ctx).Named(queueName)})
+
+	// Reconcile when the secret changes, including its deletion.
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(key.Namespace, key.Name),
+		Handler:    controller.HandleAll(func(interface{}) { c.EnqueueKey(key) }),
+	})
+	return c
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// minScaleToZeroGracePeriod is the lowest grace period accepted by the autoscaler.
+const minScaleToZeroGracePeriod = 6 * time.Second
+
+// validateAutoscaling checks spec.autoscaling of a KnativeServing against the bounds, which the
+// autoscaler enforces when it loads config-autoscaler. A field can't be combined with its key in
+// spec.config.
+func validateAutoscaling(ks *v1beta1.KnativeServing) error {
+	autoscaling := ks.Spec.Autoscaling
+	if autoscaling == nil {
+		return nil
+	}
+	var errs []error
+	fields := map[string]string{}
+	if v := autoscaling.TargetConcurrency; v != nil {
+		fields["targetConcurrency"] = "container-concurrency-target-default"
+		if *v < 1 {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.targetConcurrency: must be at least 1, got %d", *v))
+		}
+	}
+	if v := autoscaling.TargetUtilizationPercentage; v != nil {
+		fields["targetUtilizationPercentage"] = "container-concurrency-target-percentage"
+		if *v < 1 || *v > 100 {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.targetUtilizationPercentage: must be between 1 and 100, got %d", *v))
+		}
+	}
+	if v := autoscaling.ScaleToZeroGracePeriod; v != nil {
+		fields["scaleToZeroGracePeriod"] = "scale-to-zero-grace-period"
+		if v.Duration < minScaleToZeroGracePeriod {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.scaleToZeroGracePeriod: must be at least %v, got %v", minScaleToZeroGracePeriod, v.Duration))
+		}
+	}
+	if v := autoscaling.InitialScale; v != nil {
+		fields["initialScale"] = "initial-scale"
+		if *v < 0 {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.initialScale: must not be negative, got %d", *v))
+		}
+		if *v == 0 && (autoscaling.AllowZeroInitialScale == nil || !*autoscaling.AllowZeroInitialScale) {
+			errs = append(errs, errors.New("spec.autoscaling.initialScale: zero requires allowZeroInitialScale"))
+		}
+	}
+	if autoscaling.AllowZeroInitialScale != nil {
+		fields["allowZeroInitialScale"] = "allow-zero-initial-scale"
+	}
+	if v := autoscaling.PanicWindowPercentage; v != nil {
+		fields["panicWindowPercentage"] = "panic-window-percentage"
+		if *v < 1 || *v > 100 {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.panicWindowPercentage: must be between 1 and 100, got %d", *v))
+		}
+	}
+	if v := autoscaling.MinScale; v != nil {
+		fields["minScale"] = "min-scale"
+		if *v < 0 {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.minScale: must not be negative, got %d", *v))
+		}
+	}
+	if v := autoscaling.MaxScale; v != nil {
+		fields["maxScale"] = "max-scale"
+		if *v < 0 {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.maxScale: must not be negative, got %d", *v))
+		}
+		if minScale := autoscaling.MinScale; minScale != nil && *v > 0 && *minScale > *v {
+			errs = append(errs, fmt.Errorf("spec.autoscaling.minScale: must not exceed maxScale %d, got %d", *v, *minScale))
+		}
+	}
+
+	config := ks.Spec.GetConfig()
+	for _, field := range sortedKeys(fields) {
+		for _, name := range []string{"autoscaler", "config-autoscaler"} {
+			if _, ok := config[name][fields[field]]; ok {
+				errs = append(errs, fmt.Errorf("spec.autoscaling.%s: can't be combined with spec.config.%s.%s", field, name, fields[field]))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid autoscaling configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestValidateAutoscaling(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling *base.AutoscalingConfiguration
+		config      base.ConfigMapData
+		wantErr     string
+	}{{
+		name: "no autoscaling",
+	}, {
+		name: "valid",
+		autoscaling: &base.AutoscalingConfiguration{
+			TargetConcurrency:      ptr.Int64(10),
+			ScaleToZeroGracePeriod: &metav1.Duration{Duration: time.Minute},
+			InitialScale:           ptr.Int32(0),
+			AllowZeroInitialScale:  ptr.Bool(true),
+			MinScale:               ptr.Int32(2),
+			MaxScale:               ptr.Int32(0),
+		},
+		config: base.ConfigMapData{"autoscaler": {"stable-window": "2m"}},
+	}, {
+		name:        "short grace period",
+		autoscaling: &base.AutoscalingConfiguration{ScaleToZeroGracePeriod: &metav1.Duration{Duration: time.Second}},
+		wantErr:     "spec.autoscaling.scaleToZeroGracePeriod: must be at least 6s, got 1s",
+	}, {
+		name:        "zero initial scale",
+		autoscaling: &base.AutoscalingConfiguration{InitialScale: ptr.Int32(0)},
+		wantErr:     "spec.autoscaling.initialScale: zero requires allowZeroInitialScale",
+	}, {
+		name:        "percentage out of range",
+		autoscaling: &base.AutoscalingConfiguration{PanicWindowPercentage: ptr.Int64(200)},
+		wantErr:     "spec.autoscaling.panicWindowPercentage: must be between 1 and 100, got 200",
+	}, {
+		name:        "min above max",
+		autoscaling: &base.AutoscalingConfiguration{MinScale: ptr.Int32(5), MaxScale: ptr.Int32(3)},
+		wantErr:     "spec.autoscaling.minScale: must not exceed maxScale 3, got 5",
+	}, {
+		name:        "key in spec.config",
+		autoscaling: &base.AutoscalingConfiguration{MaxScale: ptr.Int32(3)},
+		config:      base.ConfigMapData{"config-autoscaler": {"max-scale": "5"}},
+		wantErr:     "spec.autoscaling.maxScale: can't be combined with spec.config.config-autoscaler.max-scale",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec:  base.CommonSpec{Config: test.config},
+					Autoscaling: test.autoscaling,
+				},
+			}
+			err := validateAutoscaling(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateAutoscaling() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateAutoscaling() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	mtChannelBasedBroker   = "MTChannelBasedBroker"
+	defaultChannelName     = "config-br-default-channel"
+	channelTemplateSpecKey = "channel-template-spec"
+)
+
+// brokerClassAPIs are the APIs served by the implementations of the known broker classes, other than
+// the MTChannelBasedBroker of Knative Eventing itself.
+var brokerClassAPIs = map[string]schema.GroupVersionKind{
+	"Kafka":          {Group: "eventing.knative.dev", Version: "v1alpha1", Kind: "KafkaSink"},
+	"RabbitMQBroker": {Group: "eventing.knative.dev", Version: "v1alpha1", Kind: "RabbitmqBrokerConfig"},
+}
+
+// validateDefaultBroker checks spec.defaultBrokerConfig of a KnativeEventing: the reference to the
+// configuration has to be complete, and the delivery valid for a broker. It can't be combined with the
+// default-br-config in spec.config.
+func validateDefaultBroker(ke *v1beta1.KnativeEventing) error {
+	brokerConfig := ke.Spec.DefaultBrokerConfig
+	if brokerConfig == nil {
+		return nil
+	}
+	var errs []error
+	if ref := brokerConfig.Config; ref != nil {
+		if ref.APIVersion == "" || ref.Kind == "" || ref.Name == "" {
+			errs = append(errs, errors.New("spec.defaultBrokerConfig.config: apiVersion, kind and name are required"))
+		}
+	}
+	if d := brokerConfig.Delivery; d != nil {
+		delivery := &eventingduckv1.DeliverySpec{Retry: d.Retry, DeadLetterSink: d.DeadLetterSink}
+		if d.BackoffPolicy != "" {
+			policy := eventingduckv1.BackoffPolicyType(d.BackoffPolicy)
+			delivery.BackoffPolicy = &policy
+		}
+		if d.BackoffDelay != "" {
+			delivery.BackoffDelay = &d.BackoffDelay
+		}
+		if err := delivery.Validate(context.Background()); err != nil {
+			errs = append(errs, fmt.Errorf("spec.defaultBrokerConfig.delivery: %w", err))
+		}
+	}
+	config := ke.Spec.GetConfig()
+	for _, name := range []string{"br-defaults", "config-br-defaults"} {
+		if _, ok := config[name]["default-br-config"]; ok {
+			errs = append(errs, fmt.Errorf("spec.defaultBrokerConfig: can't be combined with spec.config.%s.default-br-config", name))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid default broker configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// brokerWarnings returns a warning for each API required by the default broker class and its
+// configuration, which is neither served by the cluster nor installed with the manifest of the
+// component. The implementation of a broker class is usually installed after Knative Eventing, so
+// that a missing one doesn't reject the component, its brokers stay not ready until it is installed.
+func brokerWarnings(client discovery.DiscoveryInterface, ke *v1beta1.KnativeEventing) []string {
+	if ke.Spec.DefaultBrokerClass == "" && ke.Spec.DefaultBrokerConfig == nil {
+		return nil
+	}
+	// The discovery of the operator's cluster tells nothing about another target cluster.
+	if client == nil || ke.Spec.TargetCluster != nil {
+		return nil
+	}
+	manifest, err := common.TargetManifest(ke)
+	if err != nil {
+		manifest = mf.Manifest{}
+	}
+	class := ke.Spec.DefaultBrokerClass
+	if class == "" {
+		class = mtChannelBasedBroker
+	}
+
+	type requirement struct {
+		gvk    schema.GroupVersionKind
+		reason string
+	}
+	var required []requirement
+	if gvk, ok := brokerClassAPIs[class]; ok {
+		required = append(required, requirement{gvk, "the broker class " + class})
+	}
+	var ref *duckv1.KReference
+	if ke.Spec.DefaultBrokerConfig != nil {
+		ref = ke.Spec.DefaultBrokerConfig.Config
+	}
+	if ref != nil && ref.Kind != "ConfigMap" && ref.APIVersion != "" {
+		gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+		required = append(required, requirement{gvk, "spec.defaultBrokerConfig.config"})
+	}
+	if class == mtChannelBasedBroker && (ref == nil || ref.Name == defaultChannelName) {
+		if gvk, ok := defaultChannel(ke, manifest); ok {
+			required = append(required, requirement{gvk, "the channel of " + defaultChannelName})
+		}
+	}
+
+	var warnings []string
+	for _, r := range required {
+		if installedWith(manifest, r.gvk) {
+			continue
+		}
+		served, err := servedBy(client, r.gvk)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("failed to check the API %s of %s: %v", r.gvk.Kind, r.reason, err))
+			continue
+		}
+		if !served {
+			warnings = append(warnings, fmt.Sprintf("%s requires %s of %s, which is not installed, the brokers are not ready until it is",
+				r.reason, r.gvk.Kind, r.gvk.GroupVersion()))
+		}
+	}
+	return warnings
+}
+
+// defaultChannel returns the kind of the channel in config-br-default-channel, as set in spec.config
+// or shipped in the manifest.
+func defaultChannel(ke *v1beta1.KnativeEventing, manifest mf.Manifest) (schema.GroupVersionKind, bool) {
+	config := ke.Spec.GetConfig()
+	template, ok := config[defaultChannelName][channelTemplateSpecKey]
+	if !ok {
+		template, ok = config["br-default-channel"][channelTemplateSpecKey]
+	}
+	if !ok {
+		for _, u := range manifest.Filter(mf.ByKind("ConfigMap"), mf.ByName(defaultChannelName)).Resources() {
+			template, ok, _ = unstructured.NestedString(u.Object, "data", channelTemplateSpecKey)
+		}
+	}
+	if !ok {
+		return schema.GroupVersionKind{}, false
+	}
+	var spec struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := yaml.Unmarshal([]byte(template), &spec); err != nil || spec.APIVersion == "" || spec.Kind == "" {
+		return schema.GroupVersionKind{}, false
+	}
+	return schema.FromAPIVersionAndKind(spec.APIVersion, spec.Kind), true
+}
+
+// installedWith returns whether the manifest has the CRD of the kind.
+func installedWith(manifest mf.Manifest, gvk schema.GroupVersionKind) bool {
+	for _, u := range manifest.Filter(mf.ByKind("CustomResourceDefinition")).Resources() {
+		group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(u.Object, "spec", "names", "kind")
+		if group == gvk.Group && kind == gvk.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+// servedBy returns whether the cluster serves the kind.
+func servedBy(client discovery.DiscoveryInterface, gvk schema.GroupVersionKind) (bool, error) {
+	resources, err := client.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == gvk.Kind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
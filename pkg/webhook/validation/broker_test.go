@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestValidateDefaultBroker(t *testing.T) {
+	tests := []struct {
+		name         string
+		brokerConfig *base.BrokerConfiguration
+		config       base.ConfigMapData
+		wantErr      string
+	}{{
+		name: "no default broker config",
+	}, {
+		name: "valid",
+		brokerConfig: &base.BrokerConfiguration{
+			Config: &duckv1.KReference{APIVersion: "v1", Kind: "ConfigMap", Name: "kafka-broker-config", Namespace: "knative-eventing"},
+			Delivery: &base.BrokerDeliveryConfiguration{
+				Retry:          ptr.Int32(5),
+				BackoffPolicy:  "linear",
+				BackoffDelay:   "PT0.5S",
+				DeadLetterSink: &duckv1.Destination{URI: apis.HTTP("dead-letter.default.svc.cluster.local")},
+			},
+		},
+	}, {
+		name:         "incomplete reference",
+		brokerConfig: &base.BrokerConfiguration{Config: &duckv1.KReference{Kind: "ConfigMap", Name: "kafka-broker-config"}},
+		wantErr:      "spec.defaultBrokerConfig.config: apiVersion, kind and name are required",
+	}, {
+		name:         "invalid delivery",
+		brokerConfig: &base.BrokerConfiguration{Delivery: &base.BrokerDeliveryConfiguration{BackoffPolicy: "random", BackoffDelay: "200ms"}},
+		wantErr:      "spec.defaultBrokerConfig.delivery: invalid value: 200ms: backoffDelay",
+	}, {
+		name:         "default-br-config in spec.config",
+		brokerConfig: &base.BrokerConfiguration{Delivery: &base.BrokerDeliveryConfiguration{Retry: ptr.Int32(5)}},
+		config:       base.ConfigMapData{"br-defaults": {"default-br-config": "clusterDefault: {}"}},
+		wantErr:      "spec.defaultBrokerConfig: can't be combined with spec.config.br-defaults.default-br-config",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec:          base.CommonSpec{Config: test.config},
+					DefaultBrokerConfig: test.brokerConfig,
+				},
+			}
+			err := validateDefaultBroker(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateDefaultBroker() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateDefaultBroker() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestBrokerWarnings(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	common.ClearCache()
+
+	kafkaServed := kubefake.NewSimpleClientset()
+	kafkaServed.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "eventing.knative.dev/v1alpha1",
+		APIResources: []metav1.APIResource{{Name: "kafkasinks", Kind: "KafkaSink"}},
+	}}
+
+	tests := []struct {
+		name     string
+		client   *kubefake.Clientset
+		class    string
+		config   base.ConfigMapData
+		expected []string
+	}{{
+		name:   "channel installed with eventing",
+		client: kubefake.NewSimpleClientset(),
+		class:  "MTChannelBasedBroker",
+	}, {
+		name:   "channel not installed",
+		client: kubefake.NewSimpleClientset(),
+		class:  "MTChannelBasedBroker",
+		config: base.ConfigMapData{"br-default-channel": {"channel-template-spec": "apiVersion: messaging.knative.dev/v1beta1\nkind: KafkaChannel\n"}},
+		expected: []string{
+			"the channel of config-br-default-channel requires KafkaChannel of messaging.knative.dev/v1beta1, which is not installed, the brokers are not ready until it is",
+		},
+	}, {
+		name:   "broker class not installed",
+		client: kubefake.NewSimpleClientset(),
+		class:  "Kafka",
+		expected: []string{
+			"the broker class Kafka requires KafkaSink of eventing.knative.dev/v1alpha1, which is not installed, the brokers are not ready until it is",
+		},
+	}, {
+		name:   "broker class installed",
+		client: kafkaServed,
+		class:  "Kafka",
+	}, {
+		name:   "unknown broker class",
+		client: kubefake.NewSimpleClientset(),
+		class:  "MyBroker",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec:         base.CommonSpec{Version: "1.21.0", Config: test.config},
+					DefaultBrokerClass: test.class,
+				},
+			}
+			util.AssertDeepEqual(t, brokerWarnings(test.client.Discovery(), ke), test.expected)
+		})
+	}
+}
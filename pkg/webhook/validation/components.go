@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	kec "knative.dev/operator/pkg/reconciler/knativeeventing/common"
+)
+
+// validateComponents checks the toggles of the components of a KnativeEventing, spec.jobSink and
+// spec.triggerFilters, against the manifest of the target version, which has to have the
+// component. The new-trigger-filters flag can't be combined with its key in spec.features or
+// spec.config. The components of custom manifests are not checked.
+func validateComponents(ke *v1beta1.KnativeEventing) error {
+	jobSink := ke.Spec.JobSink != nil && ke.Spec.JobSink.Enabled != nil
+	filters := ke.Spec.TriggerFilters != nil && ke.Spec.TriggerFilters.New != nil
+	if (!jobSink && !filters) || len(ke.Spec.GetManifests()) > 0 {
+		return nil
+	}
+	manifest, err := common.TargetManifest(ke)
+	if err != nil {
+		// The version itself is validated by the operator, which reports it in the status.
+		return nil
+	}
+	version := common.TargetVersion(ke)
+
+	var errs []error
+	if jobSink && len(manifest.Filter(mf.ByKind("Deployment"), mf.ByName(kec.JobSinkName)).Resources()) == 0 {
+		errs = append(errs, fmt.Errorf("spec.jobSink.enabled: version %s has no JobSink", version))
+	}
+	if filters {
+		if _, ok := featureDefaults(manifest)[kec.NewTriggerFiltersFeature]; !ok {
+			errs = append(errs, fmt.Errorf("spec.triggerFilters.new: version %s has no %s flag", version, kec.NewTriggerFiltersFeature))
+		}
+		if _, ok := ke.Spec.GetFeatures()[kec.NewTriggerFiltersFeature]; ok {
+			errs = append(errs, fmt.Errorf("spec.triggerFilters.new: can't be combined with spec.features.%s", kec.NewTriggerFiltersFeature))
+		}
+		for _, name := range []string{"features", common.FeaturesConfigMapName} {
+			if _, ok := ke.Spec.GetConfig()[name][kec.NewTriggerFiltersFeature]; ok {
+				errs = append(errs, fmt.Errorf("spec.triggerFilters.new: can't be combined with spec.config.%s.%s", name, kec.NewTriggerFiltersFeature))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid components: %w", errors.Join(errs...))
+	}
+	return nil
+}
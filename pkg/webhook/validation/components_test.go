@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateComponents(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	tests := []struct {
+		name     string
+		version  string
+		jobSink  *base.JobSinkConfiguration
+		filters  *base.TriggerFiltersConfiguration
+		features map[string]string
+		wantErr  string
+	}{{
+		name:    "no toggles",
+		version: "1.12.0",
+		jobSink: &base.JobSinkConfiguration{},
+		filters: &base.TriggerFiltersConfiguration{},
+	}, {
+		name:    "job sink disabled",
+		version: "1.21.0",
+		jobSink: &base.JobSinkConfiguration{Enabled: ptr.Bool(false)},
+	}, {
+		name:    "new trigger filters",
+		version: "1.12.0",
+		filters: &base.TriggerFiltersConfiguration{New: ptr.Bool(true)},
+	}, {
+		name:    "version without job sink",
+		version: "1.12.0",
+		jobSink: &base.JobSinkConfiguration{Enabled: ptr.Bool(true)},
+		wantErr: "spec.jobSink.enabled: version 1.12.0 has no JobSink",
+	}, {
+		name:    "version without the flag",
+		version: "1.21.0",
+		filters: &base.TriggerFiltersConfiguration{New: ptr.Bool(true)},
+		wantErr: "spec.triggerFilters.new: version 1.21.0 has no new-trigger-filters flag",
+	}, {
+		name:     "flag in spec.features",
+		version:  "1.12.0",
+		filters:  &base.TriggerFiltersConfiguration{New: ptr.Bool(false)},
+		features: map[string]string{"new-trigger-filters": "enabled"},
+		wantErr:  "spec.triggerFilters.new: can't be combined with spec.features.new-trigger-filters",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec:     base.CommonSpec{Version: test.version, Features: test.features},
+					JobSink:        test.jobSink,
+					TriggerFilters: test.filters,
+				},
+			}
+			err := validateComponents(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateComponents() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateComponents() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
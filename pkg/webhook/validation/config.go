@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+const (
+	exampleKey = "_example"
+	// disabledValue is accepted in place of some durations by Knative, e.g. in config-gc.
+	disabledValue = "disabled"
+)
+
+// openConfigMaps are the ConfigMaps, whose keys are not fixed by their example, e.g. the domains
+// in config-domain or the log levels of arbitrary components in config-logging.
+var openConfigMaps = map[string]bool{
+	"config-domain":             true,
+	"config-logging":            true,
+	"config-kreference-mapping": true,
+}
+
+// exampleEntry matches the top-level entries of the example of a ConfigMap. Indented lines are
+// continuations of multi-line values, and commented lines are documentation.
+var exampleEntry = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._/-]*):\s*(.*)$`)
+
+// configMapSchema are the keys of a ConfigMap, which the Knative component understands, with the
+// value of the example for each key.
+type configMapSchema map[string]string
+
+// validateConfig checks the entries of spec.config against the ConfigMaps in the manifest of the
+// target version: a key has to be known to the ConfigMap, and its value must be a valid duration,
+// if the example of the key is one. ConfigMaps without an example are not checked, neither are the
+// ConfigMaps of custom manifests, nor those not shipped in the manifest, e.g. the ingress ones.
+func validateConfig(instance base.KComponent) error {
+	config := instance.GetSpec().GetConfig()
+	if len(config) == 0 || len(instance.GetSpec().GetManifests()) > 0 {
+		return nil
+	}
+	manifest, err := common.TargetManifest(instance)
+	if err != nil {
+		// The version itself is validated by the operator, which reports it in the status.
+		return nil
+	}
+	schemas := configMapSchemas(manifest)
+
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		cmName := name
+		schema, ok := schemas[cmName]
+		if !ok {
+			// The "config-" prefix is optional
+			cmName = "config-" + name
+			schema, ok = schemas[cmName]
+		}
+		if !ok || openConfigMaps[cmName] {
+			continue
+		}
+		keys := make([]string, 0, len(config[name]))
+		for key := range config[name] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := schema.validate(key, config[name][key]); err != nil {
+				errs = append(errs, fmt.Errorf("spec.config.%s.%s: %w", name, key, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration for version %s: %w", common.TargetVersion(instance), errors.Join(errs...))
+	}
+	return nil
+}
+
+func (s configMapSchema) validate(key, value string) error {
+	if key == exampleKey {
+		return nil
+	}
+	example, ok := s[key]
+	if !ok {
+		return errors.New("unknown key")
+	}
+	if value != "" && value != disabledValue && isDuration(example) {
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid duration %q", value)
+		}
+	}
+	return nil
+}
+
+// isDuration returns whether the example value is a duration. Values like "100m", which are
+// also valid quantities, are ambiguous and not considered a duration.
+func isDuration(example string) bool {
+	if _, err := time.ParseDuration(example); err != nil {
+		return false
+	}
+	_, err := resource.ParseQuantity(example)
+	return err != nil
+}
+
+// configMapSchemas returns the schemas of the ConfigMaps in the manifest, which have an example.
+func configMapSchemas(manifest mf.Manifest) map[string]configMapSchema {
+	schemas := map[string]configMapSchema{}
+	for _, u := range manifest.Filter(mf.ByKind("ConfigMap")).Resources() {
+		data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+		example, ok := data[exampleKey]
+		if !ok {
+			continue
+		}
+		schema := configMapSchema{}
+		for _, line := range strings.Split(example, "\n") {
+			if m := exampleEntry.FindStringSubmatch(line); m != nil {
+				schema[m[1]] = strings.Trim(strings.TrimSpace(m[2]), `"'`)
+			}
+		}
+		if len(schema) == 0 {
+			continue
+		}
+		// Keys set outside of the example are known as well.
+		for key, value := range data {
+			if _, ok := schema[key]; !ok && key != exampleKey {
+				schema[key] = value
+			}
+		}
+		schemas[u.GetName()] = schema
+	}
+	return schemas
+}
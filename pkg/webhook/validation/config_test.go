@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	common.ClearCache()
+
+	tests := []struct {
+		name      string
+		config    base.ConfigMapData
+		manifests []base.Manifest
+		wantErr   string
+	}{{
+		name: "valid",
+		config: base.ConfigMapData{
+			"autoscaler":        {"stable-window": "2m", "activator-cpu-request": "200m"},
+			"config-gc":         {"retain-since-create-time": "disabled"},
+			"deployment":        {"queue-sidecar-image": "custom", "progress-deadline": "10m"},
+			"domain":            {"my.example.com": ""},
+			"tracing":           {"backend": "zipkin"},
+			"config-unknown":    {"foo": "bar"},
+			"config-autoscaler": {"_example": ""},
+		},
+	}, {
+		name:    "unknown key",
+		config:  base.ConfigMapData{"autoscaler": {"stable-windw": "60s"}},
+		wantErr: "spec.config.autoscaler.stable-windw: unknown key",
+	}, {
+		name:    "malformed duration",
+		config:  base.ConfigMapData{"config-autoscaler": {"stable-window": "60"}},
+		wantErr: `spec.config.config-autoscaler.stable-window: invalid duration "60"`,
+	}, {
+		name:      "custom manifests",
+		config:    base.ConfigMapData{"autoscaler": {"stable-windw": "60s"}},
+		manifests: []base.Manifest{{Url: "testdata/kodata/knative-serving/1.21.0"}},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Version: "1.21.0", Config: test.config, Manifests: test.manifests},
+				},
+			}
+			err := validateConfig(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateConfig() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateConfig() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	operatorclient "knative.dev/operator/pkg/client/injection/client"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/controller"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+)
+
+// NewAdmissionController returns a controller, which keeps the CA bundle and the path of the named
+// ValidatingWebhookConfiguration up to date. Its reconciler implements webhook.AdmissionController,
+// validating the KnativeServing and KnativeEventing resources on the path.
+func NewAdmissionController(ctx context.Context, name, path string) *controller.Impl {
+	secretInformer := secretinformer.Get(ctx)
+	woptions := webhook.GetOptions(ctx)
+	key := types.NamespacedName{Name: name}
+
+	r := &reconciler{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			// Have this reconciler enqueue our singleton whenever it becomes leader.
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+				enq(bkt, key)
+				return nil
+			},
+		},
+		key:            key,
+		path:           path,
+		secretName:     woptions.SecretName,
+		secretLister:   secretInformer.Lister(),
+		kubeClient:     kubeclient.Get(ctx),
+		operatorClient: operatorclient.Get(ctx),
+	}
+
+	const queueName = "ValidationWebhook"
+	c := controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: queueName, Logger: logging.FromContext(ctx).Named(queueName)})
+
+	// Reconcile when the cert bundle changes.
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(system.Namespace(), r.secretName),
+		Handler:    controller.HandleAll(func(interface{}) { c.EnqueueKey(key) }),
+	})
+	return c
+}
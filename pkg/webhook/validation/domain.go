@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// validateDomain checks spec.domain of a KnativeServing: the domains have to be valid DNS names,
+// each one assigned once, and the template a valid Go template. spec.domain can't be combined with
+// the same settings in spec.config, as it would be unclear, which one applies.
+func validateDomain(ks *v1beta1.KnativeServing) error {
+	domain := ks.Spec.Domain
+	if domain == nil {
+		return nil
+	}
+	config := ks.Spec.GetConfig()
+
+	var errs []error
+	seen := sets.New[string]()
+	check := func(field, name string) {
+		if msgs := k8svalidation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("%s: invalid domain %q: %s", field, name, strings.Join(msgs, ", ")))
+		}
+		if seen.Has(name) {
+			errs = append(errs, fmt.Errorf("%s: duplicate domain %q", field, name))
+		}
+		seen.Insert(name)
+	}
+	if domain.Default != "" {
+		check("spec.domain.default", domain.Default)
+	}
+	for i, selector := range domain.Selectors {
+		field := fmt.Sprintf("spec.domain.selectors[%d]", i)
+		check(field+".domain", selector.Domain)
+		if len(selector.Selector) == 0 {
+			errs = append(errs, fmt.Errorf("%s.selector: must not be empty", field))
+		}
+	}
+	if domain.Default != "" || len(domain.Selectors) > 0 {
+		for _, name := range []string{"domain", "config-domain"} {
+			for key := range config[name] {
+				if key != exampleKey {
+					errs = append(errs, fmt.Errorf("spec.domain: can't be combined with the domains of spec.config.%s", name))
+					break
+				}
+			}
+		}
+	}
+
+	if domain.Template != "" {
+		if _, err := template.New("domain-template").Parse(domain.Template); err != nil {
+			errs = append(errs, fmt.Errorf("spec.domain.template: %w", err))
+		}
+		for _, name := range []string{"network", "config-network"} {
+			if _, ok := config[name]["domain-template"]; ok {
+				errs = append(errs, fmt.Errorf("spec.domain.template: can't be combined with spec.config.%s.domain-template", name))
+			}
+		}
+	}
+	if domain.Mapping != nil && domain.Mapping.AutocreateClusterDomainClaims != nil {
+		for _, name := range []string{"network", "config-network"} {
+			if _, ok := config[name]["autocreate-cluster-domain-claims"]; ok {
+				errs = append(errs, fmt.Errorf("spec.domain.mapping.autocreateClusterDomainClaims: can't be combined with spec.config.%s.autocreate-cluster-domain-claims", name))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid domain configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestValidateDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		domain  *base.DomainConfiguration
+		config  base.ConfigMapData
+		wantErr string
+	}{{
+		name: "no domain",
+	}, {
+		name: "valid",
+		domain: &base.DomainConfiguration{
+			Default:   "example.com",
+			Selectors: []base.DomainSelector{{Domain: "internal.example.com", Selector: map[string]string{"app": "internal"}}},
+			Template:  "{{.Name}}-{{.Namespace}}.{{.Domain}}",
+		},
+		config: base.ConfigMapData{"domain": {"_example": ""}},
+	}, {
+		name:    "invalid domain",
+		domain:  &base.DomainConfiguration{Default: "Example_com"},
+		wantErr: `spec.domain.default: invalid domain "Example_com"`,
+	}, {
+		name: "duplicate domain",
+		domain: &base.DomainConfiguration{
+			Default:   "example.com",
+			Selectors: []base.DomainSelector{{Domain: "example.com", Selector: map[string]string{"app": "internal"}}},
+		},
+		wantErr: `spec.domain.selectors[0].domain: duplicate domain "example.com"`,
+	}, {
+		name:    "empty selector",
+		domain:  &base.DomainConfiguration{Selectors: []base.DomainSelector{{Domain: "internal.example.com"}}},
+		wantErr: "spec.domain.selectors[0].selector: must not be empty",
+	}, {
+		name:    "invalid template",
+		domain:  &base.DomainConfiguration{Template: "{{.Name}.{{.Domain}}"},
+		wantErr: "spec.domain.template: template: domain-template",
+	}, {
+		name:    "domains in spec.config",
+		domain:  &base.DomainConfiguration{Default: "example.com"},
+		config:  base.ConfigMapData{"config-domain": {"other.com": ""}},
+		wantErr: "spec.domain: can't be combined with the domains of spec.config.config-domain",
+	}, {
+		name:    "template in spec.config",
+		domain:  &base.DomainConfiguration{Template: "{{.Name}}.{{.Domain}}"},
+		config:  base.ConfigMapData{"network": {"domain-template": "{{.Name}}.{{.Domain}}"}},
+		wantErr: "spec.domain.template: can't be combined with spec.config.network.domain-template",
+	}, {
+		name:    "claims in spec.config",
+		domain:  &base.DomainConfiguration{Mapping: &base.DomainMappingConfiguration{AutocreateClusterDomainClaims: ptr.Bool(true)}},
+		config:  base.ConfigMapData{"config-network": {"autocreate-cluster-domain-claims": "false"}},
+		wantErr: "spec.domain.mapping.autocreateClusterDomainClaims: can't be combined with spec.config.config-network.autocreate-cluster-domain-claims",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Config: test.config},
+					Domain:     test.domain,
+				},
+			}
+			err := validateDomain(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateDomain() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateDomain() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// flagValues are the values of the feature flags, which are switched on and off. Other features,
+// e.g. transport-encryption of Knative Eventing, have values of their own.
+var flagValues = sets.New("enabled", "disabled", "allowed")
+
+// validateFeatures checks spec.features against config-features in the manifest of the target
+// version: a feature has to exist in that version, and a flag has to be enabled, disabled or allowed.
+// A feature can't be combined with its key in spec.config. The features of custom manifests are not
+// checked.
+func validateFeatures(instance base.KComponent) error {
+	features := instance.GetSpec().GetFeatures()
+	if len(features) == 0 || len(instance.GetSpec().GetManifests()) > 0 {
+		return nil
+	}
+	manifest, err := common.TargetManifest(instance)
+	if err != nil {
+		// The version itself is validated by the operator, which reports it in the status.
+		return nil
+	}
+	defaults := featureDefaults(manifest)
+	config := instance.GetSpec().GetConfig()
+
+	var errs []error
+	for _, key := range sortedKeys(features) {
+		value := features[key]
+		def, ok := defaults[key]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("spec.features.%s: unknown feature", key))
+		case flagValues.Has(def) && !flagValues.Has(value):
+			errs = append(errs, fmt.Errorf("spec.features.%s: must be enabled, disabled or allowed, got %q", key, value))
+		}
+		for _, name := range []string{"features", common.FeaturesConfigMapName} {
+			if _, ok := config[name][key]; ok {
+				errs = append(errs, fmt.Errorf("spec.features.%s: can't be combined with spec.config.%s.%s", key, name, key))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid features for version %s: %w", common.TargetVersion(instance), errors.Join(errs...))
+	}
+	return nil
+}
+
+// featureWarnings returns a warning for each feature, whose default changes with the upgrade from
+// the old to the new version of the component, unless the new one sets the feature itself.
+func featureWarnings(oldComponent, newComponent base.KComponent) []string {
+	// Only a changed spec.version upgrades the component with its update.
+	if oldComponent.GetSpec().GetVersion() == newComponent.GetSpec().GetVersion() {
+		return nil
+	}
+	if len(oldComponent.GetSpec().GetManifests()) > 0 || len(newComponent.GetSpec().GetManifests()) > 0 {
+		return nil
+	}
+	oldVersion, newVersion := common.TargetVersion(oldComponent), common.TargetVersion(newComponent)
+	if oldVersion == newVersion {
+		return nil
+	}
+	oldManifest, err := common.TargetManifest(oldComponent)
+	if err != nil {
+		return nil
+	}
+	newManifest, err := common.TargetManifest(newComponent)
+	if err != nil {
+		return nil
+	}
+	oldDefaults, newDefaults := featureDefaults(oldManifest), featureDefaults(newManifest)
+	config := newComponent.GetSpec().GetConfig()
+
+	var warnings []string
+	for _, key := range sortedKeys(newDefaults) {
+		oldDefault, ok := oldDefaults[key]
+		if !ok || oldDefault == newDefaults[key] {
+			continue
+		}
+		if _, ok := newComponent.GetSpec().GetFeatures()[key]; ok {
+			continue
+		}
+		if _, ok := config["features"][key]; ok {
+			continue
+		}
+		if _, ok := config[common.FeaturesConfigMapName][key]; ok {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("the default of the feature %s changes from %q in version %s to %q in version %s, set spec.features.%s to keep it",
+			key, oldDefault, oldVersion, newDefaults[key], newVersion, key))
+	}
+	return warnings
+}
+
+// featureDefaults returns the features of config-features in the manifest with their default values,
+// which are the keys of its example, if it has one, and of its data.
+func featureDefaults(manifest mf.Manifest) map[string]string {
+	defaults := map[string]string{}
+	for _, u := range manifest.Filter(mf.ByKind("ConfigMap"), mf.ByName(common.FeaturesConfigMapName)).Resources() {
+		data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+		for _, line := range strings.Split(data[exampleKey], "\n") {
+			if m := exampleEntry.FindStringSubmatch(line); m != nil {
+				defaults[m[1]] = strings.Trim(strings.TrimSpace(m[2]), `"'`)
+			}
+		}
+		for key, value := range data {
+			if key != exampleKey {
+				defaults[key] = value
+			}
+		}
+	}
+	return defaults
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func servingWithFeatures(version string, features map[string]string, config base.ConfigMapData) *v1beta1.KnativeServing {
+	return &v1beta1.KnativeServing{
+		Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: base.CommonSpec{Version: version, Features: features, Config: config},
+		},
+	}
+}
+
+func TestValidateFeatures(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	common.ClearCache()
+
+	tests := []struct {
+		name     string
+		version  string
+		features map[string]string
+		config   base.ConfigMapData
+		wantErr  string
+	}{{
+		name:     "valid",
+		version:  "1.21.0",
+		features: map[string]string{"kubernetes.podspec-affinity": "enabled", "kubernetes.podspec-volumes-image": "allowed"},
+	}, {
+		name:     "feature of a later version",
+		version:  "1.20.0",
+		features: map[string]string{"kubernetes.podspec-volumes-image": "enabled"},
+		wantErr:  "invalid features for version 1.20.0: spec.features.kubernetes.podspec-volumes-image: unknown feature",
+	}, {
+		name:     "invalid flag",
+		version:  "1.21.0",
+		features: map[string]string{"multi-container": "true"},
+		wantErr:  `spec.features.multi-container: must be enabled, disabled or allowed, got "true"`,
+	}, {
+		name:     "key in spec.config",
+		version:  "1.21.0",
+		features: map[string]string{"multi-container": "enabled"},
+		config:   base.ConfigMapData{"features": {"multi-container": "disabled"}},
+		wantErr:  "spec.features.multi-container: can't be combined with spec.config.features.multi-container",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateFeatures(servingWithFeatures(test.version, test.features, test.config))
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateFeatures() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateFeatures() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestFeatureWarnings(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	common.ClearCache()
+
+	old := servingWithFeatures("1.20.0", nil, nil)
+	util.AssertDeepEqual(t, featureWarnings(old, servingWithFeatures("1.21.0", nil, nil)), []string{
+		`the default of the feature multi-container changes from "disabled" in version 1.20.0 to "enabled" in version 1.21.0, set spec.features.multi-container to keep it`,
+	})
+	util.AssertDeepEqual(t, featureWarnings(old, servingWithFeatures("1.21.0", map[string]string{"multi-container": "disabled"}, nil)), []string(nil))
+	util.AssertDeepEqual(t, featureWarnings(old, servingWithFeatures("1.21.0", nil, base.ConfigMapData{"config-features": {"multi-container": "disabled"}})), []string(nil))
+	util.AssertDeepEqual(t, featureWarnings(old, servingWithFeatures("1.20.0", nil, nil)), []string(nil))
+}
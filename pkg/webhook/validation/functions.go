@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	kfc "knative.dev/operator/pkg/reconciler/knativefunctions/common"
+)
+
+// pvcAccessModes are the access modes, which a PersistentVolumeClaim accepts.
+var pvcAccessModes = sets.New(
+	corev1.ReadWriteOnce,
+	corev1.ReadOnlyMany,
+	corev1.ReadWriteMany,
+	corev1.ReadWriteOncePod,
+)
+
+// validateFunctions checks spec.builders and spec.pvc of a KnativeFunctions: the claims need a
+// positive size and a valid access mode. Neither can be combined with the same keys in spec.config,
+// as it would be unclear, which one applies.
+func validateFunctions(kf *v1beta1.KnativeFunctions) error {
+	config := kf.Spec.GetConfig()
+	var errs []error
+	conflicts := func(field, cmName, key string) {
+		for _, name := range []string{cmName, strings.TrimPrefix(cmName, "config-")} {
+			if _, ok := config[name][key]; ok {
+				errs = append(errs, fmt.Errorf("%s: can't be combined with spec.config.%s.%s", field, name, key))
+			}
+		}
+	}
+	if builders := kf.Spec.Builders; builders != nil {
+		if builders.Pack != "" {
+			conflicts("spec.builders.pack", kfc.BuildersConfigMapName, kfc.PackKey)
+		}
+		if builders.S2I != "" {
+			conflicts("spec.builders.s2i", kfc.BuildersConfigMapName, kfc.S2IKey)
+		}
+	}
+	if pvc := kf.Spec.PVC; pvc != nil {
+		if pvc.Size != nil {
+			if pvc.Size.Sign() <= 0 {
+				errs = append(errs, fmt.Errorf("spec.pvc.size: must be positive, got %s", pvc.Size.String()))
+			}
+			conflicts("spec.pvc.size", kfc.PVCConfigMapName, kfc.SizeKey)
+		}
+		if pvc.StorageClassName != "" {
+			conflicts("spec.pvc.storageClassName", kfc.PVCConfigMapName, kfc.StorageClassNameKey)
+		}
+		if pvc.AccessMode != "" {
+			if !pvcAccessModes.Has(pvc.AccessMode) {
+				errs = append(errs, fmt.Errorf("spec.pvc.accessMode: must be one of %v, got %q", sets.List(pvcAccessModes), pvc.AccessMode))
+			}
+			conflicts("spec.pvc.accessMode", kfc.PVCConfigMapName, kfc.AccessModeKey)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid functions configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
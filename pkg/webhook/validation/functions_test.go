@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestValidateFunctions(t *testing.T) {
+	size := resource.MustParse("1Gi")
+	zero := resource.MustParse("0")
+	tests := []struct {
+		name     string
+		builders *base.FunctionsBuildersConfiguration
+		pvc      *base.FunctionsPVCConfiguration
+		config   base.ConfigMapData
+		wantErr  string
+	}{{
+		name: "no configuration",
+	}, {
+		name:     "valid",
+		builders: &base.FunctionsBuildersConfiguration{Pack: "example.com/builder:latest"},
+		pvc:      &base.FunctionsPVCConfiguration{Size: &size, StorageClassName: "fast", AccessMode: corev1.ReadWriteMany},
+		config:   base.ConfigMapData{"func-builders": {"s2i": "example.com/s2i:latest"}},
+	}, {
+		name:    "zero size",
+		pvc:     &base.FunctionsPVCConfiguration{Size: &zero},
+		wantErr: "spec.pvc.size: must be positive, got 0",
+	}, {
+		name:    "invalid access mode",
+		pvc:     &base.FunctionsPVCConfiguration{AccessMode: "ReadWriteAll"},
+		wantErr: `spec.pvc.accessMode: must be one of [ReadOnlyMany ReadWriteMany ReadWriteOnce ReadWriteOncePod], got "ReadWriteAll"`,
+	}, {
+		name:     "builder in spec.config",
+		builders: &base.FunctionsBuildersConfiguration{Pack: "example.com/builder:latest"},
+		config:   base.ConfigMapData{"config-func-builders": {"pack": "example.com/other:latest"}},
+		wantErr:  "spec.builders.pack: can't be combined with spec.config.config-func-builders.pack",
+	}, {
+		name:    "size in spec.config",
+		pvc:     &base.FunctionsPVCConfiguration{Size: &size},
+		config:  base.ConfigMapData{"func-pvc": {"size": "2Gi"}},
+		wantErr: "spec.pvc.size: can't be combined with spec.config.func-pvc.size",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kf := &v1beta1.KnativeFunctions{
+				Spec: v1beta1.KnativeFunctionsSpec{
+					CommonSpec: base.CommonSpec{Config: test.config},
+					Builders:   test.builders,
+					PVC:        test.pvc,
+				},
+			}
+			err := validateFunctions(kf)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateFunctions() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateFunctions() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// validateGarbageCollection checks spec.garbageCollection of a KnativeServing the way the garbage
+// collector of Knative Serving loads config-gc: the retention times are durations or "disabled", the
+// numbers of revisions not negative, with the maximum not below the minimum. A field can't be
+// combined with its key in spec.config.
+func validateGarbageCollection(ks *v1beta1.KnativeServing) error {
+	gc := ks.Spec.GarbageCollection
+	if gc == nil {
+		return nil
+	}
+	var errs []error
+	fields := map[string]string{}
+	for _, retention := range []struct{ field, key, value string }{
+		{"retainSinceCreateTime", "retain-since-create-time", gc.RetainSinceCreateTime},
+		{"retainSinceLastActiveTime", "retain-since-last-active-time", gc.RetainSinceLastActiveTime},
+	} {
+		if retention.value == "" {
+			continue
+		}
+		fields[retention.field] = retention.key
+		if retention.value == disabledValue {
+			continue
+		}
+		if d, err := time.ParseDuration(retention.value); err != nil || d < 0 {
+			errs = append(errs, fmt.Errorf("spec.garbageCollection.%s: must be a duration or %q, got %q", retention.field, disabledValue, retention.value))
+		}
+	}
+	if v := gc.MinNonActiveRevisions; v != nil {
+		fields["minNonActiveRevisions"] = "min-non-active-revisions"
+		if *v < 0 {
+			errs = append(errs, fmt.Errorf("spec.garbageCollection.minNonActiveRevisions: must not be negative, got %d", *v))
+		}
+	}
+	if v := gc.MaxNonActiveRevisions; v != nil {
+		fields["maxNonActiveRevisions"] = "max-non-active-revisions"
+		switch {
+		case v.Type == intstr.String && v.StrVal != disabledValue:
+			errs = append(errs, fmt.Errorf("spec.garbageCollection.maxNonActiveRevisions: must be a number or %q, got %q", disabledValue, v.StrVal))
+		case v.Type == intstr.Int && v.IntVal < 0:
+			errs = append(errs, fmt.Errorf("spec.garbageCollection.maxNonActiveRevisions: must not be negative, got %d", v.IntVal))
+		case v.Type == intstr.Int && gc.MinNonActiveRevisions != nil && int64(v.IntVal) < *gc.MinNonActiveRevisions:
+			errs = append(errs, fmt.Errorf("spec.garbageCollection.maxNonActiveRevisions: must not be below minNonActiveRevisions %d, got %d", *gc.MinNonActiveRevisions, v.IntVal))
+		}
+	}
+
+	config := ks.Spec.GetConfig()
+	for _, field := range sortedKeys(fields) {
+		for _, name := range []string{"gc", "config-gc"} {
+			if _, ok := config[name][fields[field]]; ok {
+				errs = append(errs, fmt.Errorf("spec.garbageCollection.%s: can't be combined with spec.config.%s.%s", field, name, fields[field]))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid garbage collection configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestValidateGarbageCollection(t *testing.T) {
+	intOrString := func(v intstr.IntOrString) *intstr.IntOrString { return &v }
+
+	tests := []struct {
+		name    string
+		gc      *base.GarbageCollectionConfiguration
+		config  base.ConfigMapData
+		wantErr string
+	}{{
+		name: "no garbage collection",
+	}, {
+		name: "valid",
+		gc: &base.GarbageCollectionConfiguration{
+			RetainSinceCreateTime:     "48h",
+			RetainSinceLastActiveTime: "disabled",
+			MinNonActiveRevisions:     ptr.Int64(2),
+			MaxNonActiveRevisions:     intOrString(intstr.FromString("disabled")),
+		},
+		config: base.ConfigMapData{"gc": {"_example": ""}},
+	}, {
+		name:    "invalid duration",
+		gc:      &base.GarbageCollectionConfiguration{RetainSinceCreateTime: "2d"},
+		wantErr: `spec.garbageCollection.retainSinceCreateTime: must be a duration or "disabled", got "2d"`,
+	}, {
+		name:    "invalid maximum",
+		gc:      &base.GarbageCollectionConfiguration{MaxNonActiveRevisions: intOrString(intstr.FromString("unlimited"))},
+		wantErr: `spec.garbageCollection.maxNonActiveRevisions: must be a number or "disabled", got "unlimited"`,
+	}, {
+		name: "maximum below minimum",
+		gc: &base.GarbageCollectionConfiguration{
+			MinNonActiveRevisions: ptr.Int64(20),
+			MaxNonActiveRevisions: intOrString(intstr.FromInt32(10)),
+		},
+		wantErr: "spec.garbageCollection.maxNonActiveRevisions: must not be below minNonActiveRevisions 20, got 10",
+	}, {
+		name:    "key in spec.config",
+		gc:      &base.GarbageCollectionConfiguration{MinNonActiveRevisions: ptr.Int64(2)},
+		config:  base.ConfigMapData{"config-gc": {"min-non-active-revisions": "5"}},
+		wantErr: "spec.garbageCollection.minNonActiveRevisions: can't be combined with spec.config.config-gc.min-non-active-revisions",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec:        base.CommonSpec{Config: test.config},
+					GarbageCollection: test.gc,
+				},
+			}
+			err := validateGarbageCollection(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateGarbageCollection() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateGarbageCollection() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	mf "github.com/manifestival/manifestival"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// validateHighAvailability checks spec.high-availability: each deployment is listed at most once,
+// and neither a deployment, which must run a single replica, nor an excluded one can be scaled.
+func validateHighAvailability(instance base.KComponent) error {
+	ha := instance.GetSpec().GetHighAvailability()
+	if ha == nil {
+		return nil
+	}
+	var errs []error
+	seen := sets.New[string]()
+	for i, deployment := range ha.Deployments {
+		field := fmt.Sprintf("spec.high-availability.deployments[%d]", i)
+		switch {
+		case deployment.Name == "":
+			errs = append(errs, fmt.Errorf("%s.name: required", field))
+		case seen.Has(deployment.Name):
+			errs = append(errs, fmt.Errorf("%s: duplicate deployment %s", field, deployment.Name))
+		case common.HighAvailabilitySingleReplica(deployment.Name):
+			errs = append(errs, fmt.Errorf("%s: %s must run a single replica", field, deployment.Name))
+		case slices.Contains(ha.Exclude, deployment.Name):
+			errs = append(errs, fmt.Errorf("%s: %s is excluded by spec.high-availability.exclude", field, deployment.Name))
+		}
+		seen.Insert(deployment.Name)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid high availability configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// highAvailabilityWarnings returns a warning for each deployment of the manifest scaled by
+// spec.high-availability, whose PodDisruptionBudget allows no disruption of its replicas, which
+// blocks the drain of their nodes, or whose required pod anti-affinity of spec.workloads needs more
+// topology domains than the nodes of the cluster of the client have, which leaves replicas pending.
+// The nodes are not checked without a client, or for another target cluster.
+func highAvailabilityWarnings(ctx context.Context, client kubernetes.Interface, instance base.KComponent) []string {
+	ha := instance.GetSpec().GetHighAvailability()
+	if ha == nil {
+		return nil
+	}
+	manifest, err := common.TargetManifest(instance)
+	if err != nil {
+		return nil
+	}
+	overrides := map[string]base.WorkloadOverride{}
+	for _, override := range instance.GetSpec().GetWorkloadOverrides() {
+		overrides[override.Name] = override
+	}
+	budgets := podDisruptionBudgets(manifest, instance.GetSpec().GetPodDisruptionBudgetOverride())
+
+	var nodes []corev1.Node
+	if client != nil && instance.GetSpec().GetTargetCluster() == nil {
+		if list, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{}); err == nil {
+			nodes = list.Items
+		}
+	}
+
+	var warnings []string
+	for _, u := range manifest.Filter(mf.ByKind("Deployment")).Resources() {
+		override := overrides[u.GetName()]
+		if override.Replicas != nil {
+			// spec.workloads[].replicas takes precedence over spec.high-availability.
+			continue
+		}
+		replicas := common.HighAvailabilityReplicas(ha, u.GetName())
+		if replicas == nil {
+			continue
+		}
+		deployment := &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(&u, deployment, nil); err != nil {
+			continue
+		}
+		podLabels := labels.Merge(deployment.Spec.Template.Labels, override.Labels)
+
+		for _, budget := range budgets {
+			selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+			if err != nil || selector.Empty() || !selector.Matches(podLabels) {
+				continue
+			}
+			if disruptions, ok := allowedDisruptions(budget.Spec, *replicas); ok && disruptions <= 0 {
+				warnings = append(warnings, fmt.Sprintf("the PodDisruptionBudget %s allows no disruption of the %d replicas of the deployment %s, which blocks the drain of their nodes",
+					budget.Name, *replicas, u.GetName()))
+			}
+		}
+
+		if len(nodes) == 0 || *replicas < 2 || override.Affinity == nil || override.Affinity.PodAntiAffinity == nil {
+			continue
+		}
+		for _, term := range override.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+			if err != nil || !selector.Matches(podLabels) {
+				continue
+			}
+			if domains := topologyDomains(nodes, term.TopologyKey); int(*replicas) > domains {
+				warnings = append(warnings, fmt.Sprintf("the %d replicas of the deployment %s require distinct values of the node label %s, but the nodes only have %d, the other replicas stay pending",
+					*replicas, u.GetName(), term.TopologyKey, domains))
+			}
+		}
+	}
+	return warnings
+}
+
+// podDisruptionBudgets returns the PodDisruptionBudgets of the manifest with the overrides of
+// spec.podDisruptionBudgets applied.
+func podDisruptionBudgets(manifest mf.Manifest, overrides []base.PodDisruptionBudgetOverride) []policyv1.PodDisruptionBudget {
+	var budgets []policyv1.PodDisruptionBudget
+	for _, u := range manifest.Filter(mf.ByKind("PodDisruptionBudget")).Resources() {
+		budget := policyv1.PodDisruptionBudget{}
+		if err := scheme.Scheme.Convert(&u, &budget, nil); err != nil {
+			continue
+		}
+		for _, override := range overrides {
+			if override.Name == budget.Name && (override.MinAvailable != nil || override.MaxUnavailable != nil) {
+				budget.Spec.MinAvailable = override.MinAvailable
+				budget.Spec.MaxUnavailable = override.MaxUnavailable
+			}
+		}
+		budgets = append(budgets, budget)
+	}
+	return budgets
+}
+
+// allowedDisruptions returns the number of the replicas, which the PodDisruptionBudget allows to be
+// evicted at the same time, rounding percentages the way Kubernetes does, false if it sets no limit.
+func allowedDisruptions(spec policyv1.PodDisruptionBudgetSpec, replicas int32) (int, bool) {
+	switch {
+	case spec.MaxUnavailable != nil:
+		unavailable, err := intstr.GetScaledValueFromIntOrPercent(spec.MaxUnavailable, int(replicas), true)
+		return unavailable, err == nil
+	case spec.MinAvailable != nil:
+		available, err := intstr.GetScaledValueFromIntOrPercent(spec.MinAvailable, int(replicas), true)
+		return int(replicas) - available, err == nil
+	}
+	return 0, false
+}
+
+// topologyDomains returns the number of distinct values of the label among the nodes.
+func topologyDomains(nodes []corev1.Node, key string) int {
+	values := sets.New[string]()
+	for _, node := range nodes {
+		if value, ok := node.Labels[key]; ok {
+			values.Insert(value)
+		}
+	}
+	return values.Len()
+}
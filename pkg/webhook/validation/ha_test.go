@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateHighAvailability(t *testing.T) {
+	tests := []struct {
+		name    string
+		ha      *base.HighAvailability
+		wantErr string
+	}{{
+		name: "no high availability",
+	}, {
+		name: "valid",
+		ha: &base.HighAvailability{
+			Replicas:    ptr.To(int32(2)),
+			Deployments: []base.HighAvailabilityDeployment{{Name: "activator", Replicas: 3}},
+			Exclude:     []string{"controller"},
+		},
+	}, {
+		name:    "no name",
+		ha:      &base.HighAvailability{Deployments: []base.HighAvailabilityDeployment{{Replicas: 3}}},
+		wantErr: "spec.high-availability.deployments[0].name: required",
+	}, {
+		name: "duplicate",
+		ha: &base.HighAvailability{Deployments: []base.HighAvailabilityDeployment{
+			{Name: "activator", Replicas: 3}, {Name: "activator", Replicas: 2},
+		}},
+		wantErr: "spec.high-availability.deployments[1]: duplicate deployment activator",
+	}, {
+		name:    "single replica",
+		ha:      &base.HighAvailability{Deployments: []base.HighAvailabilityDeployment{{Name: "pingsource-mt-adapter", Replicas: 2}}},
+		wantErr: "spec.high-availability.deployments[0]: pingsource-mt-adapter must run a single replica",
+	}, {
+		name: "excluded",
+		ha: &base.HighAvailability{
+			Deployments: []base.HighAvailabilityDeployment{{Name: "activator", Replicas: 2}},
+			Exclude:     []string{"activator"},
+		},
+		wantErr: "spec.high-availability.deployments[0]: activator is excluded by spec.high-availability.exclude",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{HighAvailability: test.ha}},
+			}
+			err := validateHighAvailability(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateHighAvailability() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateHighAvailability() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestHighAvailabilityWarnings(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	common.ClearCache()
+
+	node := func(name, zone string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{corev1.LabelHostname: name, corev1.LabelTopologyZone: zone},
+		}}
+	}
+	antiAffinity := func(app, topologyKey string) *corev1.Affinity {
+		return &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+				TopologyKey:   topologyKey,
+			}},
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		ha        *base.HighAvailability
+		workloads []base.WorkloadOverride
+		budgets   []base.PodDisruptionBudgetOverride
+		expected  []string
+	}{{
+		name: "no high availability",
+	}, {
+		name: "single replica",
+		ha:   &base.HighAvailability{Replicas: ptr.To(int32(1))},
+		expected: []string{
+			"the PodDisruptionBudget activator-pdb allows no disruption of the 1 replicas of the deployment activator, which blocks the drain of their nodes",
+		},
+	}, {
+		name: "enough replicas",
+		ha:   &base.HighAvailability{Replicas: ptr.To(int32(1)), Deployments: []base.HighAvailabilityDeployment{{Name: "activator", Replicas: 5}}},
+	}, {
+		name: "excluded",
+		ha:   &base.HighAvailability{Replicas: ptr.To(int32(1)), Exclude: []string{"activator"}},
+	}, {
+		name:      "replicas of the workload",
+		ha:        &base.HighAvailability{Replicas: ptr.To(int32(1))},
+		workloads: []base.WorkloadOverride{{Name: "activator", Replicas: ptr.To(int32(1))}},
+	}, {
+		name: "overridden budget",
+		ha:   &base.HighAvailability{Replicas: ptr.To(int32(2))},
+		budgets: []base.PodDisruptionBudgetOverride{{
+			Name:                    "activator-pdb",
+			PodDisruptionBudgetSpec: policyv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt32(0))},
+		}},
+		expected: []string{
+			"the PodDisruptionBudget activator-pdb allows no disruption of the 2 replicas of the deployment activator, which blocks the drain of their nodes",
+		},
+	}, {
+		name:      "anti-affinity across hosts",
+		ha:        &base.HighAvailability{Replicas: ptr.To(int32(3)), Exclude: []string{"activator"}},
+		workloads: []base.WorkloadOverride{{Name: "controller", Affinity: antiAffinity("controller", corev1.LabelHostname)}},
+	}, {
+		name:      "anti-affinity across zones",
+		ha:        &base.HighAvailability{Replicas: ptr.To(int32(3)), Exclude: []string{"activator"}},
+		workloads: []base.WorkloadOverride{{Name: "controller", Affinity: antiAffinity("controller", corev1.LabelTopologyZone)}},
+		expected: []string{
+			"the 3 replicas of the deployment controller require distinct values of the node label topology.kubernetes.io/zone, but the nodes only have 2, the other replicas stay pending",
+		},
+	}, {
+		name:      "anti-affinity of other pods",
+		ha:        &base.HighAvailability{Replicas: ptr.To(int32(3)), Exclude: []string{"activator"}},
+		workloads: []base.WorkloadOverride{{Name: "controller", Affinity: antiAffinity("activator", corev1.LabelTopologyZone)}},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{
+					Version:                     "1.21.0",
+					HighAvailability:            test.ha,
+					Workloads:                   test.workloads,
+					PodDisruptionBudgetOverride: test.budgets,
+				}},
+			}
+			client := kubefake.NewSimpleClientset(node("a", "zone-1"), node("b", "zone-1"), node("c", "zone-2"))
+			got := highAvailabilityWarnings(context.Background(), client, ks)
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("highAvailabilityWarnings() (-want, +got): %s", diff)
+			}
+		})
+	}
+}
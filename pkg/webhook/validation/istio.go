@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	kec "knative.dev/operator/pkg/reconciler/knativeeventing/common"
+	"knative.dev/operator/pkg/reconciler/knativeeventing/source"
+)
+
+// validateIstio checks spec.istio of a KnativeEventing: the operator has to ship eventing-istio for
+// the target version, unless it is part of spec.additionalManifests, and the istio flag of
+// spec.features or spec.config can't disable it again.
+func validateIstio(ke *v1beta1.KnativeEventing) error {
+	istio := ke.Spec.Istio
+	if istio == nil || !istio.Enabled {
+		return nil
+	}
+	var errs []error
+	if value, ok := ke.Spec.GetFeatures()[kec.IstioFeature]; ok && value != "enabled" {
+		errs = append(errs, fmt.Errorf("spec.istio.enabled: can't be combined with spec.features.%s: %s", kec.IstioFeature, value))
+	}
+	for _, name := range []string{"features", "config-features"} {
+		if value, ok := ke.Spec.GetConfig()[name][kec.IstioFeature]; ok && value != "enabled" {
+			errs = append(errs, fmt.Errorf("spec.istio.enabled: can't be combined with spec.config.%s.%s: %s", name, kec.IstioFeature, value))
+		}
+	}
+	errs = append(errs, validateBundles(ke, "spec.istio", source.IstioBundles(istio))...)
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid Istio configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
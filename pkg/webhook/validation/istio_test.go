@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateIstio(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	enabled := &base.EventingComponentConfiguration{Enabled: true}
+	additional := []base.Manifest{{Url: "https://example.com/eventing-istio.yaml"}}
+
+	tests := []struct {
+		name                string
+		istio               *base.EventingComponentConfiguration
+		features            map[string]string
+		config              base.ConfigMapData
+		additionalManifests []base.Manifest
+		wantErr             string
+	}{{
+		name: "no istio",
+	}, {
+		name:  "disabled",
+		istio: &base.EventingComponentConfiguration{},
+	}, {
+		name:                "additional manifests",
+		istio:               enabled,
+		features:            map[string]string{"istio": "enabled"},
+		additionalManifests: additional,
+	}, {
+		name:    "not shipped",
+		istio:   enabled,
+		wantErr: "spec.istio: the operator ships no istio bundle for version",
+	}, {
+		name:                "disabled by spec.features",
+		istio:               enabled,
+		features:            map[string]string{"istio": "disabled"},
+		additionalManifests: additional,
+		wantErr:             "spec.istio.enabled: can't be combined with spec.features.istio: disabled",
+	}, {
+		name:                "disabled by spec.config",
+		istio:               enabled,
+		config:              base.ConfigMapData{"config-features": {"istio": "disabled"}},
+		additionalManifests: additional,
+		wantErr:             "spec.istio.enabled: can't be combined with spec.config.config-features.istio: disabled",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{
+						Version:             "1.21.0",
+						Config:              test.config,
+						Features:            test.features,
+						AdditionalManifests: test.additionalManifests,
+					},
+					Istio: test.istio,
+				},
+			}
+			err := validateIstio(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateIstio() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateIstio() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	kec "knative.dev/operator/pkg/reconciler/knativeeventing/common"
+	"knative.dev/operator/pkg/reconciler/knativeeventing/source"
+)
+
+// validateKafka checks spec.kafka of a KnativeEventing: the bootstrap servers have to be host:port
+// addresses, and the topics need at least one replica and partition. The operator has to ship the
+// bundles of the enabled components for the target version, unless spec.additionalManifests may
+// contain them. spec.kafka can't be combined with the keys it sets in spec.config.
+func validateKafka(ke *v1beta1.KnativeEventing) error {
+	kafka := ke.Spec.Kafka
+	if kafka == nil {
+		return nil
+	}
+	var errs []error
+	if len(kafka.BootstrapServers) == 0 {
+		errs = append(errs, errors.New("spec.kafka.bootstrapServers: at least one server is required"))
+	}
+	for i, server := range kafka.BootstrapServers {
+		if _, port, err := net.SplitHostPort(server); err != nil {
+			errs = append(errs, fmt.Errorf("spec.kafka.bootstrapServers[%d]: %w", i, err))
+		} else if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			errs = append(errs, fmt.Errorf("spec.kafka.bootstrapServers[%d]: invalid port %q", i, port))
+		}
+	}
+	if kafka.AuthSecretRef != nil && kafka.AuthSecretRef.Name == "" {
+		errs = append(errs, errors.New("spec.kafka.authSecretRef.name: is required"))
+	}
+	if kafka.ReplicationFactor != nil && *kafka.ReplicationFactor < 1 {
+		errs = append(errs, fmt.Errorf("spec.kafka.replicationFactor: must be at least 1, got %d", *kafka.ReplicationFactor))
+	}
+	if kafka.Partitions != nil && *kafka.Partitions < 1 {
+		errs = append(errs, fmt.Errorf("spec.kafka.partitions: must be at least 1, got %d", *kafka.Partitions))
+	}
+
+	errs = append(errs, validateBundles(ke, "spec.kafka", source.KafkaBundles(kafka))...)
+
+	// Render the keys of spec.kafka the way the operator does, to find those also set in spec.config.
+	config := ke.Spec.GetConfig()
+	for _, name := range []string{kec.KafkaBrokerConfigMapName, kec.KafkaChannelConfigMapName} {
+		u := &unstructured.Unstructured{}
+		u.SetKind("ConfigMap")
+		u.SetName(name)
+		if err := kec.KafkaTransform(&v1beta1.KnativeEventing{Spec: v1beta1.KnativeEventingSpec{Kafka: kafka}})(u); err != nil {
+			return err
+		}
+		data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+		for _, key := range sortedKeys(data) {
+			if _, ok := config[name][key]; ok {
+				errs = append(errs, fmt.Errorf("spec.kafka: can't be combined with spec.config.%s.%s", name, key))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid Kafka configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
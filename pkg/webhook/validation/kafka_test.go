@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateKafka(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	zero := int32(0)
+	servers := []string{"kafka-0.kafka:9092"}
+	broker := &base.EventingComponentConfiguration{Enabled: true}
+
+	tests := []struct {
+		name                string
+		kafka               *base.KafkaConfiguration
+		config              base.ConfigMapData
+		additionalManifests []base.Manifest
+		wantErr             string
+	}{{
+		name: "no kafka",
+	}, {
+		name:  "valid",
+		kafka: &base.KafkaConfiguration{BootstrapServers: []string{"kafka-0.kafka:9092", "[fd00::1]:9093"}},
+	}, {
+		name:    "no servers",
+		kafka:   &base.KafkaConfiguration{},
+		wantErr: "spec.kafka.bootstrapServers: at least one server is required",
+	}, {
+		name:    "server without port",
+		kafka:   &base.KafkaConfiguration{BootstrapServers: []string{"kafka-0.kafka"}},
+		wantErr: "spec.kafka.bootstrapServers[0]: address kafka-0.kafka: missing port in address",
+	}, {
+		name:    "invalid port",
+		kafka:   &base.KafkaConfiguration{BootstrapServers: []string{"kafka-0.kafka:kafka"}},
+		wantErr: `spec.kafka.bootstrapServers[0]: invalid port "kafka"`,
+	}, {
+		name:    "secret without name",
+		kafka:   &base.KafkaConfiguration{BootstrapServers: servers, AuthSecretRef: &corev1.LocalObjectReference{}},
+		wantErr: "spec.kafka.authSecretRef.name: is required",
+	}, {
+		name:    "no replicas",
+		kafka:   &base.KafkaConfiguration{BootstrapServers: servers, ReplicationFactor: &zero},
+		wantErr: "spec.kafka.replicationFactor: must be at least 1, got 0",
+	}, {
+		name:    "no partitions",
+		kafka:   &base.KafkaConfiguration{BootstrapServers: servers, Partitions: &zero},
+		wantErr: "spec.kafka.partitions: must be at least 1, got 0",
+	}, {
+		name:    "data plane not shipped",
+		kafka:   &base.KafkaConfiguration{BootstrapServers: servers, Broker: broker},
+		wantErr: "spec.kafka: the operator ships no kafka-broker bundle for version 1.21.0, add its manifests to spec.additionalManifests",
+	}, {
+		name:                "data plane in additional manifests",
+		kafka:               &base.KafkaConfiguration{BootstrapServers: servers, Broker: broker},
+		additionalManifests: []base.Manifest{{Url: "https://example.com/eventing-kafka-broker.yaml"}},
+	}, {
+		name:    "servers in spec.config",
+		kafka:   &base.KafkaConfiguration{BootstrapServers: servers},
+		config:  base.ConfigMapData{"kafka-channel-config": {"bootstrap.servers": "other.kafka:9092"}},
+		wantErr: "spec.kafka: can't be combined with spec.config.kafka-channel-config.bootstrap.servers",
+	}, {
+		name:   "other keys in spec.config",
+		kafka:  &base.KafkaConfiguration{BootstrapServers: servers},
+		config: base.ConfigMapData{"kafka-broker-config": {"default.topic.partitions": "4"}},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Version: "1.21.0", Config: test.config, AdditionalManifests: test.additionalManifests},
+					Kafka:      test.kafka,
+				},
+			}
+			err := validateKafka(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateKafka() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateKafka() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
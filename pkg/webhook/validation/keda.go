@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	kec "knative.dev/operator/pkg/reconciler/knativeeventing/common"
+)
+
+// validateKEDA checks spec.keda of a KnativeEventing: the bounds of the scaling have to be ordered,
+// and the Kafka consumers can only be scaled with spec.kafka. Each workload has to be a deployment
+// of the target version, scaled only once, whose replicas spec.workloads leaves to KEDA.
+// spec.keda.kafka can't be combined with the keys it sets in spec.config.
+func validateKEDA(ke *v1beta1.KnativeEventing) error {
+	keda := ke.Spec.KEDA
+	if keda == nil {
+		return nil
+	}
+	var errs []error
+	if keda.Kafka != nil {
+		if ke.Spec.Kafka == nil {
+			errs = append(errs, errors.New("spec.keda.kafka: requires spec.kafka"))
+		}
+		// The Kafka consumers scale to zero without lag.
+		errs = append(errs, validateKEDAScaling("spec.keda.kafka", &keda.Kafka.KEDAScalingConfiguration, 0)...)
+		if keda.Kafka.LagThreshold != nil && *keda.Kafka.LagThreshold < 1 {
+			errs = append(errs, fmt.Errorf("spec.keda.kafka.lagThreshold: must be at least 1, got %d", *keda.Kafka.LagThreshold))
+		}
+		errs = append(errs, kafkaAutoscalerConflicts(ke)...)
+	}
+
+	var deployments sets.Set[string]
+	if len(keda.Workloads) > 0 && len(ke.Spec.GetManifests()) == 0 {
+		// The version itself is validated by the operator, which reports it in the status.
+		if manifest, err := common.TargetManifest(ke); err == nil {
+			deployments = sets.New[string]()
+			for _, u := range manifest.Filter(mf.ByKind("Deployment")).Resources() {
+				deployments.Insert(u.GetName())
+			}
+		}
+	}
+	replicas := sets.New[string]()
+	for _, override := range ke.Spec.GetWorkloadOverrides() {
+		if override.Replicas != nil {
+			replicas.Insert(override.Name)
+		}
+	}
+	seen := sets.New[string]()
+	for i, workload := range keda.Workloads {
+		field := fmt.Sprintf("spec.keda.workloads[%d]", i)
+		switch {
+		case workload.Name == "":
+			errs = append(errs, fmt.Errorf("%s.name: is required", field))
+		case seen.Has(workload.Name):
+			errs = append(errs, fmt.Errorf("%s.name: duplicate workload %q", field, workload.Name))
+		case deployments != nil && !deployments.Has(workload.Name):
+			errs = append(errs, fmt.Errorf("%s.name: version %s has no deployment %q", field, common.TargetVersion(ke), workload.Name))
+		case replicas.Has(workload.Name):
+			errs = append(errs, fmt.Errorf("%s.name: can't be combined with the replicas of %q in spec.workloads", field, workload.Name))
+		}
+		seen.Insert(workload.Name)
+		// CPU utilization can't scale a deployment without pods.
+		errs = append(errs, validateKEDAScaling(field, &workload.KEDAScalingConfiguration, 1)...)
+		if workload.CPUUtilization != nil && *workload.CPUUtilization < 1 {
+			errs = append(errs, fmt.Errorf("%s.cpuUtilization: must be at least 1, got %d", field, *workload.CPUUtilization))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid KEDA configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func validateKEDAScaling(field string, scaling *base.KEDAScalingConfiguration, minReplicas int32) []error {
+	var errs []error
+	if scaling.MinReplicas != nil && *scaling.MinReplicas < minReplicas {
+		errs = append(errs, fmt.Errorf("%s.minReplicas: must be at least %d, got %d", field, minReplicas, *scaling.MinReplicas))
+	}
+	if scaling.MaxReplicas != nil && *scaling.MaxReplicas < 1 {
+		errs = append(errs, fmt.Errorf("%s.maxReplicas: must be at least 1, got %d", field, *scaling.MaxReplicas))
+	}
+	if scaling.MinReplicas != nil && scaling.MaxReplicas != nil && *scaling.MinReplicas > *scaling.MaxReplicas {
+		errs = append(errs, fmt.Errorf("%s.minReplicas: must not exceed maxReplicas %d, got %d", field, *scaling.MaxReplicas, *scaling.MinReplicas))
+	}
+	if scaling.PollingInterval != nil && *scaling.PollingInterval < 1 {
+		errs = append(errs, fmt.Errorf("%s.pollingInterval: must be at least 1 second, got %d", field, *scaling.PollingInterval))
+	}
+	if scaling.CooldownPeriod != nil && *scaling.CooldownPeriod < 0 {
+		errs = append(errs, fmt.Errorf("%s.cooldownPeriod: must not be negative, got %d", field, *scaling.CooldownPeriod))
+	}
+	return errs
+}
+
+// kafkaAutoscalerConflicts renders the keys of spec.keda.kafka the way the operator does, to find
+// those also set in spec.config.
+func kafkaAutoscalerConflicts(ke *v1beta1.KnativeEventing) []error {
+	var errs []error
+	config := ke.Spec.GetConfig()
+	transform := kec.KEDATransform(&v1beta1.KnativeEventing{Spec: v1beta1.KnativeEventingSpec{KEDA: ke.Spec.KEDA}})
+	for _, name := range []string{kec.KafkaFeaturesConfigMapName, kec.KafkaAutoscalerConfigMapName} {
+		u := &unstructured.Unstructured{}
+		u.SetKind("ConfigMap")
+		u.SetName(name)
+		if err := transform(u); err != nil {
+			return []error{err}
+		}
+		data, _, _ := unstructured.NestedStringMap(u.Object, "data")
+		for _, key := range sortedKeys(data) {
+			// The "config-" prefix is optional
+			for _, configName := range []string{name, strings.TrimPrefix(name, "config-")} {
+				if _, ok := config[configName][key]; ok {
+					errs = append(errs, fmt.Errorf("spec.keda.kafka: can't be combined with spec.config.%s.%s", configName, key))
+				}
+			}
+		}
+	}
+	return errs
+}
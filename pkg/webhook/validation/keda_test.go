@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateKEDA(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	kafka := &base.KafkaConfiguration{BootstrapServers: []string{"my-cluster-kafka-bootstrap.kafka:9092"}}
+	tests := []struct {
+		name      string
+		keda      *base.KEDAConfiguration
+		kafka     *base.KafkaConfiguration
+		config    base.ConfigMapData
+		workloads []base.WorkloadOverride
+		wantErr   string
+	}{{
+		name: "no keda",
+	}, {
+		name: "valid",
+		keda: &base.KEDAConfiguration{
+			Kafka: &base.KEDAKafkaConfiguration{
+				KEDAScalingConfiguration: base.KEDAScalingConfiguration{MinReplicas: ptr.Int32(0), MaxReplicas: ptr.Int32(10)},
+				LagThreshold:             ptr.Int64(100),
+			},
+			Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress", CPUUtilization: ptr.Int32(80)}},
+		},
+		kafka:  kafka,
+		config: base.ConfigMapData{"kafka-autoscaler": {"polling-interval": "5"}},
+	}, {
+		name:    "kafka without spec.kafka",
+		keda:    &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{}},
+		wantErr: "spec.keda.kafka: requires spec.kafka",
+	}, {
+		name: "unordered bounds",
+		keda: &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{
+			KEDAScalingConfiguration: base.KEDAScalingConfiguration{MinReplicas: ptr.Int32(5), MaxReplicas: ptr.Int32(2)},
+		}},
+		kafka:   kafka,
+		wantErr: "spec.keda.kafka.minReplicas: must not exceed maxReplicas 2, got 5",
+	}, {
+		name:    "lag threshold",
+		keda:    &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{LagThreshold: ptr.Int64(0)}},
+		kafka:   kafka,
+		wantErr: "spec.keda.kafka.lagThreshold: must be at least 1, got 0",
+	}, {
+		name: "keys in spec.config",
+		keda: &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{
+			KEDAScalingConfiguration: base.KEDAScalingConfiguration{MaxReplicas: ptr.Int32(10)},
+		}},
+		kafka:   kafka,
+		config:  base.ConfigMapData{"config-kafka-autoscaler": {"max-scale": "20"}},
+		wantErr: "spec.keda.kafka: can't be combined with spec.config.config-kafka-autoscaler.max-scale",
+	}, {
+		name:    "flag in spec.config",
+		keda:    &base.KEDAConfiguration{Kafka: &base.KEDAKafkaConfiguration{}},
+		kafka:   kafka,
+		config:  base.ConfigMapData{"kafka-features": {"controller-autoscaler-keda": "disabled"}},
+		wantErr: "spec.keda.kafka: can't be combined with spec.config.kafka-features.controller-autoscaler-keda",
+	}, {
+		name:    "workload without name",
+		keda:    &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{}}},
+		wantErr: "spec.keda.workloads[0].name: is required",
+	}, {
+		name:    "duplicate workload",
+		keda:    &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress"}, {Name: "mt-broker-ingress"}}},
+		wantErr: `spec.keda.workloads[1].name: duplicate workload "mt-broker-ingress"`,
+	}, {
+		name:    "unknown deployment",
+		keda:    &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "imc-dispatcher"}}},
+		wantErr: `spec.keda.workloads[0].name: version 1.21.0 has no deployment "imc-dispatcher"`,
+	}, {
+		name:      "replicas in spec.workloads",
+		keda:      &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress"}}},
+		workloads: []base.WorkloadOverride{{Name: "mt-broker-ingress", Replicas: ptr.Int32(2)}},
+		wantErr:   `spec.keda.workloads[0].name: can't be combined with the replicas of "mt-broker-ingress" in spec.workloads`,
+	}, {
+		name: "workload scaled to zero",
+		keda: &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{
+			Name:                     "mt-broker-ingress",
+			KEDAScalingConfiguration: base.KEDAScalingConfiguration{MinReplicas: ptr.Int32(0)},
+		}}},
+		wantErr: "spec.keda.workloads[0].minReplicas: must be at least 1, got 0",
+	}, {
+		name:    "cpu utilization",
+		keda:    &base.KEDAConfiguration{Workloads: []base.KEDAWorkloadConfiguration{{Name: "mt-broker-ingress", CPUUtilization: ptr.Int32(0)}}},
+		wantErr: "spec.keda.workloads[0].cpuUtilization: must be at least 1, got 0",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Version: "1.21.0", Config: test.config, Workloads: test.workloads},
+					Kafka:      test.kafka,
+					KEDA:       test.keda,
+				},
+			}
+			err := validateKEDA(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateKEDA() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateKEDA() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
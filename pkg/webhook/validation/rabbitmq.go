@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/knativeeventing/source"
+)
+
+// validateRabbitMQ checks spec.rabbitmq of a KnativeEventing: the RabbitmqCluster needs a name, and
+// the RabbitMQBroker, whose CRD defines the RabbitmqBrokerConfig for it. The operator has to ship
+// the bundles of the enabled components for the target version.
+func validateRabbitMQ(ke *v1beta1.KnativeEventing) error {
+	rabbitmq := ke.Spec.RabbitMQ
+	if rabbitmq == nil {
+		return nil
+	}
+	var errs []error
+	if ref := rabbitmq.ClusterRef; ref != nil {
+		if ref.Name == "" {
+			errs = append(errs, errors.New("spec.rabbitmq.clusterRef.name: is required"))
+		}
+		if rabbitmq.Broker == nil || !rabbitmq.Broker.Enabled {
+			errs = append(errs, errors.New("spec.rabbitmq.clusterRef: requires spec.rabbitmq.broker.enabled"))
+		}
+	}
+	switch rabbitmq.QueueType {
+	case "", "quorum", "classic":
+	default:
+		errs = append(errs, fmt.Errorf("spec.rabbitmq.queueType: must be quorum or classic, got %q", rabbitmq.QueueType))
+	}
+	if rabbitmq.QueueType != "" && rabbitmq.ClusterRef == nil {
+		errs = append(errs, errors.New("spec.rabbitmq.queueType: requires spec.rabbitmq.clusterRef"))
+	}
+	errs = append(errs, validateBundles(ke, "spec.rabbitmq", source.RabbitMQBundles(rabbitmq))...)
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid RabbitMQ configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
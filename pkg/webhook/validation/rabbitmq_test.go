@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateRabbitMQ(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	enabled := &base.EventingComponentConfiguration{Enabled: true}
+	cluster := &base.RabbitMQClusterReference{Name: "rabbitmq"}
+	additional := []base.Manifest{{Url: "https://example.com/rabbitmq-broker.yaml"}}
+
+	tests := []struct {
+		name                string
+		rabbitmq            *base.RabbitMQConfiguration
+		additionalManifests []base.Manifest
+		wantErr             string
+	}{{
+		name: "no rabbitmq",
+	}, {
+		name:                "broker with a cluster",
+		rabbitmq:            &base.RabbitMQConfiguration{ClusterRef: cluster, QueueType: "quorum", Broker: enabled},
+		additionalManifests: additional,
+	}, {
+		name:                "cluster without a name",
+		rabbitmq:            &base.RabbitMQConfiguration{ClusterRef: &base.RabbitMQClusterReference{}, Broker: enabled},
+		additionalManifests: additional,
+		wantErr:             "spec.rabbitmq.clusterRef.name: is required",
+	}, {
+		name:     "cluster without a broker",
+		rabbitmq: &base.RabbitMQConfiguration{ClusterRef: cluster},
+		wantErr:  "spec.rabbitmq.clusterRef: requires spec.rabbitmq.broker.enabled",
+	}, {
+		name:                "unknown queue type",
+		rabbitmq:            &base.RabbitMQConfiguration{ClusterRef: cluster, QueueType: "stream", Broker: enabled},
+		additionalManifests: additional,
+		wantErr:             `spec.rabbitmq.queueType: must be quorum or classic, got "stream"`,
+	}, {
+		name:     "queue type without a cluster",
+		rabbitmq: &base.RabbitMQConfiguration{QueueType: "classic"},
+		wantErr:  "spec.rabbitmq.queueType: requires spec.rabbitmq.clusterRef",
+	}, {
+		name:     "broker not shipped",
+		rabbitmq: &base.RabbitMQConfiguration{Broker: enabled},
+		wantErr:  "spec.rabbitmq: the operator ships no rabbitmq-broker bundle for version 1.21.0",
+	}, {
+		name:     "source not shipped",
+		rabbitmq: &base.RabbitMQConfiguration{Source: enabled},
+		wantErr:  "spec.rabbitmq: the operator ships no rabbitmq bundle for version 1.21.0",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Version: "1.21.0", AdditionalManifests: test.additionalManifests},
+					RabbitMQ:   test.rabbitmq,
+				},
+			}
+			err := validateRabbitMQ(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateRabbitMQ() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateRabbitMQ() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
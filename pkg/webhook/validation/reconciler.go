@@ -0,0 +1,372 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	clientset "knative.dev/operator/pkg/client/clientset/versioned"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+type reconciler struct {
+	webhook.StatelessAdmissionImpl
+	pkgreconciler.LeaderAwareFuncs
+
+	key        types.NamespacedName
+	path       string
+	secretName string
+
+	secretLister   corelisters.SecretLister
+	kubeClient     kubernetes.Interface
+	operatorClient clientset.Interface
+}
+
+var (
+	_ controller.Reconciler                = (*reconciler)(nil)
+	_ pkgreconciler.LeaderAware            = (*reconciler)(nil)
+	_ webhook.AdmissionController          = (*reconciler)(nil)
+	_ webhook.StatelessAdmissionController = (*reconciler)(nil)
+)
+
+// Path implements webhook.AdmissionController
+func (r *reconciler) Path() string {
+	return r.path
+}
+
+// Reconcile implements controller.Reconciler
+func (r *reconciler) Reconcile(ctx context.Context, _ string) error {
+	logger := logging.FromContext(ctx)
+
+	if !r.IsLeaderFor(r.key) {
+		return controller.NewSkipKey(r.key.String())
+	}
+
+	// Look up the webhook secret, and fetch the CA cert bundle.
+	secret, err := r.secretLister.Secrets(system.Namespace()).Get(r.secretName)
+	if err != nil {
+		logger.Errorw("Error fetching secret", zap.Error(err))
+		return err
+	}
+	cacert, ok := secret.Data[certresources.CACert]
+	if !ok {
+		return fmt.Errorf("secret %q is missing %q key", r.secretName, certresources.CACert)
+	}
+
+	client := r.kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	configured, err := client.Get(ctx, r.key.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error retrieving webhook: %w", err)
+	}
+	current := configured.DeepCopy()
+	for i := range current.Webhooks {
+		current.Webhooks[i].ClientConfig.CABundle = cacert
+		if current.Webhooks[i].ClientConfig.Service == nil {
+			return fmt.Errorf("missing service reference for webhook: %s", current.Webhooks[i].Name)
+		}
+		current.Webhooks[i].ClientConfig.Service.Path = ptr.String(r.path)
+	}
+	if equality.Semantic.DeepEqual(configured, current) {
+		logger.Info("Webhook is valid")
+		return nil
+	}
+	logger.Info("Updating webhook")
+	if _, err := client.Update(ctx, current, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return nil
+}
+
+// Admit implements webhook.AdmissionController
+func (r *reconciler) Admit(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Kind.Group != operator.GroupName || (req.Operation != admissionv1.Create && req.Operation != admissionv1.Update) {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+	newComponent, others, err := r.decode(ctx, req.Kind, req.Object.Raw)
+	if err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if newComponent == nil {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+	if err := validateConfig(newComponent); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if err := validateFeatures(newComponent); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if err := validateVersionOverrides(newComponent); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if err := validateRegistry(newComponent); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if err := validateSpot(newComponent); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if err := validateHighAvailability(newComponent); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if err := validateResourceRecommendations(newComponent); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if ks, ok := newComponent.(*v1beta1.KnativeServing); ok {
+		if err := validateDomain(ks); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateAutoscaling(ks); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateRevisionDeployments(ks); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateGarbageCollection(ks); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+	}
+	warnings := highAvailabilityWarnings(ctx, r.kubeClient, newComponent)
+	warnings = append(warnings, resilienceWarnings(ctx, r.kubeClient, newComponent)...)
+	if ke, ok := newComponent.(*v1beta1.KnativeEventing); ok {
+		if err := validateDefaultBroker(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateSources(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateKafka(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateRabbitMQ(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateTransportEncryption(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateSugar(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateIstio(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateComponents(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if err := validateKEDA(ke); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		warnings = append(warnings, brokerWarnings(r.discovery(), ke)...)
+	}
+	if kf, ok := newComponent.(*v1beta1.KnativeFunctions); ok {
+		if err := validateFunctions(kf); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+	}
+	var oldComponent base.KComponent
+	if req.Operation == admissionv1.Update {
+		if oldComponent, _, err = r.decode(ctx, req.Kind, req.OldObject.Raw); err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		warnings = append(warnings, featureWarnings(oldComponent, newComponent)...)
+	}
+	if peerKind := skewPeerKind(req.Kind.Kind); peerKind != "" {
+		peers, err := r.list(ctx, peerKind)()
+		if err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		warning, err := validateVersionSkew(oldComponent, newComponent, peers)
+		if err != nil {
+			return webhook.MakeErrorStatus("%v", err)
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	if oldComponent != nil {
+		// Only a changed target cluster can make an existing component conflict with another one.
+		if common.SameTargetCluster(oldComponent, newComponent) {
+			return &admissionv1.AdmissionResponse{Allowed: true, Warnings: warnings}
+		}
+	}
+	existing, err := others()
+	if err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	if err := validateSingleton(newComponent, existing); err != nil {
+		return webhook.MakeErrorStatus("%v", err)
+	}
+	return &admissionv1.AdmissionResponse{Allowed: true, Warnings: warnings}
+}
+
+// discovery returns the discovery client of the cluster of the operator, nil without a client.
+func (r *reconciler) discovery() discovery.DiscoveryInterface {
+	if r.kubeClient == nil {
+		return nil
+	}
+	return r.kubeClient.Discovery()
+}
+
+// validateSingleton rejects the component, if another one of the same kind already installs Knative
+// into the same cluster. Parts of Knative are cluster-scoped, so that two such components would
+// keep overwriting each other's resources.
+func validateSingleton(component base.KComponent, existing []base.KComponent) error {
+	for _, other := range existing {
+		if other.GetNamespace() == component.GetNamespace() && other.GetName() == component.GetName() {
+			continue
+		}
+		if !other.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if common.SameTargetCluster(other, component) {
+			kind := component.GroupVersionKind().Kind
+			return fmt.Errorf("%s %s/%s already installs Knative into this cluster, only one %s is supported per cluster",
+				kind, other.GetNamespace(), other.GetName(), kind)
+		}
+	}
+	return nil
+}
+
+// decode returns the component of the kind in the raw object, and a function listing all the
+// existing components of the kind. The component is nil for an unknown kind. A component of the
+// deprecated v1alpha1 API is converted to v1beta1, so that its fields, which v1beta1 moved, are
+// validated as well.
+func (r *reconciler) decode(ctx context.Context, gvk metav1.GroupVersionKind, raw []byte) (base.KComponent, func() ([]base.KComponent, error), error) {
+	kind := gvk.Kind
+	if gvk.Version == v1alpha1.SchemaVersion {
+		component, err := decodeV1alpha1(ctx, kind, raw)
+		if err != nil || component == nil {
+			return nil, nil, err
+		}
+		return component, r.list(ctx, kind), nil
+	}
+	var component base.KComponent
+	switch kind {
+	case operator.KindKnativeServing:
+		component = &v1beta1.KnativeServing{}
+	case operator.KindKnativeEventing:
+		component = &v1beta1.KnativeEventing{}
+	case operator.KindKnativeFunctions:
+		component = &v1beta1.KnativeFunctions{}
+	case operator.KindKnativeNetworking:
+		component = &v1beta1.KnativeNetworking{}
+	default:
+		return nil, nil, nil
+	}
+	if err := json.Unmarshal(raw, component); err != nil {
+		return nil, nil, fmt.Errorf("cannot decode incoming %s: %w", kind, err)
+	}
+	return component, r.list(ctx, kind), nil
+}
+
+// decodeV1alpha1 returns the v1beta1 component converted from the v1alpha1 one of the kind in the
+// raw object. The component is nil for a kind, which v1alpha1 does not have.
+func decodeV1alpha1(ctx context.Context, kind string, raw []byte) (base.KComponent, error) {
+	var source apis.Convertible
+	var component base.KComponent
+	switch kind {
+	case operator.KindKnativeServing:
+		source, component = &v1alpha1.KnativeServing{}, &v1beta1.KnativeServing{}
+	case operator.KindKnativeEventing:
+		source, component = &v1alpha1.KnativeEventing{}, &v1beta1.KnativeEventing{}
+	default:
+		return nil, nil
+	}
+	if err := json.Unmarshal(raw, source); err != nil {
+		return nil, fmt.Errorf("cannot decode incoming %s: %w", kind, err)
+	}
+	if err := source.ConvertTo(ctx, component.(apis.Convertible)); err != nil {
+		return nil, fmt.Errorf("cannot convert incoming %s to %s: %w", kind, v1beta1.SchemaVersion, err)
+	}
+	return component, nil
+}
+
+// list returns a function listing all the existing components of the kind.
+func (r *reconciler) list(ctx context.Context, kind string) func() ([]base.KComponent, error) {
+	switch kind {
+	case operator.KindKnativeServing:
+		return func() ([]base.KComponent, error) {
+			kss, err := r.operatorClient.OperatorV1beta1().KnativeServings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list all KnativeServings: %w", err)
+			}
+			components := make([]base.KComponent, 0, len(kss.Items))
+			for i := range kss.Items {
+				components = append(components, &kss.Items[i])
+			}
+			return components, nil
+		}
+	case operator.KindKnativeEventing:
+		return func() ([]base.KComponent, error) {
+			kes, err := r.operatorClient.OperatorV1beta1().KnativeEventings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list all KnativeEventings: %w", err)
+			}
+			components := make([]base.KComponent, 0, len(kes.Items))
+			for i := range kes.Items {
+				components = append(components, &kes.Items[i])
+			}
+			return components, nil
+		}
+	case operator.KindKnativeFunctions:
+		return func() ([]base.KComponent, error) {
+			kfs, err := r.operatorClient.OperatorV1beta1().KnativeFunctions(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list all KnativeFunctions: %w", err)
+			}
+			components := make([]base.KComponent, 0, len(kfs.Items))
+			for i := range kfs.Items {
+				components = append(components, &kfs.Items[i])
+			}
+			return components, nil
+		}
+	case operator.KindKnativeNetworking:
+		return func() ([]base.KComponent, error) {
+			kns, err := r.operatorClient.OperatorV1beta1().KnativeNetworkings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list all KnativeNetworkings: %w", err)
+			}
+			components := make([]base.KComponent, 0, len(kns.Items))
+			for i := range kns.Items {
+				components = append(components, &kns.Items[i])
+			}
+			return components, nil
+		}
+	}
+	return nil
+}
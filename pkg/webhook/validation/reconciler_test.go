@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1alpha1"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	operatorfake "knative.dev/operator/pkg/client/clientset/versioned/fake"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	_ "knative.dev/pkg/system/testing"
+)
+
+func knativeServing(ns, name string, target *base.TargetCluster) *v1beta1.KnativeServing {
+	return &v1beta1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+		Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{TargetCluster: target}},
+	}
+}
+
+func request(t *testing.T, op admissionv1.Operation, obj, old runtime.Object) *admissionv1.AdmissionRequest {
+	t.Helper()
+	req := &admissionv1.AdmissionRequest{
+		Operation: op,
+		Kind:      metav1.GroupVersionKind{Group: "operator.knative.dev", Version: "v1beta1", Kind: "KnativeServing"},
+	}
+	for raw, o := range map[*runtime.RawExtension]runtime.Object{&req.Object: obj, &req.OldObject: old} {
+		if o == nil {
+			continue
+		}
+		b, err := json.Marshal(o)
+		if err != nil {
+			t.Fatalf("Marshal() = %v", err)
+		}
+		raw.Raw = b
+	}
+	return req
+}
+
+func TestAdmit(t *testing.T) {
+	deleting := knativeServing("deleting", "knative-serving", nil)
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+	spoke := &base.TargetCluster{SecretName: "spoke"}
+
+	tests := []struct {
+		name     string
+		existing []runtime.Object
+		req      func(*testing.T) *admissionv1.AdmissionRequest
+		allowed  bool
+	}{{
+		name: "first",
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			return request(t, admissionv1.Create, knativeServing("knative-serving", "knative-serving", nil), nil)
+		},
+		allowed: true,
+	}, {
+		name:     "second in another namespace",
+		existing: []runtime.Object{knativeServing("knative-serving", "knative-serving", nil)},
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			return request(t, admissionv1.Create, knativeServing("other", "knative-serving", nil), nil)
+		},
+	}, {
+		name:     "second in the same namespace",
+		existing: []runtime.Object{knativeServing("knative-serving", "knative-serving", nil)},
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			return request(t, admissionv1.Create, knativeServing("knative-serving", "other", nil), nil)
+		},
+	}, {
+		name:     "second in another cluster",
+		existing: []runtime.Object{knativeServing("knative-serving", "knative-serving", nil)},
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			return request(t, admissionv1.Create, knativeServing("knative-serving", "spoke", spoke), nil)
+		},
+		allowed: true,
+	}, {
+		name:     "other is being deleted",
+		existing: []runtime.Object{deleting},
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			return request(t, admissionv1.Create, knativeServing("knative-serving", "knative-serving", nil), nil)
+		},
+		allowed: true,
+	}, {
+		name:     "update of an existing conflict",
+		existing: []runtime.Object{knativeServing("knative-serving", "knative-serving", nil), knativeServing("other", "knative-serving", nil)},
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			ks := knativeServing("other", "knative-serving", nil)
+			ks.Finalizers = []string{"knativeservings.operator.knative.dev"}
+			return request(t, admissionv1.Update, ks, knativeServing("other", "knative-serving", nil))
+		},
+		allowed: true,
+	}, {
+		name:     "update into a conflict",
+		existing: []runtime.Object{knativeServing("knative-serving", "knative-serving", nil), knativeServing("knative-serving", "spoke", spoke)},
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			return request(t, admissionv1.Update, knativeServing("knative-serving", "spoke", nil), knativeServing("knative-serving", "spoke", spoke))
+		},
+	}, {
+		name:     "delete",
+		existing: []runtime.Object{knativeServing("knative-serving", "knative-serving", nil)},
+		req: func(t *testing.T) *admissionv1.AdmissionRequest {
+			return request(t, admissionv1.Delete, nil, knativeServing("other", "knative-serving", nil))
+		},
+		allowed: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &reconciler{operatorClient: operatorfake.NewSimpleClientset(test.existing...)}
+			resp := r.Admit(context.Background(), test.req(t))
+			if resp.Allowed != test.allowed {
+				t.Errorf("Admit().Allowed = %v, want %v, result %v", resp.Allowed, test.allowed, resp.Result)
+			}
+		})
+	}
+}
+
+func TestAdmitV1alpha1(t *testing.T) {
+	gateway := &base.IstioGatewayOverride{Selector: map[string]string{"istio": "ingressgateway"}}
+	ks := &v1alpha1.KnativeServing{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+		Spec: v1alpha1.KnativeServingSpec{
+			DeprecatedKnativeIngressGateway: gateway,
+			Autoscaling:                     &base.AutoscalingConfiguration{TargetConcurrency: ptr.Int64(0)},
+		},
+	}
+	req := request(t, admissionv1.Create, ks, nil)
+	req.Kind.Version = "v1alpha1"
+	r := &reconciler{operatorClient: operatorfake.NewSimpleClientset()}
+
+	// The fields, which v1beta1 moved, are converted.
+	component, _, err := r.decode(context.Background(), req.Kind, req.Object.Raw)
+	if err != nil {
+		t.Fatalf("decode() = %v", err)
+	}
+	converted, ok := component.(*v1beta1.KnativeServing)
+	if !ok {
+		t.Fatalf("decode() = %T, want *v1beta1.KnativeServing", component)
+	}
+	if converted.Spec.Ingress == nil {
+		t.Fatal("spec.ingress = nil, want the gateway of v1alpha1 in spec.ingress.istio")
+	}
+	util.AssertDeepEqual(t, converted.Spec.Ingress.Istio.KnativeIngressGateway, gateway)
+
+	resp := r.Admit(context.Background(), req)
+	if resp.Allowed {
+		t.Error("Admit().Allowed = true, want the invalid spec.autoscaling of v1alpha1 rejected")
+	}
+
+	ks.Spec.Autoscaling = nil
+	req = request(t, admissionv1.Create, ks, nil)
+	req.Kind.Version = "v1alpha1"
+	if resp := r.Admit(context.Background(), req); !resp.Allowed {
+		t.Errorf("Admit().Allowed = false, result %v", resp.Result)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	const name = "validation.webhook.operator.knative.dev"
+	vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name: name,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Namespace: system.Namespace(), Name: "operator-webhook"},
+			},
+		}},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: "operator-webhook-certs"},
+		Data:       map[string][]byte{certresources.CACert: []byte("ca")},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(secret); err != nil {
+		t.Fatalf("Add() = %v", err)
+	}
+	kubeClient := kubefake.NewSimpleClientset(vwc)
+	r := &reconciler{
+		key:          types.NamespacedName{Name: name},
+		path:         "/resource-validation",
+		secretName:   secret.Name,
+		secretLister: corelisters.NewSecretLister(indexer),
+		kubeClient:   kubeClient,
+	}
+	if err := r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}); err != nil {
+		t.Fatalf("Promote() = %v", err)
+	}
+
+	if err := r.Reconcile(context.Background(), name); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	got, err := kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	util.AssertEqual(t, string(got.Webhooks[0].ClientConfig.CABundle), "ca")
+	util.AssertEqual(t, *got.Webhooks[0].ClientConfig.Service.Path, "/resource-validation")
+}
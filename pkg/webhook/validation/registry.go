@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// validateRegistry checks spec.registry: the rewrites need a prefix to replace, with a wildcard only
+// at the end of both prefixes or of none, and the credentials of the digest resolution have to
+// refer to a secret by a valid name.
+func validateRegistry(instance base.KComponent) error {
+	registry := instance.GetSpec().GetRegistry()
+	if registry == nil {
+		return nil
+	}
+	var errs []error
+	for i, rewrite := range registry.Rewrites {
+		field := fmt.Sprintf("spec.registry.rewrites[%d]", i)
+		if strings.TrimSuffix(rewrite.From, "*") == "" {
+			errs = append(errs, fmt.Errorf("%s.from: must not be empty", field))
+		}
+		if rewrite.To == "" {
+			errs = append(errs, fmt.Errorf("%s.to: must not be empty", field))
+		}
+		if strings.Contains(strings.TrimSuffix(rewrite.From, "*"), "*") {
+			errs = append(errs, fmt.Errorf("%s.from: the wildcard is only allowed at the end of %q", field, rewrite.From))
+		}
+		if strings.Contains(strings.TrimSuffix(rewrite.To, "*"), "*") {
+			errs = append(errs, fmt.Errorf("%s.to: the wildcard is only allowed at the end of %q", field, rewrite.To))
+		}
+		if strings.HasSuffix(rewrite.From, "*") != strings.HasSuffix(rewrite.To, "*") {
+			errs = append(errs, fmt.Errorf("%s: either both or none of %q and %q have to end with a wildcard", field, rewrite.From, rewrite.To))
+		}
+	}
+	if resolution := registry.DigestResolution; resolution != nil && resolution.CredentialsSecret != nil {
+		secret := resolution.CredentialsSecret
+		if msgs := k8svalidation.IsDNS1123Subdomain(secret.Name); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("spec.registry.digestResolution.credentialsSecret.name: invalid name %q: %s", secret.Name, strings.Join(msgs, ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid registry configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestValidateRegistry(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolution *base.DigestResolution
+		wantErr    string
+	}{{
+		name: "no digest resolution",
+	}, {
+		name:       "anonymous",
+		resolution: &base.DigestResolution{Enabled: true},
+	}, {
+		name:       "credentials",
+		resolution: &base.DigestResolution{Enabled: true, CredentialsSecret: &corev1.LocalObjectReference{Name: "registry-credentials"}},
+	}, {
+		name:       "empty name",
+		resolution: &base.DigestResolution{Enabled: true, CredentialsSecret: &corev1.LocalObjectReference{}},
+		wantErr:    `spec.registry.digestResolution.credentialsSecret.name: invalid name ""`,
+	}, {
+		name:       "invalid name",
+		resolution: &base.DigestResolution{Enabled: true, CredentialsSecret: &corev1.LocalObjectReference{Name: "Registry_Credentials"}},
+		wantErr:    `spec.registry.digestResolution.credentialsSecret.name: invalid name "Registry_Credentials"`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Registry: base.Registry{DigestResolution: test.resolution}},
+				},
+			}
+			err := validateRegistry(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateRegistry() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateRegistry() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRegistryRewrites(t *testing.T) {
+	tests := []struct {
+		name     string
+		rewrites []base.ImageRewrite
+		wantErr  string
+	}{{
+		name:     "wildcard",
+		rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/*", To: "registry.corp/knative/*"}},
+	}, {
+		name:     "repository",
+		rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/knative.dev/serving/cmd/queue", To: "registry.corp/queue"}},
+	}, {
+		name:     "empty from",
+		rewrites: []base.ImageRewrite{{From: "*", To: "registry.corp/knative/*"}},
+		wantErr:  "spec.registry.rewrites[0].from: must not be empty",
+	}, {
+		name:     "empty to",
+		rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/knative.dev/serving/cmd/queue"}},
+		wantErr:  "spec.registry.rewrites[0].to: must not be empty",
+	}, {
+		name:     "inner wildcard",
+		rewrites: []base.ImageRewrite{{From: "gcr.io/*/knative.dev/*", To: "registry.corp/knative/*"}},
+		wantErr:  `spec.registry.rewrites[0].from: the wildcard is only allowed at the end of "gcr.io/*/knative.dev/*"`,
+	}, {
+		name:     "one wildcard",
+		rewrites: []base.ImageRewrite{{From: "gcr.io/knative-releases/*", To: "registry.corp/knative"}},
+		wantErr:  "spec.registry.rewrites[0]: either both or none",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec: base.CommonSpec{Registry: base.Registry{Rewrites: test.rewrites}},
+				},
+			}
+			err := validateRegistry(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateRegistry() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateRegistry() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
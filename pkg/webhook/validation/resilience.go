@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// resilienceWarnings returns a warning, when the zonal resilience profile is selected, but the nodes
+// of the cluster of the client span less than two zones, so that the components don't survive the
+// outage of a zone. The nodes are not checked without a client, or for another target cluster.
+func resilienceWarnings(ctx context.Context, client kubernetes.Interface, instance base.KComponent) []string {
+	if instance.GetSpec().GetResilience() != base.ResilienceZonal || client == nil || instance.GetSpec().GetTargetCluster() != nil {
+		return nil
+	}
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil || len(nodes.Items) == 0 {
+		return nil
+	}
+	if zones := topologyDomains(nodes.Items, corev1.LabelTopologyZone); zones < 2 {
+		return []string{fmt.Sprintf("spec.resilience is zonal, but the nodes span %d zones of the node label %s, the components don't survive the outage of a zone",
+			zones, corev1.LabelTopologyZone)}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestResilienceWarnings(t *testing.T) {
+	node := func(name, zone string) runtime.Object {
+		labels := map[string]string{corev1.LabelHostname: name}
+		if zone != "" {
+			labels[corev1.LabelTopologyZone] = zone
+		}
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+
+	tests := []struct {
+		name       string
+		resilience base.Resilience
+		nodes      []runtime.Object
+		expected   []string
+	}{{
+		name:  "no resilience",
+		nodes: []runtime.Object{node("a", "zone-1")},
+	}, {
+		name:       "none",
+		resilience: base.ResilienceNone,
+		nodes:      []runtime.Object{node("a", "zone-1")},
+	}, {
+		name:       "zones",
+		resilience: base.ResilienceZonal,
+		nodes:      []runtime.Object{node("a", "zone-1"), node("b", "zone-2")},
+	}, {
+		name:       "single zone",
+		resilience: base.ResilienceZonal,
+		nodes:      []runtime.Object{node("a", "zone-1"), node("b", "zone-1")},
+		expected: []string{
+			"spec.resilience is zonal, but the nodes span 1 zones of the node label topology.kubernetes.io/zone, the components don't survive the outage of a zone",
+		},
+	}, {
+		name:       "no zone labels",
+		resilience: base.ResilienceZonal,
+		nodes:      []runtime.Object{node("a", ""), node("b", "")},
+		expected: []string{
+			"spec.resilience is zonal, but the nodes span 0 zones of the node label topology.kubernetes.io/zone, the components don't survive the outage of a zone",
+		},
+	}, {
+		name:       "no nodes",
+		resilience: base.ResilienceZonal,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Resilience: test.resilience}},
+			}
+			got := resilienceWarnings(context.Background(), kubefake.NewSimpleClientset(test.nodes...), ks)
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("resilienceWarnings() (-want, +got): %s", diff)
+			}
+		})
+	}
+}
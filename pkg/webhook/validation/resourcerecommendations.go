@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// validateResourceRecommendations checks, that the bounds of spec.resourceRecommendations only
+// limit the CPU and the memory, and that none of the lowest requests exceeds the highest one.
+func validateResourceRecommendations(instance base.KComponent) error {
+	config := instance.GetSpec().GetResourceRecommendations()
+	if config == nil {
+		return nil
+	}
+	var errs []error
+	for field, bounds := range map[string]corev1.ResourceList{"minAllowed": config.MinAllowed, "maxAllowed": config.MaxAllowed} {
+		for name := range bounds {
+			if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+				errs = append(errs, fmt.Errorf("spec.resourceRecommendations.%s.%s: only cpu and memory are recommended", field, name))
+			}
+		}
+	}
+	for name, min := range config.MinAllowed {
+		if max, ok := config.MaxAllowed[name]; ok && min.Cmp(max) > 0 {
+			errs = append(errs, fmt.Errorf("spec.resourceRecommendations.minAllowed.%s: %s exceeds maxAllowed %s", name, min.String(), max.String()))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+		return fmt.Errorf("invalid resource recommendations: %w", errors.Join(errs...))
+	}
+	return nil
+}
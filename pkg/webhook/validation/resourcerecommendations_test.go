@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestValidateResourceRecommendations(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *base.ResourceRecommendations
+		wantErr string
+	}{{
+		name: "no recommendations",
+	}, {
+		name: "valid",
+		config: &base.ResourceRecommendations{
+			Enabled:    true,
+			MinAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+			MaxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		},
+	}, {
+		name: "min exceeds max",
+		config: &base.ResourceRecommendations{
+			Enabled:    true,
+			MinAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			MaxAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		},
+		wantErr: "spec.resourceRecommendations.minAllowed.memory: 2Gi exceeds maxAllowed 1Gi",
+	}, {
+		name: "other resource",
+		config: &base.ResourceRecommendations{
+			Enabled:    true,
+			MaxAllowed: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+		},
+		wantErr: "spec.resourceRecommendations.maxAllowed.ephemeral-storage: only cpu and memory are recommended",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{ResourceRecommendations: test.config}},
+			}
+			err := validateResourceRecommendations(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateResourceRecommendations() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateResourceRecommendations() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// queueProxyResources are the resources of the queue-proxy, which config-deployment can set.
+var queueProxyResources = map[corev1.ResourceName]bool{
+	corev1.ResourceCPU:              true,
+	corev1.ResourceMemory:           true,
+	corev1.ResourceEphemeralStorage: true,
+}
+
+// validateRevisionDeployments checks spec.revisionDeployments of a KnativeServing: the image of the
+// queue-proxy has to be a plain reference, its resources the ones config-deployment knows, with
+// requests not above their limits, and the registries valid host names. A field can't be combined
+// with its key in spec.config.
+func validateRevisionDeployments(ks *v1beta1.KnativeServing) error {
+	deployments := ks.Spec.RevisionDeployments
+	if deployments == nil {
+		return nil
+	}
+	var errs []error
+	fields := map[string]string{}
+	if qp := deployments.QueueProxy; qp != nil {
+		if qp.Image != "" {
+			fields["queueProxy.image"] = "queue-sidecar-image"
+			if strings.ContainsAny(qp.Image, " \t\n") || strings.HasPrefix(qp.Image, "/") || strings.HasSuffix(qp.Image, ":") {
+				errs = append(errs, fmt.Errorf("spec.revisionDeployments.queueProxy.image: invalid image %q", qp.Image))
+			}
+		}
+		if r := qp.Resources; r != nil {
+			if len(r.Claims) > 0 {
+				errs = append(errs, errors.New("spec.revisionDeployments.queueProxy.resources.claims: not supported"))
+			}
+			for _, kind := range []struct {
+				field  string
+				suffix string
+				list   corev1.ResourceList
+			}{{"requests", "-request", r.Requests}, {"limits", "-limit", r.Limits}} {
+				for _, name := range sortedResources(kind.list) {
+					if !queueProxyResources[name] {
+						errs = append(errs, fmt.Errorf("spec.revisionDeployments.queueProxy.resources.%s.%s: not supported, only cpu, memory and ephemeral-storage", kind.field, name))
+						continue
+					}
+					fields[fmt.Sprintf("queueProxy.resources.%s.%s", kind.field, name)] = "queue-sidecar-" + string(name) + kind.suffix
+				}
+			}
+			for _, name := range sortedResources(r.Requests) {
+				request := r.Requests[name]
+				if limit, ok := r.Limits[name]; ok && request.Cmp(limit) > 0 {
+					errs = append(errs, fmt.Errorf("spec.revisionDeployments.queueProxy.resources.requests.%s: must not exceed the limit %s, got %s", name, limit.String(), request.String()))
+				}
+			}
+		}
+	}
+	if d := deployments.ProgressDeadline; d != nil {
+		fields["progressDeadline"] = "progress-deadline"
+		if d.Duration <= 0 {
+			errs = append(errs, fmt.Errorf("spec.revisionDeployments.progressDeadline: must be positive, got %v", d.Duration))
+		}
+	}
+	if len(deployments.RegistriesSkippingTagResolving) > 0 {
+		fields["registriesSkippingTagResolving"] = "registries-skipping-tag-resolving"
+		for i, registry := range deployments.RegistriesSkippingTagResolving {
+			// A registry may have a port, e.g. localhost:5000.
+			host, _, _ := strings.Cut(registry, ":")
+			if msgs := k8svalidation.IsDNS1123Subdomain(host); len(msgs) > 0 {
+				errs = append(errs, fmt.Errorf("spec.revisionDeployments.registriesSkippingTagResolving[%d]: invalid registry %q: %s", i, registry, strings.Join(msgs, ", ")))
+			}
+		}
+	}
+
+	config := ks.Spec.GetConfig()
+	for _, field := range sortedKeys(fields) {
+		for _, name := range []string{"deployment", "config-deployment"} {
+			if _, ok := config[name][fields[field]]; ok {
+				errs = append(errs, fmt.Errorf("spec.revisionDeployments.%s: can't be combined with spec.config.%s.%s", field, name, fields[field]))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid revision deployment configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func sortedResources(list corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
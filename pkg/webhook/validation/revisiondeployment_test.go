@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestValidateRevisionDeployments(t *testing.T) {
+	tests := []struct {
+		name        string
+		deployments *base.RevisionDeploymentConfiguration
+		config      base.ConfigMapData
+		wantErr     string
+	}{{
+		name: "no revision deployments",
+	}, {
+		name: "valid",
+		deployments: &base.RevisionDeploymentConfiguration{
+			QueueProxy: &base.QueueProxyConfiguration{
+				Image: "registry.example.com:5000/knative/queue@sha256:d479c6879b4e5429745f96026679e6267e7b5a8cfc7dc8e334a64d4241cd5c1a",
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+					Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+			ProgressDeadline:               &metav1.Duration{Duration: 10 * time.Minute},
+			RegistriesSkippingTagResolving: []string{"kind.local", "localhost:5000"},
+		},
+		config: base.ConfigMapData{"deployment": {"digest-resolution-timeout": "20s"}},
+	}, {
+		name:        "invalid image",
+		deployments: &base.RevisionDeploymentConfiguration{QueueProxy: &base.QueueProxyConfiguration{Image: "registry.example.com/queue:"}},
+		wantErr:     `spec.revisionDeployments.queueProxy.image: invalid image "registry.example.com/queue:"`,
+	}, {
+		name: "unsupported resource",
+		deployments: &base.RevisionDeploymentConfiguration{QueueProxy: &base.QueueProxyConfiguration{
+			Resources: &corev1.ResourceRequirements{Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}},
+		}},
+		wantErr: "spec.revisionDeployments.queueProxy.resources.limits.nvidia.com/gpu: not supported",
+	}, {
+		name: "request above limit",
+		deployments: &base.RevisionDeploymentConfiguration{QueueProxy: &base.QueueProxyConfiguration{
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+		}},
+		wantErr: "spec.revisionDeployments.queueProxy.resources.requests.memory: must not exceed the limit 1Gi, got 2Gi",
+	}, {
+		name:        "invalid registry",
+		deployments: &base.RevisionDeploymentConfiguration{RegistriesSkippingTagResolving: []string{"kind.local,ko.local"}},
+		wantErr:     `spec.revisionDeployments.registriesSkippingTagResolving[0]: invalid registry "kind.local,ko.local"`,
+	}, {
+		name:        "key in spec.config",
+		deployments: &base.RevisionDeploymentConfiguration{QueueProxy: &base.QueueProxyConfiguration{Image: "registry.example.com/queue"}},
+		config:      base.ConfigMapData{"deployment": {"queue-sidecar-image": "other.example.com/queue"}},
+		wantErr:     "spec.revisionDeployments.queueProxy.image: can't be combined with spec.config.deployment.queue-sidecar-image",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{
+					CommonSpec:          base.CommonSpec{Config: test.config},
+					RevisionDeployments: test.deployments,
+				},
+			}
+			err := validateRevisionDeployments(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateRevisionDeployments() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateRevisionDeployments() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/pkg/reconciler/knativeeventing/source"
+)
+
+// validateSources checks, that the operator ships the bundles of spec.source.bundles for the target
+// version. The bundles of custom manifests are not checked, they may be part of spec.manifests.
+func validateSources(ke *v1beta1.KnativeEventing) error {
+	if ke.Spec.Source == nil || len(ke.Spec.Source.Bundles) == 0 || len(ke.Spec.GetManifests()) > 0 {
+		return nil
+	}
+	version := common.TargetVersion(ke)
+	available := source.Bundles(version)
+	var errs []error
+	for i, name := range ke.Spec.Source.Bundles {
+		if !slices.Contains(available, name) {
+			errs = append(errs, fmt.Errorf("spec.source.bundles[%d]: unknown bundle %q, the bundles of version %s are %v", i, name, version, available))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid sources: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// validateBundles checks, that the operator ships the bundles of the field for the target version.
+// The optional ones may be in spec.additionalManifests instead, and nothing is checked with custom
+// manifests.
+func validateBundles(ke *v1beta1.KnativeEventing, field string, names []string) []error {
+	if len(ke.Spec.GetManifests()) > 0 {
+		return nil
+	}
+	version := common.TargetVersion(ke)
+	available := source.Bundles(version)
+	var errs []error
+	for _, name := range names {
+		if slices.Contains(available, name) || (source.OptionalBundle(name) && len(ke.Spec.GetAdditionalManifests()) > 0) {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s: the operator ships no %s bundle for version %s, add its manifests to spec.additionalManifests", field, name, version))
+	}
+	return errs
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateSources(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+
+	tests := []struct {
+		name      string
+		source    *v1beta1.SourceConfigs
+		manifests []base.Manifest
+		wantErr   string
+	}{{
+		name: "no sources",
+	}, {
+		name:   "shipped bundles",
+		source: &v1beta1.SourceConfigs{Bundles: []string{"kafka", "redis"}},
+	}, {
+		name:    "unknown bundle",
+		source:  &v1beta1.SourceConfigs{Bundles: []string{"kafka", "awssqs"}},
+		wantErr: `spec.source.bundles[1]: unknown bundle "awssqs", the bundles of version 1.21.0 are [kafka redis]`,
+	}, {
+		name:    "path",
+		source:  &v1beta1.SourceConfigs{Bundles: []string{"../kafka"}},
+		wantErr: `spec.source.bundles[0]: unknown bundle "../kafka"`,
+	}, {
+		name:      "custom manifests",
+		source:    &v1beta1.SourceConfigs{Bundles: []string{"awssqs"}},
+		manifests: []base.Manifest{{Url: "https://example.com/awssqs.yaml"}},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Version: "1.21.0", Manifests: test.manifests},
+					Source:     test.source,
+				},
+			}
+			err := validateSources(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateSources() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateSources() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
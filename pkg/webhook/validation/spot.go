@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+)
+
+// validateSpot checks spec.spot: the spot nodes have to be selected by valid labels, as the
+// on-demand deployments would be kept off no node otherwise.
+func validateSpot(instance base.KComponent) error {
+	spot := instance.GetSpec().GetSpot()
+	if spot == nil || !spot.Enabled {
+		return nil
+	}
+	if len(spot.NodeSelector) == 0 {
+		return errors.New("invalid spot configuration: spec.spot.nodeSelector: required when enabled")
+	}
+	var errs []error
+	for _, key := range sortedKeys(spot.NodeSelector) {
+		for _, msg := range validation.IsQualifiedName(key) {
+			errs = append(errs, fmt.Errorf("spec.spot.nodeSelector.%s: %s", key, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(spot.NodeSelector[key]) {
+			errs = append(errs, fmt.Errorf("spec.spot.nodeSelector.%s: %s", key, msg))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid spot configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
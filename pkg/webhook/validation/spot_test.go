@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestValidateSpot(t *testing.T) {
+	tests := []struct {
+		name    string
+		spot    *base.SpotConfiguration
+		wantErr string
+	}{{
+		name: "no spot",
+	}, {
+		name: "disabled",
+		spot: &base.SpotConfiguration{},
+	}, {
+		name: "valid",
+		spot: &base.SpotConfiguration{Enabled: true, NodeSelector: map[string]string{"cloud.google.com/gke-spot": "true"}},
+	}, {
+		name:    "no node selector",
+		spot:    &base.SpotConfiguration{Enabled: true},
+		wantErr: "spec.spot.nodeSelector: required when enabled",
+	}, {
+		name:    "invalid label",
+		spot:    &base.SpotConfiguration{Enabled: true, NodeSelector: map[string]string{"spot/": "true"}},
+		wantErr: "spec.spot.nodeSelector.spot/:",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks := &v1beta1.KnativeServing{
+				Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Spot: test.spot}},
+			}
+			err := validateSpot(ks)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateSpot() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateSpot() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	kec "knative.dev/operator/pkg/reconciler/knativeeventing/common"
+)
+
+// validateSugar checks spec.sugar of a KnativeEventing: the selectors have to be valid label
+// selectors, and can't be combined with the same keys of config-sugar in spec.config.
+func validateSugar(ke *v1beta1.KnativeEventing) error {
+	sugar := ke.Spec.Sugar
+	if sugar == nil {
+		return nil
+	}
+	var errs []error
+	for _, selector := range []struct {
+		field    string
+		key      string
+		selector *metav1.LabelSelector
+	}{
+		{"spec.sugar.namespaceSelector", kec.NamespaceSelectorKey, sugar.NamespaceSelector},
+		{"spec.sugar.triggerSelector", kec.TriggerSelectorKey, sugar.TriggerSelector},
+	} {
+		if selector.selector == nil {
+			continue
+		}
+		if _, err := metav1.LabelSelectorAsSelector(selector.selector); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", selector.field, err))
+		}
+		for _, name := range []string{"sugar", kec.SugarConfigMapName} {
+			if _, ok := ke.Spec.GetConfig()[name][selector.key]; ok {
+				errs = append(errs, fmt.Errorf("%s: can't be combined with spec.config.%s.%s", selector.field, name, selector.key))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid sugar configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
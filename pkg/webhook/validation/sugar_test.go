@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestValidateSugar(t *testing.T) {
+	tests := []struct {
+		name    string
+		sugar   *base.SugarConfiguration
+		config  base.ConfigMapData
+		wantErr string
+	}{{
+		name: "no sugar",
+	}, {
+		name: "valid",
+		sugar: &base.SugarConfiguration{
+			NamespaceSelector: &metav1.LabelSelector{},
+			TriggerSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"a", "b"}},
+			}},
+		},
+		config: base.ConfigMapData{"sugar": {"_example": ""}},
+	}, {
+		name: "invalid selector",
+		sugar: &base.SugarConfiguration{TriggerSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "team", Operator: metav1.LabelSelectorOpIn},
+		}}},
+		wantErr: "spec.sugar.triggerSelector:",
+	}, {
+		name:    "selector in spec.config",
+		sugar:   &base.SugarConfiguration{NamespaceSelector: &metav1.LabelSelector{}},
+		config:  base.ConfigMapData{"config-sugar": {"namespace-selector": "{}"}},
+		wantErr: "spec.sugar.namespaceSelector: can't be combined with spec.config.config-sugar.namespace-selector",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec: base.CommonSpec{Config: test.config},
+					Sugar:      test.sugar,
+				},
+			}
+			err := validateSugar(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateSugar() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateSugar() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
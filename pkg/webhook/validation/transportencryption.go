@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+// validateTransportEncryption checks spec.transportEncryption of a KnativeEventing: the mode has to
+// be one of config-features, and can't be combined with the transport-encryption of spec.features
+// or spec.config. The self-signed CA of the operator can't be configured with an issuer of its own,
+// whose CA the trust bundle has to name instead.
+func validateTransportEncryption(ke *v1beta1.KnativeEventing) error {
+	encryption := ke.Spec.TransportEncryption
+	if encryption == nil {
+		return nil
+	}
+	var errs []error
+	valid := false
+	for _, mode := range []feature.Flag{feature.Disabled, feature.Permissive, feature.Strict} {
+		valid = valid || strings.EqualFold(encryption.Mode, string(mode))
+	}
+	if !valid {
+		errs = append(errs, fmt.Errorf("spec.transportEncryption.mode: must be disabled, permissive or strict, got %q", encryption.Mode))
+	}
+	if _, ok := ke.Spec.GetFeatures()[feature.TransportEncryption]; ok {
+		errs = append(errs, fmt.Errorf("spec.transportEncryption.mode: can't be combined with spec.features.%s", feature.TransportEncryption))
+	}
+	for _, name := range []string{"features", "config-features"} {
+		if _, ok := ke.Spec.GetConfig()[name][feature.TransportEncryption]; ok {
+			errs = append(errs, fmt.Errorf("spec.transportEncryption.mode: can't be combined with spec.config.%s.%s", name, feature.TransportEncryption))
+		}
+	}
+
+	if ref := encryption.IssuerRef; ref != nil {
+		if ref.Name == "" {
+			errs = append(errs, errors.New("spec.transportEncryption.issuerRef.name: is required"))
+		}
+		switch ref.Kind {
+		case "", "ClusterIssuer", "Issuer":
+		default:
+			errs = append(errs, fmt.Errorf("spec.transportEncryption.issuerRef.kind: must be ClusterIssuer or Issuer, got %q", ref.Kind))
+		}
+		if encryption.CA != nil {
+			errs = append(errs, errors.New("spec.transportEncryption.ca: can't be combined with spec.transportEncryption.issuerRef"))
+		}
+	}
+	if ca := encryption.CA; ca != nil {
+		if ca.Duration != nil && ca.Duration.Duration <= 0 {
+			errs = append(errs, errors.New("spec.transportEncryption.ca.duration: must be positive"))
+		}
+		if ca.RenewBefore != nil && ca.RenewBefore.Duration <= 0 {
+			errs = append(errs, errors.New("spec.transportEncryption.ca.renewBefore: must be positive"))
+		}
+		if ca.Duration != nil && ca.RenewBefore != nil && ca.RenewBefore.Duration >= ca.Duration.Duration {
+			errs = append(errs, errors.New("spec.transportEncryption.ca.renewBefore: must be shorter than the duration"))
+		}
+	}
+
+	if bundle := encryption.TrustBundle; bundle != nil {
+		if bundle.Source == nil && encryption.IssuerRef != nil {
+			errs = append(errs, errors.New("spec.transportEncryption.trustBundle.source: is required with spec.transportEncryption.issuerRef"))
+		}
+		if bundle.Source != nil && bundle.Source.SecretName == "" {
+			errs = append(errs, errors.New("spec.transportEncryption.trustBundle.source.secretName: is required"))
+		}
+		if bundle.NamespaceSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(bundle.NamespaceSelector); err != nil {
+				errs = append(errs, fmt.Errorf("spec.transportEncryption.trustBundle.namespaceSelector: %w", err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid transport encryption configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
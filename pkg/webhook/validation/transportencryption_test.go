@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+)
+
+func TestValidateTransportEncryption(t *testing.T) {
+	issuer := &base.CertManagerIssuerRef{Name: "corporate"}
+	tests := []struct {
+		name       string
+		encryption *base.TransportEncryptionConfiguration
+		features   map[string]string
+		config     base.ConfigMapData
+		wantErr    string
+	}{{
+		name: "no transport encryption",
+	}, {
+		name: "self-signed CA",
+		encryption: &base.TransportEncryptionConfiguration{
+			Mode:        "Strict",
+			CA:          &base.TransportEncryptionCAConfiguration{Duration: &metav1.Duration{Duration: 48 * time.Hour}, RenewBefore: &metav1.Duration{Duration: time.Hour}},
+			TrustBundle: &base.EventingTrustBundleConfiguration{},
+		},
+	}, {
+		name: "own issuer",
+		encryption: &base.TransportEncryptionConfiguration{
+			Mode:        "permissive",
+			IssuerRef:   &base.CertManagerIssuerRef{Kind: "Issuer", Name: "corporate"},
+			TrustBundle: &base.EventingTrustBundleConfiguration{Source: &base.TrustBundleConfiguration{SecretName: "corporate-ca"}},
+		},
+	}, {
+		name:       "unknown mode",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "enabled"},
+		wantErr:    `spec.transportEncryption.mode: must be disabled, permissive or strict, got "enabled"`,
+	}, {
+		name:       "mode in spec.features",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict"},
+		features:   map[string]string{"transport-encryption": "strict"},
+		wantErr:    "spec.transportEncryption.mode: can't be combined with spec.features.transport-encryption",
+	}, {
+		name:       "mode in spec.config",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict"},
+		config:     base.ConfigMapData{"config-features": {"transport-encryption": "strict"}},
+		wantErr:    "spec.transportEncryption.mode: can't be combined with spec.config.config-features.transport-encryption",
+	}, {
+		name:       "issuer without a name",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict", IssuerRef: &base.CertManagerIssuerRef{}},
+		wantErr:    "spec.transportEncryption.issuerRef.name: is required",
+	}, {
+		name:       "unknown issuer kind",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict", IssuerRef: &base.CertManagerIssuerRef{Kind: "Vault", Name: "corporate"}},
+		wantErr:    `spec.transportEncryption.issuerRef.kind: must be ClusterIssuer or Issuer, got "Vault"`,
+	}, {
+		name:       "CA with an issuer",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict", IssuerRef: issuer, CA: &base.TransportEncryptionCAConfiguration{}},
+		wantErr:    "spec.transportEncryption.ca: can't be combined with spec.transportEncryption.issuerRef",
+	}, {
+		name: "renewal after the expiry",
+		encryption: &base.TransportEncryptionConfiguration{
+			Mode: "strict",
+			CA:   &base.TransportEncryptionCAConfiguration{Duration: &metav1.Duration{Duration: time.Hour}, RenewBefore: &metav1.Duration{Duration: 2 * time.Hour}},
+		},
+		wantErr: "spec.transportEncryption.ca.renewBefore: must be shorter than the duration",
+	}, {
+		name:       "trust bundle of an issuer without a source",
+		encryption: &base.TransportEncryptionConfiguration{Mode: "strict", IssuerRef: issuer, TrustBundle: &base.EventingTrustBundleConfiguration{}},
+		wantErr:    "spec.transportEncryption.trustBundle.source: is required with spec.transportEncryption.issuerRef",
+	}, {
+		name: "invalid namespace selector",
+		encryption: &base.TransportEncryptionConfiguration{
+			Mode: "strict",
+			TrustBundle: &base.EventingTrustBundleConfiguration{NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "eventing", Operator: "Like"}},
+			}},
+		},
+		wantErr: "spec.transportEncryption.trustBundle.namespaceSelector:",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ke := &v1beta1.KnativeEventing{
+				Spec: v1beta1.KnativeEventingSpec{
+					CommonSpec:          base.CommonSpec{Config: test.config, Features: test.features},
+					TransportEncryption: test.encryption,
+				},
+			}
+			err := validateTransportEncryption(ke)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateTransportEncryption() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateTransportEncryption() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/pkg/reconciler/knativeeventing/source"
+	"knative.dev/operator/pkg/reconciler/knativeserving/ingress"
+)
+
+// validateVersionOverrides checks spec.versionOverrides: every key has to name a component, which
+// can be pinned, and its version has to be shipped by the operator and within the skew, which the
+// component supports to the target version.
+func validateVersionOverrides(instance base.KComponent) error {
+	overrides := instance.GetSpec().GetVersionOverrides()
+	if len(overrides) == 0 {
+		return nil
+	}
+	target := common.TargetVersion(instance)
+	var errs []error
+	for _, name := range sortedKeys(overrides) {
+		field := "spec.versionOverrides." + name
+		version := overrides[name]
+		skew, path, err := versionSkew(instance, name, version)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+			continue
+		}
+		// No skew is known to a target version of latest.
+		if !strings.EqualFold(target, common.LATEST_VERSION) {
+			if err := skew.Check(version, target); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", field, err))
+				continue
+			}
+		}
+		if _, err := os.Stat(path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: the operator ships no %s manifests for version %s", field, name, version))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid version overrides: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// versionSkew returns the skew of the named component of the Knative component, and the path of
+// its manifests in the version.
+func versionSkew(instance base.KComponent, name, version string) (common.VersionSkew, string, error) {
+	if !semver.IsValid(common.SanitizeSemver(version)) {
+		return common.VersionSkew{}, "", fmt.Errorf("invalid version %q", version)
+	}
+	switch kind := instance.GroupVersionKind().Kind; kind {
+	case operator.KindKnativeServing, operator.KindKnativeNetworking:
+		skew, ok := ingress.VersionSkews[name]
+		if !ok {
+			names := make([]string, 0, len(ingress.VersionSkews))
+			for name := range ingress.VersionSkews {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			return common.VersionSkew{}, "", fmt.Errorf("unknown component %q, the components are %v", name, names)
+		}
+		return skew, ingress.Path(version, name), nil
+	case operator.KindKnativeEventing:
+		return source.VersionSkew, source.Path(version, name), nil
+	default:
+		return common.VersionSkew{}, "", fmt.Errorf("%s has no components, which can be pinned", kind)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateVersionOverrides(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+
+	spec := func(version string, overrides map[string]string) base.CommonSpec {
+		return base.CommonSpec{Version: version, VersionOverrides: overrides}
+	}
+	tests := []struct {
+		name      string
+		component base.KComponent
+		wantErr   string
+	}{{
+		name:      "no overrides",
+		component: &v1beta1.KnativeServing{},
+	}, {
+		name: "ingresses",
+		component: &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"istio": "1.19", "kourier": "1.20.1"}),
+		}},
+	}, {
+		name: "networking",
+		component: &v1beta1.KnativeNetworking{Spec: v1beta1.KnativeNetworkingSpec{
+			CommonSpec: spec("1.21", map[string]string{"istio": "1.19"}),
+		}},
+	}, {
+		name: "bundle",
+		component: &v1beta1.KnativeEventing{Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"kafka": "1.20"}),
+		}},
+	}, {
+		name: "latest",
+		component: &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: spec("latest", map[string]string{"istio": "1.19"}),
+		}},
+	}, {
+		name: "unknown ingress",
+		component: &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"ambassador": "1.20"}),
+		}},
+		wantErr: `spec.versionOverrides.ambassador: unknown component "ambassador", the components are [contour gateway-api istio kourier]`,
+	}, {
+		name: "invalid version",
+		component: &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"istio": "1.x"}),
+		}},
+		wantErr: `spec.versionOverrides.istio: invalid version "1.x"`,
+	}, {
+		name: "major version only",
+		component: &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"istio": "1"}),
+		}},
+		wantErr: "spec.versionOverrides.istio: version 1 is not in the format major.minor[.patch]",
+	}, {
+		name: "too far behind",
+		component: &v1beta1.KnativeEventing{Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"kafka": "1.19"}),
+		}},
+		wantErr: "spec.versionOverrides.kafka: version 1.19 is more than 1 minor versions behind 1.21.0",
+	}, {
+		name: "ahead",
+		component: &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: spec("1.20.0", map[string]string{"kourier": "1.21"}),
+		}},
+		wantErr: "spec.versionOverrides.kourier: version 1.21 is ahead of 1.20.0",
+	}, {
+		name: "not shipped",
+		component: &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"contour": "1.20"}),
+		}},
+		wantErr: "spec.versionOverrides.contour: the operator ships no contour manifests for version 1.20",
+	}, {
+		name: "functions",
+		component: &v1beta1.KnativeFunctions{Spec: v1beta1.KnativeFunctionsSpec{
+			CommonSpec: spec("1.21.0", map[string]string{"tekton": "1.20"}),
+		}},
+		wantErr: "spec.versionOverrides.tekton: KnativeFunctions has no components, which can be pinned",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateVersionOverrides(test.component)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateVersionOverrides() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateVersionOverrides() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"knative.dev/operator/pkg/apis/operator"
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// skewPeerKind returns the kind of the components, whose versions have to be in skew with the
+// components of the kind, or an empty string.
+func skewPeerKind(kind string) string {
+	switch kind {
+	case operator.KindKnativeServing:
+		return operator.KindKnativeEventing
+	case operator.KindKnativeEventing:
+		return operator.KindKnativeServing
+	}
+	return ""
+}
+
+// validateVersionSkew checks the target version of a KnativeServing or a KnativeEventing against the
+// one of its peer in the same cluster. A skew is returned as a warning, unless VERSION_SKEW_POLICY
+// blocks it. Only a changed target version is blocked, so that the other settings of a component
+// out of the skew can still be updated.
+func validateVersionSkew(old, component base.KComponent, peers []base.KComponent) (string, error) {
+	peer := common.PeerComponent(component, peers)
+	if peer == nil {
+		return "", nil
+	}
+	err := common.CheckServingEventingSkew(component, peer)
+	if err == nil {
+		return "", nil
+	}
+	changed := old == nil || common.TargetVersion(old) != common.TargetVersion(component)
+	if common.BlockVersionSkew() && changed {
+		return "", err
+	}
+	return err.Error(), nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+func TestValidateVersionSkew(t *testing.T) {
+	serving := func(version string) base.KComponent {
+		return &v1beta1.KnativeServing{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-serving", Name: "knative-serving"},
+			Spec:       v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Version: version}},
+		}
+	}
+	eventing := func(version string, target *base.TargetCluster) base.KComponent {
+		return &v1beta1.KnativeEventing{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "knative-eventing"},
+			Spec:       v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{Version: version, TargetCluster: target}},
+		}
+	}
+	tests := []struct {
+		name        string
+		policy      string
+		old         base.KComponent
+		component   base.KComponent
+		peers       []base.KComponent
+		wantWarning string
+		wantErr     string
+	}{{
+		name:      "no peer",
+		component: serving("1.21.0"),
+	}, {
+		name:      "in skew",
+		component: serving("1.21.0"),
+		peers:     []base.KComponent{eventing("1.20.0", nil)},
+	}, {
+		name:      "peer in another cluster",
+		policy:    common.VersionSkewBlock,
+		component: serving("1.21.0"),
+		peers:     []base.KComponent{eventing("1.18.0", &base.TargetCluster{SecretName: "spoke"})},
+	}, {
+		name:        "warning",
+		component:   serving("1.21.0"),
+		peers:       []base.KComponent{eventing("1.19.0", nil)},
+		wantWarning: "KnativeEventing knative-eventing/knative-eventing at version 1.19.0 is out of the supported skew to KnativeServing knative-serving/knative-serving at version 1.21.0: version 1.19.0 is more than 1 minor versions behind 1.21.0",
+	}, {
+		name:      "blocked",
+		policy:    common.VersionSkewBlock,
+		component: eventing("1.19.0", nil),
+		peers:     []base.KComponent{serving("1.21.0")},
+		wantErr:   "KnativeEventing knative-eventing/knative-eventing at version 1.19.0 is out of the supported skew to KnativeServing knative-serving/knative-serving at version 1.21.0",
+	}, {
+		name:      "blocked upgrade",
+		policy:    common.VersionSkewBlock,
+		old:       serving("1.20.0"),
+		component: serving("1.22.0"),
+		peers:     []base.KComponent{eventing("1.20.0", nil)},
+		wantErr:   "version 1.20.0 is more than 1 minor versions behind 1.22.0",
+	}, {
+		name:        "unchanged version is not blocked",
+		policy:      common.VersionSkewBlock,
+		old:         serving("1.22.0"),
+		component:   serving("1.22.0"),
+		peers:       []base.KComponent{eventing("1.20.0", nil)},
+		wantWarning: "version 1.20.0 is more than 1 minor versions behind 1.22.0",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(common.VersionSkewPolicyEnvKey, test.policy)
+			warning, err := validateVersionSkew(test.old, test.component, test.peers)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateVersionSkew() = %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("validateVersionSkew() = %v, want an error containing %q", err, test.wantErr)
+			}
+			if (test.wantWarning == "") != (warning == "") || !strings.Contains(warning, test.wantWarning) {
+				t.Errorf("validateVersionSkew() warning = %q, want %q", warning, test.wantWarning)
+			}
+		})
+	}
+}
+
+func TestSkewPeerKind(t *testing.T) {
+	for kind, want := range map[string]string{
+		"KnativeServing":    "KnativeEventing",
+		"KnativeEventing":   "KnativeServing",
+		"KnativeFunctions":  "",
+		"KnativeNetworking": "",
+	} {
+		if got := skewPeerKind(kind); got != want {
+			t.Errorf("skewPeerKind(%s) = %q, want %q", kind, got, want)
+		}
+	}
+}
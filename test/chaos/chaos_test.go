@@ -0,0 +1,105 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"knative.dev/operator/pkg/reconciler/knativeeventing"
+	"knative.dev/operator/pkg/reconciler/knativeserving"
+	"knative.dev/operator/test"
+	"knative.dev/operator/test/client"
+	"knative.dev/operator/test/resources"
+)
+
+// The chaos tests expect the operator to run with the feature gate WatchDriftRepair, otherwise only
+// the deployments and the config maps are restored before the next resync.
+
+// TestKnativeServingDriftRepair deletes and mutates the resources of the KnativeServing at random, and
+// verifies that the operator restores each within the SLO.
+func TestKnativeServingDriftRepair(t *testing.T) {
+	clients := client.Setup(t)
+	names := test.ResourceNames{
+		KnativeServing: test.OperatorName,
+		Namespace:      test.ServingOperatorNamespace,
+	}
+	test.CleanupOnInterrupt(func() { test.TearDown(clients, names) })
+	defer test.TearDown(clients, names)
+
+	if _, err := resources.EnsureKnativeServingExists(clients.KnativeServing(), names); err != nil {
+		t.Fatalf("KnativeServing %q failed to create: %v", names.KnativeServing, err)
+	}
+	resources.AssertKSOperatorCRReadyStatus(t, clients, names)
+
+	perturber := NewPerturber(clients.Dynamic, names.Namespace, knativeserving.Selector, knativeserving.SelectorKey,
+		"KnativeServing", seed(t))
+	assertDriftRepaired(t, perturber)
+	resources.AssertKSOperatorCRReadyStatus(t, clients, names)
+}
+
+// TestKnativeEventingDriftRepair deletes and mutates the resources of the KnativeEventing at random, and
+// verifies that the operator restores each within the SLO.
+func TestKnativeEventingDriftRepair(t *testing.T) {
+	clients := client.Setup(t)
+	names := test.ResourceNames{
+		KnativeEventing: test.OperatorName,
+		Namespace:       test.EventingOperatorNamespace,
+	}
+	test.CleanupOnInterrupt(func() { test.TearDown(clients, names) })
+	defer test.TearDown(clients, names)
+
+	if _, err := resources.EnsureKnativeEventingExists(clients.KnativeEventing(), names); err != nil {
+		t.Fatalf("KnativeEventing %q failed to create: %v", names.KnativeEventing, err)
+	}
+	resources.AssertKEOperatorCRReadyStatus(t, clients, names)
+
+	perturber := NewPerturber(clients.Dynamic, names.Namespace, knativeeventing.Selector, knativeeventing.SelectorKey,
+		"KnativeEventing", seed(t))
+	assertDriftRepaired(t, perturber)
+	resources.AssertKEOperatorCRReadyStatus(t, clients, names)
+}
+
+// seed returns the seed of the flag --chaosseed, or a random one, which is logged to reproduce the run.
+func seed(t *testing.T) int64 {
+	seed := test.OperatorFlags.ChaosSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	t.Logf("Perturbing the resources with --chaosseed=%d", seed)
+	return seed
+}
+
+func assertDriftRepaired(t *testing.T, perturber *Perturber) {
+	t.Helper()
+	results, err := perturber.Run(context.Background(), test.OperatorFlags.ChaosRounds, resources.Interval,
+		test.OperatorFlags.DriftRepairSLO)
+	if err != nil {
+		t.Fatalf("Failed to perturb the resources: %v", err)
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			t.Errorf("%s: %v", result.Perturbation, result.Err)
+			continue
+		}
+		t.Logf("%s: restored in %v", result.Perturbation, result.Duration)
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chaos deletes and mutates the resources installed by the operator at random, and measures
+// how long the drift repair of the operator takes to restore them.
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+// Resources are the resources, whose drift the operator repairs right away with the feature gate
+// WatchDriftRepair. The others are only repaired on the next resync.
+var Resources = []schema.GroupVersionResource{
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Version: "v1", Resource: "configmaps"},
+	{Version: "v1", Resource: "services"},
+	{Version: "v1", Resource: "serviceaccounts"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"},
+	{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+	{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"},
+}
+
+// Action is the way a resource is perturbed.
+type Action string
+
+const (
+	// Delete deletes the resource, which the operator must recreate.
+	Delete Action = "delete"
+	// Mutate changes the value of a label of the resource, which the operator must revert.
+	Mutate Action = "mutate"
+)
+
+// MutatedValue is the value of the labels changed by Mutate.
+const MutatedValue = "chaos"
+
+// Target is a resource installed by the operator, which can be perturbed.
+type Target struct {
+	Resource schema.GroupVersionResource
+	Object   unstructured.Unstructured
+}
+
+// Perturbation is a change of a resource installed by the operator.
+type Perturbation struct {
+	Action    Action
+	Resource  schema.GroupVersionResource
+	Namespace string
+	Name      string
+	// UID is the UID of the resource before it was perturbed.
+	UID types.UID
+	// Label is the label changed by Mutate, and Value its value before.
+	Label string
+	Value string
+}
+
+func (p Perturbation) String() string {
+	if p.Action == Mutate {
+		return fmt.Sprintf("%s label %s of %s %s/%s", p.Action, p.Label, p.Resource.Resource, p.Namespace, p.Name)
+	}
+	return fmt.Sprintf("%s %s %s/%s", p.Action, p.Resource.Resource, p.Namespace, p.Name)
+}
+
+// Result is how long the operator took to restore a resource after a perturbation.
+type Result struct {
+	Perturbation Perturbation
+	Duration     time.Duration
+	// Err is set, if the resource was not restored within the SLO.
+	Err error
+}
+
+// Perturber perturbs the resources of a Knative component in a namespace. The resources are selected
+// by the label selector of the operator and by the kind of their controller, and a seed makes a run
+// reproducible.
+type Perturber struct {
+	client    dynamic.Interface
+	namespace string
+	selector  string
+	ownerKind string
+	protected string
+	rand      *rand.Rand
+}
+
+// NewPerturber returns a Perturber of the resources in the namespace, which match the label selector
+// and are controlled by a resource of the ownerKind, e.g. KnativeServing. The label protected, usually
+// the key of the selector, is never mutated, as the operator would no longer see the resource.
+func NewPerturber(client dynamic.Interface, namespace, selector, protected, ownerKind string, seed int64) *Perturber {
+	return &Perturber{
+		client:    client,
+		namespace: namespace,
+		selector:  selector,
+		ownerKind: ownerKind,
+		protected: protected,
+		rand:      rand.New(rand.NewSource(seed)), //nolint:gosec // A seed to reproduce the run is required, no cryptographic randomness
+	}
+}
+
+// Targets lists the resources, which can be perturbed, sorted by resource and name.
+func (p *Perturber) Targets(ctx context.Context) ([]Target, error) {
+	var targets []Target
+	for _, gvr := range Resources {
+		list, err := p.client.Resource(gvr).Namespace(p.namespace).List(ctx, metav1.ListOptions{LabelSelector: p.selector})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the %s: %w", gvr.Resource, err)
+		}
+		for _, u := range list.Items {
+			if owner := metav1.GetControllerOf(&u); owner == nil || owner.Kind != p.ownerKind {
+				continue
+			}
+			targets = append(targets, Target{Resource: gvr, Object: u})
+		}
+	}
+	sort.SliceStable(targets, func(i, j int) bool {
+		if targets[i].Resource.Resource != targets[j].Resource.Resource {
+			return targets[i].Resource.Resource < targets[j].Resource.Resource
+		}
+		return targets[i].Object.GetName() < targets[j].Object.GetName()
+	})
+	return targets, nil
+}
+
+// Perturb deletes or mutates one of the targets at random. A target without a label to mutate is
+// deleted.
+func (p *Perturber) Perturb(ctx context.Context, targets []Target) (Perturbation, error) {
+	if len(targets) == 0 {
+		return Perturbation{}, errors.New("no resources to perturb")
+	}
+	target := targets[p.rand.Intn(len(targets))]
+	u := target.Object.DeepCopy()
+	perturbation := Perturbation{
+		Action:    Delete,
+		Resource:  target.Resource,
+		Namespace: u.GetNamespace(),
+		Name:      u.GetName(),
+		UID:       u.GetUID(),
+	}
+	client := p.client.Resource(target.Resource).Namespace(u.GetNamespace())
+
+	labels := p.mutableLabels(u)
+	if len(labels) > 0 && p.rand.Intn(2) == 0 {
+		perturbation.Action = Mutate
+		perturbation.Label = labels[p.rand.Intn(len(labels))]
+		perturbation.Value = u.GetLabels()[perturbation.Label]
+		all := u.GetLabels()
+		all[perturbation.Label] = MutatedValue
+		u.SetLabels(all)
+		if _, err := client.Update(ctx, u, metav1.UpdateOptions{}); err != nil {
+			return perturbation, fmt.Errorf("failed to %s: %w", perturbation, err)
+		}
+		return perturbation, nil
+	}
+	if err := client.Delete(ctx, u.GetName(), metav1.DeleteOptions{}); err != nil {
+		return perturbation, fmt.Errorf("failed to %s: %w", perturbation, err)
+	}
+	return perturbation, nil
+}
+
+// mutableLabels returns the sorted keys of the labels, which can be mutated.
+func (p *Perturber) mutableLabels(u *unstructured.Unstructured) []string {
+	var keys []string
+	for key, value := range u.GetLabels() {
+		if key != p.protected && value != MutatedValue {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Restored returns whether the operator restored the resource of the perturbation, i.e. recreated
+// it after Delete, or reverted its label after Mutate.
+func (p *Perturber) Restored(ctx context.Context, perturbation Perturbation) (bool, error) {
+	u, err := p.client.Resource(perturbation.Resource).Namespace(perturbation.Namespace).Get(ctx, perturbation.Name, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if u.GetDeletionTimestamp() != nil {
+		return false, nil
+	}
+	if perturbation.Action == Delete {
+		return u.GetUID() != perturbation.UID, nil
+	}
+	return u.GetLabels()[perturbation.Label] == perturbation.Value, nil
+}
+
+// Run perturbs a target at random for the given number of rounds, one at a time, and waits up to the
+// SLO for the operator to restore it. The targets are listed again in each round, as the recreated
+// resources have new UIDs.
+func (p *Perturber) Run(ctx context.Context, rounds int, interval, slo time.Duration) ([]Result, error) {
+	results := make([]Result, 0, rounds)
+	for i := 0; i < rounds; i++ {
+		targets, err := p.Targets(ctx)
+		if err != nil {
+			return results, err
+		}
+		perturbation, err := p.Perturb(ctx, targets)
+		if err != nil {
+			return results, err
+		}
+		start := time.Now()
+		err = wait.PollUntilContextTimeout(ctx, interval, slo, true, func(ctx context.Context) (bool, error) {
+			return p.Restored(ctx, perturbation)
+		})
+		result := Result{Perturbation: perturbation, Duration: time.Since(start)}
+		if err != nil {
+			result.Err = fmt.Errorf("not restored within %v: %w", slo, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+const (
+	namespace = "knative-serving"
+	selector  = "app.kubernetes.io/name=knative-serving"
+)
+
+func TestPerturber(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(
+		makeResource("ConfigMap", "config-network", "KnativeServing", map[string]string{"app.kubernetes.io/name": "knative-serving", "app.kubernetes.io/version": "1.21.1"}),
+		makeResource("Service", "activator-service", "KnativeServing", map[string]string{"app.kubernetes.io/name": "knative-serving"}),
+		makeResource("Service", "ingress", "KnativeEventing", map[string]string{"app.kubernetes.io/name": "knative-serving"}),
+		makeResource("Service", "user-service", "", map[string]string{"app.kubernetes.io/name": "knative-serving"}),
+	)
+	perturber := NewPerturber(client, namespace, selector, "app.kubernetes.io/name", "KnativeServing", 1)
+
+	targets, err := perturber.Targets(ctx)
+	if err != nil {
+		t.Fatalf("Targets() = %v", err)
+	}
+	util.AssertEqual(t, len(targets), 2)
+	util.AssertEqual(t, targets[0].Object.GetName(), "config-network")
+	util.AssertEqual(t, targets[1].Object.GetName(), "activator-service")
+
+	// The service has no label to mutate, so it is deleted.
+	perturbation, err := perturber.Perturb(ctx, targets[1:])
+	if err != nil {
+		t.Fatalf("Perturb() = %v", err)
+	}
+	util.AssertEqual(t, perturbation.Action, Delete)
+	assertRestored(t, perturber, perturbation, false)
+	recreated := makeResource("Service", "activator-service", "KnativeServing", nil)
+	recreated.SetUID("recreated")
+	if _, err := client.Resource(Resources[2]).Namespace(namespace).Create(ctx, recreated, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	assertRestored(t, perturber, perturbation, true)
+
+	// The protected label of the selector is never mutated. The config map is recreated, until it is
+	// mutated instead of deleted.
+	configMaps := client.Resource(Resources[1]).Namespace(namespace)
+	for {
+		if perturbation, err = perturber.Perturb(ctx, targets[:1]); err != nil {
+			t.Fatalf("Perturb() = %v", err)
+		}
+		if perturbation.Action == Mutate {
+			break
+		}
+		if _, err := configMaps.Create(ctx, &targets[0].Object, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Create() = %v", err)
+		}
+	}
+	util.AssertEqual(t, perturbation.Label, "app.kubernetes.io/version")
+	util.AssertEqual(t, perturbation.Value, "1.21.1")
+	assertRestored(t, perturber, perturbation, false)
+	if _, err := configMaps.Update(ctx, &targets[0].Object, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	assertRestored(t, perturber, perturbation, true)
+}
+
+func TestPerturbNoTargets(t *testing.T) {
+	perturber := NewPerturber(newClient(), namespace, selector, "app.kubernetes.io/name", "KnativeServing", 1)
+	if _, err := perturber.Perturb(context.Background(), nil); err == nil {
+		t.Error("Perturb() = nil, want an error without targets")
+	}
+}
+
+func assertRestored(t *testing.T, perturber *Perturber, perturbation Perturbation, want bool) {
+	t.Helper()
+	restored, err := perturber.Restored(context.Background(), perturbation)
+	if err != nil {
+		t.Fatalf("Restored() = %v", err)
+	}
+	if restored != want {
+		t.Fatalf("Restored(%s) = %v, want %v", perturbation, restored, want)
+	}
+}
+
+func newClient(objs ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for _, gvr := range Resources {
+		listKinds[gvr] = gvr.Resource + "List"
+	}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objs...)
+}
+
+func makeResource(kind, name, ownerKind string, labels map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	u.SetUID(types.UID(kind + "/" + name))
+	u.SetLabels(labels)
+	if ownerKind != "" {
+		controller := true
+		u.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: "operator.knative.dev/v1beta1",
+			Kind:       ownerKind,
+			Name:       "knative",
+			Controller: &controller,
+		}})
+	}
+	return u
+}
@@ -0,0 +1,54 @@
+#!/usr/bin/env bash
+
+# Copyright 2026 The Knative Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This script runs the chaos tests against the Knative Operator built from
+# source, with the feature gate WatchDriftRepair. They delete and mutate the
+# resources of Knative Serving and Eventing at random, and verify that the
+# operator restores each of them within the SLO.
+
+# If you already have a cluster and kubectl pointing to it, call this script
+# with the --run-tests arguments and it will use the cluster and run the tests.
+# Set CHAOS_SEED to the seed logged by a failed run to reproduce it.
+
+# Calling this script without arguments will create a new cluster in
+# project $PROJECT_ID, start knative in it, run the tests and delete the
+# cluster.
+
+export GO111MODULE=on
+
+source "$(dirname "${BASH_SOURCE[0]}")/e2e-common.sh"
+
+function knative_setup() {
+  create_namespace
+  install_operator
+  # WatchDriftRepair only takes effect after a restart of the operator.
+  kubectl -n "${TEST_OPERATOR_NAMESPACE}" patch configmap config-operator --type merge \
+    -p '{"data":{"feature-gates":"WatchDriftRepair=true"}}' || fail_test "Failed to enable WatchDriftRepair"
+  kubectl -n "${TEST_OPERATOR_NAMESPACE}" rollout restart deployment/knative-operator
+  kubectl -n "${TEST_OPERATOR_NAMESPACE}" rollout status deployment/knative-operator --timeout=5m \
+    || fail_test "Operator did not restart"
+}
+
+initialize "$@"
+
+header "Running the chaos tests"
+
+go_test_e2e -tags=e2e -timeout=30m \
+  ./test/chaos \
+  --chaosseed="${CHAOS_SEED:-0}" \
+  || fail_test
+
+success
@@ -0,0 +1,53 @@
+#!/usr/bin/env bash
+
+# Copyright 2026 The Knative Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This script runs the upgrade matrix against the Knative Operator built from
+# source. It installs Knative Serving and Eventing at the version N-2, creates
+# a sample Knative Service, Broker and Trigger, and upgrades through each minor
+# version to HEAD, while the sample workloads must keep serving.
+
+# If you already have a cluster, e.g. a kind cluster, and kubectl pointing
+# to it, call this script with the --run-tests arguments and it will use
+# the cluster and run the tests. On kind, set INGRESS_ENDPOINT to the IP of a
+# node, as the ingress is reached through its node ports. Set UPGRADE_FROM to
+# the minor version to start the upgrades from, instead of N-2.
+
+# Calling this script without arguments will create a new cluster in
+# project $PROJECT_ID, start knative in it, run the tests and delete the
+# cluster.
+
+export GO111MODULE=on
+
+source "$(dirname "${BASH_SOURCE[0]}")/e2e-common.sh"
+
+function knative_setup() {
+  create_namespace
+  install_operator
+}
+
+initialize "$@"
+
+# Each step of the matrix waits for both components to be upgraded.
+TIMEOUT=60m
+
+header "Running the upgrade matrix"
+
+go_test_e2e -tags=upgrade -timeout=${TIMEOUT} \
+  ./test/upgrade/matrix \
+  --ingressendpoint="${INGRESS_ENDPOINT:-}" --upgradefrom="${UPGRADE_FROM:-}" \
+  || fail_test
+
+success
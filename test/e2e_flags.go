@@ -22,6 +22,7 @@ package test
 import (
 	"flag"
 	"os"
+	"time"
 )
 
 var (
@@ -44,8 +45,13 @@ func getenv(name, defaultValue string) string {
 
 // OperatorEnvironmentFlags holds the e2e flags needed only by the operator repo.
 type OperatorEnvironmentFlags struct {
-	PreviousServingVersion  string // Indicates the previous version of Knative Serving.
-	PreviousEventingVersion string // Indicates the previous version of Knative Eventing.
+	PreviousServingVersion  string        // Indicates the previous version of Knative Serving.
+	PreviousEventingVersion string        // Indicates the previous version of Knative Eventing.
+	UpgradeFrom             string        // Indicates the minor version of Knative the upgrade matrix starts from.
+	SampleImage             string        // Indicates the image of the sample Knative Service of the upgrade matrix.
+	ChaosSeed               int64         // Indicates the seed of the chaos tests, random if it is 0.
+	ChaosRounds             int           // Indicates the number of resources the chaos tests perturb.
+	DriftRepairSLO          time.Duration // Indicates the time the operator may take to restore a perturbed resource.
 }
 
 func initializeOperatorFlags() *OperatorEnvironmentFlags {
@@ -55,6 +61,16 @@ func initializeOperatorFlags() *OperatorEnvironmentFlags {
 		"Set this flag to the previous version of Knative Serving.")
 	flag.StringVar(&f.PreviousEventingVersion, "preeventingversion", "",
 		"Set this flag to the previous version of Knative Eventing.")
+	flag.StringVar(&f.UpgradeFrom, "upgradefrom", "",
+		"Set this flag to the minor version of Knative to start the upgrade matrix from. Defaults to N-2.")
+	flag.StringVar(&f.SampleImage, "sampleimage", "ghcr.io/knative/helloworld-go:latest",
+		"Set this flag to the image of the sample Knative Service of the upgrade matrix.")
+	flag.Int64Var(&f.ChaosSeed, "chaosseed", 0,
+		"Set this flag to the seed of the chaos tests to reproduce a run. Defaults to a random seed.")
+	flag.IntVar(&f.ChaosRounds, "chaosrounds", 20,
+		"Set this flag to the number of resources the chaos tests delete or mutate, one at a time.")
+	flag.DurationVar(&f.DriftRepairSLO, "driftrepairslo", 30*time.Second,
+		"Set this flag to the time the operator may take to restore a resource deleted or mutated by the chaos tests.")
 
 	return &f
 }
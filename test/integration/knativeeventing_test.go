@@ -0,0 +1,357 @@
+//go:build envtest
+// +build envtest
+
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+const (
+	// eventingVersion is the version of the KnativeEventings, which install the manifests of the tests.
+	eventingVersion = "1.21.0"
+	// eventingFinalizer is the finalizer of the generated reconciler of the KnativeEventings.
+	eventingFinalizer = "knativeeventings.operator.knative.dev"
+)
+
+func deploymentYAML(name string) string {
+	return fmt.Sprintf(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: %[1]s
+spec:
+  selector:
+    matchLabels:
+      app: %[1]s
+  template:
+    metadata:
+      labels:
+        app: %[1]s
+    spec:
+      containers:
+      - name: controller
+        image: registry.example.com/%[1]s:v1`, name)
+}
+
+func configMapYAML(name string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+data:
+  key: value`, name)
+}
+
+// createEventing creates a KnativeEventing, which installs the manifest instead of the release of
+// Knative Eventing, and deletes it at the end of the test. The pre-flight checks are skipped, because
+// the API server has neither nodes nor access to the registries.
+func createEventing(t *testing.T, namespace, manifest string) *v1beta1.KnativeEventing {
+	t.Helper()
+	ke := &v1beta1.KnativeEventing{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "knative-eventing",
+			Namespace:   namespace,
+			Annotations: map[string]string{base.SkipPreflightAnnotation: "true"},
+		},
+		Spec: v1beta1.KnativeEventingSpec{
+			CommonSpec: base.CommonSpec{
+				Version:   eventingVersion,
+				Manifests: []base.Manifest{{Url: manifest}},
+			},
+		},
+	}
+	kes := operatorClient.OperatorV1beta1().KnativeEventings(namespace)
+	ke, err := kes.Create(context.Background(), ke, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create the KnativeEventing: %v", err)
+	}
+	t.Cleanup(func() {
+		// The finalizer of a KnativeEventing does not uninstall, while others exist, so that the next
+		// test starts without any.
+		if err := kes.Delete(context.Background(), ke.Name, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+			t.Errorf("Failed to delete the KnativeEventing: %v", err)
+		}
+		waitFor(t, "the KnativeEventing to be deleted", func(ctx context.Context) (bool, error) {
+			_, err := kes.Get(ctx, ke.Name, metav1.GetOptions{})
+			return apierrs.IsNotFound(err), nil
+		})
+	})
+	return ke
+}
+
+// waitForEventing waits until the KnativeEventing is in the state.
+func waitForEventing(t *testing.T, ke *v1beta1.KnativeEventing, desc string, inState func(*v1beta1.KnativeEventing) bool) *v1beta1.KnativeEventing {
+	t.Helper()
+	var last *v1beta1.KnativeEventing
+	waitFor(t, desc, func(ctx context.Context) (bool, error) {
+		var err error
+		last, err = operatorClient.OperatorV1beta1().KnativeEventings(ke.Namespace).Get(ctx, ke.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return inState(last), nil
+	})
+	return last
+}
+
+// markDeploymentAvailable sets the status of the deployment to the one of a completed rollout, as the
+// API server runs no deployment controller.
+func markDeploymentAvailable(t *testing.T, namespace, name string) {
+	t.Helper()
+	deployments := kubeClient.AppsV1().Deployments(namespace)
+	waitFor(t, "the deployment "+name+" to be applied", func(ctx context.Context) (bool, error) {
+		d, err := deployments.Get(ctx, name, metav1.GetOptions{})
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		d.Status = appsv1.DeploymentStatus{
+			ObservedGeneration: d.Generation,
+			Replicas:           1,
+			UpdatedReplicas:    1,
+			ReadyReplicas:      1,
+			AvailableReplicas:  1,
+			Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionTrue,
+			}},
+		}
+		_, err = deployments.UpdateStatus(ctx, d, metav1.UpdateOptions{})
+		// The operator may have applied the deployment in between.
+		return err == nil, nil
+	})
+}
+
+// resourceVersion returns the resource version of the resource. The API server takes it from the
+// revision of etcd, so that it orders the writes of a single API server.
+func resourceVersion(t *testing.T, gvr schema.GroupVersionResource, namespace, name string) int64 {
+	t.Helper()
+	client := dynamic.NewForConfigOrDie(restConfig).Resource(gvr)
+	var resource dynamic.ResourceInterface = client
+	if namespace != "" {
+		resource = client.Namespace(namespace)
+	}
+	u, err := resource.Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get the %s %s: %v", gvr.Resource, name, err)
+	}
+	rv, err := strconv.ParseInt(u.GetResourceVersion(), 10, 64)
+	if err != nil {
+		t.Fatalf("Failed to parse the resource version of the %s %s: %v", gvr.Resource, name, err)
+	}
+	return rv
+}
+
+func TestStatusTransitions(t *testing.T) {
+	startOperator(t, "")
+	const namespace = "status-transitions"
+	createNamespace(t, namespace)
+	manifest := writeManifest(t, "manifest.yaml", deploymentYAML("integration-controller"), configMapYAML("config-integration"))
+	ke := createEventing(t, namespace, manifest)
+
+	// The resources are applied, but the deployment never becomes available without a kubelet.
+	got := waitForEventing(t, ke, "the deployments to be unavailable", func(ke *v1beta1.KnativeEventing) bool {
+		return ke.Status.GetCondition(base.DeploymentsAvailable).IsFalse()
+	})
+	util.AssertEqual(t, got.Status.IsReady(), false)
+	util.AssertEqual(t, got.Status.GetCondition(base.VersionMigrationEligible).IsTrue(), true)
+	util.AssertEqual(t, got.Status.GetCondition(base.InstallSucceeded).IsUnknown(), true)
+	util.AssertEqual(t, got.Status.GetVersion(), "")
+	util.AssertEqual(t, got.Status.ObservedGeneration, got.Generation)
+	util.AssertEqual(t, slices.Contains(got.Finalizers, eventingFinalizer), true)
+	if _, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(context.Background(), "config-integration", metav1.GetOptions{}); err != nil {
+		t.Errorf("Failed to get the applied config map: %v", err)
+	}
+
+	markDeploymentAvailable(t, namespace, "integration-controller")
+	got = waitForEventing(t, ke, "the KnativeEventing to be ready", func(ke *v1beta1.KnativeEventing) bool {
+		return ke.Status.IsReady()
+	})
+	util.AssertEqual(t, got.Status.GetCondition(base.InstallSucceeded).IsTrue(), true)
+	util.AssertEqual(t, got.Status.GetCondition(base.DeploymentsAvailable).IsTrue(), true)
+	util.AssertEqual(t, got.Status.GetVersion(), eventingVersion)
+	util.AssertDeepEqual(t, got.Status.GetManifests(), []string{manifest})
+}
+
+func TestApplyOrdering(t *testing.T) {
+	crd := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.integration.knative.dev
+spec:
+  group: integration.knative.dev
+  names:
+    kind: Widget
+    plural: widgets
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        x-kubernetes-preserve-unknown-fields: true`
+	widget := `apiVersion: integration.knative.dev/v1
+kind: Widget
+metadata:
+  name: widget`
+	role := `apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: integration
+rules:
+- apiGroups: [""]
+  resources: ["configmaps"]
+  verbs: ["get"]`
+	roleBinding := `apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: integration
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: integration
+subjects:
+- kind: ServiceAccount
+  name: integration`
+	var (
+		crdGVR         = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+		widgetGVR      = schema.GroupVersionResource{Group: "integration.knative.dev", Version: "v1", Resource: "widgets"}
+		roleGVR        = schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}
+		roleBindingGVR = schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}
+	)
+
+	tests := []struct {
+		name         string
+		featureGates string
+		// documents are the resources of the manifest in their order.
+		documents []string
+	}{{
+		// The resources are applied in their order, after the roles and role bindings.
+		name:      "sequential",
+		documents: []string{roleBinding, crd, widget, role},
+	}, {
+		// The namespaces and CRDs are applied before the other resources.
+		name:         "parallel",
+		featureGates: "ParallelApply=true",
+		documents:    []string{roleBinding, widget, crd, role},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startOperator(t, tt.featureGates)
+			namespace := "apply-ordering-" + tt.name
+			createNamespace(t, namespace)
+			ke := createEventing(t, namespace, writeManifest(t, "manifest.yaml", tt.documents...))
+			waitForEventing(t, ke, "the KnativeEventing to be ready", func(ke *v1beta1.KnativeEventing) bool {
+				return ke.Status.IsReady()
+			})
+
+			if rv := resourceVersion(t, roleGVR, namespace, "integration"); rv > resourceVersion(t, roleBindingGVR, namespace, "integration") {
+				t.Error("The role binding was applied before its role")
+			}
+			if rv := resourceVersion(t, crdGVR, "", "widgets.integration.knative.dev"); rv > resourceVersion(t, widgetGVR, namespace, "widget") {
+				t.Error("The custom resource was applied before its CRD")
+			}
+		})
+	}
+}
+
+func TestGarbageCollection(t *testing.T) {
+	startOperator(t, "")
+	const namespace = "garbage-collection"
+	createNamespace(t, namespace)
+	ke := createEventing(t, namespace, writeManifest(t, "manifest.yaml",
+		deploymentYAML("integration-controller"), configMapYAML("config-kept"), configMapYAML("config-obsolete")))
+	markDeploymentAvailable(t, namespace, "integration-controller")
+	waitForEventing(t, ke, "the KnativeEventing to be ready", func(ke *v1beta1.KnativeEventing) bool {
+		return ke.Status.IsReady()
+	})
+
+	// The next version of the manifest drops a config map.
+	next := writeManifest(t, "next.yaml", deploymentYAML("integration-controller"), configMapYAML("config-kept"))
+	kes := operatorClient.OperatorV1beta1().KnativeEventings(namespace)
+	latest, err := kes.Get(context.Background(), ke.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get the KnativeEventing: %v", err)
+	}
+	latest.Spec.Manifests = []base.Manifest{{Url: next}}
+	if _, err := kes.Update(context.Background(), latest, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Failed to update the KnativeEventing: %v", err)
+	}
+	waitForEventing(t, ke, "the next manifest to be installed", func(ke *v1beta1.KnativeEventing) bool {
+		return ke.Status.IsReady() && ke.Status.ObservedGeneration == ke.Generation &&
+			slices.Equal(ke.Status.GetManifests(), []string{next})
+	})
+
+	configMaps := kubeClient.CoreV1().ConfigMaps(namespace)
+	waitFor(t, "the obsolete config map to be deleted", func(ctx context.Context) (bool, error) {
+		_, err := configMaps.Get(ctx, "config-obsolete", metav1.GetOptions{})
+		return apierrs.IsNotFound(err), nil
+	})
+	if _, err := configMaps.Get(context.Background(), "config-kept", metav1.GetOptions{}); err != nil {
+		t.Errorf("Failed to get the kept config map: %v", err)
+	}
+}
+
+func TestFinalizer(t *testing.T) {
+	startOperator(t, "")
+	const namespace = "finalizer"
+	createNamespace(t, namespace)
+	ke := createEventing(t, namespace, writeManifest(t, "manifest.yaml",
+		deploymentYAML("integration-controller"), configMapYAML("config-integration")))
+	markDeploymentAvailable(t, namespace, "integration-controller")
+	waitForEventing(t, ke, "the KnativeEventing to be ready", func(ke *v1beta1.KnativeEventing) bool {
+		return ke.Status.IsReady()
+	})
+
+	kes := operatorClient.OperatorV1beta1().KnativeEventings(namespace)
+	if err := kes.Delete(context.Background(), ke.Name, metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Failed to delete the KnativeEventing: %v", err)
+	}
+	// The API server runs no garbage collector, so the resources are gone only if the finalizer
+	// deleted them.
+	waitFor(t, "the KnativeEventing to be finalized", func(ctx context.Context) (bool, error) {
+		_, err := kes.Get(ctx, ke.Name, metav1.GetOptions{})
+		return apierrs.IsNotFound(err), nil
+	})
+	if _, err := kubeClient.AppsV1().Deployments(namespace).Get(context.Background(), "integration-controller", metav1.GetOptions{}); !apierrs.IsNotFound(err) {
+		t.Errorf("Get(deployment) = %v, want NotFound", err)
+	}
+	if _, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(context.Background(), "config-integration", metav1.GetOptions{}); !apierrs.IsNotFound(err) {
+		t.Errorf("Get(config map) = %v, want NotFound", err)
+	}
+}
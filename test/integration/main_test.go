@@ -0,0 +1,182 @@
+//go:build envtest
+// +build envtest
+
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package integration runs the reconcilers of the operator against a real API server of envtest,
+// which has neither a kubelet nor the controllers of kube-controller-manager.
+package integration
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	kubefilteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	"knative.dev/pkg/configmap/informer"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
+	_ "knative.dev/pkg/system/testing"
+
+	"knative.dev/operator/pkg/client/clientset/versioned"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/pkg/reconciler/knativeeventing"
+)
+
+const (
+	// pollInterval is the time between two checks of the state of the API server.
+	pollInterval = 100 * time.Millisecond
+	// pollTimeout is how long the state of the API server is waited for.
+	pollTimeout = 30 * time.Second
+)
+
+var (
+	restConfig     *rest.Config
+	kubeClient     kubernetes.Interface
+	operatorClient versioned.Interface
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		log.Print("Failed to start the API server, KUBEBUILDER_ASSETS must point to the binaries of setup-envtest: ", err)
+		return 1
+	}
+	defer func() {
+		if err := env.Stop(); err != nil {
+			log.Print("Failed to stop the API server: ", err)
+		}
+	}()
+	restConfig = cfg
+	kubeClient = kubernetes.NewForConfigOrDie(cfg)
+	operatorClient = versioned.NewForConfigOrDie(cfg)
+
+	if _, err := kubeClient.CoreV1().Namespaces().Create(context.Background(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: system.Namespace()},
+	}, metav1.CreateOptions{}); err != nil {
+		log.Print("Failed to create the namespace of the operator: ", err)
+		return 1
+	}
+	os.Setenv(common.KoEnvKey, filepath.Join("..", "..", "cmd", "operator", "kodata"))
+	return m.Run()
+}
+
+// startOperator runs the controller of the KnativeEventings against the API server until the test
+// ends, the way the operator runs it, with the feature gates in the config map config-operator.
+func startOperator(t *testing.T, featureGates string) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background(), zaptest.NewLogger(t).Sugar()))
+	setOperatorConfig(t, featureGates)
+
+	ctx = kubefilteredfactory.WithSelectors(ctx, knativeeventing.Selector)
+	ctx, ctors, err := common.WatchOperatorConfig(ctx, kubeClient, knativeeventing.NewController)
+	if err != nil {
+		t.Fatalf("WatchOperatorConfig() = %v", err)
+	}
+	ctx, informers := injection.Default.SetupInformers(ctx, restConfig)
+	cmw := informer.NewInformedWatcher(kubeClient, system.Namespace())
+	impls := make([]*controller.Impl, 0, len(ctors))
+	for _, ctor := range ctors {
+		impls = append(impls, ctor(ctx, cmw))
+	}
+	if err := cmw.Start(ctx.Done()); err != nil {
+		t.Fatalf("Failed to start the config map watcher: %v", err)
+	}
+	if err := controller.StartInformers(ctx.Done(), informers...); err != nil {
+		t.Fatalf("Failed to start the informers: %v", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := controller.StartAll(ctx, impls...); err != nil {
+			t.Errorf("Failed to run the controllers: %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// setOperatorConfig sets the feature gates in the config map config-operator.
+func setOperatorConfig(t *testing.T, featureGates string) {
+	t.Helper()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.OperatorConfigName, Namespace: system.Namespace()},
+		Data:       map[string]string{common.FeatureGatesKey: featureGates},
+	}
+	configMaps := kubeClient.CoreV1().ConfigMaps(system.Namespace())
+	_, err := configMaps.Update(context.Background(), cm, metav1.UpdateOptions{})
+	if apierrs.IsNotFound(err) {
+		_, err = configMaps.Create(context.Background(), cm, metav1.CreateOptions{})
+	}
+	if err != nil {
+		t.Fatalf("Failed to set the config map %s: %v", common.OperatorConfigName, err)
+	}
+}
+
+// createNamespace creates the namespace of the test. The API server does not delete namespaces, so
+// each test uses its own one.
+func createNamespace(t *testing.T, name string) {
+	t.Helper()
+	if _, err := kubeClient.CoreV1().Namespaces().Create(context.Background(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create the namespace %s: %v", name, err)
+	}
+}
+
+// writeManifest writes the YAML documents into a manifest file of the test and returns its path.
+func writeManifest(t *testing.T, name string, documents ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(strings.Join(documents, "\n---\n")), 0o600); err != nil {
+		t.Fatalf("Failed to write the manifest %s: %v", path, err)
+	}
+	return path
+}
+
+// waitFor polls the condition until it is done, or fails the test after the timeout.
+func waitFor(t *testing.T, desc string, condition func(ctx context.Context) (bool, error)) {
+	t.Helper()
+	if err := wait.PollUntilContextTimeout(context.Background(), pollInterval, pollTimeout, true, condition); err != nil {
+		t.Fatalf("Timed out waiting for %s: %v", desc, err)
+	}
+}
@@ -69,6 +69,27 @@ func EnsureKnativeEventingExists(clients eventingv1beta1.KnativeEventingInterfac
 	return ke, err
 }
 
+// EnsureKnativeEventingVersion creates a KnativeEventing with the name names.KnativeEventing under the namespace names.Namespace
+// at the version, or updates its spec.version to the version if it exists.
+func EnsureKnativeEventingVersion(clients eventingv1beta1.KnativeEventingInterface, names test.ResourceNames, version string) (*v1beta1.KnativeEventing, error) {
+	ke, err := clients.Get(context.TODO(), names.KnativeEventing, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		ke := &v1beta1.KnativeEventing{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      names.KnativeEventing,
+				Namespace: names.Namespace,
+			},
+		}
+		ke.Spec.Version = version
+		return clients.Create(context.TODO(), ke, metav1.CreateOptions{})
+	}
+	if err != nil || ke.Spec.Version == version {
+		return ke, err
+	}
+	ke.Spec.Version = version
+	return clients.Update(context.TODO(), ke, metav1.UpdateOptions{})
+}
+
 // IsKnativeEventingReady will check the status conditions of the KnativeEventing and return true if the KnativeEventing is ready.
 func IsKnativeEventingReady(s *v1beta1.KnativeEventing, err error) (bool, error) {
 	return s.Status.IsReady(), err
@@ -77,6 +77,28 @@ func EnsureKnativeServingExists(clients servingv1beta1.KnativeServingInterface,
 	return ks, err
 }
 
+// EnsureKnativeServingVersion creates a KnativeServing with the name names.KnativeServing under the namespace names.Namespace
+// at the version, or updates its spec.version to the version if it exists.
+func EnsureKnativeServingVersion(clients servingv1beta1.KnativeServingInterface, names test.ResourceNames, version string) (*v1beta1.KnativeServing, error) {
+	ks, err := clients.Get(context.TODO(), names.KnativeServing, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		ks := &v1beta1.KnativeServing{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      names.KnativeServing,
+				Namespace: names.Namespace,
+			},
+		}
+		ks.Spec.Version = version
+		configureIngressClass(&ks.Spec)
+		return clients.Create(context.TODO(), ks, metav1.CreateOptions{})
+	}
+	if err != nil || ks.Spec.Version == version {
+		return ks, err
+	}
+	ks.Spec.Version = version
+	return clients.Update(context.TODO(), ks, metav1.UpdateOptions{})
+}
+
 // WaitForConfigMap takes a condition function that evaluates ConfigMap data
 func WaitForConfigMap(name string, client kubernetes.Interface, fn func(map[string]string) bool) error {
 	ns, cm, _ := cache.SplitMetaNamespaceKey(name)
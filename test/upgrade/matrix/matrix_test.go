@@ -0,0 +1,187 @@
+//go:build upgrade
+// +build upgrade
+
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matrix
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/operator/pkg/apis/operator/base"
+	"knative.dev/operator/pkg/apis/operator/v1beta1"
+	"knative.dev/operator/pkg/reconciler/common"
+	"knative.dev/operator/test"
+	"knative.dev/operator/test/client"
+	"knative.dev/operator/test/resources"
+	pkgtest "knative.dev/pkg/test"
+	"knative.dev/pkg/test/spoof"
+	servingtest "knative.dev/serving/test"
+)
+
+const (
+	// workloadNamespace is the namespace of the sample workloads.
+	workloadNamespace = "upgrade-matrix"
+	// probeInterval is the time between two probes of the sample workloads.
+	probeInterval = 100 * time.Millisecond
+	// probeTimeout is the timeout of a request to the sample Knative Service.
+	probeTimeout = 5 * time.Second
+)
+
+// TestUpgradeMatrix installs Knative Serving and Knative Eventing at the version N-2, creates the sample
+// workloads, and upgrades through each minor version to HEAD. The sample workloads must neither change nor
+// fail a single probe during the upgrades.
+func TestUpgradeMatrix(t *testing.T) {
+	clients := client.Setup(t)
+	ctx := context.Background()
+
+	resources.SetKodataDir()
+	defer os.Unsetenv(common.KoEnvKey)
+	koData := os.Getenv(common.KoEnvKey)
+	servingVersions, err := Versions(filepath.Join(koData, "knative-serving"), test.OperatorFlags.UpgradeFrom)
+	if err != nil {
+		t.Fatalf("Failed to get the versions of Knative Serving: %v", err)
+	}
+	eventingVersions, err := Versions(filepath.Join(koData, "knative-eventing"), test.OperatorFlags.UpgradeFrom)
+	if err != nil {
+		t.Fatalf("Failed to get the versions of Knative Eventing: %v", err)
+	}
+	steps := Steps(servingVersions, eventingVersions)
+	t.Logf("Upgrading through %v", steps)
+
+	t.Logf("Installing %s", steps[0])
+	installStep(t, clients, steps[0])
+
+	if _, err := clients.KubeClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: workloadNamespace},
+	}, metav1.CreateOptions{}); err != nil && !apierrs.IsAlreadyExists(err) {
+		t.Fatalf("Failed to create the namespace %s: %v", workloadNamespace, err)
+	}
+	workloads := NewWorkloads(clients.Dynamic, workloadNamespace)
+	if err := workloads.Create(ctx, test.OperatorFlags.SampleImage); err != nil {
+		t.Fatalf("Failed to create the sample workloads: %v", err)
+	}
+	want, err := workloads.WaitReady(ctx, resources.Interval, resources.Timeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, err := url.Parse(want.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse the URL %q of the sample Knative Service: %v", want.URL, err)
+	}
+
+	// On kind, the ingress is reached through the node port of the flag --ingressendpoint, on a real cluster
+	// through its load balancer, or directly if the flag --resolvabledomain is set.
+	sc, err := spoof.New(ctx, clients.KubeClient, t.Logf, target.Hostname(), servingtest.ServingFlags.ResolvableDomain,
+		pkgtest.Flags.IngressEndpoint, pkgtest.Flags.SpoofRequestInterval, pkgtest.Flags.SpoofRequestTimeout)
+	if err != nil {
+		t.Fatalf("Failed to create the client of the sample Knative Service: %v", err)
+	}
+	if _, err := sc.WaitForEndpointState(ctx, target, spoof.IsStatusOK, "UpgradeMatrixServiceServes"); err != nil {
+		t.Fatalf("The sample Knative Service does not serve: %v", err)
+	}
+
+	dataPlane := StartProber(ctx, probeInterval, HTTPProbe(sc.Client, target, probeTimeout))
+	readiness := StartProber(ctx, probeInterval, workloads.Ready)
+	for _, step := range steps[1:] {
+		t.Logf("Upgrading to %s", step)
+		installStep(t, clients, step)
+		got, err := workloads.WaitReady(ctx, resources.Interval, resources.Timeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("The sample workloads changed with the upgrade to %s (-want, +got): %s", step, diff)
+		}
+	}
+	assertNoDowntime(t, "sample Knative Service", dataPlane.Stop())
+	assertNoDowntime(t, "readiness of the sample workloads", readiness.Stop())
+
+	if !t.Failed() {
+		if err := workloads.Delete(ctx); err != nil {
+			t.Errorf("Failed to delete the sample workloads: %v", err)
+		}
+	}
+}
+
+// installStep sets the versions of the step on the KnativeServing and the KnativeEventing, and waits until
+// they and their deployments are ready at these versions.
+func installStep(t *testing.T, clients *test.Clients, step Step) {
+	t.Helper()
+	servingNames := test.ResourceNames{
+		KnativeServing: test.OperatorName,
+		Namespace:      test.ServingOperatorNamespace,
+	}
+	eventingNames := test.ResourceNames{
+		KnativeEventing: test.OperatorName,
+		Namespace:       test.EventingOperatorNamespace,
+	}
+	if _, err := resources.EnsureKnativeServingVersion(clients.KnativeServing(), servingNames, step.Serving); err != nil {
+		t.Fatalf("Failed to set the KnativeServing %q to the version %s: %v", servingNames.KnativeServing, step.Serving, err)
+	}
+	if _, err := resources.EnsureKnativeEventingVersion(clients.KnativeEventing(), eventingNames, step.Eventing); err != nil {
+		t.Fatalf("Failed to set the KnativeEventing %q to the version %s: %v", eventingNames.KnativeEventing, step.Eventing, err)
+	}
+
+	ks := &v1beta1.KnativeServing{Spec: v1beta1.KnativeServingSpec{CommonSpec: base.CommonSpec{Version: step.Serving}}}
+	servingRelease := common.GetLatestRelease(ks, step.Serving)
+	if _, err := resources.WaitForKnativeServingState(clients.KnativeServing(), servingNames.KnativeServing,
+		func(s *v1beta1.KnativeServing, err error) (bool, error) {
+			return s.Status.Version == servingRelease && s.Status.IsReady(), err
+		}); err != nil {
+		t.Fatalf("KnativeServing %q failed to get ready at the version %s: %v", servingNames.KnativeServing, servingRelease, err)
+	}
+	assertDeployments(t, clients, ks, servingNames.Namespace, servingRelease)
+
+	ke := &v1beta1.KnativeEventing{Spec: v1beta1.KnativeEventingSpec{CommonSpec: base.CommonSpec{Version: step.Eventing}}}
+	eventingRelease := common.GetLatestRelease(ke, step.Eventing)
+	if _, err := resources.WaitForKnativeEventingState(clients.KnativeEventing(), eventingNames.KnativeEventing,
+		func(s *v1beta1.KnativeEventing, err error) (bool, error) {
+			return s.Status.Version == eventingRelease && s.Status.IsReady(), err
+		}); err != nil {
+		t.Fatalf("KnativeEventing %q failed to get ready at the version %s: %v", eventingNames.KnativeEventing, eventingRelease, err)
+	}
+	assertDeployments(t, clients, ke, eventingNames.Namespace, eventingRelease)
+}
+
+// assertDeployments verifies that the deployments of the component are ready at the release.
+func assertDeployments(t *testing.T, clients *test.Clients, instance base.KComponent, namespace, release string) {
+	t.Helper()
+	manifest, err := common.TargetManifest(instance)
+	if err != nil {
+		t.Fatalf("Failed to get the manifest of the version %s: %v", release, err)
+	}
+	resources.AssertKnativeDeploymentStatus(t, clients, namespace, release, "", resources.GetExpectedDeployments(manifest))
+}
+
+func assertNoDowntime(t *testing.T, name string, result ProbeResult) {
+	t.Helper()
+	t.Logf("%d of %d probes of the %s failed", result.Failures, result.Probes, name)
+	if result.Failures > 0 {
+		t.Errorf("The %s was down during the upgrades, the first failures: %v", name, result.Errors)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matrix
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// maxProbeErrors is how many errors of the failed probes are kept to be reported.
+const maxProbeErrors = 10
+
+// Probe checks once whether a workload serves.
+type Probe func(ctx context.Context) error
+
+// ProbeResult sums up the probes of a Prober.
+type ProbeResult struct {
+	Probes   int
+	Failures int
+	// Errors are the errors of the first failed probes.
+	Errors []error
+}
+
+// Prober runs a Probe continuously in the background, until it is stopped.
+type Prober struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu     sync.Mutex
+	result ProbeResult
+}
+
+// StartProber runs the probe every interval, until the prober is stopped.
+func StartProber(ctx context.Context, interval time.Duration, probe Probe) *Prober {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Prober{cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			err := probe(ctx)
+			if ctx.Err() != nil {
+				// The probe was interrupted by the stop.
+				return
+			}
+			p.record(err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return p
+}
+
+func (p *Prober) record(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.result.Probes++
+	if err == nil {
+		return
+	}
+	p.result.Failures++
+	if len(p.result.Errors) < maxProbeErrors {
+		p.result.Errors = append(p.result.Errors, fmt.Errorf("%s: %w", time.Now().Format(time.RFC3339Nano), err))
+	}
+}
+
+// Stop stops the probes and returns their result.
+func (p *Prober) Stop() ProbeResult {
+	p.cancel()
+	<-p.done
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.result
+}
+
+// HTTPProbe returns a probe sending a GET request to the target, which succeeds if it gets the status OK
+// within the timeout.
+func HTTPProbe(client *http.Client, target *url.URL, timeout time.Duration) Probe {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		// Read the body, so that the connection is reused.
+		if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%s answered with the status %s", target, resp.Status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matrix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestProber(t *testing.T) {
+	var probes atomic.Int32
+	prober := StartProber(context.Background(), time.Millisecond, func(context.Context) error {
+		if probes.Add(1)%2 == 0 {
+			return errors.New("down")
+		}
+		return nil
+	})
+	for probes.Load() < 2*maxProbeErrors+4 {
+		time.Sleep(time.Millisecond)
+	}
+	result := prober.Stop()
+	util.AssertEqual(t, result.Probes >= 2*maxProbeErrors+2, true)
+	util.AssertEqual(t, result.Failures, result.Probes/2)
+	util.AssertEqual(t, len(result.Errors), maxProbeErrors)
+}
+
+func TestHTTPProbe(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer server.Close()
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("Parse() = %v", err)
+	}
+
+	probe := HTTPProbe(server.Client(), target, time.Second)
+	util.AssertEqual(t, probe(context.Background()), nil)
+	status.Store(http.StatusServiceUnavailable)
+	util.AssertEqual(t, probe(context.Background()) != nil, true)
+}
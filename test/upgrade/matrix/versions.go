@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package matrix upgrades Knative Serving and Knative Eventing through each of their minor versions, from
+// the oldest supported one to HEAD, while sample workloads keep serving.
+package matrix
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"golang.org/x/mod/semver"
+
+	"knative.dev/operator/pkg/reconciler/common"
+)
+
+// DefaultUpgradeSteps is how many minor versions before the latest one the upgrade starts from, i.e. N-2.
+const DefaultUpgradeSteps = 2
+
+// Versions returns the minor versions of the releases under the kodata directory of a component, which
+// the upgrade goes through in order. It starts from the minor version from, or DefaultUpgradeSteps minor
+// versions before the latest one if from is empty. The version latest, downloaded by the test scripts for
+// HEAD of Knative, comes last if it is available.
+func Versions(dir, from string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	releases := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			releases = append(releases, entry.Name())
+		}
+	}
+	return versionsOf(releases, from)
+}
+
+func versionsOf(releases []string, from string) ([]string, error) {
+	var minors []string
+	latest := false
+	seen := make(map[string]bool, len(releases))
+	for _, release := range releases {
+		if release == common.LATEST_VERSION {
+			latest = true
+			continue
+		}
+		minor := semver.MajorMinor(common.SanitizeSemver(release))
+		if minor == "" || seen[minor] {
+			continue
+		}
+		seen[minor] = true
+		minors = append(minors, minor)
+	}
+	if len(minors) == 0 {
+		return nil, fmt.Errorf("no release found among %v", releases)
+	}
+	sort.Slice(minors, func(i, j int) bool {
+		return semver.Compare(minors[i], minors[j]) < 0
+	})
+
+	start := len(minors) - 1 - DefaultUpgradeSteps
+	if from != "" {
+		start = -1
+		for i, minor := range minors {
+			if minor == semver.MajorMinor(common.SanitizeSemver(from)) {
+				start = i
+			}
+		}
+		if start < 0 {
+			return nil, fmt.Errorf("the version %s to upgrade from is not among the releases %v", from, releases)
+		}
+	}
+	if start < 0 {
+		start = 0
+	}
+
+	versions := make([]string, 0, len(minors)-start+1)
+	for _, minor := range minors[start:] {
+		// The operator takes the versions without the prefix v.
+		versions = append(versions, minor[1:])
+	}
+	if latest {
+		versions = append(versions, common.LATEST_VERSION)
+	}
+	return versions, nil
+}
+
+// Step is a version of Knative Serving and Knative Eventing the upgrade goes through.
+type Step struct {
+	Serving  string
+	Eventing string
+}
+
+func (s Step) String() string {
+	return fmt.Sprintf("Knative Serving %s and Knative Eventing %s", s.Serving, s.Eventing)
+}
+
+// Steps pairs the versions of Knative Serving and Knative Eventing, so that their last versions are upgraded
+// to together. The component with fewer versions stays at its first version in the first steps.
+func Steps(serving, eventing []string) []Step {
+	n := len(serving)
+	if len(eventing) > n {
+		n = len(eventing)
+	}
+	steps := make([]Step, 0, n)
+	for i := 0; i < n; i++ {
+		steps = append(steps, Step{
+			Serving:  serving[max(0, i-n+len(serving))],
+			Eventing: eventing[max(0, i-n+len(eventing))],
+		})
+	}
+	return steps
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matrix
+
+import (
+	"testing"
+
+	util "knative.dev/operator/pkg/reconciler/common/testing"
+)
+
+func TestVersions(t *testing.T) {
+	releases := []string{"1.20.1", "1.18.0", "1.21.0", "1.19.2", "1.20.0", "1.21.1", "1.19.0"}
+	tests := []struct {
+		name     string
+		releases []string
+		from     string
+		want     []string
+		wantErr  bool
+	}{{
+		name:     "from N-2",
+		releases: releases,
+		want:     []string{"1.19", "1.20", "1.21"},
+	}, {
+		name:     "from a minor version",
+		releases: releases,
+		from:     "1.18",
+		want:     []string{"1.18", "1.19", "1.20", "1.21"},
+	}, {
+		name:     "from a patch version",
+		releases: releases,
+		from:     "v1.20.1",
+		want:     []string{"1.20", "1.21"},
+	}, {
+		name:     "to HEAD",
+		releases: append([]string{"latest"}, releases...),
+		want:     []string{"1.19", "1.20", "1.21", "latest"},
+	}, {
+		name:     "fewer releases",
+		releases: []string{"1.21.0"},
+		want:     []string{"1.21"},
+	}, {
+		name:     "unknown version",
+		releases: releases,
+		from:     "1.17",
+		wantErr:  true,
+	}, {
+		name:     "no release",
+		releases: []string{"latest"},
+		wantErr:  true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := versionsOf(tt.releases, tt.from)
+			util.AssertEqual(t, err != nil, tt.wantErr)
+			util.AssertDeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestVersionsOfKodata(t *testing.T) {
+	versions, err := Versions("../../../cmd/operator/kodata/knative-serving", "")
+	if err != nil {
+		t.Fatalf("Versions() = %v", err)
+	}
+	util.AssertEqual(t, len(versions), DefaultUpgradeSteps+1)
+}
+
+func TestSteps(t *testing.T) {
+	got := Steps([]string{"1.19", "1.20", "1.21", "latest"}, []string{"1.20", "1.21", "latest"})
+	util.AssertDeepEqual(t, got, []Step{
+		{Serving: "1.19", Eventing: "1.20"},
+		{Serving: "1.20", Eventing: "1.20"},
+		{Serving: "1.21", Eventing: "1.21"},
+		{Serving: "latest", Eventing: "latest"},
+	})
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matrix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+// WorkloadName is the name of the sample Knative Service, Broker and Trigger.
+const WorkloadName = "upgrade-matrix"
+
+var (
+	serviceGVR = schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1", Resource: "services"}
+	brokerGVR  = schema.GroupVersionResource{Group: "eventing.knative.dev", Version: "v1", Resource: "brokers"}
+	triggerGVR = schema.GroupVersionResource{Group: "eventing.knative.dev", Version: "v1", Resource: "triggers"}
+)
+
+// Snapshot holds what must not change in the sample workloads during the upgrades: they are neither
+// recreated, nor rolled out to a new revision, nor moved to a different address.
+type Snapshot struct {
+	ServiceUID    string
+	Revision      string
+	URL           string
+	BrokerUID     string
+	BrokerAddress string
+	TriggerUID    string
+	SubscriberURI string
+}
+
+// Workloads are a sample Knative Service with a Broker and a Trigger delivering the events to it.
+type Workloads struct {
+	client    dynamic.Interface
+	namespace string
+}
+
+// NewWorkloads returns the sample workloads in the namespace.
+func NewWorkloads(client dynamic.Interface, namespace string) *Workloads {
+	return &Workloads{client: client, namespace: namespace}
+}
+
+// Create creates the sample workloads, with the Knative Service running the image.
+func (w *Workloads) Create(ctx context.Context, image string) error {
+	service := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "serving.knative.dev/v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": WorkloadName, "namespace": w.namespace},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					// Keep a pod, so that the probes measure the upgrade and not a cold start.
+					"annotations": map[string]interface{}{"autoscaling.knative.dev/min-scale": "1"},
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"image": image}},
+				},
+			},
+		},
+	}}
+	broker := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "eventing.knative.dev/v1",
+		"kind":       "Broker",
+		"metadata":   map[string]interface{}{"name": WorkloadName, "namespace": w.namespace},
+	}}
+	trigger := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "eventing.knative.dev/v1",
+		"kind":       "Trigger",
+		"metadata":   map[string]interface{}{"name": WorkloadName, "namespace": w.namespace},
+		"spec": map[string]interface{}{
+			"broker": WorkloadName,
+			"subscriber": map[string]interface{}{
+				"ref": map[string]interface{}{
+					"apiVersion": "serving.knative.dev/v1",
+					"kind":       "Service",
+					"name":       WorkloadName,
+				},
+			},
+		},
+	}}
+	for _, obj := range []struct {
+		gvr schema.GroupVersionResource
+		u   *unstructured.Unstructured
+	}{{serviceGVR, service}, {brokerGVR, broker}, {triggerGVR, trigger}} {
+		_, err := w.client.Resource(obj.gvr).Namespace(w.namespace).Create(ctx, obj.u, metav1.CreateOptions{})
+		if err != nil && !apierrs.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create the %s %s: %w", obj.u.GetKind(), WorkloadName, err)
+		}
+	}
+	return nil
+}
+
+// WaitReady waits until all the sample workloads are ready and returns their snapshot.
+func (w *Workloads) WaitReady(ctx context.Context, interval, timeout time.Duration) (Snapshot, error) {
+	var snapshot Snapshot
+	var lastErr error
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		snapshot, lastErr = w.snapshot(ctx)
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		return snapshot, fmt.Errorf("the sample workloads are not ready: %w: %w", lastErr, err)
+	}
+	return snapshot, nil
+}
+
+// Ready is a probe, which succeeds if all the sample workloads are ready.
+func (w *Workloads) Ready(ctx context.Context) error {
+	_, err := w.snapshot(ctx)
+	return err
+}
+
+func (w *Workloads) snapshot(ctx context.Context) (Snapshot, error) {
+	service, err := w.ready(ctx, serviceGVR)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	broker, err := w.ready(ctx, brokerGVR)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	trigger, err := w.ready(ctx, triggerGVR)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	revision, _, _ := unstructured.NestedString(service.Object, "status", "latestReadyRevisionName")
+	url, _, _ := unstructured.NestedString(service.Object, "status", "url")
+	address, _, _ := unstructured.NestedString(broker.Object, "status", "address", "url")
+	subscriber, _, _ := unstructured.NestedString(trigger.Object, "status", "subscriberUri")
+	return Snapshot{
+		ServiceUID:    string(service.GetUID()),
+		Revision:      revision,
+		URL:           url,
+		BrokerUID:     string(broker.GetUID()),
+		BrokerAddress: address,
+		TriggerUID:    string(trigger.GetUID()),
+		SubscriberURI: subscriber,
+	}, nil
+}
+
+// ready returns the sample workload of the resource, if its latest generation is ready.
+func (w *Workloads) ready(ctx context.Context, gvr schema.GroupVersionResource) (*unstructured.Unstructured, error) {
+	u, err := w.client.Resource(gvr).Namespace(w.namespace).Get(ctx, WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	observed, _, _ := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+	if observed != u.GetGeneration() {
+		return nil, fmt.Errorf("the %s %s has not observed its generation %d yet", u.GetKind(), WorkloadName, u.GetGeneration())
+	}
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, _ := c.(map[string]interface{})
+		if condition["type"] != "Ready" {
+			continue
+		}
+		if condition["status"] == string(metav1.ConditionTrue) {
+			return u, nil
+		}
+		return nil, fmt.Errorf("the %s %s is not ready: %v", u.GetKind(), WorkloadName, condition["message"])
+	}
+	return nil, fmt.Errorf("the %s %s has no Ready condition", u.GetKind(), WorkloadName)
+}
+
+// Delete deletes the sample workloads.
+func (w *Workloads) Delete(ctx context.Context) error {
+	for _, gvr := range []schema.GroupVersionResource{triggerGVR, brokerGVR, serviceGVR} {
+		err := w.client.Resource(gvr).Namespace(w.namespace).Delete(ctx, WorkloadName, metav1.DeleteOptions{})
+		if err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}